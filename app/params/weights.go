@@ -41,6 +41,41 @@ const (
 	DefaultWeightMsgAddAccess                       int = 10
 	DefaultWeightMsgMintMarker                      int = 67
 	DefaultWeightMsgBurnMarker                      int = 67
+	// Metadata
+	DefaultWeightMsgWriteScopeSpecification         int = 20
+	DefaultWeightMsgDeleteScopeSpecification        int = 3
+	DefaultWeightMsgWriteContractSpecification      int = 20
+	DefaultWeightMsgDeleteContractSpecification     int = 3
+	DefaultWeightMsgAddContractSpecToScopeSpec      int = 10
+	DefaultWeightMsgDeleteContractSpecFromScopeSpec int = 3
+	DefaultWeightMsgWriteRecordSpecification        int = 20
+	DefaultWeightMsgDeleteRecordSpecification       int = 3
+	DefaultWeightMsgWriteRecordType                 int = 10
+	DefaultWeightMsgDeleteRecordType                int = 3
+	DefaultWeightMsgWriteScope                      int = 30
+	DefaultWeightMsgDeleteScope                     int = 5
+	DefaultWeightMsgAddScopeDataAccess              int = 10
+	DefaultWeightMsgDeleteScopeDataAccess           int = 5
+	DefaultWeightMsgAddScopeOwner                   int = 10
+	DefaultWeightMsgDeleteScopeOwner                int = 5
+	DefaultWeightMsgLockScope                       int = 5
+	DefaultWeightMsgUnlockScope                     int = 5
+	DefaultWeightMsgTokenizeScope                   int = 5
+	DefaultWeightMsgDetokenizeScope                 int = 5
+	DefaultWeightMsgCloneScope                      int = 5
+	DefaultWeightMsgOfferValueOwnerTransfer         int = 5
+	DefaultWeightMsgAcceptValueOwnerTransfer        int = 5
+	DefaultWeightMsgCancelValueOwnerTransfer        int = 3
+	DefaultWeightMsgMigrateScopeSpec                int = 5
+	DefaultWeightMsgWriteSession                    int = 25
+	DefaultWeightMsgWriteRecord                     int = 25
+	DefaultWeightMsgDeleteRecord                    int = 5
+	DefaultWeightMsgExecuteContractSession          int = 10
+	DefaultWeightMsgWriteP8eContractSpec            int = 5
+	DefaultWeightMsgP8eMemorializeContract          int = 10
+	DefaultWeightMsgBindOSLocator                   int = 10
+	DefaultWeightMsgModifyOSLocator                 int = 5
+	DefaultWeightMsgDeleteOSLocator                 int = 3
 	// MsgFees
 	DefaultWeightAddMsgFeeProposalContent    int = 75
 	DefaultWeightRemoveMsgFeeProposalContent int = 25
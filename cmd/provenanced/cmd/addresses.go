@@ -2,7 +2,8 @@ package cmd
 
 import (
 	"fmt"
-	"regexp"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
+	"github.com/provenance-io/provenance/x/metadata/metaaddress"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -17,6 +19,15 @@ var (
 	cmdStart = fmt.Sprintf("%s metaaddress", version.AppName)
 )
 
+const (
+	// FlagBatch is the flag for the file to read batch input from.
+	FlagBatch = "batch"
+	// FlagCSV is the flag indicating that batch input is CSV.
+	FlagCSV = "csv"
+	// FlagFormat is the flag for the batch output format.
+	FlagFormat = "format"
+)
+
 // GetQueryCmd is the top-level command for name CLI queries.
 func AddMetaAddressCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -31,6 +42,7 @@ func AddMetaAddressCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		AddMetaAddressEncoder(),
 		AddMetaAddressDecoder(),
+		AddMetaAddressDeriver(),
 	)
 
 	return queryCmd
@@ -42,9 +54,21 @@ func AddMetaAddressDecoder() *cobra.Command {
 		Use:     "decode [address]",
 		Aliases: []string{"d"},
 		Short:   "Decode MetadataAddress and display associate IDs and types",
-		Example: fmt.Sprintf("%[1]s decode scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", cmdStart),
-		Args:    cobra.ExactArgs(1),
+		Long: fmt.Sprintf(`Decode MetadataAddress and display associate IDs and types.
+
+%[1]s decode address
+%[1]s decode --batch file [--csv] [--format json|csv]
+
+With --batch, addresses are read from the file (or stdin if the file is -), and the details of each are output.
+The input has any number of addresses per line, separated by whitespace, or by commas if --csv is provided.
+Each address is output as a line of JSON, or as a CSV row with --format csv.`, cmdStart),
+		Example: fmt.Sprintf(`%[1]s decode scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s decode --batch addresses.txt --format csv`, cmdStart),
+		Args: batchOrArgs(cobra.ExactArgs(1)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(FlagBatch) {
+				return runBatch(cmd, metaaddress.DecodeAll)
+			}
 			addr, parseErr := types.MetadataAddressFromBech32(args[0])
 			if parseErr != nil {
 				return parseErr
@@ -95,6 +119,7 @@ Excess (hex): %s
 			return cmdErr
 		},
 	}
+	addBatchFlags(cmd)
 	return cmd
 }
 
@@ -114,70 +139,145 @@ The third [uuid|name] argument is either required or forbidden based on the type
 
 These types forbid a third argument: scope scope-specification contract-specification
 These types require a third argument: session record record-specification
-This type requires the third argument to be a UUID: session`, cmdStart),
+This type requires the third argument to be a UUID: session
+
+With --batch, rows of "type uuid [uuid|name]" are read from the file (or stdin if the file is -), and the details of
+each encoded address are output. The fields of each row are separated by whitespace, or by commas if --csv is
+provided. Use --csv for names containing spaces. Each address is output as a line of JSON, or as a CSV row with
+--format csv.`, cmdStart),
 		Example: fmt.Sprintf(`%[1]s encode scope 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s encode session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
 %[1]s encode record 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
 %[1]s encode scope-specification dc83ea70-eacd-40fe-9adf-1cf6148bf8a2
 %[1]s encode contract-specification def6bc0a-c9dd-4874-948f-5206e6060a84
-%[1]s encode record-specification def6bc0a-c9dd-4874-948f-5206e6060a84 recordname`, cmdStart),
-		Args: cobra.RangeArgs(2, 3),
+%[1]s encode record-specification def6bc0a-c9dd-4874-948f-5206e6060a84 recordname
+%[1]s encode --batch ids.csv --csv --format csv`, cmdStart),
+		Args: batchOrArgs(cobra.RangeArgs(2, 3)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			addrType := strings.ToLower(regexp.MustCompile("[^[:alpha:]]+").ReplaceAllString(args[0], ""))
+			if cmd.Flags().Changed(FlagBatch) {
+				return runBatch(cmd, metaaddress.EncodeAll)
+			}
 			primaryUUID, err := uuid.Parse(args[1])
 			if err != nil {
 				return err
 			}
 			var uuidOrNameArg string
-			argsLen := len(args)
-			if argsLen == 3 {
-				uuidOrNameArg = strings.TrimSpace(args[2])
-				if len(uuidOrNameArg) == 0 {
-					argsLen--
-				}
+			if len(args) == 3 {
+				uuidOrNameArg = args[2]
 			}
-			var addr types.MetadataAddress
-			switch addrType {
-			case "scope":
-				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", addrType)
-				}
-				addr = types.ScopeMetadataAddress(primaryUUID)
-			case "session":
-				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", addrType)
-				}
-				secondaryUUID, err := uuid.Parse(uuidOrNameArg)
-				if err != nil {
-					return err
-				}
-				addr = types.SessionMetadataAddress(primaryUUID, secondaryUUID)
-			case "record":
-				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", addrType)
-				}
-				addr = types.RecordMetadataAddress(primaryUUID, uuidOrNameArg)
-			case "scopespecification", "scopespec":
-				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", "scope-specification")
-				}
-				addr = types.ScopeSpecMetadataAddress(primaryUUID)
-			case "contractspecification", "contractspec", "cspec":
-				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", "contract-specification")
-				}
-				addr = types.ContractSpecMetadataAddress(primaryUUID)
-			case "recordspecification", "recordspec", "recspec":
-				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", "record-specification")
-				}
-				addr = types.RecordSpecMetadataAddress(primaryUUID, uuidOrNameArg)
-			default:
-				return fmt.Errorf("unknown type: %s, Supported types: scope session record scope-specification contract-specification record-specification", args[0])
+			addr, err := metaaddress.Encode(args[0], primaryUUID, uuidOrNameArg)
+			if err != nil {
+				return err
 			}
 			_, cmdErr := fmt.Fprintf(cmd.OutOrStdout(), "%s\n", addr)
 			return cmdErr
 		},
 	}
+	addBatchFlags(cmd)
 	return cmd
 }
+
+// AddMetaAddressDeriver returns the metadata address deriver cobra Command.
+func AddMetaAddressDeriver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive [type] [uuid] [uuid|name ...]",
+		Short: "Derives the bech32 addresses of a scope or contract specification and its children",
+		Long: fmt.Sprintf(`Derives the bech32 addresses of a scope or contract specification and its children.
+
+%[1]s derive scope scope-uuid [session-uuid|record-name ...]
+%[1]s derive contract-specification contract-spec-uuid [record-spec-name ...]
+%[1]s derive --batch file [--csv] [--format json|csv]
+
+For a scope, each value that is a UUID is a session uuid, and the others are record names.
+For a contract specification, each value is a record specification name.
+
+With --batch, rows with the same arguments are read from the file (or stdin if the file is -).
+The fields of each row are separated by whitespace, or by commas if --csv is provided.
+Each address is output as a line of JSON, or as a CSV row with --format csv.`, cmdStart),
+		Example: fmt.Sprintf(`%[1]s derive scope 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0 recordname
+%[1]s derive contract-specification def6bc0a-c9dd-4874-948f-5206e6060a84 recordname othername
+%[1]s derive --batch scopes.csv --csv --format csv`, cmdStart),
+		Args: batchOrArgs(cobra.MinimumNArgs(2)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(FlagBatch) {
+				return runBatch(cmd, metaaddress.DeriveAll)
+			}
+			primaryUUID, err := uuid.Parse(args[1])
+			if err != nil {
+				return err
+			}
+			entries, err := metaaddress.Derive(args[0], primaryUUID, args[2:])
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			w, err := metaaddress.NewEntryWriter(cmd.OutOrStdout(), format)
+			if err != nil {
+				return err
+			}
+			if err = w.Write(entries...); err != nil {
+				return err
+			}
+			return w.Flush()
+		},
+	}
+	addBatchFlags(cmd)
+	return cmd
+}
+
+// addBatchFlags adds the flags used for batch input and output to a command.
+func addBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBatch, "", "read input rows from this file (- for stdin) instead of the arguments")
+	cmd.Flags().Bool(FlagCSV, false, "the batch input is CSV instead of whitespace-separated fields")
+	cmd.Flags().String(FlagFormat, metaaddress.FormatJSON, "the batch output format, json (one object per line) or csv")
+}
+
+// batchOrArgs returns an args validator that requires no arguments with --batch, or the given validator otherwise.
+func batchOrArgs(validator cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed(FlagBatch) {
+			return cobra.NoArgs(cmd, args)
+		}
+		return validator(cmd, args)
+	}
+}
+
+// runBatch reads rows from the --batch input and writes the entries made by process in the --format format.
+func runBatch(cmd *cobra.Command, process func(*metaaddress.RowReader, *metaaddress.EntryWriter) error) error {
+	flagSet := cmd.Flags()
+	batch, err := flagSet.GetString(FlagBatch)
+	if err != nil {
+		return err
+	}
+	csvInput, err := flagSet.GetBool(FlagCSV)
+	if err != nil {
+		return err
+	}
+	format, err := flagSet.GetString(FlagFormat)
+	if err != nil {
+		return err
+	}
+	w, err := metaaddress.NewEntryWriter(cmd.OutOrStdout(), format)
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader
+	switch strings.TrimSpace(batch) {
+	case "":
+		return fmt.Errorf("no --%s file provided", FlagBatch)
+	case "-":
+		in = cmd.InOrStdin()
+	default:
+		file, err := os.Open(batch)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+	return process(metaaddress.NewRowReader(in, csvInput), w)
+}
@@ -5,9 +5,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -384,3 +387,182 @@ func (s MetaaddressTestSuite) TestAddMetaAddressEncoder() {
 		})
 	}
 }
+
+func (s MetaaddressTestSuite) TestAddMetaAddressDeriver() {
+	tests := []struct {
+		name     string
+		args     []string
+		inResult []string
+		err      string
+	}{
+		{
+			name: "one arg",
+			args: []string{"scope"},
+			err:  "requires at least 2 arg(s), only received 1",
+		},
+		{
+			name: "invalid primary uuid",
+			args: []string{"scope", "not-a-uuid"},
+			err:  "invalid UUID length: 10",
+		},
+		{
+			name: "unsupported type",
+			args: []string{"session", s.scopeUUIDStr, s.sessionUUIDStr},
+			err:  "cannot derive ids from a session; only scope and contract-specification are supported",
+		},
+		{
+			name:     "scope only",
+			args:     []string{"scope", s.scopeUUIDStr},
+			inResult: []string{s.scopeIDStr},
+		},
+		{
+			name: "scope with session and record",
+			args: []string{"scope", s.scopeUUIDStr, s.sessionUUIDStr, s.recordName},
+			inResult: []string{
+				fmt.Sprintf(`"address":"%s"`, s.scopeIDStr),
+				fmt.Sprintf(`"address":"%s"`, s.sessionIDStr),
+				fmt.Sprintf(`"secondary_uuid":"%s"`, s.sessionUUIDStr),
+				fmt.Sprintf(`"address":"%s"`, s.recordIDStr),
+				fmt.Sprintf(`"name":"%s"`, s.recordName),
+				fmt.Sprintf(`"parent_address":"%s"`, s.scopeIDStr),
+			},
+		},
+		{
+			name: "contract spec with record spec as csv",
+			args: []string{"cspec", s.contractSpecUUIDStr, s.recordName, "--format", "csv"},
+			inResult: []string{
+				"type,address,primary_uuid,secondary_uuid,name,name_hash_hex,parent_address\n",
+				fmt.Sprintf("contract-specification,%s,%s,,,,\n", s.contractSpecIDStr, s.contractSpecUUIDStr),
+				fmt.Sprintf("record-specification,%s,%s,,%s,%s,%s\n",
+					s.recordSpecIDStr, s.contractSpecUUIDStr, s.recordName, s.recordNameHashedHex, s.contractSpecIDStr),
+			},
+		},
+		{
+			name: "unknown format",
+			args: []string{"scope", s.scopeUUIDStr, "--format", "xml"},
+			err:  "unknown format: xml, Supported formats: json csv",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			command := cmd.AddMetaAddressDeriver()
+			command.SetArgs(tc.args)
+			b := bytes.NewBufferString("")
+			command.SetOut(b)
+			err := command.Execute()
+			if len(tc.err) > 0 {
+				require.EqualErrorf(t, err, tc.err, "%s - expected error", command.Name())
+			} else {
+				require.NoErrorf(t, err, "%s - unexpected error", command.Name())
+				outStr := b.String()
+				for _, str := range tc.inResult {
+					assert.Containsf(t, outStr, str, "%s - expected value to be in output", command.Name())
+				}
+			}
+		})
+	}
+}
+
+func (s MetaaddressTestSuite) TestMetaAddressBatch() {
+	batchFile := filepath.Join(s.T().TempDir(), "ids.csv")
+	require.NoError(s.T(), ioutil.WriteFile(batchFile, []byte(fmt.Sprintf(
+		"# type,uuid,name\nscope,%[1]s\nrecord,%[1]s,%[2]s\n\nrecord-spec,%[3]s,%[2]s\n",
+		s.scopeUUIDStr, s.recordName, s.contractSpecUUIDStr)), 0o600), "writing batch file")
+
+	tests := []struct {
+		name     string
+		command  func() *cobra.Command
+		args     []string
+		stdin    string
+		expected string
+		err      string
+	}{
+		{
+			name:    "encode batch with args",
+			command: cmd.AddMetaAddressEncoder,
+			args:    []string{"scope", s.scopeUUIDStr, "--batch", "-"},
+			err:     `unknown command "scope" for "encode"`,
+		},
+		{
+			name:    "encode missing batch file",
+			command: cmd.AddMetaAddressEncoder,
+			args:    []string{"--batch", filepath.Join(s.T().TempDir(), "missing.txt")},
+			err:     "no such file or directory",
+		},
+		{
+			name:    "encode csv file",
+			command: cmd.AddMetaAddressEncoder,
+			args:    []string{"--batch", batchFile, "--csv", "--format", "csv"},
+			expected: fmt.Sprintf("type,address,primary_uuid,secondary_uuid,name,name_hash_hex,parent_address\n"+
+				"scope,%[1]s,%[2]s,,,,\n"+
+				"record,%[3]s,%[2]s,,%[4]s,%[5]s,%[1]s\n"+
+				"record-specification,%[6]s,%[7]s,,%[4]s,%[5]s,%[8]s\n",
+				s.scopeIDStr, s.scopeUUIDStr, s.recordIDStr, s.recordName, s.recordNameHashedHex,
+				s.recordSpecIDStr, s.contractSpecUUIDStr, s.contractSpecIDStr),
+		},
+		{
+			name:    "encode stdin",
+			command: cmd.AddMetaAddressEncoder,
+			args:    []string{"--batch", "-"},
+			stdin:   fmt.Sprintf("session %s %s\n", s.scopeUUIDStr, s.sessionUUIDStr),
+			expected: fmt.Sprintf(`{"type":"session","address":"%s","primary_uuid":"%s","secondary_uuid":"%s","parent_address":"%s"}`+"\n",
+				s.sessionIDStr, s.scopeUUIDStr, s.sessionUUIDStr, s.scopeIDStr),
+		},
+		{
+			name:     "encode stdin bad row",
+			command:  cmd.AddMetaAddressEncoder,
+			args:     []string{"--batch", "-", "--format", "csv"},
+			stdin:    fmt.Sprintf("scope %s\n# comment\nsession %s\n", s.scopeUUIDStr, s.scopeUUIDStr),
+			expected: fmt.Sprintf("type,address,primary_uuid,secondary_uuid,name,name_hash_hex,parent_address\nscope,%s,%s,,,,\n", s.scopeIDStr, s.scopeUUIDStr),
+			err:      "line 3: not enough arguments for session address encoder",
+		},
+		{
+			name:    "decode stdin",
+			command: cmd.AddMetaAddressDecoder,
+			args:    []string{"--batch", "-"},
+			stdin:   fmt.Sprintf("%s %s\n%s\n", s.scopeIDStr, s.scopeSpecIDStr, s.recordSpecIDStr),
+			expected: fmt.Sprintf(`{"type":"scope","address":"%s","primary_uuid":"%s"}`+"\n"+
+				`{"type":"scope-specification","address":"%s","primary_uuid":"%s"}`+"\n"+
+				`{"type":"record-specification","address":"%s","primary_uuid":"%s","name_hash_hex":"%s","parent_address":"%s"}`+"\n",
+				s.scopeIDStr, s.scopeUUIDStr, s.scopeSpecIDStr, s.scopeSpecUUIDStr,
+				s.recordSpecIDStr, s.contractSpecUUIDStr, s.recordNameHashedHex, s.contractSpecIDStr),
+		},
+		{
+			name:    "decode stdin invalid address",
+			command: cmd.AddMetaAddressDecoder,
+			args:    []string{"--batch", "-"},
+			stdin:   s.scopeIDStr + "bad\n",
+			err:     fmt.Sprintf(`line 1: invalid address "%sbad": decoding bech32 failed: invalid character not part of charset: 98`, s.scopeIDStr),
+		},
+		{
+			name:    "derive stdin",
+			command: cmd.AddMetaAddressDeriver,
+			args:    []string{"--batch", "-", "--csv", "--format", "csv"},
+			stdin:   fmt.Sprintf("scope,%s,%s\n", s.scopeUUIDStr, s.sessionUUIDStr),
+			expected: fmt.Sprintf("type,address,primary_uuid,secondary_uuid,name,name_hash_hex,parent_address\n"+
+				"scope,%[1]s,%[2]s,,,,\n"+
+				"session,%[3]s,%[2]s,%[4]s,,,%[1]s\n",
+				s.scopeIDStr, s.scopeUUIDStr, s.sessionIDStr, s.sessionUUIDStr),
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			command := tc.command()
+			command.SilenceUsage = true
+			command.SetArgs(tc.args)
+			command.SetIn(strings.NewReader(tc.stdin))
+			b := bytes.NewBufferString("")
+			command.SetOut(b)
+			err := command.Execute()
+			if len(tc.err) > 0 {
+				require.Errorf(t, err, "%s - expected error", command.Name())
+				assert.Containsf(t, err.Error(), tc.err, "%s - expected error", command.Name())
+			} else {
+				require.NoErrorf(t, err, "%s - unexpected error", command.Name())
+			}
+			assert.Equalf(t, tc.expected, b.String(), "%s - output", command.Name())
+		})
+	}
+}
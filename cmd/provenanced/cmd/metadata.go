@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+const (
+	flagRepairPlanHeight = "height"
+)
+
+// MetadataRepairPlanCmd returns a command that checks the metadata module's indexes and references in the local
+// application state, and prints the steps needed to fix any problems found.
+func MetadataRepairPlanCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata-repair-plan",
+		Short: "Check the local metadata state for index and reference problems and print a repair plan",
+		Long: `Check the local metadata state for index and reference problems and print a repair plan.
+
+This runs the metadata invariants against the application database in the home directory, and also identifies
+sessions that do not have any records. Each problem found is printed along with the change needed to fix it.
+The node must be stopped while this runs.`,
+		Example: fmt.Sprintf(`$ %[1]s metadata-repair-plan
+$ %[1]s metadata-repair-plan --height 1000`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			if _, err := os.Stat(config.GenesisFile()); err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetInt64(flagRepairPlanHeight)
+			if err != nil {
+				return err
+			}
+
+			db, err := sdk.NewDB("application", config.DBBackend, config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			a := app.New(serverCtx.Logger, db, nil, height == -1, map[int64]bool{}, config.RootDir,
+				cast.ToUint(serverCtx.Viper.Get(server.FlagInvCheckPeriod)), app.MakeEncodingConfig(), serverCtx.Viper)
+			if height != -1 {
+				if err = a.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			ctx := a.NewContext(true, tmproto.Header{Height: a.LastBlockHeight()})
+			plan := a.MetadataKeeper.GetRepairPlan(ctx)
+			out := cmd.OutOrStdout()
+			for _, step := range plan {
+				fmt.Fprintln(out, step.String())
+			}
+			fmt.Fprintf(out, "Found %d problem(s) in the metadata state at height %d.\n", len(plan), a.LastBlockHeight())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagRepairPlanHeight, -1, "Check the state at this height (-1 for the latest height)")
+
+	return cmd
+}
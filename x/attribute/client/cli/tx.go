@@ -16,6 +16,11 @@ import (
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
+const (
+	// FlagScopeOwners is the flag for the scope owners that consent to a change of a metadata address's attributes.
+	FlagScopeOwners = "scope-owners"
+)
+
 // NewTxCmd is the top-level command for attribute CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -75,10 +80,14 @@ Refer to %s tx name bind --help for more information on how to do this.`, versio
 				attributeType,
 				value,
 			)
+			if msg.ScopeOwners, err = cmd.Flags().GetStringSlice(FlagScopeOwners); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addScopeOwnersFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -133,15 +142,25 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 				origAttributeType,
 				updateAttributeType,
 			)
+			if msg.ScopeOwners, err = cmd.Flags().GetStringSlice(FlagScopeOwners); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addScopeOwnersFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// addScopeOwnersFlag adds the --scope-owners flag to the provided command.
+func addScopeOwnersFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagScopeOwners, nil,
+		"Comma separated list of the scope owners that consent to this change (required when the address is a scope, session, or record)")
+}
+
 func encodeAttributeValue(value string, attrType types.AttributeType) ([]byte, error) {
 	var encodedValue []byte
 	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto {
@@ -182,10 +201,14 @@ func NewDeleteDistinctAccountAttributeCmd() *cobra.Command {
 				return fmt.Errorf("error encoding value %s to type %s : %v", deleteValue, attributeType.String(), err)
 			}
 			msg := types.NewMsgDeleteDistinctAttributeRequest(args[1], clientCtx.GetFromAddress(), args[0], deleteValue)
+			if msg.ScopeOwners, err = cmd.Flags().GetStringSlice(FlagScopeOwners); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addScopeOwnersFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -214,10 +237,14 @@ func NewDeleteAccountAttributeCmd() *cobra.Command {
 				clientCtx.GetFromAddress(),
 				args[0],
 			)
+			if msg.ScopeOwners, err = cmd.Flags().GetStringSlice(FlagScopeOwners); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addScopeOwnersFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,7 @@ import (
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/x/attribute"
 	"github.com/provenance-io/provenance/x/attribute/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
@@ -201,3 +203,98 @@ func (s HandlerTestSuite) TestMsgDeleteAttributeRequest() {
 	}
 	s.runTests(cases)
 }
+
+func (s HandlerTestSuite) TestScopeOwnerConsent() {
+	pubkey2 := secp256k1.GenPrivKey().PubKey()
+	user2Addr := sdk.AccAddress(pubkey2.Address())
+	user2 := user2Addr.String()
+
+	scopeUUID := uuid.New()
+	scopeID := metadatatypes.ScopeMetadataAddress(scopeUUID)
+	sessionID := metadatatypes.SessionMetadataAddress(scopeUUID, uuid.New())
+	recordID := metadatatypes.RecordMetadataAddress(scopeUUID, "consentrecord")
+	missingScopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	owners := []metadatatypes.Party{{Address: user2, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}
+	s.app.MetadataKeeper.SetScope(s.ctx, *metadatatypes.NewScope(scopeID, nil, owners, []string{user2}, user2))
+	s.app.MetadataKeeper.SetSession(s.ctx, *metadatatypes.NewSession("consentsession", sessionID, nil, owners, nil))
+
+	var attrParams types.GenesisState
+	attrParams.Params.MaxValueLength = 100
+	s.app.AttributeKeeper.InitGenesis(s.ctx, &attrParams)
+
+	newAdd := func(account string, scopeOwners ...string) *types.MsgAddAttributeRequest {
+		msg := types.NewMsgAddAttributeRequest(account, s.user1Addr, "example.name", types.AttributeType_String, []byte("value"))
+		msg.ScopeOwners = scopeOwners
+		return msg
+	}
+	newDelete := func(account string, scopeOwners ...string) *types.MsgDeleteAttributeRequest {
+		msg := types.NewMsgDeleteAttributeRequest(account, s.user1Addr, "example.name")
+		msg.ScopeOwners = scopeOwners
+		return msg
+	}
+	missingSig := fmt.Sprintf("missing signature from scope owner %s of %s", user2, scopeID)
+
+	cases := []CommonTest{
+		{
+			"add to scope without scope owner consent",
+			newAdd(scopeID.String()),
+			[]string{s.user1},
+			missingSig,
+			nil,
+		},
+		{
+			"add to scope that does not exist",
+			newAdd(missingScopeID.String(), user2),
+			[]string{s.user1, user2},
+			fmt.Sprintf("metadata address %s not found", missingScopeID),
+			nil,
+		},
+		{
+			"add to record that does not exist",
+			newAdd(recordID.String(), user2),
+			[]string{s.user1, user2},
+			fmt.Sprintf("metadata address %s not found", recordID),
+			nil,
+		},
+		{
+			"add to scope with scope owner consent",
+			newAdd(scopeID.String(), user2),
+			[]string{s.user1, user2},
+			"",
+			types.NewEventAttributeAdd(
+				types.Attribute{Address: scopeID.String(), Name: "example.name", Value: []byte("value"), AttributeType: types.AttributeType_String},
+				s.user1),
+		},
+		{
+			"add to session without scope owner consent",
+			newAdd(sessionID.String()),
+			[]string{s.user1},
+			missingSig,
+			nil,
+		},
+		{
+			"add to session with scope owner consent",
+			newAdd(sessionID.String(), user2),
+			[]string{s.user1, user2},
+			"",
+			types.NewEventAttributeAdd(
+				types.Attribute{Address: sessionID.String(), Name: "example.name", Value: []byte("value"), AttributeType: types.AttributeType_String},
+				s.user1),
+		},
+		{
+			"delete from scope without scope owner consent",
+			newDelete(scopeID.String()),
+			[]string{s.user1},
+			missingSig,
+			nil,
+		},
+		{
+			"delete from scope with scope owner consent",
+			newDelete(scopeID.String(), user2),
+			[]string{s.user1, user2},
+			"",
+			types.NewEventAttributeDelete("example.name", scopeID.String(), s.user1),
+		},
+	}
+	s.runTests(cases)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -28,6 +29,8 @@ type Keeper struct {
 	authKeeper types.AccountKeeper
 	// The keeper used for ensuring names resolve to owners.
 	nameKeeper types.NameKeeper
+	// The keeper used to look up the owners of scopes that attributes are attached to.
+	metadataKeeper types.MetadataKeeper
 
 	// Key to access the key-value store from sdk.Context.
 	storeKey sdk.StoreKey
@@ -44,18 +47,19 @@ type Keeper struct {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	authKeeper types.AccountKeeper, nameKeeper types.NameKeeper,
+	authKeeper types.AccountKeeper, nameKeeper types.NameKeeper, metadataKeeper types.MetadataKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		nameKeeper: nameKeeper,
-		cdc:        cdc,
+		storeKey:       key,
+		paramSpace:     paramSpace,
+		authKeeper:     authKeeper,
+		nameKeeper:     nameKeeper,
+		metadataKeeper: metadataKeeper,
+		cdc:            cdc,
 	}
 }
 
@@ -296,6 +300,77 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 	return nil
 }
 
+// DeleteAllAttributes removes all attributes under the given account from the state store.
+// No owner checks are done, so this is only for use by modules cleaning up after an account, e.g. a deleted scope.
+func (k Keeper) DeleteAllAttributes(ctx sdk.Context, addr string) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "keeper_method", "delete_all")
+
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.AddrStrAttributesKeyPrefix(addr))
+	var keys [][]byte
+	var names []string
+	seen := make(map[string]bool)
+	for ; it.Valid(); it.Next() {
+		attr := types.Attribute{}
+		if err := k.cdc.Unmarshal(it.Value(), &attr); err != nil {
+			it.Close()
+			return err
+		}
+		keys = append(keys, it.Key())
+		if !seen[attr.Name] {
+			seen[attr.Name] = true
+			names = append(names, attr.Name)
+		}
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, name := range names {
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventAttributeDelete(name, addr, "")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateScopeOwnerConsent makes sure that, if the account is a metadata address, every owner of its scope is a signer.
+// When mustExist is true, the scope, session, or record that the account identifies must also exist.
+func (k Keeper) validateScopeOwnerConsent(ctx sdk.Context, account string, signers []sdk.AccAddress, mustExist bool) error {
+	mdAddr, err := metadatatypes.MetadataAddressFromBech32(account)
+	if err != nil {
+		// Not a metadata address, so there are no scope owners to consent.
+		return nil
+	}
+	scopeID, err := mdAddr.AsScopeAddress()
+	if err != nil {
+		return err
+	}
+	scope, found := k.metadataKeeper.GetScope(ctx, scopeID)
+	if mustExist {
+		switch {
+		case mdAddr.IsSessionAddress():
+			_, found = k.metadataKeeper.GetSession(ctx, mdAddr)
+		case mdAddr.IsRecordAddress():
+			_, found = k.metadataKeeper.GetRecord(ctx, mdAddr)
+		}
+		if !found {
+			return fmt.Errorf("metadata address %s not found", mdAddr)
+		}
+	}
+	signerMap := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		signerMap[signer.String()] = true
+	}
+	for _, owner := range scope.Owners {
+		if !signerMap[owner.Address] {
+			return fmt.Errorf("missing signature from scope owner %s of %s", owner.Address, scopeID)
+		}
+	}
+	return nil
+}
+
 // A predicate function for matching names
 type namePred = func(string) bool
 
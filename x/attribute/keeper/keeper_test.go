@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/provenance-io/provenance/app"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
@@ -524,6 +526,31 @@ func (s *KeeperTestSuite) TestGetAllAttributes() {
 	s.Equal(attr.Value, attributes[0].Value)
 }
 
+func (s *KeeperTestSuite) TestDeleteAllAttributes() {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	for _, attr := range []types.Attribute{
+		{Name: "example.attribute", Value: []byte("first"), Address: scopeID, AttributeType: types.AttributeType_String},
+		{Name: "example.attribute", Value: []byte("second"), Address: scopeID, AttributeType: types.AttributeType_String},
+		{Name: "attribute", Value: []byte("third"), Address: scopeID, AttributeType: types.AttributeType_String},
+		{Name: "attribute", Value: []byte("other"), Address: s.user1, AttributeType: types.AttributeType_String},
+	} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute %s %s", attr.Address, attr.Value)
+	}
+
+	em := sdk.NewEventManager()
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAllAttributes(s.ctx.WithEventManager(em), scopeID), "DeleteAllAttributes")
+	s.Assert().Len(em.Events(), 2, "events emitted")
+
+	attributes, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, scopeID)
+	s.Assert().NoError(err, "GetAllAttributes scope")
+	s.Assert().Empty(attributes, "scope attributes")
+	attributes, err = s.app.AttributeKeeper.GetAllAttributes(s.ctx, s.user1)
+	s.Assert().NoError(err, "GetAllAttributes user1")
+	s.Assert().Len(attributes, 1, "user1 attributes")
+
+	s.Assert().NoError(s.app.AttributeKeeper.DeleteAllAttributes(s.ctx, scopeID), "DeleteAllAttributes with nothing to delete")
+}
+
 func (s *KeeperTestSuite) TestGetAttributesByName() {
 
 	attr := types.Attribute{
@@ -38,6 +38,10 @@ func (k msgServer) AddAttribute(goCtx context.Context, msg *types.MsgAddAttribut
 		return nil, err
 	}
 
+	if err = k.Keeper.validateScopeOwnerConsent(ctx, msg.Account, msg.GetSigners(), true); err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.SetAttribute(ctx, attrib, ownerAddr)
 	if err != nil {
 		return nil, err
@@ -89,6 +93,10 @@ func (k msgServer) UpdateAttribute(goCtx context.Context, msg *types.MsgUpdateAt
 		return nil, err
 	}
 
+	if err = k.Keeper.validateScopeOwnerConsent(ctx, msg.Account, msg.GetSigners(), true); err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.UpdateAttribute(ctx, originalAttribute, updateAttribute, ownerAddr)
 	if err != nil {
 		return nil, err
@@ -134,6 +142,10 @@ func (k msgServer) DeleteAttribute(goCtx context.Context, msg *types.MsgDeleteAt
 		return nil, err
 	}
 
+	if err = k.Keeper.validateScopeOwnerConsent(ctx, msg.Account, msg.GetSigners(), false); err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.DeleteAttribute(ctx, msg.Account, msg.Name, nil, ownerAddr)
 	if err != nil {
 		return nil, err
@@ -175,6 +187,10 @@ func (k msgServer) DeleteDistinctAttribute(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
+	if err = k.Keeper.validateScopeOwnerConsent(ctx, msg.Account, msg.GetSigners(), false); err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.DeleteAttribute(ctx, msg.Account, msg.Name, &msg.Value, ownerAddr)
 	if err != nil {
 		return nil, err
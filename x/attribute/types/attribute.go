@@ -92,7 +92,7 @@ func GetAttributeAddressBytes(addr string) []byte {
 // ValidateAttributeAddress validates that the provide string is a valid address for an attribute.
 // Failures:
 //  * The provided address is empty
-//  * The provided address is neither an account address nor scope metadata address.
+//  * The provided address is neither an account address nor a scope, session, or record metadata address.
 func ValidateAttributeAddress(addr string) error {
 	if len(strings.TrimSpace(addr)) == 0 {
 		return errors.New("must not be empty")
@@ -102,10 +102,10 @@ func ValidateAttributeAddress(addr string) error {
 		return nil
 	}
 	mdAddr, mdErr := metadatatypes.MetadataAddressFromBech32(addr)
-	if mdErr == nil && mdAddr.IsScopeAddress() {
+	if mdErr == nil && (mdAddr.IsScopeAddress() || mdAddr.IsSessionAddress() || mdAddr.IsRecordAddress()) {
 		return nil
 	}
-	return fmt.Errorf("must be either an account address or scope metadata address: %q", addr)
+	return fmt.Errorf("must be either an account address or scope, session, or record metadata address: %q", addr)
 }
 
 // Determines whether a byte array value is valid for the given type.
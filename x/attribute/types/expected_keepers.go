@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
@@ -19,3 +20,10 @@ type NameKeeper interface {
 	GetRecordByName(ctx sdk.Context, name string) (record *nametypes.NameRecord, err error)
 	NameExists(ctx sdk.Context, name string) bool
 }
+
+// MetadataKeeper defines the expected metadata keeper used to check scope owner consent for metadata addresses (noalias)
+type MetadataKeeper interface {
+	GetScope(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.Scope, bool)
+	GetSession(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.Session, bool)
+	GetRecord(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.Record, bool)
+}
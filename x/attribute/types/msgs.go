@@ -50,6 +50,9 @@ func (msg MsgAddAttributeRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
 		return err
 	}
+	if err := validateScopeOwners(msg.ScopeOwners); err != nil {
+		return err
+	}
 	a := NewAttribute(msg.Name, msg.Account, msg.AttributeType, msg.Value)
 	return a.ValidateBasic()
 }
@@ -60,13 +63,9 @@ func (msg MsgAddAttributeRequest) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
-// GetSigners indicates that the message must have been signed by the name owner.
+// GetSigners indicates that the message must have been signed by the name owner and any consenting scope owners.
 func (msg MsgAddAttributeRequest) GetSigners() []sdk.AccAddress {
-	addr, err := sdk.AccAddressFromBech32(msg.Owner)
-	if err != nil {
-		panic(fmt.Errorf("invalid owner value on message: %w", err))
-	}
-	return []sdk.AccAddress{addr}
+	return getOwnerSigners(msg.Owner, msg.ScopeOwners)
 }
 
 // String implements stringer interface
@@ -104,6 +103,9 @@ func (msg MsgUpdateAttributeRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
 		return err
 	}
+	if err := validateScopeOwners(msg.ScopeOwners); err != nil {
+		return err
+	}
 	a := NewAttribute(msg.Name, msg.Account, msg.UpdateAttributeType, msg.UpdateValue)
 	return a.ValidateBasic()
 }
@@ -114,13 +116,9 @@ func (msg MsgUpdateAttributeRequest) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
-// GetSigners indicates that the message must have been signed by the name owner.
+// GetSigners indicates that the message must have been signed by the name owner and any consenting scope owners.
 func (msg MsgUpdateAttributeRequest) GetSigners() []sdk.AccAddress {
-	addr, err := sdk.AccAddressFromBech32(msg.Owner)
-	if err != nil {
-		panic(fmt.Errorf("invalid owner value on message: %w", err))
-	}
-	return []sdk.AccAddress{addr}
+	return getOwnerSigners(msg.Owner, msg.ScopeOwners)
 }
 
 // String implements stringer interface
@@ -160,6 +158,9 @@ func (msg MsgDeleteAttributeRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
 		return err
 	}
+	if err := validateScopeOwners(msg.ScopeOwners); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -175,13 +176,9 @@ func (msg MsgDeleteAttributeRequest) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
-// GetSigners indicates that the message must have been signed by the name owner.
+// GetSigners indicates that the message must have been signed by the name owner and any consenting scope owners.
 func (msg MsgDeleteAttributeRequest) GetSigners() []sdk.AccAddress {
-	addr, err := sdk.AccAddressFromBech32(msg.Owner)
-	if err != nil {
-		panic(fmt.Errorf("invalid owner value on message: %w", err))
-	}
-	return []sdk.AccAddress{addr}
+	return getOwnerSigners(msg.Owner, msg.ScopeOwners)
 }
 
 // NewMsgDeleteDistinctAttributeRequest deletes a attribute with specific value and type
@@ -219,6 +216,9 @@ func (msg MsgDeleteDistinctAttributeRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
 		return err
 	}
+	if err := validateScopeOwners(msg.ScopeOwners); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -234,11 +234,39 @@ func (msg MsgDeleteDistinctAttributeRequest) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
-// GetSigners indicates that the message must have been signed by the name owner.
+// GetSigners indicates that the message must have been signed by the name owner and any consenting scope owners.
 func (msg MsgDeleteDistinctAttributeRequest) GetSigners() []sdk.AccAddress {
-	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	return getOwnerSigners(msg.Owner, msg.ScopeOwners)
+}
+
+// validateScopeOwners makes sure that each of the provided scope owner addresses is valid.
+func validateScopeOwners(scopeOwners []string) error {
+	for _, scopeOwner := range scopeOwners {
+		if _, err := sdk.AccAddressFromBech32(scopeOwner); err != nil {
+			return fmt.Errorf("invalid scope owner address %q: %w", scopeOwner, err)
+		}
+	}
+	return nil
+}
+
+// getOwnerSigners gets the signers for a message with the provided owner and scope owners, without duplicates.
+func getOwnerSigners(owner string, scopeOwners []string) []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(owner)
 	if err != nil {
 		panic(fmt.Errorf("invalid owner value on message: %w", err))
 	}
-	return []sdk.AccAddress{addr}
+	signers := []sdk.AccAddress{addr}
+	seen := map[string]bool{owner: true}
+	for _, scopeOwner := range scopeOwners {
+		if seen[scopeOwner] {
+			continue
+		}
+		seen[scopeOwner] = true
+		scopeOwnerAddr, err := sdk.AccAddressFromBech32(scopeOwner)
+		if err != nil {
+			panic(fmt.Errorf("invalid scope owner value on message: %w", err))
+		}
+		signers = append(signers, scopeOwnerAddr)
+	}
+	return signers
 }
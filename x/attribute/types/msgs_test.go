@@ -106,3 +106,45 @@ func TestMsgDeleteDistinctAttribute(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic and GetSigners for messages with scope owners
+func TestMsgScopeOwners(t *testing.T) {
+	account := addrs[0].String()
+	msgs := map[string]func(scopeOwners []string) sdk.Msg{
+		"add": func(scopeOwners []string) sdk.Msg {
+			msg := NewMsgAddAttributeRequest(account, addrs[0], "example", AttributeType_String, []byte("value"))
+			msg.ScopeOwners = scopeOwners
+			return msg
+		},
+		"update": func(scopeOwners []string) sdk.Msg {
+			msg := NewMsgUpdateAttributeRequest(account, addrs[0], "example", []byte("value"), []byte("update"), AttributeType_String, AttributeType_String)
+			msg.ScopeOwners = scopeOwners
+			return msg
+		},
+		"delete": func(scopeOwners []string) sdk.Msg {
+			msg := NewMsgDeleteAttributeRequest(account, addrs[0], "example")
+			msg.ScopeOwners = scopeOwners
+			return msg
+		},
+		"delete distinct": func(scopeOwners []string) sdk.Msg {
+			msg := NewMsgDeleteDistinctAttributeRequest(account, addrs[0], "example", []byte("value"))
+			msg.ScopeOwners = scopeOwners
+			return msg
+		},
+	}
+
+	for name, newMsg := range msgs {
+		t.Run(name, func(t *testing.T) {
+			msg := newMsg(nil)
+			require.NoError(t, msg.ValidateBasic(), "ValidateBasic without scope owners")
+			require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners(), "GetSigners without scope owners")
+
+			msg = newMsg([]string{addrs[1].String(), addrs[0].String(), addrs[1].String()})
+			require.NoError(t, msg.ValidateBasic(), "ValidateBasic with scope owners")
+			require.Equal(t, []sdk.AccAddress{addrs[0], addrs[1]}, msg.GetSigners(), "GetSigners with scope owners")
+
+			msg = newMsg([]string{"not an address"})
+			require.EqualError(t, msg.ValidateBasic(), `invalid scope owner address "not an address": decoding bech32 failed: invalid character in string: ' '`, "ValidateBasic with invalid scope owner")
+		})
+	}
+}
@@ -41,6 +41,9 @@ type MsgAddAttributeRequest struct {
 	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
 	// The address that the name must resolve to.
 	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The owners of the scope that consent to this change. Required when the account is a scope, session, or record
+	// metadata address, in which case every owner of the scope must be either the owner above or one of these.
+	ScopeOwners []string `protobuf:"bytes,6,rep,name=scope_owners,json=scopeOwners,proto3" json:"scope_owners,omitempty"`
 }
 
 func (m *MsgAddAttributeRequest) Reset()      { *m = MsgAddAttributeRequest{} }
@@ -129,6 +132,9 @@ type MsgUpdateAttributeRequest struct {
 	Account string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
 	// The address that the name must resolve to.
 	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The owners of the scope that consent to this change. Required when the account is a scope, session, or record
+	// metadata address, in which case every owner of the scope must be either the owner above or one of these.
+	ScopeOwners []string `protobuf:"bytes,8,rep,name=scope_owners,json=scopeOwners,proto3" json:"scope_owners,omitempty"`
 }
 
 func (m *MsgUpdateAttributeRequest) Reset()      { *m = MsgUpdateAttributeRequest{} }
@@ -209,6 +215,9 @@ type MsgDeleteAttributeRequest struct {
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	// The address that the name must resolve to.
 	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The owners of the scope that consent to this change. Required when the account is a scope, session, or record
+	// metadata address, in which case every owner of the scope must be either the owner above or one of these.
+	ScopeOwners []string `protobuf:"bytes,4,rep,name=scope_owners,json=scopeOwners,proto3" json:"scope_owners,omitempty"`
 }
 
 func (m *MsgDeleteAttributeRequest) Reset()      { *m = MsgDeleteAttributeRequest{} }
@@ -291,6 +300,9 @@ type MsgDeleteDistinctAttributeRequest struct {
 	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
 	// The address that the name must resolve to.
 	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The owners of the scope that consent to this change. Required when the account is a scope, session, or record
+	// metadata address, in which case every owner of the scope must be either the owner above or one of these.
+	ScopeOwners []string `protobuf:"bytes,5,rep,name=scope_owners,json=scopeOwners,proto3" json:"scope_owners,omitempty"`
 }
 
 func (m *MsgDeleteDistinctAttributeRequest) Reset()      { *m = MsgDeleteDistinctAttributeRequest{} }
@@ -376,42 +388,44 @@ func init() {
 func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
 
 var fileDescriptor_5de344c1a12714be = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x4e, 0x2b, 0x3e, 0xd2, 0x80, 0x8e, 0x96, 0xb8, 0x16, 0x72, 0xdc, 0x88, 0x1f,
-	0x59, 0xb0, 0x69, 0x2a, 0x96, 0x32, 0x15, 0x75, 0x8d, 0x84, 0x22, 0x60, 0xe8, 0x40, 0xe5, 0x38,
-	0x27, 0x63, 0x29, 0xf1, 0x39, 0xf6, 0x39, 0xb4, 0x4c, 0x2c, 0x48, 0x6c, 0x20, 0x26, 0xc6, 0xfc,
-	0x39, 0x8c, 0x95, 0x58, 0x18, 0x18, 0x50, 0xb2, 0xf0, 0x1f, 0xb0, 0xa2, 0x9c, 0x13, 0xc7, 0x75,
-	0x73, 0x26, 0x81, 0xcd, 0xdf, 0xf9, 0xdd, 0x7b, 0xef, 0xbe, 0x77, 0x9f, 0x0d, 0xba, 0x1f, 0xd0,
-	0x21, 0xf1, 0x2c, 0xcf, 0x26, 0xa6, 0xc5, 0x58, 0xe0, 0x76, 0x22, 0x46, 0xcc, 0xe1, 0xbe, 0xc9,
-	0xce, 0x0c, 0x3f, 0xa0, 0x8c, 0xe2, 0xea, 0x02, 0x61, 0x24, 0x08, 0x63, 0xb8, 0xaf, 0x6e, 0x3b,
-	0xd4, 0xa1, 0x1c, 0x63, 0x4e, 0x9f, 0x62, 0xb8, 0xfa, 0x40, 0x44, 0xb8, 0xd8, 0xcb, 0x81, 0xf5,
-	0x6f, 0x08, 0x6e, 0xb7, 0x42, 0xe7, 0xa8, 0xdb, 0x3d, 0x9a, 0xbf, 0x69, 0x93, 0x41, 0x44, 0x42,
-	0x86, 0x31, 0xc8, 0x9e, 0xd5, 0x27, 0x0a, 0xd2, 0x51, 0xe3, 0x5a, 0x9b, 0x3f, 0xe3, 0x6d, 0x28,
-	0x0d, 0xad, 0x5e, 0x44, 0x94, 0x82, 0x8e, 0x1a, 0xe5, 0x76, 0x5c, 0xe0, 0x16, 0x54, 0x12, 0xde,
-	0x53, 0x76, 0xee, 0x13, 0xa5, 0xa8, 0xa3, 0x46, 0xa5, 0x79, 0xdf, 0x10, 0xb8, 0x36, 0x12, 0xb1,
-	0xe7, 0xe7, 0x3e, 0x69, 0x6f, 0x59, 0xe9, 0x12, 0x2b, 0xb0, 0x69, 0xd9, 0x36, 0x8d, 0x3c, 0xa6,
-	0xc8, 0x5c, 0x7b, 0x5e, 0x4e, 0xe5, 0xe9, 0x1b, 0x8f, 0x04, 0x4a, 0x89, 0xaf, 0xc7, 0xc5, 0xe1,
-	0xcd, 0x0f, 0xa3, 0x9a, 0xf4, 0x65, 0x54, 0x93, 0x7e, 0x8d, 0x6a, 0xd2, 0xbb, 0x1f, 0xba, 0x54,
-	0xdf, 0x85, 0xea, 0x95, 0x43, 0x85, 0x3e, 0xf5, 0x42, 0x52, 0xff, 0x5d, 0x80, 0xdd, 0x56, 0xe8,
-	0xbc, 0xf0, 0xbb, 0x16, 0x23, 0x2b, 0x9d, 0xf9, 0x1e, 0x54, 0x68, 0xe0, 0x3a, 0xae, 0x67, 0xf5,
-	0x4e, 0xd3, 0x87, 0xdf, 0x9a, 0xaf, 0xbe, 0xe4, 0x4d, 0xd8, 0x83, 0x72, 0xc4, 0x49, 0x67, 0xa0,
-	0x22, 0x07, 0x5d, 0x8f, 0xd7, 0x62, 0xc8, 0x2b, 0xa8, 0x26, 0x4c, 0x99, 0x86, 0xc9, 0x6b, 0x35,
-	0x6c, 0x67, 0x4e, 0x73, 0x69, 0x19, 0x9f, 0xc0, 0xce, 0xcc, 0x42, 0x86, 0xbd, 0xb4, 0x16, 0xfb,
-	0xad, 0xe8, 0x72, 0x73, 0xb2, 0xa1, 0x6c, 0x08, 0x42, 0xd9, 0xcc, 0x0f, 0xe5, 0x0e, 0xa8, 0xcb,
-	0x1a, 0x3f, 0xcb, 0x65, 0xc0, 0x63, 0x39, 0x26, 0x3d, 0xb2, 0x62, 0x2c, 0x29, 0x43, 0x05, 0x81,
-	0xa1, 0xe2, 0x2a, 0x86, 0xae, 0x48, 0xce, 0x0c, 0x7d, 0x44, 0xb0, 0x97, 0xbc, 0x3e, 0x76, 0x43,
-	0xe6, 0x7a, 0x36, 0xfb, 0x8f, 0x21, 0x49, 0xf9, 0x2d, 0x0a, 0xfc, 0xca, 0xf9, 0x7e, 0xef, 0x42,
-	0x3d, 0xcf, 0x50, 0xec, 0xbb, 0xf9, 0x5e, 0x86, 0x62, 0x2b, 0x74, 0xf0, 0x00, 0xca, 0xe9, 0x01,
-	0xc0, 0xa6, 0x30, 0xfd, 0xe5, 0xf3, 0xaf, 0x3e, 0x5a, 0x7d, 0x43, 0x2c, 0x8d, 0xdf, 0xc2, 0x8d,
-	0x4c, 0xbc, 0xb8, 0x99, 0x47, 0xb2, 0x7c, 0x08, 0xd5, 0x83, 0xb5, 0xf6, 0x2c, 0xb4, 0x33, 0x49,
-	0xe6, 0x6b, 0x2f, 0xbf, 0x69, 0xf9, 0xda, 0x82, 0xab, 0x82, 0x3f, 0x23, 0xa8, 0x0a, 0x62, 0xc1,
-	0x87, 0x7f, 0x27, 0x14, 0x5d, 0x2e, 0xf5, 0xc9, 0x3f, 0xed, 0x8d, 0x4d, 0x3d, 0xed, 0x7f, 0x1d,
-	0x6b, 0xe8, 0x62, 0xac, 0xa1, 0x9f, 0x63, 0x0d, 0x7d, 0x9a, 0x68, 0xd2, 0xc5, 0x44, 0x93, 0xbe,
-	0x4f, 0x34, 0x09, 0x54, 0x97, 0x8a, 0x88, 0x9f, 0xa1, 0x93, 0xc7, 0x8e, 0xcb, 0x5e, 0x47, 0x1d,
-	0xc3, 0xa6, 0x7d, 0x73, 0x81, 0x7a, 0xe8, 0xd2, 0x54, 0x65, 0x9e, 0xa5, 0xfe, 0x2a, 0xd3, 0x2f,
-	0x4c, 0xd8, 0xd9, 0xe0, 0xff, 0x93, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x84, 0xf7, 0xbe,
-	0xa7, 0xcb, 0x06, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x3f, 0x6f, 0xd3, 0x5e,
+	0x14, 0xf5, 0xab, 0x9d, 0xf4, 0xd7, 0xdb, 0x34, 0x3f, 0xf4, 0x68, 0x89, 0x6b, 0x21, 0xc7, 0x89,
+	0xf8, 0x93, 0x05, 0x9b, 0x26, 0x62, 0x29, 0x53, 0x51, 0xd7, 0x08, 0x14, 0x01, 0x43, 0x07, 0x22,
+	0xc7, 0x79, 0x32, 0x96, 0x12, 0x3f, 0x37, 0x7e, 0x0e, 0x2d, 0x13, 0x0b, 0x12, 0x23, 0x42, 0x0c,
+	0x88, 0x29, 0x9f, 0x81, 0x4f, 0xc1, 0xd8, 0x91, 0x81, 0x01, 0x25, 0x0b, 0x9f, 0x80, 0x19, 0xe5,
+	0x39, 0x7f, 0x5c, 0x37, 0x36, 0x0e, 0x6c, 0xbe, 0xd7, 0xe7, 0x9e, 0x7b, 0xee, 0x3d, 0xef, 0xd9,
+	0xa0, 0x79, 0x03, 0x3a, 0x24, 0xae, 0xe9, 0x5a, 0xc4, 0x30, 0x19, 0x1b, 0x38, 0x9d, 0x80, 0x11,
+	0x63, 0x78, 0x60, 0xb0, 0x33, 0xdd, 0x1b, 0x50, 0x46, 0x71, 0x69, 0x89, 0xd0, 0x17, 0x08, 0x7d,
+	0x78, 0xa0, 0xec, 0xda, 0xd4, 0xa6, 0x1c, 0x63, 0x4c, 0x9f, 0x42, 0xb8, 0x72, 0x37, 0x89, 0x70,
+	0x59, 0xcb, 0x81, 0xd5, 0x5f, 0x08, 0x6e, 0x34, 0x7d, 0xfb, 0xa8, 0xdb, 0x3d, 0x9a, 0xbf, 0x69,
+	0x91, 0xd3, 0x80, 0xf8, 0x0c, 0x63, 0x90, 0x5c, 0xb3, 0x4f, 0x64, 0xa4, 0xa1, 0xda, 0x56, 0x8b,
+	0x3f, 0xe3, 0x5d, 0xc8, 0x0d, 0xcd, 0x5e, 0x40, 0xe4, 0x0d, 0x0d, 0xd5, 0x0a, 0xad, 0x30, 0xc0,
+	0x4d, 0x28, 0x2e, 0x78, 0xdb, 0xec, 0xdc, 0x23, 0xb2, 0xa8, 0xa1, 0x5a, 0xb1, 0x7e, 0x47, 0x4f,
+	0x50, 0xad, 0x2f, 0x9a, 0x3d, 0x3d, 0xf7, 0x48, 0x6b, 0xc7, 0x8c, 0x86, 0x58, 0x86, 0x4d, 0xd3,
+	0xb2, 0x68, 0xe0, 0x32, 0x59, 0xe2, 0xbd, 0xe7, 0xe1, 0xb4, 0x3d, 0x7d, 0xe5, 0x92, 0x81, 0x9c,
+	0xe3, 0xf9, 0x30, 0xc0, 0x15, 0x28, 0xf8, 0x16, 0xf5, 0x48, 0x9b, 0x87, 0xbe, 0x9c, 0xd7, 0xc4,
+	0xda, 0x56, 0x6b, 0x9b, 0xe7, 0x1e, 0xf3, 0xd4, 0xe1, 0xb5, 0x77, 0xa3, 0xb2, 0xf0, 0x69, 0x54,
+	0x16, 0x7e, 0x8e, 0xca, 0xc2, 0x9b, 0xef, 0x9a, 0x50, 0xdd, 0x87, 0xd2, 0x95, 0xb9, 0x7d, 0x8f,
+	0xba, 0x3e, 0xa9, 0x7e, 0x16, 0x61, 0xbf, 0xe9, 0xdb, 0xcf, 0xbc, 0xae, 0xc9, 0x48, 0xa6, 0xb5,
+	0xdc, 0x86, 0x22, 0x1d, 0x38, 0xb6, 0xe3, 0x9a, 0xbd, 0x76, 0x74, 0x3f, 0x3b, 0xf3, 0xec, 0x73,
+	0xbe, 0xa7, 0x0a, 0x14, 0x02, 0x4e, 0x3a, 0x03, 0x89, 0x1c, 0xb4, 0x1d, 0xe6, 0x42, 0xc8, 0x0b,
+	0x28, 0x2d, 0x98, 0x62, 0x3b, 0x95, 0xd6, 0xda, 0xe9, 0xde, 0x9c, 0xe6, 0x52, 0x1a, 0x9f, 0xc0,
+	0xde, 0x4c, 0x42, 0x8c, 0x3d, 0xb7, 0x16, 0xfb, 0xf5, 0xe0, 0xf2, 0x72, 0xe2, 0xbe, 0xe5, 0x13,
+	0x7c, 0xdb, 0x4c, 0xf3, 0xed, 0xbf, 0x2c, 0xbe, 0xdd, 0x04, 0x65, 0x95, 0x37, 0x33, 0xeb, 0x3e,
+	0x22, 0x6e, 0xdd, 0x31, 0xe9, 0x91, 0x8c, 0xd6, 0x45, 0x44, 0x6f, 0x24, 0x88, 0x16, 0xd3, 0x44,
+	0x4b, 0xd9, 0x45, 0x5f, 0x51, 0x35, 0x13, 0xfd, 0x05, 0x41, 0x65, 0xf1, 0xfa, 0xd8, 0xf1, 0x99,
+	0xe3, 0x5a, 0xec, 0x1f, 0xae, 0x63, 0x64, 0x24, 0x31, 0x61, 0x24, 0x29, 0x6d, 0xa4, 0x5c, 0x96,
+	0x91, 0x6e, 0x41, 0x35, 0x4d, 0x73, 0x38, 0x5a, 0xfd, 0xad, 0x04, 0x62, 0xd3, 0xb7, 0xf1, 0x29,
+	0x14, 0xa2, 0x57, 0x0d, 0x1b, 0x89, 0xe7, 0x6c, 0xf5, 0xc7, 0x48, 0xb9, 0x9f, 0xbd, 0x20, 0x6c,
+	0x8d, 0x5f, 0xc3, 0xff, 0xb1, 0x53, 0x82, 0xeb, 0x69, 0x24, 0xab, 0xaf, 0xbb, 0xd2, 0x58, 0xab,
+	0x66, 0xd9, 0x3b, 0x66, 0x76, 0x7a, 0xef, 0xd5, 0xe7, 0x55, 0x69, 0xac, 0x55, 0x33, 0xeb, 0xfd,
+	0x01, 0x41, 0x29, 0xc1, 0x16, 0x7c, 0xf8, 0x67, 0xc2, 0xa4, 0xf3, 0xa7, 0x3c, 0xfc, 0xab, 0xda,
+	0x50, 0xd4, 0xa3, 0xfe, 0xd7, 0xb1, 0x8a, 0x2e, 0xc6, 0x2a, 0xfa, 0x31, 0x56, 0xd1, 0xfb, 0x89,
+	0x2a, 0x5c, 0x4c, 0x54, 0xe1, 0xdb, 0x44, 0x15, 0x40, 0x71, 0x68, 0x12, 0xf1, 0x13, 0x74, 0xf2,
+	0xc0, 0x76, 0xd8, 0xcb, 0xa0, 0xa3, 0x5b, 0xb4, 0x6f, 0x2c, 0x51, 0xf7, 0x1c, 0x1a, 0x89, 0x8c,
+	0xb3, 0xc8, 0x2f, 0x6e, 0xfa, 0x2d, 0xf3, 0x3b, 0x79, 0xfe, 0x73, 0x6b, 0xfc, 0x1e, 0x00, 0x14,
+	0xd9, 0x58, 0xa0, 0x58, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -630,6 +644,15 @@ func (m *MsgAddAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeOwners) > 0 {
+		for iNdEx := len(m.ScopeOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeOwners[iNdEx])
+			copy(dAtA[i:], m.ScopeOwners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -709,6 +732,15 @@ func (m *MsgUpdateAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeOwners) > 0 {
+		for iNdEx := len(m.ScopeOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeOwners[iNdEx])
+			copy(dAtA[i:], m.ScopeOwners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -800,6 +832,15 @@ func (m *MsgDeleteAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeOwners) > 0 {
+		for iNdEx := len(m.ScopeOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeOwners[iNdEx])
+			copy(dAtA[i:], m.ScopeOwners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -867,6 +908,15 @@ func (m *MsgDeleteDistinctAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeOwners) > 0 {
+		for iNdEx := len(m.ScopeOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeOwners[iNdEx])
+			copy(dAtA[i:], m.ScopeOwners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -957,6 +1007,12 @@ func (m *MsgAddAttributeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ScopeOwners) > 0 {
+		for _, s := range m.ScopeOwners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1001,6 +1057,12 @@ func (m *MsgUpdateAttributeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ScopeOwners) > 0 {
+		for _, s := range m.ScopeOwners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1031,6 +1093,12 @@ func (m *MsgDeleteAttributeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ScopeOwners) > 0 {
+		for _, s := range m.ScopeOwners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1065,6 +1133,12 @@ func (m *MsgDeleteDistinctAttributeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ScopeOwners) > 0 {
+		for _, s := range m.ScopeOwners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1261,6 +1335,38 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeOwners = append(m.ScopeOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1563,6 +1669,38 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeOwners = append(m.ScopeOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1759,6 +1897,38 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeOwners = append(m.ScopeOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1989,6 +2159,38 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeOwners = append(m.ScopeOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,24 @@
+package metadata
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker returns the end blocker for the metadata module.
+func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	// Remove any scope, session, and record history that is no longer being retained.
+	k.PruneHistory(ctx)
+	// Remove any scope data access that has expired.
+	k.PruneExpiredDataAccess(ctx)
+	// Remove any value owner transfer offers that have expired.
+	k.PruneExpiredValueOwnerTransferOffers(ctx)
+}
@@ -1,11 +1,16 @@
 package cli_test
 
 import (
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -30,6 +35,7 @@ import (
 	"github.com/provenance-io/provenance/testutil"
 	"github.com/provenance-io/provenance/x/metadata/client/cli"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/types/p8e"
 )
 
 type IntegrationCLITestSuite struct {
@@ -113,6 +119,8 @@ type IntegrationCLITestSuite struct {
 
 	objectLocator2AsText string
 	objectLocator2AsJson string
+
+	recordType metadatatypes.RecordType
 }
 
 func TestIntegrationCLITestSuite(t *testing.T) {
@@ -345,7 +353,7 @@ specification_id: %s`,
 		s.recordSpecID,
 	)
 
-	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"]}",
+	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"],\"previous_version_id\":\"\"}",
 		s.scopeSpecID,
 		s.user1AddrStr,
 		s.contractSpecID,
@@ -357,13 +365,14 @@ owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
+previous_version_id: ""
 specification_id: %s`,
 		s.contractSpecID,
 		s.user1AddrStr,
 		s.scopeSpecID,
 	)
 
-	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\"}",
+	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\",\"previous_version_id\":\"\"}",
 		s.contractSpecID,
 		s.user1AddrStr,
 	)
@@ -374,15 +383,17 @@ owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
+previous_version_id: ""
 specification_id: %s`,
 		s.user1AddrStr,
 		s.contractSpecID,
 	)
 
-	s.recordSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"name\":\"recordname\",\"inputs\":[{\"name\":\"inputname\",\"type_name\":\"inputtypename\",\"hash\":\"alsonotreallyasourcehash\"}],\"type_name\":\"recordtypename\",\"result_type\":\"DEFINITION_TYPE_RECORD\",\"responsible_parties\":[\"PARTY_TYPE_OWNER\"]}",
+	s.recordSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"name\":\"recordname\",\"inputs\":[{\"name\":\"inputname\",\"type_name\":\"inputtypename\",\"hash\":\"alsonotreallyasourcehash\"}],\"type_name\":\"recordtypename\",\"result_type\":\"DEFINITION_TYPE_RECORD\",\"responsible_parties\":[\"PARTY_TYPE_OWNER\"],\"clonable\":false}",
 		s.recordSpecID,
 	)
-	s.recordSpecAsText = fmt.Sprintf(`inputs:
+	s.recordSpecAsText = fmt.Sprintf(`clonable: false
+inputs:
 - hash: alsonotreallyasourcehash
   name: inputname
   type_name: inputtypename
@@ -401,19 +412,30 @@ type_name: recordtypename`,
 		if len(eKey) == 0 {
 			eKey = "\"\""
 		}
+		name := loc.Name
+		if len(name) == 0 {
+			name = "\"\""
+		}
 		return fmt.Sprintf(`encryption_key: %s
 locator_uri: %s
-owner: %s`,
+name: %s
+owner: %s
+priority: %d
+scope_spec_ids: []`,
 			eKey,
 			loc.LocatorUri,
+			name,
 			loc.Owner,
+			loc.Priority,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"name\":\"%s\",\"priority\":%d,\"scope_spec_ids\":[]}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
+			loc.Name,
+			loc.Priority,
 		)
 	}
 	s.ownerAddr1 = s.user1Addr
@@ -430,6 +452,9 @@ owner: %s`,
 	s.objectLocator2AsText = locAsText(s.objectLocator2)
 	s.objectLocator2AsJson = locAsJson(s.objectLocator2)
 
+	s.recordType = *metadatatypes.NewRecordType("io.provenance.Loan", metadatatypes.SchemaType_SCHEMA_TYPE_JSON_SCHEMA,
+		[]byte(`{"type":"object","properties":{"amount":{"type":"integer"}},"required":["amount"]}`), "", []string{s.user1AddrStr})
+
 	var metadataData metadatatypes.GenesisState
 	s.Require().NoError(cfg.Codec.UnmarshalJSON(genesisState[metadatatypes.ModuleName], &metadataData))
 	metadataData.Scopes = append(metadataData.Scopes, s.scope)
@@ -439,6 +464,12 @@ owner: %s`,
 	metadataData.ContractSpecifications = append(metadataData.ContractSpecifications, s.contractSpec)
 	metadataData.RecordSpecifications = append(metadataData.RecordSpecifications, s.recordSpec)
 	metadataData.ObjectStoreLocators = append(metadataData.ObjectStoreLocators, s.objectLocator1, s.objectLocator2)
+	metadataData.RecordTypes = append(metadataData.RecordTypes, s.recordType)
+	metadataData.MissingResponsibleParties = append(metadataData.MissingResponsibleParties, metadatatypes.MissingResponsibleParties{
+		RecordId:   s.recordID,
+		PartyTypes: []metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_ORIGINATOR},
+		Height:     1,
+	})
 	metadataDataBz, err := cfg.Codec.MarshalJSON(&metadataData)
 	s.Require().NoError(err)
 	genesisState[metadatatypes.ModuleName] = metadataDataBz
@@ -593,13 +624,13 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output",
 			[]string{s.asJson},
 			"",
-			[]string{"\"params\":{}"},
+			[]string{"\"params\":{\"history_retention_blocks\":\"0\",\"record_type_registrars\":[],\"require_registered_record_types\":false,\"max_scope_owners\":100,\"max_scope_data_access\":100,\"max_scope_records\":1000,\"max_record_inputs\":100,\"max_record_outputs\":100,\"max_session_parties\":100,\"max_session_context_bytes\":10240,\"enforce_responsible_parties\":false,\"disable_p8e_messages\":false}"},
 		},
 		{
 			"get params as text output",
 			[]string{s.asText},
 			"",
-			[]string{"params: {}"},
+			[]string{"params:", "history_retention_blocks: \"0\"", "record_type_registrars: []", "require_registered_record_types: false", "max_scope_owners: 100", "max_session_context_bytes: 10240", "enforce_responsible_parties: false", "disable_p8e_messages: false"},
 		},
 		{
 			"get params - invalid args",
@@ -611,7 +642,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output including request",
 			[]string{s.asJson, s.includeRequest},
 			"",
-			[]string{"\"params\":{}", "\"request\":{}"},
+			[]string{"\"params\":{\"history_retention_blocks\":\"0\",\"record_type_registrars\":[],\"require_registered_record_types\":false,\"max_scope_owners\":100,\"max_scope_data_access\":100,\"max_scope_records\":1000,\"max_record_inputs\":100,\"max_record_outputs\":100,\"max_session_parties\":100,\"max_session_context_bytes\":10240,\"enforce_responsible_parties\":false,\"disable_p8e_messages\":false}", "\"request\":{}"},
 		},
 		{
 			"get locator params as json",
@@ -1210,6 +1241,36 @@ func (s *IntegrationCLITestSuite) TestGetMetadataSessionCmd() {
 			"",
 			[]string{s.sessionAsJson},
 		},
+		{
+			"sessions from scope id with party filter",
+			[]string{s.scopeID.String(), "--party", s.user1AddrStr, "--party-role", "owner", s.asJson},
+			"",
+			[]string{s.sessionAsJson},
+		},
+		{
+			"sessions from scope id with party filter not matching",
+			[]string{s.scopeID.String(), "--party", s.user2AddrStr},
+			"no sessions found",
+			[]string{},
+		},
+		{
+			"sessions all updated after the future",
+			[]string{"all", "--updated-after", "2100-01-01T00:00:00Z"},
+			"",
+			[]string{"sessions: []"},
+		},
+		{
+			"sessions with invalid party role",
+			[]string{s.scopeID.String(), "--party-role", "notarole"},
+			"unknown party type: PARTY_TYPE_NOTAROLE",
+			[]string{},
+		},
+		{
+			"sessions with invalid updated before",
+			[]string{"all", "--updated-before", "tomorrow"},
+			`rpc error: code = InvalidArgument desc = invalid updated_before: parsing time "tomorrow" as "2006-01-02T15:04:05Z07:00": cannot parse "tomorrow" as "2006": invalid request`,
+			[]string{},
+		},
 		{
 			"bad prefix",
 			[]string{"scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m"},
@@ -1297,6 +1358,30 @@ func (s *IntegrationCLITestSuite) TestGetMetadataRecordCmd() {
 			"",
 			[]string{"records: []"},
 		},
+		{
+			"records from scope id with status filters",
+			[]string{s.scopeID.String(), "--output-status", "pass", "--input-status", "record", s.asJson},
+			"",
+			[]string{s.recordAsJson},
+		},
+		{
+			"records from scope id with output status not matching",
+			[]string{s.scopeID.String(), "--output-status", "fail"},
+			"",
+			[]string{"records: []"},
+		},
+		{
+			"records from scope id with session party filter not matching",
+			[]string{s.scopeID.String(), "--party", s.user2AddrStr},
+			"",
+			[]string{"records: []"},
+		},
+		{
+			"records with invalid output status",
+			[]string{s.scopeID.String(), "--output-status", "bad"},
+			"invalid --output-status: RESULT_STATUS_BAD",
+			[]string{},
+		},
 		{
 			"records from scope uuid as json",
 			[]string{s.scopeUUID.String(), s.asJson},
@@ -1739,6 +1824,152 @@ func (s *IntegrationCLITestSuite) TestGetValueOwnershipCmd() {
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestGetValueOwnerTransferOffersCmd() {
+	cmd := func() *cobra.Command { return cli.GetValueOwnerTransferOffersCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			"by scope id no result",
+			[]string{s.scopeID.String()},
+			"",
+			[]string{"offers: []", "total: \"0\""},
+		},
+		{
+			"by scope uuid as json",
+			[]string{s.scopeUUID.String(), s.asJson},
+			"",
+			[]string{"\"offers\":[]"},
+		},
+		{
+			"by address no result",
+			[]string{s.user2AddrStr},
+			"",
+			[]string{"offers: []", "total: \"0\""},
+		},
+		{
+			"not a scope or address",
+			[]string{"notanid"},
+			"rpc error: code = InvalidArgument desc = could not parse [notanid] into either a scope address (decoding bech32 failed: invalid bech32 string length 7) or uuid (invalid UUID length: 7)",
+			[]string{},
+		},
+		{
+			"no args",
+			[]string{},
+			"accepts 1 arg(s), received 0",
+			[]string{},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
+func (s *IntegrationCLITestSuite) TestGetRecordTypeCmd() {
+	cmd := func() *cobra.Command { return cli.GetRecordTypeCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			"record type by name as json",
+			[]string{s.recordType.Name, s.asJson},
+			"",
+			[]string{`"name":"io.provenance.Loan"`, `"schema_type":"SCHEMA_TYPE_JSON_SCHEMA"`, fmt.Sprintf(`"owner_addresses":["%s"]`, s.user1AddrStr)},
+		},
+		{
+			"record type by name as text",
+			[]string{s.recordType.Name, s.asText},
+			"",
+			[]string{"name: io.provenance.Loan", "schema_type: SCHEMA_TYPE_JSON_SCHEMA", "- " + s.user1AddrStr},
+		},
+		{
+			"record type not found",
+			[]string{"io.provenance.Lease"},
+			`record type "io.provenance.Lease" not found`,
+			[]string{},
+		},
+		{
+			"all record types as json",
+			[]string{"all", s.asJson},
+			"",
+			[]string{`"record_types":[{"name":"io.provenance.Loan"`},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
+func (s *IntegrationCLITestSuite) TestGetRecordsMissingResponsiblePartiesCmd() {
+	cmd := func() *cobra.Command { return cli.GetRecordsMissingResponsiblePartiesCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			"all as json",
+			[]string{s.asJson},
+			"",
+			[]string{fmt.Sprintf(`"entries":[{"record_id":"%s","party_types":["PARTY_TYPE_ORIGINATOR"],"height":"1"}]`, s.recordID)},
+		},
+		{
+			"by scope id as text",
+			[]string{s.scopeID.String(), s.asText},
+			"",
+			[]string{"record_id: " + s.recordID.String(), "- PARTY_TYPE_ORIGINATOR"},
+		},
+		{
+			"by scope uuid as json",
+			[]string{s.scopeUUID.String(), s.asJson},
+			"",
+			[]string{fmt.Sprintf(`"record_id":"%s"`, s.recordID)},
+		},
+		{
+			"other scope",
+			[]string{metadatatypes.ScopeMetadataAddress(uuid.New()).String(), s.asJson},
+			"",
+			[]string{`"entries":[]`},
+		},
+		{
+			"invalid scope id",
+			[]string{"notascopeid"},
+			"rpc error: code = InvalidArgument desc = could not parse [notascopeid] into either a scope address",
+			[]string{},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
+func (s *IntegrationCLITestSuite) TestValidatePayloadCmd() {
+	cmd := func() *cobra.Command { return cli.ValidatePayloadCmd() }
+
+	writePayload := func(name, payload string) string {
+		file := filepath.Join(s.T().TempDir(), name)
+		s.Require().NoError(os.WriteFile(file, []byte(payload), 0o600), "writing payload file")
+		return file
+	}
+	validPayload := `{"amount": 5}`
+	hash := sha512.Sum512([]byte(validPayload))
+
+	testCases := []queryCmdTestCase{
+		{
+			"valid payload",
+			[]string{s.recordType.Name, writePayload("valid.json", validPayload)},
+			"",
+			[]string{base64.StdEncoding.EncodeToString(hash[:])},
+		},
+		{
+			"payload does not satisfy the schema",
+			[]string{s.recordType.Name, writePayload("invalid.json", `{"amount": "five"}`)},
+			"payload does not satisfy the json schema of io.provenance.Loan",
+			[]string{},
+		},
+		{
+			"unknown record type",
+			[]string{"io.provenance.Lease", writePayload("lease.json", validPayload)},
+			`record type "io.provenance.Lease" not found`,
+			[]string{},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
 func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 	cmd := func() *cobra.Command { return cli.GetOSLocatorCmd() }
 
@@ -1882,6 +2113,47 @@ func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestExportScopeCmd() {
+	cmd := func() *cobra.Command { return cli.ExportScopeCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			"scope by id",
+			[]string{s.scopeID.String()},
+			"",
+			[]string{
+				fmt.Sprintf(`"scope":{"scope_id":"%s"`, s.scopeID),
+				fmt.Sprintf(`"scope_specification":{"specification_id":"%s"`, s.scopeSpecID),
+				fmt.Sprintf(`"contract_specifications":[{"specification_id":"%s"`, s.contractSpecID),
+				fmt.Sprintf(`"record_specifications":[{"specification_id":"%s"`, s.recordSpecID),
+				fmt.Sprintf(`"sessions":[{"session_id":"%s"`, s.sessionID),
+				fmt.Sprintf(`"records":[{"name":"%s"`, s.recordName),
+				fmt.Sprintf(`"os_locators":[{"owner":"%s"`, s.user1AddrStr),
+			},
+		},
+		{
+			"scope by uuid",
+			[]string{s.scopeUUID.String()},
+			"",
+			[]string{fmt.Sprintf(`"scope":{"scope_id":"%s"`, s.scopeID)},
+		},
+		{
+			"scope not found",
+			[]string{metadatatypes.ScopeMetadataAddress(uuid.New()).String()},
+			"not found",
+			[]string{},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+
+	s.T().Run("only owner locators", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtxWithoutKeyring(), cli.ExportScopeCmd(), []string{s.scopeID.String()})
+		require.NoError(t, err, "export-scope")
+		assert.NotContains(t, out.String(), s.uri2, "export-scope output")
+	})
+}
+
 // ---------- tx cmd tests ----------
 
 type txCmdTestCase struct {
@@ -2122,6 +2394,51 @@ func (s *IntegrationCLITestSuite) TestScopeTxCommands() {
 			},
 			false, "", &sdk.TxResponse{}, 0,
 		},
+		{
+			"should fail to add metadata scope data access, invalid expiration",
+			cli.AddRemoveScopeDataAccessCmd(),
+			[]string{
+				"add",
+				scopeID,
+				s.user1AddrStr,
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "notatime"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, `invalid expiration: parsing time "notatime" as "2006-01-02T15:04:05Z07:00": cannot parse "notatime" as "2006"`, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully add metadata scope data access with an expiration and record names",
+			cli.AddRemoveScopeDataAccessCmd(),
+			[]string{
+				"add",
+				scopeID,
+				s.user1AddrStr,
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "2100-01-01T00:00:00Z"),
+				fmt.Sprintf("--%s=%s", cli.FlagRecordNames, "recordname1,recordname2"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully remove metadata scope data access with an expiration",
+			cli.AddRemoveScopeDataAccessCmd(),
+			[]string{
+				"remove",
+				scopeID,
+				s.user1AddrStr,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
 
 		{
 			"should fail to add/remove metadata scope owners, invalid scopeid",
@@ -2179,6 +2496,167 @@ func (s *IntegrationCLITestSuite) TestScopeTxCommands() {
 			},
 			true, "invalid owners: invalid party address [notauser]: decoding bech32 failed: invalid separator index -1", &sdk.TxResponse{}, 0,
 		},
+		{
+			"should fail to tokenize metadata scope, invalid recipient",
+			cli.TokenizeScopeCmd(),
+			[]string{
+				scopeID,
+				fmt.Sprintf("--%s=%s", cli.FlagRecipient, "notauser"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%d", flags.FlagGas, 300000),
+			},
+			true, "invalid recipient: decoding bech32 failed: invalid separator index -1", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully tokenize metadata scope",
+			cli.TokenizeScopeCmd(),
+			[]string{
+				scopeID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%d", flags.FlagGas, 300000),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to remove tokenized metadata scope",
+			cli.RemoveScopeCmd(),
+			[]string{
+				scopeID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 1,
+		},
+		{
+			"should successfully detokenize metadata scope",
+			cli.DetokenizeScopeCmd(),
+			[]string{
+				scopeID,
+				s.accountAddrStr,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to detokenize metadata scope that is not tokenized",
+			cli.DetokenizeScopeCmd(),
+			[]string{
+				scopeID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 1,
+		},
+		{
+			"should fail to clone metadata scope, invalid scope id",
+			cli.CloneScopeCmd(),
+			[]string{
+				scopeID,
+				"notascopeid",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, "invalid scope id or uuid [notascopeid]: decoding bech32 failed: invalid separator index -1", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully clone metadata scope with records",
+			cli.CloneScopeCmd(),
+			[]string{
+				scopeID,
+				uuid.New().String(),
+				fmt.Sprintf("--%s", cli.FlagIncludeRecords),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to offer value owner transfer, invalid expiration",
+			cli.OfferValueOwnerTransferCmd(),
+			[]string{
+				scopeID,
+				s.user1AddrStr,
+				"100" + s.cfg.BondDenom,
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "tomorrow"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, `invalid expiration: parsing time "tomorrow" as "2006-01-02T15:04:05Z07:00": cannot parse "tomorrow" as "2006"`, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully offer value owner transfer",
+			cli.OfferValueOwnerTransferCmd(),
+			[]string{
+				scopeID,
+				s.user1AddrStr,
+				"100" + s.cfg.BondDenom,
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, time.Now().Add(24*time.Hour).UTC().Format(time.RFC3339)),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to remove metadata scope with an open value owner transfer offer",
+			cli.RemoveScopeCmd(),
+			[]string{
+				scopeID,
+				fmt.Sprintf("--%s", cli.FlagCascade),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 1,
+		},
+		{
+			"should fail to accept value owner transfer, wrong price",
+			cli.AcceptValueOwnerTransferCmd(),
+			[]string{
+				scopeID,
+				"99" + s.cfg.BondDenom,
+				fmt.Sprintf("--%s=%s", cli.FlagBuyer, s.user1AddrStr),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 1,
+		},
+		{
+			"should successfully cancel value owner transfer as the seller",
+			cli.CancelValueOwnerTransferCmd(),
+			[]string{
+				scopeID,
+				s.user1AddrStr,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
 		{
 			"should successfully remove metadata scope",
 			cli.RemoveScopeCmd(),
@@ -3395,6 +3873,408 @@ func (s *IntegrationCLITestSuite) TestWriteSessionCmd() {
 			&sdk.TxResponse{},
 			0,
 		},
+		{
+			"remove scope with sessions without cascade",
+			cli.RemoveScopeCmd(),
+			[]string{
+				scopeID.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"rewrite scope with sessions left behind",
+			cli.WriteScopeCmd(),
+			[]string{
+				scopeID.String(),
+				s.scopeSpecID.String(),
+				owner,
+				owner,
+				owner,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"remove scope with sessions with cascade",
+			cli.RemoveScopeCmd(),
+			[]string{
+				scopeID.String(),
+				fmt.Sprintf("--%s", cli.FlagCascade),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+	}
+
+	runTxCmdTestCases(s, testCases)
+}
+
+func (s *IntegrationCLITestSuite) TestImportBundleCmd() {
+	out, err := clitestutil.ExecTestCLICmd(s.getClientCtxWithoutKeyring(), cli.ExportScopeCmd(), []string{s.scopeID.String()})
+	s.Require().NoError(err, "export-scope")
+	var bundle metadatatypes.ScopeBundle
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &bundle), "UnmarshalJSON bundle")
+	// The record input in genesis isn't valid for a new record, so fix it up before importing.
+	bundle.Records[0].Inputs[0].Status = metadatatypes.RecordInputStatus_Proposed
+	bundleBz, err := s.cfg.Codec.MarshalJSON(&bundle)
+	s.Require().NoError(err, "MarshalJSON bundle")
+
+	writeFile := func(name string, contents []byte) string {
+		file := filepath.Join(s.T().TempDir(), name)
+		s.Require().NoError(os.WriteFile(file, contents, 0o600), "writing %s", name)
+		return file
+	}
+	bundleFile := writeFile("bundle.json", bundleBz)
+	generateOnlyArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.user1AddrStr),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	s.T().Run("existing entries are skipped", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ImportBundleCmd(), append([]string{bundleFile}, generateOnlyArgs...))
+		require.NoError(t, err, "import-bundle")
+		result := out.String()
+		for _, exp := range []string{
+			fmt.Sprintf("skipping existing contract specification %s", s.contractSpecID),
+			fmt.Sprintf("skipping existing record specification %s", s.recordSpecID),
+			fmt.Sprintf("skipping existing scope specification %s", s.scopeSpecID),
+			fmt.Sprintf("skipping existing object store locator \"\" for %s", s.user1AddrStr),
+			"/provenance.metadata.v1.MsgWriteScopeRequest",
+			"/provenance.metadata.v1.MsgWriteSessionRequest",
+			"/provenance.metadata.v1.MsgWriteRecordRequest",
+		} {
+			assert.Contains(t, result, exp, "import-bundle output")
+		}
+		assert.NotContains(t, result, "/provenance.metadata.v1.MsgWriteScopeSpecificationRequest", "import-bundle output")
+		assert.NotContains(t, result, "/provenance.metadata.v1.MsgBindOSLocatorRequest", "import-bundle output")
+	})
+
+	s.T().Run("new entries are written", func(t *testing.T) {
+		newBundle := bundle
+		newSpec := *bundle.ScopeSpecification
+		newSpec.SpecificationId = metadatatypes.ScopeSpecMetadataAddress(uuid.New())
+		newBundle.ScopeSpecification = &newSpec
+		newScope := *bundle.Scope
+		newScope.SpecificationId = newSpec.SpecificationId
+		newBundle.Scope = &newScope
+		bz, err := s.cfg.Codec.MarshalJSON(&newBundle)
+		require.NoError(t, err, "MarshalJSON new bundle")
+
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ImportBundleCmd(), append([]string{writeFile("new.json", bz)}, generateOnlyArgs...))
+		require.NoError(t, err, "import-bundle")
+		assert.Contains(t, out.String(), "/provenance.metadata.v1.MsgWriteScopeSpecificationRequest", "import-bundle output")
+		assert.Contains(t, out.String(), newSpec.SpecificationId.String(), "import-bundle output")
+	})
+
+	s.T().Run("invalid bundle", func(t *testing.T) {
+		invalid := bundle
+		invalid.Scope = nil
+		bz, err := s.cfg.Codec.MarshalJSON(&invalid)
+		require.NoError(t, err, "MarshalJSON invalid bundle")
+
+		_, err = clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ImportBundleCmd(), append([]string{writeFile("invalid.json", bz)}, generateOnlyArgs...))
+		assert.EqualError(t, err, "bundle does not have a scope", "import-bundle error")
+	})
+
+	s.T().Run("not a bundle", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ImportBundleCmd(), append([]string{writeFile("notabundle.json", []byte("nope"))}, generateOnlyArgs...))
+		assert.ErrorContains(t, err, "invalid scope bundle", "import-bundle error")
+	})
+}
+
+func (s *IntegrationCLITestSuite) TestApplyManifestCmd() {
+	out, err := clitestutil.ExecTestCLICmd(s.getClientCtxWithoutKeyring(), cli.ExportScopeCmd(), []string{s.scopeID.String()})
+	s.Require().NoError(err, "export-scope")
+	var bundle metadatatypes.ScopeBundle
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &bundle), "UnmarshalJSON bundle")
+	// The record input in genesis isn't valid for a new record, so the manifest's version of it is an update.
+	bundle.Records[0].Inputs[0].Status = metadatatypes.RecordInputStatus_Proposed
+	manifest := metadatatypes.Manifest{
+		ScopeSpecifications:    []metadatatypes.ScopeSpecification{*bundle.ScopeSpecification},
+		ContractSpecifications: bundle.ContractSpecifications,
+		RecordSpecifications:   bundle.RecordSpecifications,
+		Scopes:                 []metadatatypes.Scope{*bundle.Scope},
+		Sessions:               bundle.Sessions,
+		Records:                bundle.Records,
+	}
+
+	writeManifest := func(t *testing.T, name string, manifest metadatatypes.Manifest) string {
+		bz, err := s.cfg.Codec.MarshalJSON(&manifest)
+		require.NoError(t, err, "MarshalJSON manifest")
+		bz, err = yaml.JSONToYAML(bz)
+		require.NoError(t, err, "JSONToYAML manifest")
+		file := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(file, bz, 0o600), "writing %s", name)
+		return file
+	}
+	applyArgs := func(file string, extra ...string) []string {
+		return append([]string{
+			fmt.Sprintf("--%s=%s", cli.FlagManifestFile, file),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.user1AddrStr),
+		}, extra...)
+	}
+	dryRun := fmt.Sprintf("--%s", flags.FlagDryRun)
+	recordID := bundle.Records[0].GetRecordAddress()
+
+	s.T().Run("dry run only lists changes", func(t *testing.T) {
+		file := writeManifest(t, "manifest.yaml", manifest)
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ApplyManifestCmd(), applyArgs(file, dryRun))
+		require.NoError(t, err, "apply")
+		assert.Equal(t, fmt.Sprintf("update record %s\nPlan: 0 to create, 1 to update, 0 to delete, 5 unchanged.\n", recordID),
+			out.String(), "apply output")
+	})
+
+	s.T().Run("new scope is created", func(t *testing.T) {
+		newScope := *bundle.Scope
+		newScope.ScopeId = metadatatypes.ScopeMetadataAddress(uuid.New())
+		newManifest := metadatatypes.Manifest{Scopes: []metadatatypes.Scope{newScope}}
+		file := writeManifest(t, "new.yaml", newManifest)
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ApplyManifestCmd(),
+			applyArgs(file, fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)))
+		require.NoError(t, err, "apply")
+		result := out.String()
+		assert.Contains(t, result, fmt.Sprintf("create scope %s\n", newScope.ScopeId), "apply output")
+		assert.Contains(t, result, "Plan: 1 to create, 0 to update, 0 to delete, 0 unchanged.", "apply output")
+		assert.Contains(t, result, "/provenance.metadata.v1.MsgWriteScopeRequest", "apply output")
+	})
+
+	s.T().Run("prune deletes records not in the manifest", func(t *testing.T) {
+		pruned := manifest
+		pruned.Records = nil
+		file := writeManifest(t, "pruned.json", pruned)
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ApplyManifestCmd(),
+			applyArgs(file, dryRun, fmt.Sprintf("--%s", cli.FlagPrune)))
+		require.NoError(t, err, "apply")
+		assert.Equal(t, fmt.Sprintf("delete record %s\nPlan: 0 to create, 0 to update, 1 to delete, 5 unchanged.\n", recordID),
+			out.String(), "apply output")
+	})
+
+	s.T().Run("invalid manifest", func(t *testing.T) {
+		invalid := manifest
+		invalid.Scopes = append(invalid.Scopes, invalid.Scopes[0])
+		file := writeManifest(t, "invalid.yaml", invalid)
+		_, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ApplyManifestCmd(), applyArgs(file, dryRun))
+		assert.EqualError(t, err, fmt.Sprintf("duplicate scope %s", s.scopeID), "apply error")
+	})
+
+	s.T().Run("not a manifest", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "notamanifest.yaml")
+		require.NoError(t, os.WriteFile(file, []byte("scopes: nope"), 0o600), "writing notamanifest.yaml")
+		_, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ApplyManifestCmd(), applyArgs(file, dryRun))
+		assert.ErrorContains(t, err, "invalid manifest", "apply error")
+	})
+}
+
+func (s *IntegrationCLITestSuite) TestConvertP8eMemorializeContractCmd() {
+	keys := &p8e.SigningAndEncryptionPublicKeys{
+		SigningPublicKey: &p8e.PublicKey{
+			PublicKeyBytes: s.keyringAccounts[1].GetPubKey().Bytes(),
+			Type:           p8e.PublicKeyType_ELLIPTIC,
+			Curve:          p8e.PublicKeyCurve_SECP256K1,
+		},
+	}
+	scopeUUID := uuid.New()
+	p8eMsg := metadatatypes.MsgP8EMemorializeContractRequest{
+		ScopeId:              scopeUUID.String(),
+		GroupId:              uuid.New().String(),
+		ScopeSpecificationId: s.scopeSpecUUID.String(),
+		Contract: &p8e.Contract{
+			Definition: &p8e.DefinitionSpec{
+				Name:             "contractclassname",
+				ResourceLocation: &p8e.Location{Ref: &p8e.ProvenanceReference{Hash: "notreallyasourcehash"}},
+			},
+			Spec: &p8e.Fact{
+				Name:         "spec",
+				DataLocation: &p8e.Location{Ref: &p8e.ProvenanceReference{Hash: s.contractSpecID.String()}},
+			},
+			Invoker: keys,
+			Considerations: []*p8e.Consideration{
+				{
+					ConsiderationName: s.recordName,
+					Inputs:            []*p8e.ProposedFact{{Name: "inputname", Hash: "inputhash", Classname: "inputtypename"}},
+					Result: &p8e.ExecutionResult{
+						Output: &p8e.ProposedFact{Name: s.recordName, Hash: "outputhash", Classname: "recordtypename"},
+						Result: p8e.ExecutionResultType_RESULT_TYPE_PASS,
+					},
+				},
+			},
+			Recitals: []*p8e.Recital{{SignerRole: p8e.PartyType_PARTY_TYPE_OWNER, Signer: keys}},
+		},
+		Signatures: &p8e.SignatureSet{Signatures: []*p8e.Signature{{Signer: keys}}},
+		Invoker:    s.user1AddrStr,
+	}
+
+	writeFile := func(t *testing.T, name string, contents []byte) string {
+		file := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(file, contents, 0o600), "writing %s", name)
+		return file
+	}
+	p8eBz, err := s.cfg.Codec.MarshalJSON(&p8eMsg)
+	s.Require().NoError(err, "MarshalJSON p8e msg")
+	p8eFile := writeFile(s.T(), "p8e.json", p8eBz)
+
+	var converted metadatatypes.MsgExecuteContractSessionRequest
+	s.T().Run("p8e msg is converted", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ConvertP8eMemorializeContractCmd(), []string{p8eFile})
+		require.NoError(t, err, "convert-p8e-memorialize-contract")
+		require.NoError(t, s.cfg.Codec.UnmarshalJSON(out.Bytes(), &converted), "UnmarshalJSON converted msg")
+		assert.Equal(t, metadatatypes.ScopeMetadataAddress(scopeUUID), converted.Scope.ScopeId, "scope id")
+		assert.Equal(t, s.contractSpecID, converted.Session.SpecificationId, "session specification id")
+		require.Len(t, converted.Records, 1, "records")
+		assert.Equal(t, s.recordName, converted.Records[0].Record.Name, "record name")
+		assert.Equal(t, []string{s.user1AddrStr}, converted.Signers, "signers")
+	})
+
+	s.T().Run("converted msg is executed", func(t *testing.T) {
+		bz, err := s.cfg.Codec.MarshalJSON(&converted)
+		require.NoError(t, err, "MarshalJSON converted msg")
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ExecuteContractSessionCmd(), []string{
+			writeFile(t, "session.json", bz),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.user1AddrStr),
+			fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		})
+		require.NoError(t, err, "execute-contract-session")
+		assert.Contains(t, out.String(), "/provenance.metadata.v1.MsgExecuteContractSessionRequest", "execute-contract-session output")
+		assert.Contains(t, out.String(), converted.Scope.ScopeId.String(), "execute-contract-session output")
+	})
+
+	s.T().Run("signers flag replaces the msg signers", func(t *testing.T) {
+		bz, err := s.cfg.Codec.MarshalJSON(&converted)
+		require.NoError(t, err, "MarshalJSON converted msg")
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ExecuteContractSessionCmd(), []string{
+			writeFile(t, "session.json", bz),
+			fmt.Sprintf("--%s=%s", cli.FlagSigners, s.user2AddrStr),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.user2AddrStr),
+			fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		})
+		require.NoError(t, err, "execute-contract-session")
+		assert.Contains(t, out.String(), fmt.Sprintf("\"signers\":[\"%s\"]", s.user2AddrStr), "execute-contract-session output")
+	})
+
+	s.T().Run("invalid p8e msg", func(t *testing.T) {
+		invalid := p8eMsg
+		invalid.Contract = nil
+		bz, err := s.cfg.Codec.MarshalJSON(&invalid)
+		require.NoError(t, err, "MarshalJSON invalid p8e msg")
+		_, err = clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ConvertP8eMemorializeContractCmd(), []string{writeFile(t, "invalid.json", bz)})
+		assert.EqualError(t, err, "missing contract value", "convert-p8e-memorialize-contract error")
+	})
+
+	s.T().Run("not a p8e msg", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ConvertP8eMemorializeContractCmd(), []string{writeFile(t, "nope.json", []byte("nope"))})
+		assert.ErrorContains(t, err, "invalid p8e memorialize contract msg", "convert-p8e-memorialize-contract error")
+	})
+}
+
+func (s *IntegrationCLITestSuite) TestProposalCmd() {
+	writeProposal := func(name, contents string) string {
+		file := filepath.Join(s.T().TempDir(), name)
+		s.Require().NoError(os.WriteFile(file, []byte(contents), 0o600), "writing %s", name)
+		return file
+	}
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	unlockFile := writeProposal("unlock.json", fmt.Sprintf(`{"title":"Unlock","description":"court order","scope_id":"%s"}`, scopeID))
+	badScopeFile := writeProposal("badscope.json", fmt.Sprintf(`{"title":"Unlock","description":"court order","scope_id":"%s"}`, s.sessionID))
+	deposit := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()
+	txArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []txCmdTestCase{
+		{
+			"unlock scope proposal for a scope that isn't locked",
+			cli.ProposalCmd(),
+			append([]string{"unlock-scope", unlockFile, deposit}, txArgs...),
+			false,
+			"",
+			&sdk.TxResponse{},
+			5,
+		},
+		{
+			"write scope to lock",
+			cli.WriteScopeCmd(),
+			append([]string{scopeID.String(), s.scopeSpecID.String(), s.accountAddrStr, s.accountAddrStr, s.accountAddrStr}, txArgs...),
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"lock scope",
+			cli.LockScopeCmd(),
+			append([]string{scopeID.String(), "pending litigation"}, txArgs...),
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"unlock scope proposal",
+			cli.ProposalCmd(),
+			append([]string{"unlock-scope", unlockFile, deposit}, txArgs...),
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"unlock scope proposal by proposal type",
+			cli.ProposalCmd(),
+			append([]string{metadatatypes.ProposalTypeUnlockScope, unlockFile, deposit}, txArgs...),
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"unlock scope proposal with a session id",
+			cli.ProposalCmd(),
+			append([]string{"unlock-scope", badScopeFile, deposit}, txArgs...),
+			true,
+			fmt.Sprintf("address is not a scope id: %s", s.sessionID),
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"unknown proposal type",
+			cli.ProposalCmd(),
+			append([]string{"lock-scope", unlockFile, deposit}, txArgs...),
+			true,
+			"unknown proposal type lock-scope",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"invalid deposit",
+			cli.ProposalCmd(),
+			append([]string{"unlock-scope", unlockFile, "notacoin!"}, txArgs...),
+			true,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
 	}
 
 	runTxCmdTestCases(s, testCases)
@@ -2,19 +2,25 @@ package cli
 
 import (
 	"context"
+	"crypto/sha512"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -28,7 +34,17 @@ var (
 	includeSessions    bool
 	includeRecords     bool
 	includeRecordSpecs bool
+	includeAttributes  bool
 	includeRequest     bool
+
+	ownerRole string
+
+	partyAddress  string
+	partyRole     string
+	updatedAfter  string
+	updatedBefore string
+	outputStatus  string
+	inputStatus   string
 )
 
 const all = "all"
@@ -53,9 +69,19 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataScopeSpecCmd(),
 		GetMetadataContractSpecCmd(),
 		GetMetadataRecordSpecCmd(),
+		GetRecordTypeCmd(),
+		ValidatePayloadCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
+		GetAccessibleScopesCmd(),
+		GetValueOwnerTransferOffersCmd(),
+		GetScopeHistoryCmd(),
+		GetSessionsBySpecCmd(),
+		GetRecordsBySpecCmd(),
+		GetRecordsMissingResponsiblePartiesCmd(),
+		GetSpecVersionsCmd(),
 		GetOSLocatorCmd(),
+		ExportScopeCmd(),
 	)
 	return queryCmd
 }
@@ -139,6 +165,7 @@ func GetMetadataByIDCmd() *cobra.Command {
 	addIncludeSessionsFlag(cmd)
 	addIncludeRecordsFlag(cmd)
 	addIncludeRecordSpecsFlag(cmd)
+	addIncludeAttributesFlag(cmd)
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -244,6 +271,7 @@ func GetMetadataScopeCmd() *cobra.Command {
 
 	addIncludeSessionsFlag(cmd)
 	addIncludeRecordsFlag(cmd)
+	addIncludeAttributesFlag(cmd)
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scopes (all)")
@@ -265,7 +293,10 @@ func GetMetadataSessionCmd() *cobra.Command {
 %[1]s session {scope_uuid} {session_uuid} - gets a session with the given scope uuid and session uuid.
 %[1]s session {scope_uuid} {record_name} - gets the session in the given scope containing the given record.
 %[1]s session {record_id} - gets the session containing the given record.
-%[1]s session all - gets all sessions.`, cmdStart),
+%[1]s session all - gets all sessions.
+
+The --party, --party-role, --updated-after, and --updated-before flags can be used to only include sessions
+with a matching party, or that were last updated within a time range.`, cmdStart),
 		Args: cobra.RangeArgs(1, 2),
 		Example: fmt.Sprintf(`%[1]s session session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
 %[1]s session scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
@@ -275,7 +306,9 @@ func GetMetadataSessionCmd() *cobra.Command {
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
 %[1]s session record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
-%[1]s session all`, cmdStart),
+%[1]s session all
+%[1]s session scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --party pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s session all --updated-after 2022-03-01T00:00:00Z --updated-before 2022-04-01T00:00:00Z`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
 			if arg0 == all {
@@ -305,6 +338,9 @@ func GetMetadataSessionCmd() *cobra.Command {
 
 	addIncludeScopeFlag(cmd)
 	addIncludeRecordsFlag(cmd)
+	addPartyFilterFlags(cmd, "sessions")
+	cmd.Flags().StringVar(&updatedAfter, "updated-after", "", "only include sessions last updated at or after this RFC 3339 time")
+	cmd.Flags().StringVar(&updatedBefore, "updated-before", "", "only include sessions last updated before this RFC 3339 time")
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "sessions (all)")
@@ -325,7 +361,10 @@ func GetMetadataRecordCmd() *cobra.Command {
 %[1]s record {scope_id} {record_name} - gets the record with the given name from the given scope.
 %[1]s record {scope_uuid} - gets the list of records associated with a scope uuid.
 %[1]s record {scope_uuid} {record_name} - gets the record with the given name from the given scope.
-%[1]s record all - all records.`, cmdStart),
+%[1]s record all - all records.
+
+The --party, --party-role, --output-status, and --input-status flags can be used to only include records
+in sessions with a matching party, or with an output or input having the given status.`, cmdStart),
 		Args: cobra.MinimumNArgs(1),
 		Example: fmt.Sprintf(`%[1]s record record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
 %[1]s record session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
@@ -334,7 +373,9 @@ func GetMetadataRecordCmd() *cobra.Command {
 %[1]s record scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel recordname
 %[1]s record 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s record 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
-%[1]s record all`, cmdStart),
+%[1]s record all
+%[1]s record scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --output-status fail
+%[1]s record all --party pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --party-role servicer`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
 			if arg0 == all {
@@ -361,6 +402,9 @@ func GetMetadataRecordCmd() *cobra.Command {
 
 	addIncludeScopeFlag(cmd)
 	addIncludeSessionsFlag(cmd)
+	addPartyFilterFlags(cmd, "records in sessions")
+	cmd.Flags().StringVar(&outputStatus, "output-status", "", "only include records with an output having this result status, e.g. pass, skip, fail")
+	cmd.Flags().StringVar(&inputStatus, "input-status", "", "only include records with an input having this status, e.g. proposed, record")
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "records (all)")
@@ -471,6 +515,72 @@ func GetMetadataRecordSpecCmd() *cobra.Command {
 	return cmd
 }
 
+// GetRecordTypeCmd returns the command handler for registered record type querying.
+func GetRecordTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recordtype {name|\"all\"}",
+		Aliases: []string{"rt", "recordtypes", "record-type", "record-types"},
+		Short:   "Query the registered record types",
+		Long: fmt.Sprintf(`%[1]s recordtype {name} - gets the registered record type with the given name.
+%[1]s recordtype all - gets all the registered record types`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s recordtype io.provenance.Loan
+%[1]s recordtype all`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg0 := strings.TrimSpace(args[0])
+			if arg0 == all {
+				return outputRecordTypesAll(cmd)
+			}
+			return outputRecordType(cmd, arg0)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record types (all)")
+
+	return cmd
+}
+
+// ValidatePayloadCmd returns the command handler for validating an off-chain payload against a registered record type.
+func ValidatePayloadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-payload [type-name] [payload-file]",
+		Short: "Validate a payload against a registered record type and output its hash",
+		Long: fmt.Sprintf(`%[1]s validate-payload [type-name] [payload-file] - checks that the contents of the payload file satisfy the
+schema of the registered record type, then outputs the base64 encoded sha512 hash of the payload.
+For record types with a proto schema, the payload can be either the binary or JSON encoding of the message.`, cmdStart),
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s validate-payload io.provenance.Loan loan.json`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			payload, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordType(context.Background(), &types.RecordTypeRequest{Name: strings.TrimSpace(args[0])})
+			if err != nil {
+				return err
+			}
+			if err = res.RecordType.ValidatePayload(payload); err != nil {
+				return err
+			}
+
+			hash := sha512.Sum512(payload)
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", base64.StdEncoding.EncodeToString(hash[:])))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetOwnershipCmd returns the command handler for metadata entry querying by owner address
 func GetOwnershipCmd() *cobra.Command {
 	// Note: Once we get queries for ownership of things other than scopes,
@@ -480,18 +590,26 @@ func GetOwnershipCmd() *cobra.Command {
 		Use:     "owner address",
 		Aliases: []string{"o", "ownership"},
 		Short:   "Query the current metadata for entries owned by an address",
-		Long:    fmt.Sprintf(`%[1]s owner {address} - gets a list of scope uuids owned by the provided address.`, cmdStart),
-		Args:    cobra.ExactArgs(1),
-		Example: fmt.Sprintf(`%[1]s owner pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
+		Long: fmt.Sprintf(`%[1]s owner {address} [--role {role}] - gets a list of scope uuids owned by the provided address.
+
+The --role flag limits the results to scopes where the address is an owner with that party type, e.g. originator.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s owner pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s owner pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --role originator`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			address := strings.TrimSpace(args[0])
 			if len(address) == 0 {
 				return fmt.Errorf("empty address")
 			}
-			return outputOwnership(cmd, address)
+			role, err := parseOwnerRole(ownerRole)
+			if err != nil {
+				return err
+			}
+			return outputOwnership(cmd, address, role)
 		},
 	}
 
+	cmd.Flags().StringVar(&ownerRole, "role", "", "only include scopes where the address is an owner with this party type")
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "scopes")
@@ -505,7 +623,8 @@ func GetValueOwnershipCmd() *cobra.Command {
 		Use:     "valueowner address",
 		Aliases: []string{"vo", "valueownership"},
 		Short:   "Query the current metadata for scopes with the provided address as the value owner",
-		Long:    fmt.Sprintf(`%[1]s valueowner {address} - gets a list of scope uuids value-owned by the provided address.`, cmdStart),
+		Long: fmt.Sprintf(`%[1]s valueowner {address} - gets a list of scope uuids value-owned by the provided address.
+This includes the tokenized scopes whose marker coin is held by the provided address.`, cmdStart),
 		Args:    cobra.ExactArgs(1),
 		Example: fmt.Sprintf(`%[1]s valueowner pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -524,20 +643,231 @@ func GetValueOwnershipCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAccessibleScopesCmd returns the command handler for metadata scope querying by data access address
+func GetAccessibleScopesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accessible address",
+		Aliases: []string{"da", "dataaccess"},
+		Short:   "Query the current metadata for scopes with the provided address in their data access list",
+		Long:    fmt.Sprintf(`%[1]s accessible {address} - gets a list of scope uuids that the provided address has data access to.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s accessible pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := strings.TrimSpace(args[0])
+			if len(address) == 0 {
+				return fmt.Errorf("empty address")
+			}
+			return outputAccessibleScopes(cmd, address)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
+
+// GetValueOwnerTransferOffersCmd returns the command handler for querying open value owner transfer offers by scope
+// or by seller/buyer address.
+func GetValueOwnerTransferOffersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offers {scope_id|scope_uuid|address}",
+		Aliases: []string{"offer", "value-owner-offers"},
+		Short:   "Query the open offers to transfer the value ownership of scopes",
+		Long: fmt.Sprintf(`%[1]s offers {scope_id|scope_uuid} - gets the open value owner transfer offers for the scope.
+%[1]s offers {address} - gets the open value owner transfer offers that the address is the seller or buyer in.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s offers scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s offers pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg := strings.TrimSpace(args[0])
+			if len(arg) == 0 {
+				return fmt.Errorf("empty scope id or address")
+			}
+			if _, err := sdk.AccAddressFromBech32(arg); err == nil {
+				return outputValueOwnerTransferOffersByAddress(cmd, arg)
+			}
+			return outputValueOwnerTransferOffers(cmd, arg)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers")
+
+	return cmd
+}
+
+// GetScopeHistoryCmd returns the command handler for querying the history of a scope.
+func GetScopeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history {scope_id|scope_uuid} [height]",
+		Aliases: []string{"hist"},
+		Short:   "Query the change history of a scope",
+		Long: fmt.Sprintf(`%[1]s history {scope_id|scope_uuid} - gets the history entries for the scope, its sessions, and its records.
+%[1]s history {scope_id|scope_uuid} {height} - gets the records of the scope as they were at the end of the given block height.`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`%[1]s history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s history 91978ba2-5f35-459a-86a7-feca1b0512e0 123456`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := strings.TrimSpace(args[0])
+			if len(scopeID) == 0 {
+				return fmt.Errorf("empty scope id")
+			}
+			if len(args) == 1 {
+				return outputScopeHistory(cmd, scopeID)
+			}
+			height, err := strconv.ParseInt(strings.TrimSpace(args[1]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+			return outputScopeRecordsAtHeight(cmd, scopeID, height)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history entries")
+
+	return cmd
+}
+
+// GetSessionsBySpecCmd returns the command handler for querying sessions by contract specification.
+func GetSessionsBySpecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sessions-by-spec {contract_spec_id|contract_spec_uuid}",
+		Aliases: []string{"sbs"},
+		Short:   "Query the current metadata for sessions created using a contract specification",
+		Long:    fmt.Sprintf(`%[1]s sessions-by-spec {contract_spec_id|contract_spec_uuid} - gets the sessions, across all scopes, that use that contract specification.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s sessions-by-spec contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn
+%[1]s sessions-by-spec def6bc0a-c9dd-4874-948f-5206e6060a84`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			specID := strings.TrimSpace(args[0])
+			if len(specID) == 0 {
+				return fmt.Errorf("empty specification id")
+			}
+			return outputSessionsBySpec(cmd, specID)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sessions")
+
+	return cmd
+}
+
+// GetRecordsBySpecCmd returns the command handler for querying records by record specification.
+func GetRecordsBySpecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "records-by-spec {rec_spec_id|{contract_spec_id|contract_spec_uuid} record_name}",
+		Aliases: []string{"rbs"},
+		Short:   "Query the current metadata for records created using a record specification",
+		Long: fmt.Sprintf(`%[1]s records-by-spec {rec_spec_id} - gets the records, across all scopes, that use that record specification.
+%[1]s records-by-spec {contract_spec_id|contract_spec_uuid} {record_name} - gets the records, across all scopes, that use the record specification with that name in that contract specification.`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`%[1]s records-by-spec recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44
+%[1]s records-by-spec contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn recordname
+%[1]s records-by-spec def6bc0a-c9dd-4874-948f-5206e6060a84 recordname`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			specID := strings.TrimSpace(args[0])
+			if len(specID) == 0 {
+				return fmt.Errorf("empty specification id")
+			}
+			name := ""
+			if len(args) > 1 {
+				name = strings.TrimSpace(args[1])
+			}
+			return outputRecordsBySpec(cmd, specID, name)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+
+	return cmd
+}
+
+// GetRecordsMissingResponsiblePartiesCmd returns the command handler for querying the records that were written
+// without all of their responsible parties.
+func GetRecordsMissingResponsiblePartiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "records-missing-responsible-parties [scope_id|scope_uuid]",
+		Aliases: []string{"rmrp", "missing-responsible-parties"},
+		Short:   "Query the records that were written without their responsible parties",
+		Long: fmt.Sprintf(`%[1]s records-missing-responsible-parties - gets all records, across all scopes, that were last written without a signer of each of their responsible party types.
+%[1]s records-missing-responsible-parties {scope_id|scope_uuid} - gets the records in that scope that were last written without a signer of each of their responsible party types.`, cmdStart),
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s records-missing-responsible-parties
+%[1]s records-missing-responsible-parties scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s records-missing-responsible-parties 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := ""
+			if len(args) > 0 {
+				scopeID = strings.TrimSpace(args[0])
+			}
+			return outputRecordsMissingResponsibleParties(cmd, scopeID)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+
+	return cmd
+}
+
+// GetSpecVersionsCmd returns the command handler for querying the versions of a scope or contract specification.
+func GetSpecVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spec-versions {scope_spec_id|contract_spec_id}",
+		Aliases: []string{"sv", "versions"},
+		Short:   "Query all versions of a scope or contract specification",
+		Long: fmt.Sprintf(`%[1]s spec-versions {scope_spec_id} - gets all versions of that scope specification, oldest first.
+%[1]s spec-versions {contract_spec_id} - gets all versions of that contract specification, oldest first.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s spec-versions scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m
+%[1]s spec-versions contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg0 := strings.TrimSpace(args[0])
+			specID, err := types.MetadataAddressFromBech32(arg0)
+			if err != nil {
+				return fmt.Errorf("invalid specification id %q: %w", arg0, err)
+			}
+			switch {
+			case specID.IsScopeSpecificationAddress():
+				return outputScopeSpecVersions(cmd, arg0)
+			case specID.IsContractSpecificationAddress():
+				return outputContractSpecVersions(cmd, arg0)
+			}
+			return fmt.Errorf("id %s is not a scope or contract specification id", arg0)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetOSLocatorCmd returns the command handler for metadata object store locator querying.
 func GetOSLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "locator {owner|scope_id|scope_uuid|uri|\"params\"|\"all\"}",
 		Aliases: []string{"l", "locators"},
 		Short:   "Query the current metadata for object store locators",
-		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the object store locator for that owner.
-%[1]s locator {scope_id} - gets object store locators for all the owners of that scope.
-%[1]s locator {scope_uuid} - gets object store locators for all the owners of that scope.
+		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the object store locators for that owner.
+%[1]s locator {scope_id} - gets object store locators for all the owners and data access parties of that scope.
+%[1]s locator {scope_uuid} - gets object store locators for all the owners and data access parties of that scope.
 %[1]s locator {uri} - gets object store locators with that uri.
 %[1]s locator params - gets the object store locator params.
 %[1]s locator all - gets all object store locators.`, cmdStart),
 		Args: cobra.ExactArgs(1),
 		Example: fmt.Sprintf(`%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --locator-name backup
 %[1]s locator scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s locator 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s locator https://provenance.io/
@@ -576,6 +906,30 @@ func GetOSLocatorCmd() *cobra.Command {
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "locators (all)")
+	cmd.Flags().String(FlagLocatorName, "", "name of the owner's locator to get")
+
+	return cmd
+}
+
+// ExportScopeCmd returns the command handler for exporting a scope along with everything it references.
+func ExportScopeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-scope {scope_id|scope_uuid}",
+		Short: "Export a scope and everything it references as a JSON bundle",
+		Long: fmt.Sprintf(`%[1]s export-scope {scope_id} - outputs a JSON bundle with the scope, its sessions and records,
+the scope, contract, and record specifications they use, the registered record types used by those record
+specifications, and the object store locators of the scope's owners.
+%[1]s export-scope {scope_uuid} - same as above, for the scope with the given uuid.
+The bundle can be imported into another chain using the tx metadata import-bundle command.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s export-scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel > scope-bundle.json
+%[1]s export-scope 91978ba2-5f35-459a-86a7-feca1b0512e0 > scope-bundle.json`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return outputScopeBundle(cmd, strings.TrimSpace(args[0]))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -610,11 +964,12 @@ func outputScope(cmd *cobra.Command, scopeID string, sessionAddr string, recordA
 	}
 
 	req := types.ScopeRequest{
-		ScopeId:         scopeID,
-		SessionAddr:     sessionAddr,
-		RecordAddr:      recordAddr,
-		IncludeSessions: includeSessions,
-		IncludeRecords:  includeRecords,
+		ScopeId:           scopeID,
+		SessionAddr:       sessionAddr,
+		RecordAddr:        recordAddr,
+		IncludeSessions:   includeSessions,
+		IncludeRecords:    includeRecords,
+		IncludeAttributes: includeAttributes,
 	}
 
 	queryClient := types.NewQueryClient(clientCtx)
@@ -663,6 +1018,11 @@ func outputSessions(cmd *cobra.Command, scopeID, sessionID, recordID, recordName
 		return err
 	}
 
+	role, err := parseOwnerRole(partyRole)
+	if err != nil {
+		return err
+	}
+
 	req := types.SessionsRequest{
 		ScopeId:        scopeID,
 		SessionId:      sessionID,
@@ -670,6 +1030,10 @@ func outputSessions(cmd *cobra.Command, scopeID, sessionID, recordID, recordName
 		RecordName:     recordName,
 		IncludeScope:   includeScope,
 		IncludeRecords: includeRecords,
+		PartyAddress:   partyAddress,
+		PartyRole:      role,
+		UpdatedAfter:   updatedAfter,
+		UpdatedBefore:  updatedBefore,
 	}
 
 	queryClient := types.NewQueryClient(clientCtx)
@@ -698,10 +1062,20 @@ func outputSessionsAll(cmd *cobra.Command) error {
 	if e != nil {
 		return e
 	}
+	role, err := parseOwnerRole(partyRole)
+	if err != nil {
+		return err
+	}
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.SessionsAll(
 		context.Background(),
-		&types.SessionsAllRequest{Pagination: pageReq},
+		&types.SessionsAllRequest{
+			PartyAddress:  partyAddress,
+			PartyRole:     role,
+			UpdatedAfter:  updatedAfter,
+			UpdatedBefore: updatedBefore,
+			Pagination:    pageReq,
+		},
 	)
 	if err != nil {
 		return err
@@ -721,6 +1095,11 @@ func outputRecords(cmd *cobra.Command, recordAddr string, scopeID string, sessio
 		return err
 	}
 
+	role, outStatus, inStatus, err := parseRecordFilterFlags()
+	if err != nil {
+		return err
+	}
+
 	req := types.RecordsRequest{
 		RecordAddr:      recordAddr,
 		ScopeId:         scopeID,
@@ -728,6 +1107,10 @@ func outputRecords(cmd *cobra.Command, recordAddr string, scopeID string, sessio
 		Name:            name,
 		IncludeScope:    includeScope,
 		IncludeSessions: includeSessions,
+		PartyAddress:    partyAddress,
+		PartyRole:       role,
+		OutputStatus:    outStatus,
+		InputStatus:     inStatus,
 	}
 
 	queryClient := types.NewQueryClient(clientCtx)
@@ -753,10 +1136,20 @@ func outputRecordsAll(cmd *cobra.Command) error {
 	if e != nil {
 		return e
 	}
+	role, outStatus, inStatus, err := parseRecordFilterFlags()
+	if err != nil {
+		return err
+	}
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.RecordsAll(
 		context.Background(),
-		&types.RecordsAllRequest{Pagination: pageReq},
+		&types.RecordsAllRequest{
+			PartyAddress: partyAddress,
+			PartyRole:    role,
+			OutputStatus: outStatus,
+			InputStatus:  inStatus,
+			Pagination:   pageReq,
+		},
 	)
 	if err != nil {
 		return err
@@ -770,7 +1163,7 @@ func outputRecordsAll(cmd *cobra.Command) error {
 }
 
 // outputOwnership calls the Ownership query and outputs the response.
-func outputOwnership(cmd *cobra.Command, address string) error {
+func outputOwnership(cmd *cobra.Command, address string, role types.PartyType) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
 		return err
@@ -782,7 +1175,7 @@ func outputOwnership(cmd *cobra.Command, address string) error {
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.Ownership(
 		context.Background(),
-		&types.OwnershipRequest{Address: address, Pagination: pageReq},
+		&types.OwnershipRequest{Address: address, Role: role, Pagination: pageReq},
 	)
 	if err != nil {
 		return err
@@ -821,6 +1214,210 @@ func outputValueOwnership(cmd *cobra.Command, address string) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputAccessibleScopes calls the AccessibleScopes query and outputs the response.
+func outputAccessibleScopes(cmd *cobra.Command, address string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.AccessibleScopes(
+		context.Background(),
+		&types.AccessibleScopesRequest{Address: address, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputScopeHistory calls the ScopeHistory query and outputs the response.
+func outputScopeHistory(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeHistory(
+		context.Background(),
+		&types.ScopeHistoryRequest{ScopeId: scopeID, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputValueOwnerTransferOffers calls the ValueOwnerTransferOffers query and outputs the response.
+func outputValueOwnerTransferOffers(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ValueOwnerTransferOffers(
+		context.Background(),
+		&types.ValueOwnerTransferOffersRequest{ScopeId: scopeID, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputValueOwnerTransferOffersByAddress calls the ValueOwnerTransferOffersByAddress query and outputs the response.
+func outputValueOwnerTransferOffersByAddress(cmd *cobra.Command, address string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ValueOwnerTransferOffersByAddress(
+		context.Background(),
+		&types.ValueOwnerTransferOffersByAddressRequest{Address: address, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputScopeRecordsAtHeight calls the ScopeRecordsAtHeight query and outputs the response.
+func outputScopeRecordsAtHeight(cmd *cobra.Command, scopeID string, height int64) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeRecordsAtHeight(
+		context.Background(),
+		&types.ScopeRecordsAtHeightRequest{ScopeId: scopeID, Height: height},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputSessionsBySpec calls the SessionsBySpec query and outputs the response.
+func outputSessionsBySpec(cmd *cobra.Command, specificationID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SessionsBySpec(
+		context.Background(),
+		&types.SessionsBySpecRequest{SpecificationId: specificationID, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputRecordsBySpec calls the RecordsBySpec query and outputs the response.
+func outputRecordsBySpec(cmd *cobra.Command, specificationID string, name string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordsBySpec(
+		context.Background(),
+		&types.RecordsBySpecRequest{SpecificationId: specificationID, Name: name, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputRecordsMissingResponsibleParties calls the RecordsMissingResponsibleParties query and outputs the response.
+func outputRecordsMissingResponsibleParties(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordsMissingResponsibleParties(
+		context.Background(),
+		&types.RecordsMissingResponsiblePartiesRequest{ScopeId: scopeID, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputScopeSpec calls the ScopeSpecification query and outputs the response.
 func outputScopeSpec(cmd *cobra.Command, specificationID string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -845,6 +1442,52 @@ func outputScopeSpec(cmd *cobra.Command, specificationID string) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputScopeSpecVersions calls the ScopeSpecificationVersions query and outputs the response.
+func outputScopeSpecVersions(cmd *cobra.Command, specificationID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeSpecificationVersions(
+		context.Background(),
+		&types.ScopeSpecificationVersionsRequest{SpecificationId: specificationID},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputContractSpecVersions calls the ContractSpecificationVersions query and outputs the response.
+func outputContractSpecVersions(cmd *cobra.Command, specificationID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ContractSpecificationVersions(
+		context.Background(),
+		&types.ContractSpecificationVersionsRequest{SpecificationId: specificationID},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputScopeSpecsAll calls the ScopeSpecificationsAll query and outputs the response.
 func outputScopeSpecsAll(cmd *cobra.Command) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -997,6 +1640,52 @@ func outputRecordSpecsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputRecordType calls the RecordType query and outputs the response.
+func outputRecordType(cmd *cobra.Command, name string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordType(context.Background(), &types.RecordTypeRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputRecordTypesAll calls the RecordTypesAll query and outputs the response.
+func outputRecordTypesAll(cmd *cobra.Command) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordTypesAll(
+		context.Background(),
+		&types.RecordTypesAllRequest{Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputOSLocatorParams calls the OSLocatorParams query and outputs the response.
 func outputOSLocatorParams(cmd *cobra.Command) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -1026,9 +1715,10 @@ func outputOSLocator(cmd *cobra.Command, owner string) error {
 		return err
 	}
 	queryClient := types.NewQueryClient(clientCtx)
+	name, _ := cmd.Flags().GetString(FlagLocatorName)
 	res, err := queryClient.OSLocator(
 		context.Background(),
-		&types.OSLocatorRequest{Owner: owner},
+		&types.OSLocatorRequest{Owner: owner, Name: name},
 	)
 	if err != nil {
 		return err
@@ -1115,6 +1805,105 @@ func outputOSLocatorsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputScopeBundle queries a scope and everything it references, and outputs it all as a JSON bundle.
+func outputScopeBundle(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	ctx := context.Background()
+
+	scopeRes, err := queryClient.Scope(ctx, &types.ScopeRequest{ScopeId: scopeID, IncludeSessions: true, IncludeRecords: true})
+	if err != nil {
+		return err
+	}
+	if scopeRes.Scope == nil || scopeRes.Scope.Scope == nil {
+		return fmt.Errorf("scope %s not found", scopeID)
+	}
+	bundle := types.ScopeBundle{Scope: scopeRes.Scope.Scope}
+	for _, sw := range scopeRes.Sessions {
+		bundle.Sessions = append(bundle.Sessions, *sw.Session)
+	}
+	for _, rw := range scopeRes.Records {
+		bundle.Records = append(bundle.Records, *rw.Record)
+	}
+
+	var contractSpecIDs []types.MetadataAddress
+	addContractSpecID := func(id types.MetadataAddress) {
+		for _, known := range contractSpecIDs {
+			if known.Equals(id) {
+				return
+			}
+		}
+		contractSpecIDs = append(contractSpecIDs, id)
+	}
+	scopeSpecRes, err := queryClient.ScopeSpecification(ctx, &types.ScopeSpecificationRequest{SpecificationId: bundle.Scope.SpecificationId.String()})
+	if err != nil {
+		return err
+	}
+	if scopeSpecRes.ScopeSpecification != nil && scopeSpecRes.ScopeSpecification.Specification != nil {
+		bundle.ScopeSpecification = scopeSpecRes.ScopeSpecification.Specification
+		for _, id := range bundle.ScopeSpecification.ContractSpecIds {
+			addContractSpecID(id)
+		}
+	}
+	for _, session := range bundle.Sessions {
+		addContractSpecID(session.SpecificationId)
+	}
+
+	var typeNames []string
+	addTypeName := func(name string) {
+		for _, known := range typeNames {
+			if known == name {
+				return
+			}
+		}
+		typeNames = append(typeNames, name)
+	}
+	for _, id := range contractSpecIDs {
+		res, err := queryClient.ContractSpecification(ctx, &types.ContractSpecificationRequest{SpecificationId: id.String(), IncludeRecordSpecs: true})
+		if err != nil {
+			return err
+		}
+		if res.ContractSpecification == nil || res.ContractSpecification.Specification == nil {
+			continue
+		}
+		bundle.ContractSpecifications = append(bundle.ContractSpecifications, *res.ContractSpecification.Specification)
+		for _, rsw := range res.RecordSpecifications {
+			bundle.RecordSpecifications = append(bundle.RecordSpecifications, *rsw.Specification)
+			addTypeName(rsw.Specification.TypeName)
+			for _, input := range rsw.Specification.Inputs {
+				addTypeName(input.TypeName)
+			}
+		}
+	}
+
+	// Type names that aren't registered record types are left out.
+	for _, name := range typeNames {
+		res, err := queryClient.RecordType(ctx, &types.RecordTypeRequest{Name: name})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return err
+		}
+		bundle.RecordTypes = append(bundle.RecordTypes, *res.RecordType)
+	}
+
+	locatorRes, err := queryClient.OSLocatorsByScope(ctx, &types.OSLocatorsByScopeRequest{ScopeId: bundle.Scope.ScopeId.String()})
+	if err != nil {
+		return err
+	}
+	for _, locator := range locatorRes.Locators {
+		if _, isOwner := bundle.Scope.GetOwnerIndexWithAddress(locator.Owner); isOwner {
+			bundle.OsLocators = append(bundle.OsLocators, locator)
+		}
+	}
+
+	return clientCtx.WithOutputFormat("json").PrintProto(&bundle)
+}
+
 // ------------ private generic helper functions ------------
 
 // trimSpaceAndJoin trims leading and trailing whitespace from each arg,
@@ -1152,12 +1941,75 @@ func addIncludeRecordSpecsFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&includeRecordSpecs, "include-record-specs", false, "include record specs in the output")
 }
 
+// addIncludeAttributesFlag sets up a command to look for an --include-attributes flag.
+// The flag value is tied to the includeAttributes variable.
+func addIncludeAttributesFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&includeAttributes, "include-attributes", false, "include the scope's attributes in the output")
+}
+
 // addIncludeRequestFlag sets up a command to look for an --include-request.
 // The flag value is tied to the includeRequest variable.
 func addIncludeRequestFlag(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&includeRequest, "include-request", false, "include the query request in the output")
 }
 
+// parseOwnerRole converts the value of the --role flag into a PartyType.
+// An empty value results in PARTY_TYPE_UNSPECIFIED, i.e. no role filtering.
+func parseOwnerRole(role string) (types.PartyType, error) {
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if len(role) == 0 {
+		return types.PartyType_PARTY_TYPE_UNSPECIFIED, nil
+	}
+	if !strings.HasPrefix(role, "PARTY_TYPE_") {
+		role = "PARTY_TYPE_" + role
+	}
+	if val, found := types.PartyType_value[role]; found && val != int32(types.PartyType_PARTY_TYPE_UNSPECIFIED) {
+		return types.PartyType(val), nil
+	}
+	return types.PartyType_PARTY_TYPE_UNSPECIFIED, fmt.Errorf("unknown party type: %s", role)
+}
+
+// addPartyFilterFlags sets up a command to look for --party and --party-role flags.
+// The flag values are tied to the partyAddress and partyRole variables.
+func addPartyFilterFlags(cmd *cobra.Command, what string) {
+	cmd.Flags().StringVar(&partyAddress, "party", "", fmt.Sprintf("only include %s with a party having this address", what))
+	cmd.Flags().StringVar(&partyRole, "party-role", "", fmt.Sprintf("only include %s with a party having this party type", what))
+}
+
+// parseRecordFilterFlags converts the values of the --party-role, --output-status, and --input-status flags.
+// Empty values result in the unspecified value of each, i.e. no filtering.
+func parseRecordFilterFlags() (types.PartyType, types.ResultStatus, types.RecordInputStatus, error) {
+	role, err := parseOwnerRole(partyRole)
+	if err != nil {
+		return role, types.ResultStatus_RESULT_STATUS_UNSPECIFIED, types.RecordInputStatus_Unknown, err
+	}
+	outStatus, err := parseEnumFlag("output-status", outputStatus, "RESULT_STATUS_", types.ResultStatus_value)
+	if err != nil {
+		return role, types.ResultStatus_RESULT_STATUS_UNSPECIFIED, types.RecordInputStatus_Unknown, err
+	}
+	inStatus, err := parseEnumFlag("input-status", inputStatus, "RECORD_INPUT_STATUS_", types.RecordInputStatus_value)
+	if err != nil {
+		return role, types.ResultStatus(outStatus), types.RecordInputStatus_Unknown, err
+	}
+	return role, types.ResultStatus(outStatus), types.RecordInputStatus(inStatus), nil
+}
+
+// parseEnumFlag converts a flag value into an enum value, allowing the enum's prefix to be omitted.
+// An empty value results in 0 (the unspecified value).
+func parseEnumFlag(flagName, value, prefix string, values map[string]int32) (int32, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) == 0 {
+		return 0, nil
+	}
+	if !strings.HasPrefix(value, prefix) {
+		value = prefix + value
+	}
+	if val, found := values[value]; found && val != 0 {
+		return val, nil
+	}
+	return 0, fmt.Errorf("invalid --%s: %s", flagName, value)
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
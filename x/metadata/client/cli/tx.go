@@ -1,17 +1,29 @@
 package cli
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 
@@ -19,9 +31,28 @@ import (
 )
 
 const (
-	FlagSigners  = "signers"
-	AddSwitch    = "add"
-	RemoveSwitch = "remove"
+	FlagSigners              = "signers"
+	FlagPreviousVersion      = "previous-version"
+	FlagExpectedValueHash    = "expected-value-hash"
+	FlagExpectedAuditVersion = "expected-audit-version"
+	FlagExpectedOutputHashes = "expected-output-hashes"
+	FlagLocatorName          = "locator-name"
+	FlagLocatorPriority      = "priority"
+	FlagLocatorScopeSpecs    = "scope-specs"
+	FlagMessageName          = "message-name"
+	FlagRecipient            = "recipient"
+	FlagTransferAgents       = "transfer-agents"
+	FlagExpiration           = "expiration"
+	FlagRecordNames          = "record-names"
+	FlagManifestFile         = "file"
+	FlagIncludeRecords       = "include-records"
+	FlagSession              = "session"
+	FlagClonable             = "clonable"
+	FlagPrune                = "prune"
+	FlagCascade              = "cascade"
+	FlagBuyer                = "buyer"
+	AddSwitch                = "add"
+	RemoveSwitch             = "remove"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -40,6 +71,15 @@ func NewTxCmd() *cobra.Command {
 		RemoveScopeCmd(),
 		AddRemoveScopeDataAccessCmd(),
 		AddRemoveScopeOwnersCmd(),
+		LockScopeCmd(),
+		UnlockScopeCmd(),
+		ProposalCmd(),
+		TokenizeScopeCmd(),
+		DetokenizeScopeCmd(),
+		CloneScopeCmd(),
+		OfferValueOwnerTransferCmd(),
+		AcceptValueOwnerTransferCmd(),
+		CancelValueOwnerTransferCmd(),
 
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
@@ -47,6 +87,7 @@ func NewTxCmd() *cobra.Command {
 
 		WriteScopeSpecificationCmd(),
 		RemoveScopeSpecificationCmd(),
+		MigrateScopeSpecCmd(),
 
 		WriteContractSpecificationCmd(),
 		RemoveContractSpecificationCmd(),
@@ -57,10 +98,19 @@ func NewTxCmd() *cobra.Command {
 		WriteRecordSpecificationCmd(),
 		RemoveRecordSpecificationCmd(),
 
+		WriteRecordTypeCmd(),
+		RemoveRecordTypeCmd(),
+
 		WriteSessionCmd(),
 
 		WriteRecordCmd(),
 		RemoveRecordCmd(),
+
+		ExecuteContractSessionCmd(),
+		ConvertP8eMemorializeContractCmd(),
+
+		ImportBundleCmd(),
+		ApplyManifestCmd(),
 	)
 
 	return txCmd
@@ -112,6 +162,11 @@ func WriteScopeCmd() *cobra.Command {
 				valueOwnerAddress)
 
 			msg := types.NewMsgWriteScopeRequest(scope, signers)
+			expectedHash, _ := cmd.Flags().GetString(FlagExpectedValueHash)
+			msg.ExpectedValueHash, err = hex.DecodeString(expectedHash)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %w", FlagExpectedValueHash, err)
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -122,6 +177,7 @@ func WriteScopeCmd() *cobra.Command {
 	}
 
 	addSignerFlagCmd(cmd)
+	cmd.Flags().String(FlagExpectedValueHash, "", "hex encoded sha256 hash the existing scope must have for the write to succeed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -130,10 +186,14 @@ func WriteScopeCmd() *cobra.Command {
 // RemoveScopeCmd creates a command for removing a scope.
 func RemoveScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "remove-scope [scope-id]",
-		Short:   "Remove a metadata scope to the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
-		Args:    cobra.ExactArgs(1),
+		Use:   "remove-scope [scope-id]",
+		Short: "Remove a metadata scope to the provenance blockchain",
+		Long: `Remove a metadata scope from the provenance blockchain.
+The scope's records are removed with it, along with each session once its last record is removed.
+Use --cascade to also remove the scope's sessions that don't have any records.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn
+$ %[1]s tx metadata remove-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn --cascade`, version.AppName),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -151,7 +211,8 @@ func RemoveScopeCmd() *cobra.Command {
 				return err
 			}
 
-			msg := *types.NewMsgDeleteScopeRequest(scopeID, signers)
+			cascade, _ := cmd.Flags().GetBool(FlagCascade)
+			msg := *types.NewMsgDeleteScopeRequest(scopeID, cascade, signers)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -161,57 +222,43 @@ func RemoveScopeCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagCascade, false, "also remove the scope's sessions that don't have any records")
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func AddRemoveScopeDataAccessCmd() *cobra.Command {
+func LockScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "scope-data-access {add|remove} [scope-id] [data-access]",
-		Short: "Add or remove a metadata scope data access on to the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata scope-data-access add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
-									 $ %[1]s tx metadata scope-data-access remove scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
-		Args: cobra.ExactArgs(3),
+		Use:     "lock-scope [scope-id] [reason]",
+		Short:   "Lock a metadata scope on the provenance blockchain so that it cannot be changed",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata lock-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn "pending litigation"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			removeOrAdd := strings.ToLower(args[0])
-			if removeOrAdd != RemoveSwitch && removeOrAdd != AddSwitch {
-				return fmt.Errorf("incorrect command %s : required remove or update", removeOrAdd)
-			}
-
 			var scopeID types.MetadataAddress
-			scopeID, err = types.MetadataAddressFromBech32(args[1])
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			if !scopeID.IsScopeAddress() {
-				return fmt.Errorf("meta address is not a scope: %s", scopeID.String())
-			}
-
 			signers, err := parseSigners(cmd, &clientCtx)
 			if err != nil {
 				return err
 			}
 
-			dataAccess := strings.Split(args[2], ",")
-			var msg sdk.Msg
-			if removeOrAdd == AddSwitch {
-				msg = types.NewMsgAddScopeDataAccessRequest(scopeID, dataAccess, signers)
-			} else {
-				msg = types.NewMsgDeleteScopeDataAccessRequest(scopeID, dataAccess, signers)
-			}
+			msg := *types.NewMsgLockScopeRequest(scopeID, clientCtx.GetFromAddress().String(), args[1], signers)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
@@ -221,56 +268,36 @@ func AddRemoveScopeDataAccessCmd() *cobra.Command {
 	return cmd
 }
 
-func AddRemoveScopeOwnersCmd() *cobra.Command {
+func UnlockScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "scope-owners {add|remove} [scope-id] [owner-addresses]",
-		Short: "Add or remove a metadata scope owners on to the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata scope-owners add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
-									 $ %[1]s tx metadata scope-owners remove scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
-		Args: cobra.ExactArgs(3),
+		Use:     "unlock-scope [scope-id]",
+		Short:   "Remove the lock from a metadata scope on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata unlock-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			removeOrAdd := strings.ToLower(args[0])
-			if removeOrAdd != RemoveSwitch && removeOrAdd != AddSwitch {
-				return fmt.Errorf("incorrect command %s : required remove or update", removeOrAdd)
-			}
-
 			var scopeID types.MetadataAddress
-			scopeID, err = types.MetadataAddressFromBech32(args[1])
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			if !scopeID.IsScopeAddress() {
-				return fmt.Errorf("meta address is not a scope: %s", scopeID.String())
-			}
-
 			signers, err := parseSigners(cmd, &clientCtx)
 			if err != nil {
 				return err
 			}
 
-			ownerAddresses := strings.Split(args[2], ",")
-
-			var msg sdk.Msg
-			if removeOrAdd == AddSwitch {
-				owners := make([]types.Party, len(ownerAddresses))
-				for i, ownerAddr := range ownerAddresses {
-					owners[i] = types.Party{Address: ownerAddr, Role: types.PartyType_PARTY_TYPE_OWNER}
-				}
-				msg = types.NewMsgAddScopeOwnerRequest(scopeID, owners, signers)
-			} else {
-				msg = types.NewMsgDeleteScopeOwnerRequest(scopeID, ownerAddresses, signers)
-			}
+			msg := *types.NewMsgUnlockScopeRequest(scopeID, signers)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
@@ -280,30 +307,65 @@ func AddRemoveScopeOwnersCmd() *cobra.Command {
 	return cmd
 }
 
-// BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
-func BindOsLocatorCmd() *cobra.Command {
+// ProposalCmd creates a command for submitting metadata governance proposals.
+func ProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind-locator [owner] [uri]",
-		Short:   "Bind a uri to an owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "proposal [type] [proposal-file] [deposit]",
+		Short: "Submit a metadata proposal along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a metadata proposal along with an initial deposit.
+Proposal title, description, and metadata proposal params must be set in a provided JSON file.
+
+Where proposal.json contains:
+
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  // additional properties based on type here
+}
+
+Valid Proposal Types (and associated parameters):
+
+- UnlockScope (or unlock-scope)
+	"scope_id": "scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn"
+`),
+		Example: fmt.Sprintf(`$ %s tx metadata proposal unlock-scope path/to/proposal.json 1000%s --from mykey`, version.AppName, sdk.DefaultBondDenom),
+		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
-				fmt.Printf("failed to bind locator for a given owner address, invalid address: %s\n", args[0])
-				return fmt.Errorf("invalid address: %w", errAddr)
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
 			}
 
-			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+			var proposal govtypes.Content
+			switch args[0] {
+			case types.ProposalTypeUnlockScope, "unlock-scope":
+				proposal = &types.UnlockScopeProposal{}
+			default:
+				return fmt.Errorf("unknown proposal type %s", args[0])
+			}
+			if err = json.Unmarshal(contents, proposal); err != nil {
+				return err
 			}
 
-			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &addOSLocator)
+			deposit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid governance proposal: %w", err)
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -312,96 +374,144 @@ func BindOsLocatorCmd() *cobra.Command {
 	return cmd
 }
 
-// RemoveOsLocatorCmd creates a command for removing an object store locator entry.
-func RemoveOsLocatorCmd() *cobra.Command {
+func TokenizeScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "remove-locator [owner] [uri]",
-		Short:   "Remove an os locator already associated owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "tokenize-scope [scope-id]",
+		Short: "Make a restricted marker coin the value owner of a metadata scope on the provenance blockchain",
+		Long: `Make a restricted marker coin the value owner of a metadata scope on the provenance blockchain.
+The marker's denom is the scope id and its supply is one. The coin is sent to the recipient,
+which defaults to the scope's current value owner. Only the transfer agents can move the coin,
+which defaults to just the recipient.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata tokenize-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn
+$ %[1]s tx metadata tokenize-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn --recipient pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 \
+    --transfer-agents pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42,pb1tj3mhmnc8yzt4xzgskuhrzc3ggjvknnf3tggva`, version.AppName),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
-				fmt.Printf("failed to remove locator for a given owner address, invalid address: %s\n", args[0])
-				return fmt.Errorf("invalid address: %w", errAddr)
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
 			}
 
-			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
 			}
 
-			deleteOSLocator := *types.NewMsgDeleteOSLocatorRequest(objectStoreLocator)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &deleteOSLocator)
+			recipient, _ := cmd.Flags().GetString(FlagRecipient)
+			var transferAgents []string
+			agents, _ := cmd.Flags().GetString(FlagTransferAgents)
+			for _, agent := range strings.Split(agents, ",") {
+				if len(strings.TrimSpace(agent)) > 0 {
+					transferAgents = append(transferAgents, strings.TrimSpace(agent))
+				}
+			}
+
+			msg := *types.NewMsgTokenizeScopeRequest(scopeID, recipient, transferAgents, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagRecipient, "", "bech32 address to send the scope's coin to, defaults to the scope's value owner")
+	cmd.Flags().String(FlagTransferAgents, "", "comma delimited list of bech32 addresses allowed to transfer the scope's coin, defaults to the recipient")
+	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// ModifyOsLocatorCmd creates a command to modify the object store locator uri for an owner.
-func ModifyOsLocatorCmd() *cobra.Command {
+func DetokenizeScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "modify-locator [owner] [uri]",
-		Short:   "Modify a uri already associated owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "detokenize-scope [scope-id] [value-owner-address]",
+		Short: "Return a tokenized metadata scope's coin and set its value owner on the provenance blockchain",
+		Long: `Return a tokenized metadata scope's coin and set its value owner on the provenance blockchain.
+One of the signers must hold the scope's coin. The value owner defaults to that signer.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata detokenize-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn
+$ %[1]s tx metadata detokenize-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
-				fmt.Printf("failed to add locator for a given owner address, invalid address: %s\n", args[0])
-				return fmt.Errorf("invalid address: %w", errAddr)
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
 			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
 			if err != nil {
-				fmt.Printf("Invalid uuid for scope id: %s", args[0])
 				return err
 			}
 
-			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+			valueOwner := ""
+			if len(args) > 1 {
+				valueOwner = args[1]
 			}
 
-			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &modifyOSLocator)
+			msg := *types.NewMsgDetokenizeScopeRequest(scopeID, valueOwner, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// WriteScopeSpecificationCmd creates a command for adding scope specificiation
-func WriteScopeSpecificationCmd() *cobra.Command {
+// CloneScopeCmd creates a command for making a new metadata scope from an existing one.
+func CloneScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "write-scope-specification [specification-id] [owner-addresses] [responsible-parties] [contract-specification-ids] [description-name, optional] [description, optional] [website-url, optional] [icon-url, optional]",
-		Short:   "Add/Update metadata scope specification to the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata write-scope-specification scopespec1qn7jh3jvw4gytq9r5x770e8yj74s9t479r pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" contractspec1q0mnck3mqh75mg9qvykqq0jxzs2struaa8`, version.AppName),
-		Args:    cobra.RangeArgs(4, 8),
+		Use:   "clone-scope [source-scope-id] {scope-id|scope-uuid}",
+		Short: "Create a new metadata scope from an existing one on the provenance blockchain",
+		Long: `Create a new metadata scope from an existing one on the provenance blockchain.
+source-scope-id - a bech32 address string of the scope to copy
+scope-id        - a bech32 address string for the new scope
+scope-uuid      - a UUID string representing the uuid of the new scope
+The new scope gets the source scope's specification, owners, data access, and value owner.
+With --include-records, the source scope's records with clonable record specifications are copied into a new session.
+The new session's id can be given with --session as a bech32 session id or a UUID, and defaults to a random UUID.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata clone-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 91978ba2-5f35-459a-86a7-feca1b0512e0
+$ %[1]s tx metadata clone-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 91978ba2-5f35-459a-86a7-feca1b0512e0 --include-records`, version.AppName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			specificationID, err := types.MetadataAddressFromBech32(args[0])
+			sourceScopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			scopeID, err := parseScopeIDOrUUID(args[1])
 			if err != nil {
 				return err
 			}
 
-			cSpecIds := strings.Split(args[3], ",")
-			contractSpecIds := make([]types.MetadataAddress, len(cSpecIds))
-			for i, cid := range cSpecIds {
-				contractSpecIds[i], err = types.MetadataAddressFromBech32(cid)
+			includeRecords, _ := cmd.Flags().GetBool(FlagIncludeRecords)
+			var sessionID types.MetadataAddress
+			if includeRecords {
+				session, _ := cmd.Flags().GetString(FlagSession)
+				sessionID, err = parseSessionIDOrUUID(scopeID, session)
 				if err != nil {
 					return err
 				}
@@ -412,76 +522,53 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 				return err
 			}
 
-			scopeSpec := types.ScopeSpecification{
-				SpecificationId: specificationID,
-				OwnerAddresses:  strings.Split(args[1], ","),
-				Description:     parseDescription(args[4:]),
-				PartiesInvolved: parsePartyTypes(args[2]),
-				ContractSpecIds: contractSpecIds,
-			}
-
-			msg := types.NewMsgWriteScopeSpecificationRequest(scopeSpec, signers)
+			msg := *types.NewMsgCloneScopeRequest(sourceScopeID, scopeID, includeRecords, sessionID, signers)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagIncludeRecords, false, "copy the records with clonable record specifications into a new session")
+	cmd.Flags().String(FlagSession, "", "the bech32 id or UUID of the new session for copied records, defaults to a random UUID")
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// WriteContractSpecificationCmd creates a command to add/update contract specifications
-func WriteContractSpecificationCmd() *cobra.Command {
+// OfferValueOwnerTransferCmd creates a command for offering a scope's value ownership to a buyer for a price.
+func OfferValueOwnerTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "write-contract-specification [contractspec-id] [owners] [parties-involved] [source-value] [classname] [description-name, optional] [description, optional] [website-url, optional] [icon-url, optional]",
-		Short: "Add/Update metadata contract specification on the provenance blockchain",
-		Long: `Add/Update metadata contract specification on the provenance blockchain
-contractspec-id    - contract specification metaaddress
-owners             - comma delimited list of bech32 owner addresses
-parties-involved   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
-source-value       - source identifier of type hash or resourceid
-classname          - name of contract specification
-description-name   - description name identifier (optional)
-description        - description text (optional, can only be provided with a description-name)
-website-url        - address of website (optional, can only be provided with a description)
-icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)`,
-		Example: fmt.Sprintf(`$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --from=mykey`, version.AppName),
-		Args:    cobra.RangeArgs(5, 9),
+		Use:   "offer-value-owner-transfer [scope-id] [buyer] [price] --expiration [time]",
+		Short: "Offer a metadata scope's value ownership to a buyer for a price on the provenance blockchain",
+		Long: `Offer a metadata scope's value ownership to a buyer for a price on the provenance blockchain.
+The scope's value owner must sign. The buyer becomes the value owner once they accept the offer and pay the price.
+While a scope has open offers, its value owner cannot be changed in any other way.
+An existing offer to the same buyer is replaced.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata offer-value-owner-transfer scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 100nhash --expiration 2023-01-01T00:00:00Z`, version.AppName),
+		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			specificationID, err := types.MetadataAddressFromBech32(args[0])
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
-
-			partiesInvolved := parsePartyTypes(args[2])
-			description := parseDescription(args[5:])
-			contractSpecification := types.ContractSpecification{SpecificationId: specificationID,
-				Description:     description,
-				OwnerAddresses:  strings.Split(args[1], ","),
-				PartiesInvolved: partiesInvolved,
-				ClassName:       args[4],
+			price, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
 			}
-			sourceValue := args[3]
-			var recordID types.MetadataAddress
-			recordID, err = types.MetadataAddressFromBech32(sourceValue)
+			expiration, _ := cmd.Flags().GetString(FlagExpiration)
+			expTime, err := time.Parse(time.RFC3339, expiration)
 			if err != nil {
-				contractSpecification.Source = &types.ContractSpecification_Hash{
-					Hash: sourceValue,
-				}
-			} else {
-				contractSpecification.Source = &types.ContractSpecification_ResourceId{
-					ResourceId: recordID,
-				}
+				return fmt.Errorf("invalid %s: %w", FlagExpiration, err)
 			}
 
 			signers, err := parseSigners(cmd, &clientCtx)
@@ -489,79 +576,619 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 				return err
 			}
 
-			msg := types.NewMsgWriteContractSpecificationRequest(contractSpecification, signers)
+			msg := *types.NewMsgOfferValueOwnerTransferRequest(scopeID, args[1], price, expTime, signers)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(FlagExpiration, "", "RFC3339 time at which the offer expires, e.g. 2023-01-01T00:00:00Z")
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// AddContractSpecToScopeSpecCmd creates an add contract spec to scope spec command
-func AddContractSpecToScopeSpecCmd() *cobra.Command {
+// AcceptValueOwnerTransferCmd creates a command for accepting an offer for a scope's value ownership.
+func AcceptValueOwnerTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "add-contract-spec-to-scope-spec [contract-specification-id] [scope-specification-id]",
-		Short:   "Add an existing contract specification to a scope specification",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata add-contract-spec-to-scope-spec contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 scopespec1qjpreurq8n7ylc4y5zw6gn255lkqle56sv --from=mykey`, version.AppName),
-		Aliases: []string{"acstss"},
+		Use:   "accept-value-owner-transfer [scope-id] [price]",
+		Short: "Accept an offer for a metadata scope's value ownership on the provenance blockchain",
+		Long: `Accept an offer for a metadata scope's value ownership on the provenance blockchain.
+The price must equal the offered price, and is paid from the buyer to the seller.
+The buyer defaults to the first signer.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata accept-value-owner-transfer scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 100nhash`, version.AppName),
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			contractSpecID, err := types.MetadataAddressFromBech32(args[0])
-			if err != nil || !contractSpecID.IsContractSpecificationAddress() {
-				return fmt.Errorf("invalid contract specification id : %s", args[0])
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
 			}
-			scopeSpecID, err := types.MetadataAddressFromBech32(args[1])
-			if err != nil || !scopeSpecID.IsScopeSpecificationAddress() {
-				return fmt.Errorf("invalid scope specification id : %s", args[1])
+			price, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
 			}
 
 			signers, err := parseSigners(cmd, &clientCtx)
 			if err != nil {
 				return err
 			}
+			buyer, _ := cmd.Flags().GetString(FlagBuyer)
+			if len(buyer) == 0 {
+				buyer = signers[0]
+			}
 
-			msg := types.NewMsgAddContractSpecToScopeSpecRequest(contractSpecID, scopeSpecID, signers)
+			msg := *types.NewMsgAcceptValueOwnerTransferRequest(scopeID, buyer, price, signers)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(FlagBuyer, "", "bech32 address of the buyer the offer was made to, defaults to the first signer")
 	addSignerFlagCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func WriteSessionCmd() *cobra.Command {
+// CancelValueOwnerTransferCmd creates a command for cancelling an offer for a scope's value ownership.
+func CancelValueOwnerTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "write-session {session-id|{scope-id|scope-uuid} session-uuid} [contract-spec-id] [parties] [name] [context, optional]",
-		Short: "Add/Update metadata sessioon to the provenance blockchain",
-		Long: `Add/Update metadata session to the provenance blockchain.
-session-id        - a bech32 address string for this session
-scope-id          - a bech32 address string for the scope this session belongs to
-scope-uuid        - a UUID string representing the uuid of the scope this session belongs to
-session-uuid      - a UUID string representing the uuid for this session
-  The above arguments are used to define the session-id. They must be provided in one of these forms:
-    session-id
-    scope-id session-uuid
-    scope-uuid session-uuid
-contract-spec-id  - a bech32 address string for the contract specification that applies to this session
-parties-involved  - semicolon delimited list of party structures(address,role). Accepted roles: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
-name              - a name for this session
-context           - a base64 encoded string of the bytes that represent the session context (optional)`,
-		Example: fmt.Sprintf(`$ %[1]s tx metadata write-session \
+		Use:   "cancel-value-owner-transfer [scope-id] [buyer]",
+		Short: "Cancel an offer for a metadata scope's value ownership on the provenance blockchain",
+		Long: `Cancel an offer for a metadata scope's value ownership on the provenance blockchain.
+Either the seller or the buyer can cancel the offer.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata cancel-value-owner-transfer scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := *types.NewMsgCancelValueOwnerTransferRequest(scopeID, args[1], signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseScopeIDOrUUID gets a scope id from either a bech32 scope id or a scope UUID.
+func parseScopeIDOrUUID(arg string) (types.MetadataAddress, error) {
+	if scopeUUID, err := uuid.Parse(arg); err == nil {
+		return types.ScopeMetadataAddress(scopeUUID), nil
+	}
+	scopeID, err := types.MetadataAddressFromBech32(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope id or uuid [%s]: %w", arg, err)
+	}
+	return scopeID, nil
+}
+
+// parseSessionIDOrUUID gets a session id from either a bech32 session id or a session UUID in the given scope.
+// An empty value results in a session id with a random UUID.
+func parseSessionIDOrUUID(scopeID types.MetadataAddress, arg string) (types.MetadataAddress, error) {
+	if len(arg) == 0 {
+		return scopeID.AsSessionAddress(uuid.New())
+	}
+	if sessionUUID, err := uuid.Parse(arg); err == nil {
+		return scopeID.AsSessionAddress(sessionUUID)
+	}
+	sessionID, err := types.MetadataAddressFromBech32(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id or uuid [%s]: %w", arg, err)
+	}
+	return sessionID, nil
+}
+
+func AddRemoveScopeDataAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scope-data-access {add|remove} [scope-id] [data-access]",
+		Short: "Add or remove a metadata scope data access on to the provenance blockchain",
+		Long: `Add or remove a metadata scope data access on to the provenance blockchain.
+When adding, the data access can be given an expiration, after which the addresses are removed from the scope's
+data access, and can be limited to the scope's records with specific names.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata scope-data-access add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+									 $ %[1]s tx metadata scope-data-access add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --expiration 2023-01-01T00:00:00Z --record-names recordname1,recordname2
+									 $ %[1]s tx metadata scope-data-access remove scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			removeOrAdd := strings.ToLower(args[0])
+			if removeOrAdd != RemoveSwitch && removeOrAdd != AddSwitch {
+				return fmt.Errorf("incorrect command %s : required remove or update", removeOrAdd)
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			if !scopeID.IsScopeAddress() {
+				return fmt.Errorf("meta address is not a scope: %s", scopeID.String())
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			dataAccess := strings.Split(args[2], ",")
+			var msg sdk.Msg
+			if removeOrAdd == AddSwitch {
+				addMsg := types.NewMsgAddScopeDataAccessRequest(scopeID, dataAccess, signers)
+				expiration, _ := cmd.Flags().GetString(FlagExpiration)
+				if len(expiration) > 0 {
+					expTime, err := time.Parse(time.RFC3339, expiration)
+					if err != nil {
+						return fmt.Errorf("invalid %s: %w", FlagExpiration, err)
+					}
+					addMsg.Expiration = &expTime
+				}
+				addMsg.RecordNames, _ = cmd.Flags().GetStringSlice(FlagRecordNames)
+				msg = addMsg
+			} else {
+				msg = types.NewMsgDeleteScopeDataAccessRequest(scopeID, dataAccess, signers)
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "RFC3339 time at which the added data access expires, e.g. 2023-01-01T00:00:00Z")
+	cmd.Flags().StringSlice(FlagRecordNames, nil, "comma delimited names of the scope's records the added data access is limited to")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func AddRemoveScopeOwnersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scope-owners {add|remove} [scope-id] [owner-addresses]",
+		Short: "Add or remove a metadata scope owners on to the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata scope-owners add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+									 $ %[1]s tx metadata scope-owners remove scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			removeOrAdd := strings.ToLower(args[0])
+			if removeOrAdd != RemoveSwitch && removeOrAdd != AddSwitch {
+				return fmt.Errorf("incorrect command %s : required remove or update", removeOrAdd)
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			if !scopeID.IsScopeAddress() {
+				return fmt.Errorf("meta address is not a scope: %s", scopeID.String())
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			ownerAddresses := strings.Split(args[2], ",")
+
+			var msg sdk.Msg
+			if removeOrAdd == AddSwitch {
+				owners := make([]types.Party, len(ownerAddresses))
+				for i, ownerAddr := range ownerAddresses {
+					owners[i] = types.Party{Address: ownerAddr, Role: types.PartyType_PARTY_TYPE_OWNER}
+				}
+				msg = types.NewMsgAddScopeOwnerRequest(scopeID, owners, signers)
+			} else {
+				msg = types.NewMsgDeleteScopeOwnerRequest(scopeID, ownerAddresses, signers)
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
+func BindOsLocatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bind-locator [owner] [uri]",
+		Short:   "Bind a uri to an owner address on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
+				fmt.Printf("failed to bind locator for a given owner address, invalid address: %s\n", args[0])
+				return fmt.Errorf("invalid address: %w", errAddr)
+			}
+
+			objectStoreLocator := types.ObjectStoreLocator{
+				LocatorUri: args[1], Owner: args[0],
+			}
+			if err = parseOSLocatorFlags(cmd, &objectStoreLocator); err != nil {
+				return err
+			}
+
+			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &addOSLocator)
+		},
+	}
+
+	addOSLocatorFlagsCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveOsLocatorCmd creates a command for removing an object store locator entry.
+func RemoveOsLocatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-locator [owner] [uri]",
+		Short:   "Remove an os locator already associated owner address on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
+				fmt.Printf("failed to remove locator for a given owner address, invalid address: %s\n", args[0])
+				return fmt.Errorf("invalid address: %w", errAddr)
+			}
+
+			objectStoreLocator := types.ObjectStoreLocator{
+				LocatorUri: args[1], Owner: args[0],
+			}
+			objectStoreLocator.Name, _ = cmd.Flags().GetString(FlagLocatorName)
+
+			deleteOSLocator := *types.NewMsgDeleteOSLocatorRequest(objectStoreLocator)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &deleteOSLocator)
+		},
+	}
+
+	cmd.Flags().String(FlagLocatorName, "", "name of the locator to remove (default is the owner's unnamed locator)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ModifyOsLocatorCmd creates a command to modify the object store locator uri for an owner.
+func ModifyOsLocatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modify-locator [owner] [uri]",
+		Short:   "Modify a uri already associated owner address on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, errAddr := sdk.AccAddressFromBech32(args[0]); errAddr != nil {
+				fmt.Printf("failed to add locator for a given owner address, invalid address: %s\n", args[0])
+				return fmt.Errorf("invalid address: %w", errAddr)
+			}
+			if err != nil {
+				fmt.Printf("Invalid uuid for scope id: %s", args[0])
+				return err
+			}
+
+			objectStoreLocator := types.ObjectStoreLocator{
+				LocatorUri: args[1], Owner: args[0],
+			}
+			if err = parseOSLocatorFlags(cmd, &objectStoreLocator); err != nil {
+				return err
+			}
+
+			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &modifyOSLocator)
+		},
+	}
+
+	addOSLocatorFlagsCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// WriteScopeSpecificationCmd creates a command for adding scope specificiation
+func WriteScopeSpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "write-scope-specification [specification-id] [owner-addresses] [responsible-parties] [contract-specification-ids] [description-name, optional] [description, optional] [website-url, optional] [icon-url, optional]",
+		Short:   "Add/Update metadata scope specification to the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-scope-specification scopespec1qn7jh3jvw4gytq9r5x770e8yj74s9t479r pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" contractspec1q0mnck3mqh75mg9qvykqq0jxzs2struaa8`, version.AppName),
+		Args:    cobra.RangeArgs(4, 8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			specificationID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cSpecIds := strings.Split(args[3], ",")
+			contractSpecIds := make([]types.MetadataAddress, len(cSpecIds))
+			for i, cid := range cSpecIds {
+				contractSpecIds[i], err = types.MetadataAddressFromBech32(cid)
+				if err != nil {
+					return err
+				}
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			previousVersionID, err := parsePreviousVersion(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeSpec := types.ScopeSpecification{
+				SpecificationId:   specificationID,
+				OwnerAddresses:    strings.Split(args[1], ","),
+				Description:       parseDescription(args[4:]),
+				PartiesInvolved:   parsePartyTypes(args[2]),
+				ContractSpecIds:   contractSpecIds,
+				PreviousVersionId: previousVersionID,
+			}
+
+			msg := types.NewMsgWriteScopeSpecificationRequest(scopeSpec, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	addPreviousVersionFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// WriteContractSpecificationCmd creates a command to add/update contract specifications
+func WriteContractSpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-contract-specification [contractspec-id] [owners] [parties-involved] [source-value] [classname] [description-name, optional] [description, optional] [website-url, optional] [icon-url, optional]",
+		Short: "Add/Update metadata contract specification on the provenance blockchain",
+		Long: `Add/Update metadata contract specification on the provenance blockchain
+contractspec-id    - contract specification metaaddress
+owners             - comma delimited list of bech32 owner addresses
+parties-involved   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
+source-value       - source identifier of type hash or resourceid
+classname          - name of contract specification
+description-name   - description name identifier (optional)
+description        - description text (optional, can only be provided with a description-name)
+website-url        - address of website (optional, can only be provided with a description)
+icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --from=mykey`, version.AppName),
+		Args:    cobra.RangeArgs(5, 9),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			specificationID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			previousVersionID, err := parsePreviousVersion(cmd)
+			if err != nil {
+				return err
+			}
+
+			partiesInvolved := parsePartyTypes(args[2])
+			description := parseDescription(args[5:])
+			contractSpecification := types.ContractSpecification{SpecificationId: specificationID,
+				Description:       description,
+				OwnerAddresses:    strings.Split(args[1], ","),
+				PartiesInvolved:   partiesInvolved,
+				ClassName:         args[4],
+				PreviousVersionId: previousVersionID,
+			}
+			sourceValue := args[3]
+			var recordID types.MetadataAddress
+			recordID, err = types.MetadataAddressFromBech32(sourceValue)
+			if err != nil {
+				contractSpecification.Source = &types.ContractSpecification_Hash{
+					Hash: sourceValue,
+				}
+			} else {
+				contractSpecification.Source = &types.ContractSpecification_ResourceId{
+					ResourceId: recordID,
+				}
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWriteContractSpecificationRequest(contractSpecification, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignerFlagCmd(cmd)
+	addPreviousVersionFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MigrateScopeSpecCmd creates a command to move a scope to a newer version of its scope specification.
+func MigrateScopeSpecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-scope-spec [scope-id] [scope-specification-id]",
+		Short:   "Move a scope to a newer version of its scope specification",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata migrate-scope-spec scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn scopespec1qjpreurq8n7ylc4y5zw6gn255lkqle56sv --from=mykey`, version.AppName),
+		Aliases: []string{"mss"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil || !scopeID.IsScopeAddress() {
+				return fmt.Errorf("invalid scope id : %s", args[0])
+			}
+			scopeSpecID, err := types.MetadataAddressFromBech32(args[1])
+			if err != nil || !scopeSpecID.IsScopeSpecificationAddress() {
+				return fmt.Errorf("invalid scope specification id : %s", args[1])
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateScopeSpecRequest(scopeID, scopeSpecID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AddContractSpecToScopeSpecCmd creates an add contract spec to scope spec command
+func AddContractSpecToScopeSpecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-contract-spec-to-scope-spec [contract-specification-id] [scope-specification-id]",
+		Short:   "Add an existing contract specification to a scope specification",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata add-contract-spec-to-scope-spec contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 scopespec1qjpreurq8n7ylc4y5zw6gn255lkqle56sv --from=mykey`, version.AppName),
+		Aliases: []string{"acstss"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			contractSpecID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil || !contractSpecID.IsContractSpecificationAddress() {
+				return fmt.Errorf("invalid contract specification id : %s", args[0])
+			}
+			scopeSpecID, err := types.MetadataAddressFromBech32(args[1])
+			if err != nil || !scopeSpecID.IsScopeSpecificationAddress() {
+				return fmt.Errorf("invalid scope specification id : %s", args[1])
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddContractSpecToScopeSpecRequest(contractSpecID, scopeSpecID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func WriteSessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-session {session-id|{scope-id|scope-uuid} session-uuid} [contract-spec-id] [parties] [name] [context, optional]",
+		Short: "Add/Update metadata sessioon to the provenance blockchain",
+		Long: `Add/Update metadata session to the provenance blockchain.
+session-id        - a bech32 address string for this session
+scope-id          - a bech32 address string for the scope this session belongs to
+scope-uuid        - a UUID string representing the uuid of the scope this session belongs to
+session-uuid      - a UUID string representing the uuid for this session
+  The above arguments are used to define the session-id. They must be provided in one of these forms:
+    session-id
+    scope-id session-uuid
+    scope-uuid session-uuid
+contract-spec-id  - a bech32 address string for the contract specification that applies to this session
+parties-involved  - semicolon delimited list of party structures(address,role). Accepted roles: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
+name              - a name for this session
+context           - a base64 encoded string of the bytes that represent the session context (optional)`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-session \
 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0 \
 contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn \
 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42,owner \
@@ -663,6 +1290,7 @@ ChFIRUxMTyBQUk9WRU5BTkNFIQ==`, version.AppName),
 				Context:         context,
 			}
 			writeSessionMsg := types.NewMsgWriteSessionRequest(session, signers)
+			writeSessionMsg.ExpectedAuditVersion, _ = cmd.Flags().GetUint32(FlagExpectedAuditVersion)
 			err = writeSessionMsg.ValidateBasic()
 			if err != nil {
 				return err
@@ -672,6 +1300,7 @@ ChFIRUxMTyBQUk9WRU5BTkNFIQ==`, version.AppName),
 	}
 
 	addSignerFlagCmd(cmd)
+	cmd.Flags().Uint32(FlagExpectedAuditVersion, 0, "audit version the existing session must have for the write to succeed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -790,6 +1419,7 @@ contractspec-name
 				return fmt.Errorf("id must be a contract or session id: %s", contractOrSessionID.String())
 			}
 			msg := *types.NewMsgWriteRecordRequest(record, nil, "", signers, parties)
+			msg.ExpectedOutputHashes, _ = cmd.Flags().GetStringSlice(FlagExpectedOutputHashes)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -802,6 +1432,7 @@ contractspec-name
 	}
 
 	addSignerFlagCmd(cmd)
+	cmd.Flags().StringSlice(FlagExpectedOutputHashes, nil, "comma delimited output hashes the existing record must have for the write to succeed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -896,7 +1527,8 @@ name                  - record name
 input-specifications  - semi-colon delimited list of input specifications <name>,<type-name>,<source-value>
 type-name             - contract specification type name
 result-types          - result definition type. Accepted values: proposed, record, record_list
-responsible-parties   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance`,
+responsible-parties   - comma delimited list of party types.  Accepted values: originator,servicer,investor,custodian,owner,affiliate,omnibus,provenance
+Use --clonable to have records of this specification copied when a scope is cloned with clone-scope --include-records.`,
 		Example: fmt.Sprintf(`$ %[1]s tx metadata write-record-specification recspec1qh... \
 recordname \
 inputname1,typename1,hashvalue; \
@@ -922,23 +1554,113 @@ owner,originator`, version.AppName),
 				return err
 			}
 
-			resultType := types.DefinitionType(types.DefinitionType_value[fmt.Sprintf("DEFINITION_TYPE_%s", strings.ToUpper(args[4]))])
-			partyTypes := parsePartyTypes(args[5])
+			resultType := types.DefinitionType(types.DefinitionType_value[fmt.Sprintf("DEFINITION_TYPE_%s", strings.ToUpper(args[4]))])
+			partyTypes := parsePartyTypes(args[5])
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			recordSpecification := types.RecordSpecification{
+				SpecificationId:    specificationID,
+				Name:               recordName,
+				Inputs:             inputs,
+				TypeName:           args[3],
+				ResultType:         resultType,
+				ResponsibleParties: partyTypes,
+			}
+			recordSpecification.Clonable, _ = cmd.Flags().GetBool(FlagClonable)
+
+			msg := *types.NewMsgWriteRecordSpecificationRequest(recordSpecification, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagClonable, false, "copy records of this specification when their scope is cloned")
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// WriteRecordTypeCmd creates a command for registering or updating a record type.
+func WriteRecordTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-record-type [name] [schema-type] [schema-file] [owner-addresses]",
+		Short: "Register/Update a record type on the provenance blockchain",
+		Long: `Register/Update a record type on the provenance blockchain.
+name             - the type name that record and input specifications can use
+schema-type      - the kind of schema in the schema file. Accepted values: proto, json
+schema-file      - the file containing the schema.
+                   For proto, this is a FileDescriptorSet, e.g. from protoc --include_imports --descriptor_set_out
+                   For json, this is a JSON Schema document.
+owner-addresses  - comma delimited list of bech32 addresses that can update or remove the record type
+A new record type must also be signed by one of the record type registrars.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-record-type io.provenance.Loan proto loan.pb pb1... --message-name io.provenance.Loan
+$ %[1]s tx metadata write-record-type loan-document json loan-schema.json pb1...,pb2...`, version.AppName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			schemaType, err := parseSchemaType(args[1])
+			if err != nil {
+				return err
+			}
+			schema, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			owners := strings.Split(args[3], ",")
+			messageName, _ := cmd.Flags().GetString(FlagMessageName)
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			recordType := types.NewRecordType(args[0], schemaType, schema, messageName, owners)
+			msg := *types.NewMsgWriteRecordTypeRequest(*recordType, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMessageName, "", "the fully qualified name of the proto message payloads must be (required for proto)")
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveRecordTypeCmd creates a command to remove a record type
+func RemoveRecordTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-record-type [name]",
+		Short:   "Remove a record type from the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-record-type io.provenance.Loan --from=mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			signers, err := parseSigners(cmd, &clientCtx)
 			if err != nil {
 				return err
 			}
-
-			recordSpecification := types.RecordSpecification{
-				SpecificationId:    specificationID,
-				Name:               recordName,
-				Inputs:             inputs,
-				TypeName:           args[3],
-				ResultType:         resultType,
-				ResponsibleParties: partyTypes,
-			}
-
-			msg := *types.NewMsgWriteRecordSpecificationRequest(recordSpecification, signers)
+			msg := *types.NewMsgDeleteRecordTypeRequest(args[0], signers)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -954,6 +1676,17 @@ owner,originator`, version.AppName),
 	return cmd
 }
 
+// parseSchemaType converts a cli schema type argument into a SchemaType.
+func parseSchemaType(arg string) (types.SchemaType, error) {
+	switch strings.ToLower(arg) {
+	case "proto":
+		return types.SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR, nil
+	case "json":
+		return types.SchemaType_SCHEMA_TYPE_JSON_SCHEMA, nil
+	}
+	return types.SchemaType_SCHEMA_TYPE_UNSPECIFIED, fmt.Errorf("unknown schema type %q: expected proto or json", arg)
+}
+
 // parseInputSpecification converts cli delimited argument and converts it to InputSpecifications
 func parseInputSpecification(cliDelimitedValue string) ([]*types.InputSpecification, error) {
 	delimitedInputs := strings.Split(cliDelimitedValue, ";")
@@ -982,6 +1715,43 @@ func addSignerFlagCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSigners, "", "comma delimited list of bech32 addresses")
 }
 
+func addPreviousVersionFlagCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPreviousVersion, "", "bech32 id of the specification that this one is a newer version of")
+}
+
+func addOSLocatorFlagsCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagLocatorName, "", "name of the locator, needed when an owner has more than one")
+	cmd.Flags().Uint32(FlagLocatorPriority, 0, "priority of the locator among the owner's locators, lowest first")
+	cmd.Flags().String(FlagLocatorScopeSpecs, "", "comma delimited list of scope specification ids to limit the locator to")
+}
+
+// parseOSLocatorFlags sets the name, priority, and scope specification ids of a locator from the flags (if provided).
+func parseOSLocatorFlags(cmd *cobra.Command, locator *types.ObjectStoreLocator) error {
+	locator.Name, _ = cmd.Flags().GetString(FlagLocatorName)
+	locator.Priority, _ = cmd.Flags().GetUint32(FlagLocatorPriority)
+	scopeSpecs, _ := cmd.Flags().GetString(FlagLocatorScopeSpecs)
+	for _, specID := range strings.Split(scopeSpecs, ",") {
+		if len(strings.TrimSpace(specID)) == 0 {
+			continue
+		}
+		addr, err := types.MetadataAddressFromBech32(strings.TrimSpace(specID))
+		if err != nil {
+			return fmt.Errorf("invalid scope specification id [%s]: %w", specID, err)
+		}
+		locator.ScopeSpecIds = append(locator.ScopeSpecIds, addr)
+	}
+	return nil
+}
+
+// parsePreviousVersion gets the previous version specification id from the previous-version flag (if provided).
+func parsePreviousVersion(cmd *cobra.Command) (types.MetadataAddress, error) {
+	previous, _ := cmd.Flags().GetString(FlagPreviousVersion)
+	if len(previous) == 0 {
+		return nil, nil
+	}
+	return types.MetadataAddressFromBech32(previous)
+}
+
 // parseSigners checks signers flag for signers, else uses the from address
 func parseSigners(cmd *cobra.Command, client *client.Context) ([]string, error) {
 	flagSet := cmd.Flags()
@@ -1223,3 +1993,519 @@ func RemoveRecordSpecificationCmd() *cobra.Command {
 
 	return cmd
 }
+
+// ExecuteContractSessionCmd creates a command for writing the scope, session, and records of a contract execution.
+func ExecuteContractSessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-contract-session [msg-file]",
+		Short: "Write the scope, session, and records of a contract execution to the provenance blockchain",
+		Long: `Write the scope, session, and records of a contract execution to the provenance blockchain.
+msg-file - a JSON file containing a MsgExecuteContractSessionRequest, e.g. as output by the convert-p8e-memorialize-contract command.
+If --signers is provided, it replaces the signers in the file. If neither has signers, the --from address is used.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata execute-contract-session contract-session.json --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var msg types.MsgExecuteContractSessionRequest
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &msg); err != nil {
+				return fmt.Errorf("invalid execute contract session msg %s: %w", args[0], err)
+			}
+			if len(msg.Signers) == 0 || cmd.Flags().Changed(FlagSigners) {
+				msg.Signers, err = parseSigners(cmd, &clientCtx)
+				if err != nil {
+					return err
+				}
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ConvertP8eMemorializeContractCmd creates a command that converts a p8e memorialize contract msg into the native
+// execute contract session msg. It does not need a connection to a node.
+func ConvertP8eMemorializeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-p8e-memorialize-contract [p8e-msg-file]",
+		Short: "Convert a p8e memorialize contract msg into an execute contract session msg",
+		Long: `Convert a p8e memorialize contract msg into an execute contract session msg.
+p8e-msg-file - a JSON file containing a MsgP8eMemorializeContractRequest.
+The converted msg is output as JSON, and can be submitted using the execute-contract-session command.
+Its signers are the addresses of the contract's signatures followed by the invoker. All of them must sign the transaction.
+Nothing is sent to the blockchain.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata convert-p8e-memorialize-contract p8e-msg.json > contract-session.json
+$ %[1]s tx metadata execute-contract-session contract-session.json --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var p8eMsg types.MsgP8EMemorializeContractRequest
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &p8eMsg); err != nil {
+				return fmt.Errorf("invalid p8e memorialize contract msg %s: %w", args[0], err)
+			}
+			msg, err := types.ConvertP8eMemorializeContractToExecuteContractSession(&p8eMsg)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+
+	return cmd
+}
+
+// ImportBundleCmd creates a command for writing a scope bundle, as output by the query metadata export-scope command.
+func ImportBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-bundle [bundle-file]",
+		Short: "Write a scope bundle to the provenance blockchain",
+		Long: `Write a scope bundle to the provenance blockchain.
+bundle-file - a JSON file created using the query metadata export-scope command.
+Account addresses in the bundle are converted to this chain's address prefix.
+Record types, specifications, and object store locators that already exist are not written again.
+The rest of the bundle is written in a single transaction. Use --generate-only to output it without broadcasting it.
+The scope's lock and tokenization are not part of a bundle.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata import-bundle scope-bundle.json --from mykey
+$ %[1]s tx metadata import-bundle scope-bundle.json --from mykey --generate-only > import-tx.json`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var bundle types.ScopeBundle
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &bundle); err != nil {
+				return fmt.Errorf("invalid scope bundle %s: %w", args[0], err)
+			}
+			if err = bundle.ConvertAccountAddresses(sdk.GetConfig().GetBech32AccountAddrPrefix()); err != nil {
+				return err
+			}
+			if err = bundle.ValidateBasic(); err != nil {
+				return err
+			}
+			if err = removeExistingBundleEntries(cmd, clientCtx, &bundle); err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msgs := bundle.ImportMsgs(signers)
+			for _, msg := range msgs {
+				if err = msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// removeExistingBundleEntries takes the record types, specifications, and object store locators
+// that are already on chain out of the bundle, noting each one that's skipped.
+func removeExistingBundleEntries(cmd *cobra.Command, clientCtx client.Context, bundle *types.ScopeBundle) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	ctx := context.Background()
+
+	recordTypes := make([]types.RecordType, 0, len(bundle.RecordTypes))
+	for _, recordType := range bundle.RecordTypes {
+		_, err := queryClient.RecordType(ctx, &types.RecordTypeRequest{Name: recordType.Name})
+		switch {
+		case err == nil:
+			cmd.PrintErrf("skipping existing record type %s\n", recordType.Name)
+		case status.Code(err) == codes.NotFound:
+			recordTypes = append(recordTypes, recordType)
+		default:
+			return err
+		}
+	}
+	bundle.RecordTypes = recordTypes
+
+	contractSpecs := make([]types.ContractSpecification, 0, len(bundle.ContractSpecifications))
+	for _, spec := range bundle.ContractSpecifications {
+		res, err := queryClient.ContractSpecification(ctx, &types.ContractSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return err
+		}
+		if res.ContractSpecification != nil && res.ContractSpecification.Specification != nil {
+			cmd.PrintErrf("skipping existing contract specification %s\n", spec.SpecificationId)
+			continue
+		}
+		contractSpecs = append(contractSpecs, spec)
+	}
+	bundle.ContractSpecifications = contractSpecs
+
+	recordSpecs := make([]types.RecordSpecification, 0, len(bundle.RecordSpecifications))
+	for _, spec := range bundle.RecordSpecifications {
+		res, err := queryClient.RecordSpecification(ctx, &types.RecordSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return err
+		}
+		if res.RecordSpecification != nil && res.RecordSpecification.Specification != nil {
+			cmd.PrintErrf("skipping existing record specification %s\n", spec.SpecificationId)
+			continue
+		}
+		recordSpecs = append(recordSpecs, spec)
+	}
+	bundle.RecordSpecifications = recordSpecs
+
+	if bundle.ScopeSpecification != nil {
+		res, err := queryClient.ScopeSpecification(ctx, &types.ScopeSpecificationRequest{SpecificationId: bundle.ScopeSpecification.SpecificationId.String()})
+		if err != nil {
+			return err
+		}
+		if res.ScopeSpecification != nil && res.ScopeSpecification.Specification != nil {
+			cmd.PrintErrf("skipping existing scope specification %s\n", bundle.ScopeSpecification.SpecificationId)
+			bundle.ScopeSpecification = nil
+		}
+	}
+
+	locators := make([]types.ObjectStoreLocator, 0, len(bundle.OsLocators))
+	for _, locator := range bundle.OsLocators {
+		res, err := queryClient.OSLocator(ctx, &types.OSLocatorRequest{Owner: locator.Owner, Name: locator.Name})
+		if err != nil && !strings.Contains(err.Error(), types.ErrAddressNotBound.Error()) {
+			return err
+		}
+		exists := false
+		if err == nil {
+			// Without a name, all of the owner's locators are returned.
+			for _, existing := range res.Locators {
+				exists = exists || existing.Name == locator.Name
+			}
+		}
+		if exists {
+			cmd.PrintErrf("skipping existing object store locator %q for %s\n", locator.Name, locator.Owner)
+			continue
+		}
+		locators = append(locators, locator)
+	}
+	bundle.OsLocators = locators
+
+	return nil
+}
+
+// ApplyManifestCmd creates a command for bringing the metadata on chain in line with a manifest.
+func ApplyManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f [manifest-file]",
+		Short: "Write the metadata described in a manifest to the provenance blockchain",
+		Long: `Write the metadata described in a manifest to the provenance blockchain.
+manifest-file - a YAML or JSON file with any of these lists: scope_specifications, contract_specifications,
+  record_specifications, scopes, sessions, and records. Entries use the same fields as the query metadata output.
+Each entry is compared to what's on chain, and only the ones that are missing or different are written.
+The plan is printed before anything is broadcast. With --dry-run, only the plan is printed, and no transaction is
+simulated or broadcast.
+With --prune, records of the manifest's scopes and record specifications of the manifest's contract specifications
+that aren't in the manifest are deleted.
+Everything is written in a single transaction, in the order needed for each entry's dependencies to exist first.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata apply -f manifest.yaml --from mykey --dry-run
+$ %[1]s tx metadata apply -f manifest.yaml --from mykey
+$ %[1]s tx metadata apply -f manifest.json --from mykey --prune`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, _ := cmd.Flags().GetString(FlagManifestFile)
+			manifest, err := readManifest(clientCtx.Codec, file)
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			prune, _ := cmd.Flags().GetBool(FlagPrune)
+			plan, err := planManifest(clientCtx, manifest, signers, prune)
+			if err != nil {
+				return err
+			}
+
+			dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+			if dryRun {
+				plan.print(cmd.OutOrStdout())
+				return nil
+			}
+			plan.print(cmd.ErrOrStderr())
+			if len(plan.steps) == 0 {
+				return nil
+			}
+
+			msgs := make([]sdk.Msg, len(plan.steps))
+			for i, step := range plan.steps {
+				if err = step.msg.ValidateBasic(); err != nil {
+					return fmt.Errorf("invalid %s %s: %w", step.kind, step.id, err)
+				}
+				msgs[i] = step.msg
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().StringP(FlagManifestFile, "f", "", "the YAML or JSON manifest file to apply")
+	cmd.Flags().Bool(FlagPrune, false, "delete records and record specifications that are not in the manifest")
+	_ = cmd.MarkFlagRequired(FlagManifestFile)
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readManifest reads and validates a YAML or JSON manifest file.
+func readManifest(cdc codec.Codec, file string) (types.Manifest, error) {
+	var manifest types.Manifest
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return manifest, err
+	}
+	// JSON is also YAML, so converting everything to JSON lets the codec handle both.
+	bz, err := yaml.YAMLToJSON(contents)
+	if err == nil {
+		err = cdc.UnmarshalJSON(bz, &manifest)
+	}
+	if err != nil {
+		return manifest, fmt.Errorf("invalid manifest %s: %w", file, err)
+	}
+	return manifest, manifest.ValidateBasic()
+}
+
+// applyStep is a single change that the apply command makes.
+type applyStep struct {
+	// action is one of "create", "update", or "delete".
+	action string
+	kind   string
+	id     string
+	msg    sdk.Msg
+}
+
+// applyPlan is the list of changes needed to bring the chain in line with a manifest.
+type applyPlan struct {
+	cdc       codec.Codec
+	steps     []applyStep
+	unchanged int
+}
+
+// write adds a step to write an entry unless it exists on chain and is already the same as the proposed one.
+func (p *applyPlan) write(kind string, id fmt.Stringer, exists bool, existing, proposed codec.ProtoMarshaler, msg sdk.Msg) {
+	action := "create"
+	if exists {
+		if bytes.Equal(p.cdc.MustMarshal(existing), p.cdc.MustMarshal(proposed)) {
+			p.unchanged++
+			return
+		}
+		action = "update"
+	}
+	p.steps = append(p.steps, applyStep{action: action, kind: kind, id: id.String(), msg: msg})
+}
+
+// remove adds a step to delete an entry.
+func (p *applyPlan) remove(kind string, id fmt.Stringer, msg sdk.Msg) {
+	p.steps = append(p.steps, applyStep{action: "delete", kind: kind, id: id.String(), msg: msg})
+}
+
+// print writes out each step of the plan followed by a summary line.
+func (p applyPlan) print(w io.Writer) {
+	counts := make(map[string]int, 3)
+	for _, step := range p.steps {
+		fmt.Fprintf(w, "%s %s %s\n", step.action, step.kind, step.id)
+		counts[step.action]++
+	}
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		counts["create"], counts["update"], counts["delete"], p.unchanged)
+}
+
+// planManifest compares a manifest to what's on chain and determines the messages needed to apply it.
+// Specifications come first, then scopes, sessions, and records, and finally anything being pruned.
+func planManifest(clientCtx client.Context, manifest types.Manifest, signers []string, prune bool) (*applyPlan, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	ctx := context.Background()
+	plan := &applyPlan{cdc: clientCtx.Codec}
+
+	for _, spec := range manifest.ContractSpecifications {
+		res, err := queryClient.ContractSpecification(ctx, &types.ContractSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return nil, err
+		}
+		existing := res.ContractSpecification.GetSpecification()
+		plan.write("contract specification", spec.SpecificationId, existing != nil, existing, &spec,
+			types.NewMsgWriteContractSpecificationRequest(spec, signers))
+	}
+	for _, spec := range manifest.RecordSpecifications {
+		res, err := queryClient.RecordSpecification(ctx, &types.RecordSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return nil, err
+		}
+		existing := res.RecordSpecification.GetSpecification()
+		plan.write("record specification", spec.SpecificationId, existing != nil, existing, &spec,
+			types.NewMsgWriteRecordSpecificationRequest(spec, signers))
+	}
+	for _, spec := range manifest.ScopeSpecifications {
+		res, err := queryClient.ScopeSpecification(ctx, &types.ScopeSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return nil, err
+		}
+		existing := res.ScopeSpecification.GetSpecification()
+		plan.write("scope specification", spec.SpecificationId, existing != nil, existing, &spec,
+			types.NewMsgWriteScopeSpecificationRequest(spec, signers))
+	}
+
+	// Each scope's sessions and records are looked up along with the scope, so only one query is needed per scope.
+	scopes := make(map[string]*types.ScopeResponse)
+	getScope := func(scopeID types.MetadataAddress) (*types.ScopeResponse, error) {
+		if res, found := scopes[scopeID.String()]; found {
+			return res, nil
+		}
+		res, err := queryClient.Scope(ctx, &types.ScopeRequest{ScopeId: scopeID.String(), IncludeSessions: true, IncludeRecords: true})
+		if err != nil {
+			return nil, err
+		}
+		scopes[scopeID.String()] = res
+		return res, nil
+	}
+
+	for _, scope := range manifest.Scopes {
+		res, err := getScope(scope.ScopeId)
+		if err != nil {
+			return nil, err
+		}
+		existing := res.Scope.GetScope()
+		plan.write("scope", scope.ScopeId, existing != nil, existing, &scope, types.NewMsgWriteScopeRequest(scope, signers))
+	}
+
+	sessionParties := make(map[string][]types.Party, len(manifest.Sessions))
+	for _, session := range manifest.Sessions {
+		// The audit fields are set by the chain, so they're ignored both in the manifest and when comparing.
+		session.Audit = nil
+		sessionParties[session.SessionId.String()] = session.Parties
+		res, err := getScope(session.SessionId.MustGetAsScopeAddress())
+		if err != nil {
+			return nil, err
+		}
+		var existing *types.Session
+		for _, wrapper := range res.Sessions {
+			if wrapper.Session != nil && wrapper.Session.SessionId.Equals(session.SessionId) {
+				existingSession := *wrapper.Session
+				existingSession.Audit = nil
+				existing = &existingSession
+			}
+		}
+		plan.write("session", session.SessionId, existing != nil, existing, &session, types.NewMsgWriteSessionRequest(session, signers))
+	}
+
+	for _, record := range manifest.OrderedRecords() {
+		res, err := getScope(record.SessionId.MustGetAsScopeAddress())
+		if err != nil {
+			return nil, err
+		}
+		if _, found := sessionParties[record.SessionId.String()]; !found {
+			for _, wrapper := range res.Sessions {
+				if wrapper.Session != nil && wrapper.Session.SessionId.Equals(record.SessionId) {
+					sessionParties[record.SessionId.String()] = wrapper.Session.Parties
+				}
+			}
+		}
+		var existing *types.Record
+		for _, wrapper := range res.Records {
+			if wrapper.Record != nil && wrapper.Record.Name == record.Name {
+				existing = wrapper.Record
+			}
+		}
+		// The chain fills in a record's specification id when it isn't provided.
+		compare := record
+		if existing != nil && compare.SpecificationId.Empty() {
+			compare.SpecificationId = existing.SpecificationId
+		}
+		plan.write("record", record.GetRecordAddress(), existing != nil, existing, &compare,
+			types.NewMsgWriteRecordRequest(record, nil, "", signers, sessionParties[record.SessionId.String()]))
+	}
+
+	if !prune {
+		return plan, nil
+	}
+
+	wanted := make(map[string]bool, len(manifest.Records)+len(manifest.RecordSpecifications))
+	for _, record := range manifest.Records {
+		wanted[record.GetRecordAddress().String()] = true
+	}
+	for _, spec := range manifest.RecordSpecifications {
+		wanted[spec.SpecificationId.String()] = true
+	}
+	for _, scope := range manifest.Scopes {
+		res, err := getScope(scope.ScopeId)
+		if err != nil {
+			return nil, err
+		}
+		for _, wrapper := range res.Records {
+			if wrapper.Record == nil {
+				continue
+			}
+			recordID := wrapper.Record.GetRecordAddress()
+			if !wanted[recordID.String()] {
+				plan.remove("record", recordID, types.NewMsgDeleteRecordRequest(recordID, signers))
+			}
+		}
+	}
+	for _, spec := range manifest.ContractSpecifications {
+		res, err := queryClient.RecordSpecificationsForContractSpecification(ctx,
+			&types.RecordSpecificationsForContractSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return nil, err
+		}
+		for _, wrapper := range res.RecordSpecifications {
+			if wrapper.Specification == nil {
+				continue
+			}
+			specID := wrapper.Specification.SpecificationId
+			if !wanted[specID.String()] {
+				plan.remove("record specification", specID, types.NewMsgDeleteRecordSpecificationRequest(specID, signers))
+			}
+		}
+	}
+
+	return plan, nil
+}
@@ -190,6 +190,9 @@ func (suite *IntegrationGRPCTestSuite) TestGRPCQueries() {
 					Scope:           &suite.scope,
 					ScopeIdInfo:     types.GetScopeIDInfo(suite.scopeID),
 					ScopeSpecIdInfo: types.GetScopeSpecIDInfo(suite.specID),
+					EffectiveDataAccess: []metadatatypes.DataAccessGrant{
+						{ScopeId: suite.scopeID, Address: suite.scope.DataAccess[0]},
+					},
 				},
 				Request: &metadatatypes.ScopeRequest{ScopeId: suite.scopeUUID.String()},
 			},
@@ -226,7 +229,8 @@ func (suite *IntegrationGRPCTestSuite) TestGRPCQueries() {
 			false,
 			&metadatatypes.OSLocatorResponse{},
 			&metadatatypes.OSLocatorResponse{
-				Locator: &suite.objectLocator,
+				Locator:  &suite.objectLocator,
+				Locators: []metadatatypes.ObjectStoreLocator{suite.objectLocator},
 				Request: &metadatatypes.OSLocatorRequest{
 					Owner: suite.ownerAddr.String(),
 				},
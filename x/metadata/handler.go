@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns a handler for metadata messages.
@@ -34,6 +35,30 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeleteScopeOwnerRequest:
 			res, err := msgServer.DeleteScopeOwner(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgLockScopeRequest:
+			res, err := msgServer.LockScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnlockScopeRequest:
+			res, err := msgServer.UnlockScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTokenizeScopeRequest:
+			res, err := msgServer.TokenizeScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDetokenizeScopeRequest:
+			res, err := msgServer.DetokenizeScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCloneScopeRequest:
+			res, err := msgServer.CloneScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOfferValueOwnerTransferRequest:
+			res, err := msgServer.OfferValueOwnerTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptValueOwnerTransferRequest:
+			res, err := msgServer.AcceptValueOwnerTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelValueOwnerTransferRequest:
+			res, err := msgServer.CancelValueOwnerTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgWriteRecordRequest:
 			res, err := msgServer.WriteRecord(sdk.WrapSDKContext(ctx), msg)
@@ -44,7 +69,13 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgWriteSessionRequest:
 			res, err := msgServer.WriteSession(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExecuteContractSessionRequest:
+			res, err := msgServer.ExecuteContractSession(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgMigrateScopeSpecRequest:
+			res, err := msgServer.MigrateScopeSpec(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgWriteScopeSpecificationRequest:
 			res, err := msgServer.WriteScopeSpecification(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -69,6 +100,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeleteRecordSpecificationRequest:
 			res, err := msgServer.DeleteRecordSpecification(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWriteRecordTypeRequest:
+			res, err := msgServer.WriteRecordType(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleteRecordTypeRequest:
+			res, err := msgServer.DeleteRecordType(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgWriteP8EContractSpecRequest:
 			res, err := msgServer.WriteP8EContractSpec(sdk.WrapSDKContext(ctx), msg)
@@ -92,3 +129,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewProposalHandler returns a handler for metadata governance proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UnlockScopeProposal:
+			return keeper.HandleUnlockScopeProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized metadata proposal content type: %T", c)
+		}
+	}
+}
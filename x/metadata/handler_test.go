@@ -3,21 +3,28 @@ package metadata_test
 import (
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/provenance-io/provenance/app"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata"
 	"github.com/provenance-io/provenance/x/metadata/types"
 	"github.com/provenance-io/provenance/x/metadata/types/p8e"
@@ -945,6 +952,1066 @@ func (s MetadataHandlerTestSuite) TestAddAndDeleteScopeDataAccess() {
 	})
 }
 
+func (s MetadataHandlerTestSuite) TestLockAndUnlockScope() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1, s.user2), []string{}, "")
+	dneScopeID := types.ScopeMetadataAddress(uuid.New())
+	lockedErr := fmt.Sprintf("scope %s was locked by %s: pending litigation: scope is locked", scopeID, s.user1)
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"setup test with new scope specification",
+			types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}),
+			"",
+		},
+		{
+			"setup test with new scope",
+			types.NewMsgWriteScopeRequest(*scope, []string{s.user1, s.user2}),
+			"",
+		},
+		{
+			"should fail to lock scope that does not exist",
+			types.NewMsgLockScopeRequest(dneScopeID, s.user1, "pending litigation", []string{s.user1}),
+			fmt.Sprintf("scope not found with id %s", dneScopeID),
+		},
+		{
+			"should fail to lock scope without all owner signatures",
+			types.NewMsgLockScopeRequest(scopeID, s.user1, "pending litigation", []string{s.user1}),
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user2),
+		},
+		{
+			"should fail to unlock scope that is not locked",
+			types.NewMsgUnlockScopeRequest(scopeID, []string{s.user1}),
+			fmt.Sprintf("scope %s is not locked", scopeID),
+		},
+		{
+			"should successfully lock scope",
+			types.NewMsgLockScopeRequest(scopeID, s.user1, "pending litigation", []string{s.user1, s.user2}),
+			"",
+		},
+		{
+			"should fail to lock scope that is already locked",
+			types.NewMsgLockScopeRequest(scopeID, s.user1, "pending litigation", []string{s.user1, s.user2}),
+			lockedErr,
+		},
+		{
+			"should fail to update locked scope",
+			types.NewMsgWriteScopeRequest(*types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1, s.user2), []string{s.user1}, ""), []string{s.user1, s.user2}),
+			lockedErr,
+		},
+		{
+			"should fail to add data access to locked scope",
+			types.NewMsgAddScopeDataAccessRequest(scopeID, []string{s.user1}, []string{s.user1, s.user2}),
+			lockedErr,
+		},
+		{
+			"should fail to delete locked scope",
+			types.NewMsgDeleteScopeRequest(scopeID, false, []string{s.user1, s.user2}),
+			lockedErr,
+		},
+		{
+			"should fail to unlock scope without locker signature",
+			types.NewMsgUnlockScopeRequest(scopeID, []string{s.user2}),
+			fmt.Sprintf("only the locker %s can unlock scope %s: missing signature from existing owner %s; required for update", s.user1, scopeID, s.user1),
+		},
+		{
+			"should successfully unlock scope",
+			types.NewMsgUnlockScopeRequest(scopeID, []string{s.user1}),
+			"",
+		},
+		{
+			"should successfully delete unlocked scope",
+			types.NewMsgDeleteScopeRequest(scopeID, false, []string{s.user1, s.user2}),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	s.T().Run("unlock scope by governance proposal", func(t *testing.T) {
+		otherScopeID := types.ScopeMetadataAddress(uuid.New())
+		otherScope := types.NewScope(otherScopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, "")
+		_, err := s.handler(s.ctx, types.NewMsgWriteScopeRequest(*otherScope, []string{s.user1}))
+		require.NoError(t, err, "WriteScope")
+		_, err = s.handler(s.ctx, types.NewMsgLockScopeRequest(otherScopeID, s.user1, "servicing transfer", []string{s.user1}))
+		require.NoError(t, err, "LockScope")
+
+		lock, found := s.app.MetadataKeeper.GetScopeLock(s.ctx, otherScopeID)
+		require.True(t, found, "lock found after LockScope")
+		assert.Equal(t, s.user1, lock.Locker, "lock locker")
+		assert.Equal(t, "servicing transfer", lock.Reason, "lock reason")
+		res, err := s.app.MetadataKeeper.Scope(sdk.WrapSDKContext(s.ctx), &types.ScopeRequest{ScopeId: otherScopeID.String()})
+		require.NoError(t, err, "Scope query")
+		assert.Equal(t, &lock, res.Scope.Lock, "Scope query lock")
+
+		proposal := types.NewUnlockScopeProposal("unlock", "court order", otherScopeID)
+		require.NoError(t, proposal.ValidateBasic(), "proposal ValidateBasic")
+		propHandler := metadata.NewProposalHandler(s.app.MetadataKeeper)
+		require.NoError(t, propHandler(s.ctx, proposal), "UnlockScopeProposal")
+		_, found = s.app.MetadataKeeper.GetScopeLock(s.ctx, otherScopeID)
+		assert.False(t, found, "lock found after UnlockScopeProposal")
+
+		err = propHandler(s.ctx, proposal)
+		assert.EqualError(t, err, fmt.Sprintf("scope %s is not locked", otherScopeID), "second UnlockScopeProposal")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestTokenizeAndDetokenizeScope() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1)
+	noValueOwnerScopeID := types.ScopeMetadataAddress(uuid.New())
+	noValueOwnerScope := types.NewScope(noValueOwnerScopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, "")
+	denom := types.ScopeTokenDenom(scopeID)
+	markerAddr := types.ScopeTokenMarkerAddress(scopeID)
+	changedValueOwner := *scope
+	changedValueOwner.ValueOwnerAddress = s.user2
+
+	runMsgs := func(cases []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}) {
+		for _, tc := range cases {
+			s.T().Run(tc.name, func(t *testing.T) {
+				_, err := s.handler(s.ctx, tc.msg)
+				if len(tc.errorMsg) > 0 {
+					assert.EqualError(t, err, tc.errorMsg)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+		}
+	}
+
+	runMsgs([]struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"setup test with new scope specification",
+			types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}),
+			"",
+		},
+		{
+			"setup test with new scopes",
+			types.NewMsgWriteScopeRequest(*scope, []string{s.user1}),
+			"",
+		},
+		{
+			"setup test with new scope without a value owner",
+			types.NewMsgWriteScopeRequest(*noValueOwnerScope, []string{s.user1}),
+			"",
+		},
+		{
+			"should fail to tokenize a scope without a value owner or recipient",
+			types.NewMsgTokenizeScopeRequest(noValueOwnerScopeID, "", nil, []string{s.user1}),
+			fmt.Sprintf("a recipient is required to tokenize scope %s since it does not have a value owner", noValueOwnerScopeID),
+		},
+		{
+			"should fail to tokenize a scope without a value owner or the owner signatures",
+			types.NewMsgTokenizeScopeRequest(noValueOwnerScopeID, s.user2, nil, []string{s.user2}),
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			"should fail to tokenize a scope without the value owner signature",
+			types.NewMsgTokenizeScopeRequest(scopeID, "", nil, []string{s.user2}),
+			fmt.Sprintf("missing signature from existing value owner %s", s.user1),
+		},
+		{
+			"should successfully tokenize a scope",
+			types.NewMsgTokenizeScopeRequest(scopeID, "", nil, []string{s.user1}),
+			"",
+		},
+		{
+			"should fail to tokenize a scope that is already tokenized",
+			types.NewMsgTokenizeScopeRequest(scopeID, "", nil, []string{s.user1}),
+			fmt.Sprintf("scope %s is already tokenized", scopeID),
+		},
+		{
+			"should fail to change the value owner of a tokenized scope",
+			types.NewMsgWriteScopeRequest(changedValueOwner, []string{s.user1}),
+			fmt.Sprintf("scope %s is tokenized; its value owner can only be changed by detokenizing it", scopeID),
+		},
+		{
+			"should fail to delete a tokenized scope",
+			types.NewMsgDeleteScopeRequest(scopeID, false, []string{s.user1}),
+			fmt.Sprintf("scope %s is tokenized; it must be detokenized before it can be deleted", scopeID),
+		},
+	})
+
+	s.T().Run("tokenized scope value owner is its marker", func(t *testing.T) {
+		tokenized, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "GetScope found")
+		assert.Equal(t, markerAddr.String(), tokenized.ValueOwnerAddress, "value owner")
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, denom).Amount.Int64(), "recipient balance")
+		marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.Equal(t, markertypes.MarkerType_RestrictedCoin, marker.GetMarkerType(), "marker type")
+		assert.Equal(t, markertypes.StatusActive, marker.GetStatus(), "marker status")
+		assert.True(t, marker.AddressHasAccess(s.user1Addr, markertypes.Access_Transfer), "recipient has transfer access")
+	})
+
+	s.T().Run("marker coin is transferred using marker transfer rules", func(t *testing.T) {
+		coin := sdk.NewInt64Coin(denom, 1)
+		assert.Error(t, s.app.MarkerKeeper.TransferCoin(s.ctx, s.user1Addr, s.user2Addr, s.user2Addr, coin), "TransferCoin without transfer access")
+		require.NoError(t, s.app.MarkerKeeper.TransferCoin(s.ctx, s.user1Addr, s.user2Addr, s.user1Addr, coin), "TransferCoin")
+	})
+
+	s.T().Run("value ownership resolves through the marker", func(t *testing.T) {
+		valueOwnedID := types.ScopeMetadataAddress(uuid.New())
+		valueOwned := types.NewScope(valueOwnedID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2)
+		_, err := s.handler(s.ctx, types.NewMsgWriteScopeRequest(*valueOwned, []string{s.user1}))
+		require.NoError(t, err, "WriteScope value owned")
+
+		var tokenized []string
+		for _, id := range []types.MetadataAddress{scopeID, types.ScopeMetadataAddress(uuid.New()), types.ScopeMetadataAddress(uuid.New())} {
+			if !id.Equals(scopeID) {
+				other := types.NewScope(id, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1)
+				_, err = s.handler(s.ctx, types.NewMsgWriteScopeRequest(*other, []string{s.user1}))
+				require.NoError(t, err, "WriteScope %s", id)
+				_, err = s.handler(s.ctx, types.NewMsgTokenizeScopeRequest(id, s.user2, nil, []string{s.user1}))
+				require.NoError(t, err, "TokenizeScope %s", id)
+			}
+			tokenized = append(tokenized, id.String())
+		}
+		// Tokenized scopes come after the value owned ones, in denom order, and a scope token denom is the scope's bech32 id.
+		sort.Strings(tokenized)
+		var expected []string
+		for _, id := range append([]string{valueOwnedID.String()}, tokenized...) {
+			scopeAddr, aErr := types.MetadataAddressFromBech32(id)
+			require.NoError(t, aErr, "MetadataAddressFromBech32 %s", id)
+			uid, uErr := scopeAddr.ScopeUUID()
+			require.NoError(t, uErr, "ScopeUUID %s", id)
+			expected = append(expected, uid.String())
+		}
+
+		goCtx := sdk.WrapSDKContext(s.ctx)
+		res, err := s.app.MetadataKeeper.ValueOwnership(goCtx, &types.ValueOwnershipRequest{Address: s.user2})
+		require.NoError(t, err, "ValueOwnership user2")
+		assert.Equal(t, expected, res.ScopeUuids, "user2 scope uuids")
+		assert.Empty(t, res.Pagination.NextKey, "user2 next key")
+		assert.Equal(t, uint64(len(expected)), res.Pagination.Total, "user2 total")
+
+		for _, limit := range []uint64{1, 2, 3} {
+			var paged []string
+			var key []byte
+			for i := 0; i <= len(expected); i++ {
+				res, err = s.app.MetadataKeeper.ValueOwnership(goCtx, &types.ValueOwnershipRequest{
+					Address:    s.user2,
+					Pagination: &query.PageRequest{Key: key, Limit: limit},
+				})
+				require.NoError(t, err, "ValueOwnership user2 limit %d page %d", limit, i)
+				assert.LessOrEqual(t, uint64(len(res.ScopeUuids)), limit, "user2 limit %d page %d size", limit, i)
+				paged = append(paged, res.ScopeUuids...)
+				key = res.Pagination.NextKey
+				if len(key) == 0 {
+					break
+				}
+			}
+			assert.Equal(t, expected, paged, "user2 scope uuids by key with limit %d", limit)
+		}
+
+		for offset := range expected {
+			res, err = s.app.MetadataKeeper.ValueOwnership(goCtx, &types.ValueOwnershipRequest{
+				Address:    s.user2,
+				Pagination: &query.PageRequest{Offset: uint64(offset), Limit: 1},
+			})
+			require.NoError(t, err, "ValueOwnership user2 offset %d", offset)
+			assert.Equal(t, expected[offset:offset+1], res.ScopeUuids, "user2 scope uuids at offset %d", offset)
+		}
+
+		res, err = s.app.MetadataKeeper.ValueOwnership(goCtx, &types.ValueOwnershipRequest{Address: s.user1})
+		require.NoError(t, err, "ValueOwnership user1")
+		assert.Empty(t, res.ScopeUuids, "user1 scope uuids")
+
+		_, err = s.handler(s.ctx, types.NewMsgDeleteScopeRequest(valueOwnedID, false, []string{s.user1, s.user2}))
+		require.NoError(t, err, "DeleteScope value owned")
+	})
+
+	runMsgs([]struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should fail to detokenize a scope that is not tokenized",
+			types.NewMsgDetokenizeScopeRequest(noValueOwnerScopeID, "", []string{s.user1}),
+			fmt.Sprintf("scope %s is not tokenized", noValueOwnerScopeID),
+		},
+		{
+			"should fail to detokenize a scope without the coin holder signature",
+			types.NewMsgDetokenizeScopeRequest(scopeID, "", []string{s.user1}),
+			fmt.Sprintf("none of the signers hold the %s coin", denom),
+		},
+		{
+			"should successfully detokenize a scope",
+			types.NewMsgDetokenizeScopeRequest(scopeID, "", []string{s.user2}),
+			"",
+		},
+	})
+
+	s.T().Run("detokenized scope value owner is the coin holder", func(t *testing.T) {
+		detokenized, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "GetScope found")
+		assert.Equal(t, s.user2, detokenized.ValueOwnerAddress, "value owner")
+		assert.True(t, s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, denom).IsZero(), "holder balance")
+		assert.True(t, s.app.BankKeeper.GetSupply(s.ctx, denom).IsZero(), "supply")
+		marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.Equal(t, markertypes.StatusActive, marker.GetStatus(), "marker status")
+	})
+
+	runMsgs([]struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should successfully tokenize a detokenized scope again",
+			types.NewMsgTokenizeScopeRequest(scopeID, "", []string{s.user2}, []string{s.user2}),
+			"",
+		},
+	})
+
+	s.T().Run("retokenized scope reuses its marker with new transfer agents", func(t *testing.T) {
+		retokenized, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "GetScope found")
+		assert.Equal(t, markerAddr.String(), retokenized.ValueOwnerAddress, "value owner")
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, denom).Amount.Int64(), "recipient balance")
+		assert.Equal(t, int64(1), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64(), "supply")
+		marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.False(t, marker.AddressHasAccess(s.user1Addr, markertypes.Access_Transfer), "previous transfer agent has transfer access")
+		assert.True(t, marker.AddressHasAccess(s.user2Addr, markertypes.Access_Transfer), "new transfer agent has transfer access")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestWriteAndDeleteRecordType() {
+	schema := []byte(`{"type": "object", "properties": {"amount": {"type": "integer"}}, "required": ["amount"]}`)
+	loanType := types.NewRecordType("io.provenance.Loan", types.SchemaType_SCHEMA_TYPE_JSON_SCHEMA, schema, "", []string{s.user2})
+	rateType := types.NewRecordType("io.provenance.Rate", types.SchemaType_SCHEMA_TYPE_JSON_SCHEMA, schema, "", []string{s.user1})
+	updatedLoanType := *loanType
+	updatedLoanType.Schema = []byte(`{"type": "object"}`)
+
+	contractSpecUUID := uuid.New()
+	contractSpec := types.NewContractSpecification(types.ContractSpecMetadataAddress(contractSpecUUID), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("hash"), "io.provenance.Contract")
+	recordSpec := func(typeName, inputTypeName string) *types.RecordSpecification {
+		inputs := []*types.InputSpecification{types.NewInputSpecification("input", inputTypeName, types.NewInputSpecificationSourceHash("hash"))}
+		return types.NewRecordSpecification(types.RecordSpecMetadataAddress(contractSpecUUID, "loan"), "loan", inputs, typeName,
+			types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	}
+	recordSpecID := recordSpec("", "").SpecificationId
+
+	params := types.DefaultParams()
+	params.RecordTypeRegistrars = []string{s.user1}
+	params.RequireRegisteredRecordTypes = true
+	s.app.MetadataKeeper.SetParams(s.ctx, params)
+	defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should fail to register a record type without a registrar signature",
+			types.NewMsgWriteRecordTypeRequest(*loanType, []string{s.user2}),
+			`a record type registrar must sign to register record type "io.provenance.Loan"`,
+		},
+		{
+			"should fail to register a record type without the owner signature",
+			types.NewMsgWriteRecordTypeRequest(*loanType, []string{s.user1}),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", s.user2),
+		},
+		{
+			"should successfully register a record type",
+			types.NewMsgWriteRecordTypeRequest(*loanType, []string{s.user1, s.user2}),
+			"",
+		},
+		{
+			"should fail to update a record type without the owner signature",
+			types.NewMsgWriteRecordTypeRequest(updatedLoanType, []string{s.user1}),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", s.user2),
+		},
+		{
+			"should successfully update a record type with only the owner signature",
+			types.NewMsgWriteRecordTypeRequest(updatedLoanType, []string{s.user2}),
+			"",
+		},
+		{
+			"setup test with new contract specification",
+			types.NewMsgWriteContractSpecificationRequest(*contractSpec, []string{s.user1}),
+			"",
+		},
+		{
+			"should fail to write a record specification with an unregistered type",
+			types.NewMsgWriteRecordSpecificationRequest(*recordSpec("io.provenance.Lease", "io.provenance.Loan"), []string{s.user1}),
+			`record specification type name "io.provenance.Lease" is not a registered record type`,
+		},
+		{
+			"should fail to write a record specification with an unregistered input type",
+			types.NewMsgWriteRecordSpecificationRequest(*recordSpec("io.provenance.Loan", "io.provenance.Rate"), []string{s.user1}),
+			`input specification "input" type name "io.provenance.Rate" is not a registered record type`,
+		},
+		{
+			"setup test with another record type",
+			types.NewMsgWriteRecordTypeRequest(*rateType, []string{s.user1}),
+			"",
+		},
+		{
+			"should successfully write a record specification with registered types",
+			types.NewMsgWriteRecordSpecificationRequest(*recordSpec("io.provenance.Loan", "io.provenance.Rate"), []string{s.user1}),
+			"",
+		},
+		{
+			"should fail to delete a record type that does not exist",
+			types.NewMsgDeleteRecordTypeRequest("io.provenance.Lease", []string{s.user1}),
+			`record type "io.provenance.Lease" not found`,
+		},
+		{
+			"should fail to delete a record type without the owner signature",
+			types.NewMsgDeleteRecordTypeRequest(loanType.Name, []string{s.user1}),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", s.user2),
+		},
+		{
+			"should fail to delete an input type that is in use",
+			types.NewMsgDeleteRecordTypeRequest(rateType.Name, []string{s.user1}),
+			fmt.Sprintf(`cannot delete record type "io.provenance.Rate": record type "io.provenance.Rate" is still used by record specification %s`, recordSpecID),
+		},
+		{
+			"setup test by deleting the record specification",
+			types.NewMsgDeleteRecordSpecificationRequest(recordSpecID, []string{s.user1}),
+			"",
+		},
+		{
+			"should successfully delete a record type that is no longer used",
+			types.NewMsgDeleteRecordTypeRequest(loanType.Name, []string{s.user2}),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	s.T().Run("record type queries", func(t *testing.T) {
+		_, err := s.app.MetadataKeeper.RecordType(sdk.WrapSDKContext(s.ctx), &types.RecordTypeRequest{Name: loanType.Name})
+		assert.EqualError(t, err, `rpc error: code = NotFound desc = record type "io.provenance.Loan" not found`, "RecordType query for deleted type")
+		res, err := s.app.MetadataKeeper.RecordType(sdk.WrapSDKContext(s.ctx), &types.RecordTypeRequest{Name: rateType.Name})
+		require.NoError(t, err, "RecordType query")
+		assert.Equal(t, rateType, res.RecordType, "RecordType query record type")
+		all, err := s.app.MetadataKeeper.RecordTypesAll(sdk.WrapSDKContext(s.ctx), &types.RecordTypesAllRequest{})
+		require.NoError(t, err, "RecordTypesAll query")
+		assert.Equal(t, []types.RecordType{*rateType}, all.RecordTypes, "RecordTypesAll query record types")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestVersionedSpecsAndMigrateScopeSpec() {
+	owners := []string{s.user1}
+	ownerParty := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	newContractSpec := func(id, previous types.MetadataAddress, className string) types.ContractSpecification {
+		spec := *types.NewContractSpecification(id, nil, owners, ownerParty, types.NewContractSpecificationSourceHash("hash"), className)
+		spec.PreviousVersionId = previous
+		return spec
+	}
+	newScopeSpec := func(id, previous types.MetadataAddress, contractSpecIDs ...types.MetadataAddress) types.ScopeSpecification {
+		spec := *types.NewScopeSpecification(id, nil, owners, ownerParty, contractSpecIDs)
+		spec.PreviousVersionId = previous
+		return spec
+	}
+
+	contractSpecV1ID := types.ContractSpecMetadataAddress(uuid.New())
+	contractSpecV2ID := types.ContractSpecMetadataAddress(uuid.New())
+	otherContractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	scopeSpecV1ID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpecV2ID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpecV3ID := types.ScopeSpecMetadataAddress(uuid.New())
+	otherScopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	dneScopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+
+	scopeSpecV1 := newScopeSpec(scopeSpecV1ID, nil, contractSpecV1ID)
+	changedScopeSpecV1 := newScopeSpec(scopeSpecV1ID, nil, contractSpecV1ID)
+	changedScopeSpecV1.Description = types.NewDescription("changed", "", "", "")
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"setup test with contract specs",
+			types.NewMsgWriteContractSpecificationRequest(newContractSpec(contractSpecV1ID, nil, "v1"), owners),
+			"",
+		},
+		{
+			"setup test with unrelated contract spec",
+			types.NewMsgWriteContractSpecificationRequest(newContractSpec(otherContractSpecID, nil, "other"), owners),
+			"",
+		},
+		{
+			"setup test with scope specs",
+			types.NewMsgWriteScopeSpecificationRequest(scopeSpecV1, owners),
+			"",
+		},
+		{
+			"setup test with unrelated scope spec",
+			types.NewMsgWriteScopeSpecificationRequest(newScopeSpec(otherScopeSpecID, nil, contractSpecV1ID), owners),
+			"",
+		},
+		{
+			"setup test with scope",
+			types.NewMsgWriteScopeRequest(*types.NewScope(scopeID, scopeSpecV1ID, ownerPartyList(s.user1), []string{}, ""), owners),
+			"",
+		},
+		{
+			"should fail to change a scope spec that is in use",
+			types.NewMsgWriteScopeSpecificationRequest(changedScopeSpecV1, owners),
+			fmt.Sprintf("scope specification %s is in use and cannot be changed, write a new version of it instead", scopeSpecV1ID),
+		},
+		{
+			"should allow rewriting a scope spec that is in use without changes",
+			types.NewMsgWriteScopeSpecificationRequest(scopeSpecV1, owners),
+			"",
+		},
+		{
+			"should fail to add a contract spec to a scope spec that is in use",
+			types.NewMsgAddContractSpecToScopeSpecRequest(otherContractSpecID, scopeSpecV1ID, owners),
+			fmt.Sprintf("scope specification %s is in use and cannot be changed, write a new version of it instead", scopeSpecV1ID),
+		},
+		{
+			"should fail to write a version of a spec that does not exist",
+			types.NewMsgWriteScopeSpecificationRequest(newScopeSpec(scopeSpecV2ID, dneScopeSpecID, contractSpecV2ID), owners),
+			fmt.Sprintf("previous version %s of specification %s not found", dneScopeSpecID, scopeSpecV2ID),
+		},
+		{
+			"should successfully write new contract spec version",
+			types.NewMsgWriteContractSpecificationRequest(newContractSpec(contractSpecV2ID, contractSpecV1ID, "v2"), owners),
+			"",
+		},
+		{
+			"should successfully write new scope spec version",
+			types.NewMsgWriteScopeSpecificationRequest(newScopeSpec(scopeSpecV2ID, scopeSpecV1ID, contractSpecV2ID), owners),
+			"",
+		},
+		{
+			"should fail to write a second newer version of a spec",
+			types.NewMsgWriteScopeSpecificationRequest(newScopeSpec(scopeSpecV3ID, scopeSpecV1ID, contractSpecV2ID), owners),
+			fmt.Sprintf("specification %s already has a newer version %s", scopeSpecV1ID, scopeSpecV2ID),
+		},
+		{
+			"should fail to change the previous version of a spec",
+			types.NewMsgWriteScopeSpecificationRequest(newScopeSpec(scopeSpecV2ID, otherScopeSpecID, contractSpecV2ID), owners),
+			fmt.Sprintf("cannot change the previous version of specification %s", scopeSpecV2ID),
+		},
+		{
+			"should successfully write a third scope spec version without the needed contract spec",
+			types.NewMsgWriteScopeSpecificationRequest(newScopeSpec(scopeSpecV3ID, scopeSpecV2ID, otherContractSpecID), owners),
+			"",
+		},
+		{
+			"should fail to migrate scope to a spec that is not a newer version",
+			types.NewMsgMigrateScopeSpecRequest(scopeID, otherScopeSpecID, owners),
+			fmt.Sprintf("scope specification %s is not a newer version of %s", otherScopeSpecID, scopeSpecV1ID),
+		},
+		{
+			"should fail to migrate scope to a spec that does not exist",
+			types.NewMsgMigrateScopeSpecRequest(scopeID, dneScopeSpecID, owners),
+			fmt.Sprintf("scope specification %s not found", dneScopeSpecID),
+		},
+		{
+			"should fail to migrate scope without owner signature",
+			types.NewMsgMigrateScopeSpecRequest(scopeID, scopeSpecV2ID, []string{s.user2}),
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			"should fail to remove a spec that has a newer version",
+			types.NewMsgDeleteScopeSpecificationRequest(scopeSpecV2ID, owners),
+			fmt.Sprintf("cannot delete scope specification with id %s: specification %s has a newer version %s", scopeSpecV2ID, scopeSpecV2ID, scopeSpecV3ID),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", sessionID, contractSpecV1ID, ownerPartyList(s.user1), nil))
+
+	s.T().Run("migrate scope to newer version", func(t *testing.T) {
+		_, err := s.handler(s.ctx, types.NewMsgMigrateScopeSpecRequest(scopeID, scopeSpecV3ID, owners))
+		assert.EqualError(t, err, fmt.Sprintf("session %s uses contract specification %s which is not allowed by scope specification %s",
+			sessionID, contractSpecV1ID, scopeSpecV3ID), "migrating to a spec that does not allow the session's contract spec")
+
+		_, err = s.handler(s.ctx, types.NewMsgMigrateScopeSpecRequest(scopeID, scopeSpecV2ID, owners))
+		require.NoError(t, err, "migrating to a spec that allows a newer version of the session's contract spec")
+		scope, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "scope found after migration")
+		assert.Equal(t, scopeSpecV2ID, scope.SpecificationId, "scope spec id after migration")
+
+		_, err = s.handler(s.ctx, types.NewMsgWriteContractSpecificationRequest(newContractSpec(contractSpecV1ID, nil, "changed"), owners))
+		assert.EqualError(t, err, fmt.Sprintf("contract specification %s is in use and cannot be changed, write a new version of it instead", contractSpecV1ID),
+			"changing a contract spec used by a session")
+	})
+
+	s.T().Run("spec version queries", func(t *testing.T) {
+		goCtx := sdk.WrapSDKContext(s.ctx)
+		scopeSpecRes, err := s.app.MetadataKeeper.ScopeSpecificationVersions(goCtx, &types.ScopeSpecificationVersionsRequest{SpecificationId: scopeSpecV2ID.String()})
+		require.NoError(t, err, "ScopeSpecificationVersions")
+		var scopeSpecVersions []types.MetadataAddress
+		for _, version := range scopeSpecRes.Versions {
+			scopeSpecVersions = append(scopeSpecVersions, version.Specification.SpecificationId)
+		}
+		assert.Equal(t, []types.MetadataAddress{scopeSpecV1ID, scopeSpecV2ID, scopeSpecV3ID}, scopeSpecVersions, "scope spec versions")
+
+		contractSpecRes, err := s.app.MetadataKeeper.ContractSpecificationVersions(goCtx, &types.ContractSpecificationVersionsRequest{SpecificationId: contractSpecV1ID.String()})
+		require.NoError(t, err, "ContractSpecificationVersions")
+		require.Len(t, contractSpecRes.Versions, 2, "contract spec versions")
+		assert.Equal(t, contractSpecV1ID, contractSpecRes.Versions[0].Specification.SpecificationId, "first contract spec version")
+		assert.Equal(t, contractSpecV2ID, contractSpecRes.Versions[1].Specification.SpecificationId, "second contract spec version")
+
+		_, err = s.app.MetadataKeeper.ScopeSpecificationVersions(goCtx, &types.ScopeSpecificationVersionsRequest{SpecificationId: dneScopeSpecID.String()})
+		assert.EqualError(t, err, fmt.Sprintf("rpc error: code = NotFound desc = specification %s not found", dneScopeSpecID), "versions of unknown scope spec")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestWritesWithExpectedPriorValues() {
+	cSpecUUID := uuid.New()
+	cSpec := types.NewContractSpecification(types.ContractSpecMetadataAddress(cSpecUUID), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("somesource"), "someclass")
+	sSpec := types.NewScopeSpecification(types.ScopeSpecMetadataAddress(uuid.New()), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{cSpec.SpecificationId})
+	rSpec := types.NewRecordSpecification(types.RecordSpecMetadataAddress(cSpecUUID, "record"), "record",
+		[]*types.InputSpecification{}, "string", types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *cSpec)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *sSpec)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *rSpec)
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := *types.NewScope(scopeID, sSpec.SpecificationId, ownerPartyList(s.user1), []string{}, "")
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := *types.NewSession("someclass", sessionID, cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+	newRecord := func(outputHash string) types.Record {
+		process := types.NewProcess("rproc", &types.Process_Hash{Hash: "rprochash"}, "rprocmethod")
+		outputs := []types.RecordOutput{{Hash: outputHash, Status: types.ResultStatus_RESULT_STATUS_PASS}}
+		return *types.NewRecord(rSpec.Name, sessionID, *process, []types.RecordInput{}, outputs, rSpec.SpecificationId)
+	}
+
+	writeScope := func(dataAccess []string, expectedHash []byte) *types.MsgWriteScopeRequest {
+		proposed := scope
+		proposed.DataAccess = dataAccess
+		msg := types.NewMsgWriteScopeRequest(proposed, []string{s.user1})
+		msg.ExpectedValueHash = expectedHash
+		return msg
+	}
+	writeSession := func(name string, expectedVersion uint32) *types.MsgWriteSessionRequest {
+		proposed := session
+		proposed.Name = name
+		msg := types.NewMsgWriteSessionRequest(proposed, []string{s.user1})
+		msg.ExpectedAuditVersion = expectedVersion
+		return msg
+	}
+	writeRecord := func(outputHash string, expectedHashes ...string) *types.MsgWriteRecordRequest {
+		msg := types.NewMsgWriteRecordRequest(newRecord(outputHash), nil, "", []string{s.user1}, ownerPartyList(s.user1))
+		msg.ExpectedOutputHashes = expectedHashes
+		return msg
+	}
+
+	scopeHash := s.app.MetadataKeeper.GetScopeValueHash(scope)
+	staleScopeHash := make([]byte, len(scopeHash))
+	recordName := fmt.Sprintf("%q", rSpec.Name)
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should fail to write new scope when an existing value is expected",
+			writeScope([]string{}, scopeHash),
+			fmt.Sprintf("scope %s does not exist, but an existing value was expected: stored value has changed", scopeID),
+		},
+		{
+			"should successfully write new scope",
+			writeScope([]string{}, nil),
+			"",
+		},
+		{
+			"should fail to update scope with a stale value hash",
+			writeScope([]string{s.user1}, staleScopeHash),
+			fmt.Sprintf("scope %s value hash %X does not equal the expected value hash %X: stored value has changed", scopeID, scopeHash, staleScopeHash),
+		},
+		{
+			"should successfully update scope with the current value hash",
+			writeScope([]string{s.user1}, scopeHash),
+			"",
+		},
+		{
+			"should fail to update scope with the value hash it had before the last update",
+			writeScope([]string{s.user2}, scopeHash),
+			fmt.Sprintf("scope %s value hash %X does not equal the expected value hash %X: stored value has changed",
+				scopeID, s.app.MetadataKeeper.GetScopeValueHash(*types.NewScope(scopeID, sSpec.SpecificationId, ownerPartyList(s.user1), []string{s.user1}, "")), scopeHash),
+		},
+		{
+			"should fail to write new session when an audit version is expected",
+			writeSession("someclass", 1),
+			fmt.Sprintf("session %s does not exist, but audit version 1 was expected: stored value has changed", sessionID),
+		},
+		{
+			"should successfully write new session",
+			writeSession("someclass", 0),
+			"",
+		},
+		{
+			"should successfully update session with the current audit version",
+			writeSession("someclass2", 1),
+			"",
+		},
+		{
+			"should fail to update session with a stale audit version",
+			writeSession("someclass3", 1),
+			fmt.Sprintf("session %s audit version 2 does not equal the expected audit version 1: stored value has changed", sessionID),
+		},
+		{
+			"should fail to write new record when existing outputs are expected",
+			writeRecord("out1", "out0"),
+			fmt.Sprintf("record %s does not exist, but existing outputs were expected: stored value has changed", recordName),
+		},
+		{
+			"should successfully write new record",
+			writeRecord("out1"),
+			"",
+		},
+		{
+			"should successfully update record with the current output hashes",
+			writeRecord("out2", "out1"),
+			"",
+		},
+		{
+			"should fail to update record with stale output hashes",
+			writeRecord("out3", "out1"),
+			fmt.Sprintf(`record %s output hashes ["out2"] do not equal the expected output hashes ["out1"]: stored value has changed`, recordName),
+		},
+		{
+			"should successfully update record without expected output hashes",
+			writeRecord("out3"),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s MetadataHandlerTestSuite) TestSizeLimits() {
+	cSpecUUID := uuid.New()
+	cSpec := types.NewContractSpecification(types.ContractSpecMetadataAddress(cSpecUUID), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("somesource"), "someclass")
+	sSpec := types.NewScopeSpecification(types.ScopeSpecMetadataAddress(uuid.New()), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{cSpec.SpecificationId})
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *cSpec)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *sSpec)
+	for _, name := range []string{"record1", "record2", "record3"} {
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(types.RecordSpecMetadataAddress(cSpecUUID, name), name,
+			[]*types.InputSpecification{}, "string", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}))
+	}
+
+	params := types.DefaultParams()
+	params.MaxScopeOwners = 2
+	params.MaxScopeDataAccess = 2
+	params.MaxScopeRecords = 2
+	params.MaxRecordInputs = 1
+	params.MaxRecordOutputs = 1
+	params.MaxSessionParties = 1
+	params.MaxSessionContextBytes = 4
+	s.app.MetadataKeeper.SetParams(s.ctx, params)
+	defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+	user3 := sdk.AccAddress("user3_______________").String()
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scope := *types.NewScope(scopeID, sSpec.SpecificationId, ownerPartyList(s.user1), []string{}, "")
+	bigScopeID := types.ScopeMetadataAddress(uuid.New())
+	bigScope := *types.NewScope(bigScopeID, sSpec.SpecificationId, ownerPartyList(s.user1, s.user2, user3), []string{s.user1, s.user2, user3}, "")
+	s.app.MetadataKeeper.SetScope(s.ctx, bigScope)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := *types.NewSession("someclass", sessionID, cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+
+	writeScope := func(owners []types.Party, dataAccess []string) *types.MsgWriteScopeRequest {
+		proposed := scope
+		proposed.Owners = owners
+		proposed.DataAccess = dataAccess
+		return types.NewMsgWriteScopeRequest(proposed, []string{s.user1})
+	}
+	writeSession := func(parties []types.Party, context []byte) *types.MsgWriteSessionRequest {
+		proposed := session
+		proposed.Parties = parties
+		proposed.Context = context
+		return types.NewMsgWriteSessionRequest(proposed, []string{s.user1})
+	}
+	writeRecord := func(name string, inputCount, outputCount int) *types.MsgWriteRecordRequest {
+		inputs := make([]types.RecordInput, inputCount)
+		for i := range inputs {
+			inputs[i] = *types.NewRecordInput(fmt.Sprintf("input%d", i), &types.RecordInput_Hash{Hash: "inputhash"}, "string", types.RecordInputStatus_Proposed)
+		}
+		outputs := make([]types.RecordOutput, outputCount)
+		for i := range outputs {
+			outputs[i] = *types.NewRecordOutput(fmt.Sprintf("outputhash%d", i), types.ResultStatus_RESULT_STATUS_PASS)
+		}
+		process := types.NewProcess("rproc", &types.Process_Hash{Hash: "rprochash"}, "rprocmethod")
+		record := types.NewRecord(name, sessionID, *process, inputs, outputs, types.RecordSpecMetadataAddress(cSpecUUID, name))
+		return types.NewMsgWriteRecordRequest(*record, nil, "", []string{s.user1}, ownerPartyList(s.user1))
+	}
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"should fail to write a scope with too many owners",
+			writeScope(ownerPartyList(s.user1, s.user2, user3), []string{}),
+			"scope owner count 3 is more than the maximum 2: limit exceeded",
+		},
+		{
+			"should fail to write a scope with too many data access addresses",
+			writeScope(ownerPartyList(s.user1), []string{s.user1, s.user2, user3}),
+			"scope data access count 3 is more than the maximum 2: limit exceeded",
+		},
+		{
+			"should successfully write a scope at the limits",
+			writeScope(ownerPartyList(s.user1), []string{s.user1, s.user2}),
+			"",
+		},
+		{
+			"should fail to add data access past the limit",
+			types.NewMsgAddScopeDataAccessRequest(scopeID, []string{user3}, []string{s.user1}),
+			"scope data access count 3 is more than the maximum 2: limit exceeded",
+		},
+		{
+			"should fail to add owners past the limit",
+			types.NewMsgAddScopeOwnerRequest(scopeID, ownerPartyList(s.user2, user3), []string{s.user1}),
+			"scope owner count 3 is more than the maximum 2: limit exceeded",
+		},
+		{
+			"should successfully update a scope that was already over the limits without growing it",
+			types.NewMsgWriteScopeRequest(*types.NewScope(bigScopeID, sSpec.SpecificationId, ownerPartyList(s.user1, s.user2, user3), []string{s.user1, s.user2}, ""),
+				[]string{s.user1, s.user2, user3}),
+			"",
+		},
+		{
+			"should fail to write a session with too many parties",
+			writeSession(ownerPartyList(s.user1, s.user2), nil),
+			"session party count 2 is more than the maximum 1: limit exceeded",
+		},
+		{
+			"should fail to write a session with too much context",
+			writeSession(ownerPartyList(s.user1), []byte("12345")),
+			"session context length 5 is more than the maximum 4: limit exceeded",
+		},
+		{
+			"should successfully write a session at the limits",
+			writeSession(ownerPartyList(s.user1), []byte("1234")),
+			"",
+		},
+		{
+			"should fail to write a record with too many inputs",
+			writeRecord("record1", 2, 1),
+			"record input count 2 is more than the maximum 1: limit exceeded",
+		},
+		{
+			"should fail to write a record with too many outputs",
+			writeRecord("record1", 0, 2),
+			"record output count 2 is more than the maximum 1: limit exceeded",
+		},
+		{
+			"should successfully write a first record",
+			writeRecord("record1", 0, 1),
+			"",
+		},
+		{
+			"should successfully write a second record",
+			writeRecord("record2", 0, 1),
+			"",
+		},
+		{
+			"should fail to write a record past the scope record limit",
+			writeRecord("record3", 0, 1),
+			"scope record count 3 is more than the maximum 2: limit exceeded",
+		},
+		{
+			"should successfully update an existing record at the scope record limit",
+			writeRecord("record1", 0, 1),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s MetadataHandlerTestSuite) TestResponsibleParties() {
+	cSpecUUID := uuid.New()
+	cSpec := types.NewContractSpecification(types.ContractSpecMetadataAddress(cSpecUUID), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("somesource"), "someclass")
+	sSpec := types.NewScopeSpecification(types.ScopeSpecMetadataAddress(uuid.New()), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{cSpec.SpecificationId})
+	recSpec := types.NewRecordSpecification(types.RecordSpecMetadataAddress(cSpecUUID, "record"), "record",
+		[]*types.InputSpecification{}, "string", types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_ORIGINATOR})
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *cSpec)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *sSpec)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recSpec)
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	recordID := types.RecordMetadataAddress(scopeUUID, "record")
+	originator := types.Party{Address: s.user2, Role: types.PartyType_PARTY_TYPE_ORIGINATOR}
+	// The owner session does not have an originator, the originator session does.
+	ownerSession := types.NewSession("someclass", types.SessionMetadataAddress(scopeUUID, uuid.New()), cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+	originatorSession := types.NewSession("someclass", types.SessionMetadataAddress(scopeUUID, uuid.New()), cSpec.SpecificationId,
+		append(ownerPartyList(s.user1), originator), nil)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, sSpec.SpecificationId, ownerPartyList(s.user1), []string{}, ""))
+	s.app.MetadataKeeper.SetSession(s.ctx, *ownerSession)
+	s.app.MetadataKeeper.SetSession(s.ctx, *originatorSession)
+
+	writeRecord := func(session *types.Session, signers []string, parties ...types.Party) *types.MsgWriteRecordRequest {
+		process := types.NewProcess("rproc", &types.Process_Hash{Hash: "rprochash"}, "rprocmethod")
+		record := types.NewRecord("record", session.SessionId, *process, []types.RecordInput{},
+			[]types.RecordOutput{*types.NewRecordOutput("outputhash", types.ResultStatus_RESULT_STATUS_PASS)}, recSpec.SpecificationId)
+		return types.NewMsgWriteRecordRequest(*record, nil, "", signers, parties)
+	}
+	setEnforced := func(enforced bool) {
+		params := types.DefaultParams()
+		params.EnforceResponsibleParties = enforced
+		s.app.MetadataKeeper.SetParams(s.ctx, params)
+	}
+	defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+	queryMissing := func(scope string) []types.MissingResponsibleParties {
+		res, err := s.app.MetadataKeeper.RecordsMissingResponsibleParties(sdk.WrapSDKContext(s.ctx),
+			&types.RecordsMissingResponsiblePartiesRequest{ScopeId: scope})
+		s.Require().NoError(err, "RecordsMissingResponsibleParties(%q)", scope)
+		return res.Entries
+	}
+	expectedMissing := []types.MissingResponsibleParties{{
+		RecordId:   recordID,
+		PartyTypes: []types.PartyType{types.PartyType_PARTY_TYPE_ORIGINATOR},
+		Height:     s.ctx.BlockHeight(),
+	}}
+
+	s.T().Run("not enforced: record without its responsible party is written and tracked", func(t *testing.T) {
+		setEnforced(false)
+		_, err := s.handler(s.ctx, writeRecord(ownerSession, []string{s.user1}, ownerPartyList(s.user1)...))
+		require.NoError(t, err, "handler")
+		assert.Equal(t, expectedMissing, queryMissing(""), "all records missing responsible parties")
+		assert.Equal(t, expectedMissing, queryMissing(scopeUUID.String()), "records missing responsible parties in scope")
+		assert.Empty(t, queryMissing(types.ScopeMetadataAddress(uuid.New()).String()), "records missing responsible parties in other scope")
+	})
+
+	s.T().Run("not enforced: role claimed by a signer is still tracked as missing", func(t *testing.T) {
+		setEnforced(false)
+		claimed := types.Party{Address: s.user1, Role: types.PartyType_PARTY_TYPE_ORIGINATOR}
+		_, err := s.handler(s.ctx, writeRecord(ownerSession, []string{s.user1}, ownerPartyList(s.user1)[0], claimed))
+		require.NoError(t, err, "handler")
+		assert.Equal(t, expectedMissing, queryMissing(""), "records missing responsible parties")
+	})
+
+	s.T().Run("enforced: record without its responsible party is rejected", func(t *testing.T) {
+		setEnforced(true)
+		_, err := s.handler(s.ctx, writeRecord(ownerSession, []string{s.user1}, ownerPartyList(s.user1)...))
+		assert.EqualError(t, err, "missing signature from responsible party type [PARTY_TYPE_ORIGINATOR]")
+		assert.Equal(t, expectedMissing, queryMissing(""), "records missing responsible parties")
+	})
+
+	s.T().Run("enforced: signer claiming a role they do not hold in the session is rejected", func(t *testing.T) {
+		setEnforced(true)
+		claimed := types.Party{Address: s.user1, Role: types.PartyType_PARTY_TYPE_ORIGINATOR}
+		_, err := s.handler(s.ctx, writeRecord(ownerSession, []string{s.user1}, ownerPartyList(s.user1)[0], claimed))
+		assert.EqualError(t, err, "missing signature from responsible party type [PARTY_TYPE_ORIGINATOR]")
+		assert.Equal(t, expectedMissing, queryMissing(""), "records missing responsible parties")
+	})
+
+	s.T().Run("enforced: record signed by its responsible party is written and no longer tracked", func(t *testing.T) {
+		setEnforced(true)
+		_, err := s.handler(s.ctx, writeRecord(originatorSession, []string{s.user1, s.user2}))
+		require.NoError(t, err, "handler")
+		assert.Empty(t, queryMissing(""), "records missing responsible parties")
+	})
+
+	s.T().Run("enforced: responsible party can authorize a signer with authz", func(t *testing.T) {
+		setEnforced(true)
+		grant := authz.NewGenericAuthorization(types.TypeURLMsgWriteRecordRequest)
+		require.NoError(t, s.app.AuthzKeeper.SaveGrant(s.ctx, s.user1Addr, s.user2Addr, grant, s.ctx.BlockTime().Add(time.Hour)), "SaveGrant")
+		_, err := s.handler(s.ctx, writeRecord(originatorSession, []string{s.user1}))
+		require.NoError(t, err, "handler")
+		assert.Empty(t, queryMissing(""), "records missing responsible parties")
+	})
+
+	s.T().Run("deleting a record removes its entry", func(t *testing.T) {
+		setEnforced(false)
+		// The owner session was removed when the record moved out of it.
+		s.app.MetadataKeeper.SetSession(s.ctx, *ownerSession)
+		_, err := s.handler(s.ctx, writeRecord(ownerSession, []string{s.user1}, ownerPartyList(s.user1)...))
+		require.NoError(t, err, "handler write")
+		assert.Len(t, queryMissing(""), 1, "records missing responsible parties after write")
+		_, err = s.handler(s.ctx, types.NewMsgDeleteRecordRequest(recordID, []string{s.user1}))
+		require.NoError(t, err, "handler delete")
+		assert.Empty(t, queryMissing(""), "records missing responsible parties after delete")
+	})
+}
+
 func (s MetadataHandlerTestSuite) TestIssue412WriteScopeOptionalField() {
 	ownerAddress := "cosmos1vz99nyd2er8myeugsr4xm5duwhulhp5ae4dvpa"
 	specIDStr := "scopespec1qjkyp28sldx5r9ueaxqc5adrc5wszy6nsh"
@@ -1027,3 +2094,464 @@ func (s MetadataHandlerTestSuite) TestIssue412WriteScopeOptionalField() {
 		assert.NotNil(t, 0, res)
 	})
 }
+
+func (s MetadataHandlerTestSuite) TestDataAccessGrants() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{}))
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, ""))
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(now)
+	expiration := now.Add(time.Hour)
+	past := now.Add(-1 * time.Hour)
+	addMsg := func(addr string, exp *time.Time, recordNames ...string) *types.MsgAddScopeDataAccessRequest {
+		msg := types.NewMsgAddScopeDataAccessRequest(scopeID, []string{addr}, []string{s.user1})
+		msg.Expiration = exp
+		msg.RecordNames = recordNames
+		return msg
+	}
+	queryScope := func(ctx sdk.Context) *types.ScopeWrapper {
+		res, err := s.app.MetadataKeeper.Scope(sdk.WrapSDKContext(ctx), &types.ScopeRequest{ScopeId: scopeID.String()})
+		s.Require().NoError(err, "Scope query")
+		return res.Scope
+	}
+
+	s.T().Run("expiration in the past is rejected", func(t *testing.T) {
+		_, err := s.handler(ctx, addMsg(s.user2, &past))
+		assert.EqualError(t, err, "data access expiration 2022-03-01T11:00:00Z must be after the current block time 2022-03-01T12:00:00Z")
+	})
+
+	s.T().Run("limited and unlimited data access is added", func(t *testing.T) {
+		_, err := s.handler(ctx, addMsg(s.user2, &expiration, "record1"))
+		require.NoError(t, err, "handler add limited")
+		_, err = s.handler(ctx, addMsg(user3, nil))
+		require.NoError(t, err, "handler add unlimited")
+
+		grant, found := s.app.MetadataKeeper.GetDataAccessGrant(ctx, scopeID, s.user2)
+		require.True(t, found, "GetDataAccessGrant limited")
+		assert.Equal(t, []string{"record1"}, grant.RecordNames, "grant record names")
+		_, found = s.app.MetadataKeeper.GetDataAccessGrant(ctx, scopeID, user3)
+		assert.False(t, found, "GetDataAccessGrant unlimited")
+
+		expected := []types.DataAccessGrant{
+			{ScopeId: scopeID, Address: s.user2, Expiration: &expiration, RecordNames: []string{"record1"}},
+			{ScopeId: scopeID, Address: user3},
+		}
+		assert.Equal(t, expected, queryScope(ctx).EffectiveDataAccess, "effective data access")
+		expected = []types.DataAccessGrant{{ScopeId: scopeID, Address: user3}}
+		assert.Equal(t, expected, queryScope(ctx.WithBlockTime(expiration)).EffectiveDataAccess, "effective data access at expiration")
+	})
+
+	s.T().Run("end blocker does not prune unexpired data access", func(t *testing.T) {
+		metadata.EndBlocker(ctx, abci.RequestEndBlock{}, s.app.MetadataKeeper)
+		scope, _ := s.app.MetadataKeeper.GetScope(ctx, scopeID)
+		assert.Equal(t, []string{s.user2, user3}, scope.DataAccess, "scope data access")
+	})
+
+	s.T().Run("end blocker prunes expired data access", func(t *testing.T) {
+		em := sdk.NewEventManager()
+		metadata.EndBlocker(ctx.WithBlockTime(expiration).WithEventManager(em), abci.RequestEndBlock{}, s.app.MetadataKeeper)
+		scope, _ := s.app.MetadataKeeper.GetScope(ctx, scopeID)
+		assert.Equal(t, []string{user3}, scope.DataAccess, "scope data access")
+		_, found := s.app.MetadataKeeper.GetDataAccessGrant(ctx, scopeID, s.user2)
+		assert.False(t, found, "GetDataAccessGrant")
+
+		events := em.ABCIEvents()
+		require.NotEmpty(t, events, "emitted events")
+		event, err := sdk.ParseTypedEvent(events[len(events)-1])
+		require.NoError(t, err, "ParseTypedEvent")
+		expectedEvent := &types.EventScopeDataAccessExpired{
+			ScopeAddr:  scopeID.String(),
+			Address:    s.user2,
+			Expiration: "2022-03-01T13:00:00Z",
+		}
+		assert.Equal(t, expectedEvent, event, "last emitted event")
+	})
+
+	s.T().Run("deleting data access removes its grant", func(t *testing.T) {
+		_, err := s.handler(ctx, addMsg(s.user2, nil, "record2"))
+		require.NoError(t, err, "handler add")
+		_, err = s.handler(ctx, types.NewMsgDeleteScopeDataAccessRequest(scopeID, []string{s.user2}, []string{s.user1}))
+		require.NoError(t, err, "handler delete")
+		_, found := s.app.MetadataKeeper.GetDataAccessGrant(ctx, scopeID, s.user2)
+		assert.False(t, found, "GetDataAccessGrant")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestCloneScope() {
+	cSpecUUID := uuid.New()
+	cSpec := types.NewContractSpecification(types.ContractSpecMetadataAddress(cSpecUUID), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("somesource"), "someclass")
+	sSpec := types.NewScopeSpecification(types.ScopeSpecMetadataAddress(uuid.New()), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{cSpec.SpecificationId})
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *cSpec)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *sSpec)
+	for _, name := range []string{"kept", "skipped"} {
+		rSpec := types.NewRecordSpecification(types.RecordSpecMetadataAddress(cSpecUUID, name), name,
+			[]*types.InputSpecification{}, "string", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+		rSpec.Clonable = name == "kept"
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *rSpec)
+	}
+
+	sourceUUID := uuid.New()
+	sourceID := types.ScopeMetadataAddress(sourceUUID)
+	source := *types.NewScope(sourceID, sSpec.SpecificationId, ownerPartyList(s.user1), []string{s.user2}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, source)
+	s.app.MetadataKeeper.SetDataAccessGrant(s.ctx, types.DataAccessGrant{ScopeId: sourceID, Address: s.user2, RecordNames: []string{"kept"}})
+	sourceSessionID := types.SessionMetadataAddress(sourceUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("someclass", sourceSessionID, cSpec.SpecificationId, ownerPartyList(s.user1), nil))
+	process := types.NewProcess("rproc", &types.Process_Hash{Hash: "rprochash"}, "rprocmethod")
+	for _, name := range []string{"kept", "skipped"} {
+		outputs := []types.RecordOutput{{Hash: name + "hash", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+		s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord(name, sourceSessionID, *process, []types.RecordInput{}, outputs,
+			types.RecordSpecMetadataAddress(cSpecUUID, name)))
+	}
+
+	s.T().Run("clone with records requires the session parties", func(t *testing.T) {
+		scopeUUID := uuid.New()
+		scopeID := types.ScopeMetadataAddress(scopeUUID)
+		sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+		ctx, _ := s.ctx.CacheContext()
+		_, err := s.handler(ctx, types.NewMsgCloneScopeRequest(sourceID, scopeID, true, sessionID, []string{s.user2}))
+		assert.EqualError(t, err, fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1))
+	})
+
+	s.T().Run("clone of unknown scope fails", func(t *testing.T) {
+		dneID := types.ScopeMetadataAddress(uuid.New())
+		scopeID := types.ScopeMetadataAddress(uuid.New())
+		_, err := s.handler(s.ctx, types.NewMsgCloneScopeRequest(dneID, scopeID, false, nil, []string{s.user1}))
+		assert.EqualError(t, err, fmt.Sprintf("scope not found with id %s", dneID))
+	})
+
+	s.T().Run("clone onto an existing scope fails", func(t *testing.T) {
+		_, err := s.handler(s.ctx, types.NewMsgCloneScopeRequest(sourceID, sourceID, false, nil, []string{s.user1}))
+		assert.Error(t, err)
+		existingID := types.ScopeMetadataAddress(uuid.New())
+		s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(existingID, sSpec.SpecificationId, ownerPartyList(s.user1), []string{}, ""))
+		_, err = s.handler(s.ctx, types.NewMsgCloneScopeRequest(sourceID, existingID, false, nil, []string{s.user1}))
+		assert.EqualError(t, err, fmt.Sprintf("scope %s already exists", existingID))
+	})
+
+	s.T().Run("clone without records copies the scope and its data access grants", func(t *testing.T) {
+		scopeID := types.ScopeMetadataAddress(uuid.New())
+		_, err := s.handler(s.ctx, types.NewMsgCloneScopeRequest(sourceID, scopeID, false, nil, []string{s.user1}))
+		require.NoError(t, err, "handler")
+
+		scope, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "GetScope")
+		expected := source
+		expected.ScopeId = scopeID
+		assert.Equal(t, expected, scope, "cloned scope")
+		grant, found := s.app.MetadataKeeper.GetDataAccessGrant(s.ctx, scopeID, s.user2)
+		require.True(t, found, "GetDataAccessGrant")
+		assert.Equal(t, []string{"kept"}, grant.RecordNames, "grant record names")
+		records, err := s.app.MetadataKeeper.GetRecords(s.ctx, scopeID, "")
+		require.NoError(t, err, "GetRecords")
+		assert.Empty(t, records, "cloned records")
+	})
+
+	s.T().Run("clone with records copies only clonable records", func(t *testing.T) {
+		scopeUUID := uuid.New()
+		scopeID := types.ScopeMetadataAddress(scopeUUID)
+		sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+		_, err := s.handler(s.ctx, types.NewMsgCloneScopeRequest(sourceID, scopeID, true, sessionID, []string{s.user1}))
+		require.NoError(t, err, "handler")
+
+		session, found := s.app.MetadataKeeper.GetSession(s.ctx, sessionID)
+		require.True(t, found, "GetSession")
+		assert.Equal(t, cSpec.SpecificationId, session.SpecificationId, "session specification id")
+		assert.Equal(t, "someclass", session.Name, "session name")
+		records, err := s.app.MetadataKeeper.GetRecords(s.ctx, scopeID, "")
+		require.NoError(t, err, "GetRecords")
+		require.Len(t, records, 1, "cloned records")
+		assert.Equal(t, "kept", records[0].Name, "cloned record name")
+		assert.Equal(t, sessionID, records[0].SessionId, "cloned record session id")
+		assert.Equal(t, "kepthash", records[0].Outputs[0].Hash, "cloned record output")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestDeleteScopeCascade() {
+	// setup writes a scope with a session that has two records and a session without any records.
+	setup := func() (scopeID, recordSession, emptySession types.MetadataAddress, recordIDs []types.MetadataAddress) {
+		scopeUUID := uuid.New()
+		scopeID = types.ScopeMetadataAddress(scopeUUID)
+		s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, nil, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+		recordSession = types.SessionMetadataAddress(scopeUUID, uuid.New())
+		emptySession = types.SessionMetadataAddress(scopeUUID, uuid.New())
+		for _, sessionID := range []types.MetadataAddress{recordSession, emptySession} {
+			s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("someclass", sessionID, nil, ownerPartyList(s.user2), nil))
+		}
+		process := types.NewProcess("rproc", &types.Process_Hash{Hash: "rprochash"}, "rprocmethod")
+		for _, name := range []string{"first", "second"} {
+			s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord(name, recordSession, *process, []types.RecordInput{}, []types.RecordOutput{}, nil))
+			recordIDs = append(recordIDs, scopeID.MustGetAsRecordAddress(name))
+		}
+		return scopeID, recordSession, emptySession, recordIDs
+	}
+	countEvents := func(events []abci.Event) map[string]int {
+		eventCounts := map[string]int{}
+		for _, event := range events {
+			eventCounts[event.Type]++
+		}
+		return eventCounts
+	}
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+
+	s.T().Run("delete without cascade removes the records and leaves sessions without records", func(t *testing.T) {
+		scopeID, recordSession, emptySession, recordIDs := setup()
+		res, err := s.handler(s.ctx, types.NewMsgDeleteScopeRequest(scopeID, false, []string{s.user1}))
+		require.NoError(t, err, "handler")
+
+		for _, id := range append([]types.MetadataAddress{scopeID, recordSession}, recordIDs...) {
+			assert.False(t, store.Has(id), "store has %s", id)
+		}
+		assert.True(t, store.Has(emptySession), "store has session without records %s", emptySession)
+		eventCounts := countEvents(res.Events)
+		assert.Equal(t, 2, eventCounts["provenance.metadata.v1.EventRecordDeleted"], "record deleted events")
+		assert.Equal(t, 1, eventCounts["provenance.metadata.v1.EventSessionDeleted"], "session deleted events")
+		assert.Equal(t, 1, eventCounts["provenance.metadata.v1.EventScopeDeleted"], "scope deleted events")
+	})
+
+	scopeID, recordSession, emptySession, recordIDs := setup()
+
+	s.T().Run("cascade delete requires the scope owners", func(t *testing.T) {
+		_, err := s.handler(s.ctx, types.NewMsgDeleteScopeRequest(scopeID, true, []string{s.user2}))
+		assert.EqualError(t, err, fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1))
+	})
+
+	s.T().Run("cascade delete removes everything and emits each delete event", func(t *testing.T) {
+		res, err := s.handler(s.ctx, types.NewMsgDeleteScopeRequest(scopeID, true, []string{s.user1}))
+		require.NoError(t, err, "handler")
+
+		for _, id := range append([]types.MetadataAddress{scopeID, recordSession, emptySession}, recordIDs...) {
+			assert.False(t, store.Has(id), "store has %s", id)
+		}
+		eventCounts := countEvents(res.Events)
+		assert.Equal(t, 2, eventCounts["provenance.metadata.v1.EventRecordDeleted"], "record deleted events")
+		assert.Equal(t, 2, eventCounts["provenance.metadata.v1.EventSessionDeleted"], "session deleted events")
+		assert.Equal(t, 1, eventCounts["provenance.metadata.v1.EventScopeDeleted"], "scope deleted events")
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestValueOwnerTransferOffers() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{}))
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, scope)
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(now)
+	expiration := now.Add(time.Hour)
+	price := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+	s.Require().NoError(app.FundAccount(s.app, ctx, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "FundAccount")
+	offers := func(ctx sdk.Context, address string) []types.ValueOwnerTransferOffer {
+		if len(address) == 0 {
+			res, err := s.app.MetadataKeeper.ValueOwnerTransferOffers(sdk.WrapSDKContext(ctx), &types.ValueOwnerTransferOffersRequest{ScopeId: scopeID.String()})
+			s.Require().NoError(err, "ValueOwnerTransferOffers")
+			return res.Offers
+		}
+		res, err := s.app.MetadataKeeper.ValueOwnerTransferOffersByAddress(sdk.WrapSDKContext(ctx), &types.ValueOwnerTransferOffersByAddressRequest{Address: address})
+		s.Require().NoError(err, "ValueOwnerTransferOffersByAddress")
+		return res.Offers
+	}
+	lastEvent := func(events []abci.Event) proto.Message {
+		s.Require().NotEmpty(events, "emitted events")
+		for i := len(events) - 1; i >= 0; i-- {
+			if events[i].Type != "provenance.metadata.v1.EventTxCompleted" {
+				event, err := sdk.ParseTypedEvent(events[i])
+				s.Require().NoError(err, "ParseTypedEvent")
+				return event
+			}
+		}
+		return nil
+	}
+
+	s.T().Run("offer requires the value owner", func(t *testing.T) {
+		_, err := s.handler(ctx, types.NewMsgOfferValueOwnerTransferRequest(scopeID, s.user2, price, expiration, []string{s.user2}))
+		assert.EqualError(t, err, fmt.Sprintf("missing signature from existing value owner %s", s.user1))
+	})
+
+	s.T().Run("offer expiration must be in the future", func(t *testing.T) {
+		_, err := s.handler(ctx, types.NewMsgOfferValueOwnerTransferRequest(scopeID, s.user2, price, now, []string{s.user1}))
+		assert.EqualError(t, err, "offer expiration 2022-03-01T12:00:00Z must be after the current block time 2022-03-01T12:00:00Z")
+	})
+
+	s.T().Run("offers are stored and indexed by seller and buyer", func(t *testing.T) {
+		res, err := s.handler(ctx, types.NewMsgOfferValueOwnerTransferRequest(scopeID, s.user2, price, expiration, []string{s.user1}))
+		require.NoError(t, err, "handler offer to user2")
+		expectedEvent := &types.EventValueOwnerTransferOffered{
+			ScopeAddr:  scopeID.String(),
+			Seller:     s.user1,
+			Buyer:      s.user2,
+			Price:      "100nhash",
+			Expiration: "2022-03-01T13:00:00Z",
+		}
+		assert.Equal(t, expectedEvent, lastEvent(res.Events), "last emitted event")
+		_, err = s.handler(ctx, types.NewMsgOfferValueOwnerTransferRequest(scopeID, user3, price, expiration, []string{s.user1}))
+		require.NoError(t, err, "handler offer to user3")
+
+		assert.Len(t, offers(ctx, ""), 2, "offers for scope")
+		assert.Len(t, offers(ctx, s.user1), 2, "offers for seller")
+		assert.Len(t, offers(ctx, s.user2), 1, "offers for buyer")
+		assert.Empty(t, offers(ctx.WithBlockTime(expiration), ""), "offers for scope at expiration")
+	})
+
+	s.T().Run("value owner cannot change while offers are open", func(t *testing.T) {
+		proposed := scope
+		proposed.ValueOwnerAddress = user3
+		_, err := s.handler(ctx, types.NewMsgWriteScopeRequest(proposed, []string{s.user1}))
+		assert.EqualError(t, err, fmt.Sprintf("scope %s has open value owner transfer offers; they must be accepted or cancelled first", scopeID))
+		_, err = s.handler(ctx, types.NewMsgDeleteScopeRequest(scopeID, false, []string{s.user1}))
+		assert.EqualError(t, err, fmt.Sprintf("scope %s has open value owner transfer offers; they must be accepted or cancelled first", scopeID))
+	})
+
+	s.T().Run("accept requires the offered price", func(t *testing.T) {
+		_, err := s.handler(ctx, types.NewMsgAcceptValueOwnerTransferRequest(scopeID, s.user2, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), []string{s.user2}))
+		assert.EqualError(t, err, "price 100stake does not equal the offered price 100nhash")
+	})
+
+	s.T().Run("accept requires the buyer", func(t *testing.T) {
+		_, err := s.handler(ctx, types.NewMsgAcceptValueOwnerTransferRequest(scopeID, s.user2, price, []string{s.user1}))
+		assert.EqualError(t, err, fmt.Sprintf("missing signature from %s", s.user2))
+	})
+
+	s.T().Run("accept pays the seller and transfers the value ownership", func(t *testing.T) {
+		res, err := s.handler(ctx, types.NewMsgAcceptValueOwnerTransferRequest(scopeID, s.user2, price, []string{s.user2}))
+		require.NoError(t, err, "handler accept")
+		expectedEvent := &types.EventValueOwnerTransferAccepted{
+			ScopeAddr: scopeID.String(),
+			Seller:    s.user1,
+			Buyer:     s.user2,
+			Price:     "100nhash",
+		}
+		assert.Equal(t, expectedEvent, lastEvent(res.Events), "last emitted event")
+
+		updated, found := s.app.MetadataKeeper.GetScope(ctx, scopeID)
+		require.True(t, found, "GetScope")
+		assert.Equal(t, s.user2, updated.ValueOwnerAddress, "value owner")
+		assert.Equal(t, "100", s.app.BankKeeper.GetBalance(ctx, s.user1Addr, "nhash").Amount.String(), "seller balance")
+		assert.Equal(t, "900", s.app.BankKeeper.GetBalance(ctx, s.user2Addr, "nhash").Amount.String(), "buyer balance")
+		assert.Empty(t, offers(ctx, ""), "offers for scope")
+		assert.Empty(t, offers(ctx, s.user1), "offers for old seller")
+	})
+
+	s.T().Run("either party can cancel an offer", func(t *testing.T) {
+		_, err := s.handler(ctx, types.NewMsgOfferValueOwnerTransferRequest(scopeID, user3, price, expiration, []string{s.user2}))
+		require.NoError(t, err, "handler offer")
+		_, err = s.handler(ctx, types.NewMsgCancelValueOwnerTransferRequest(scopeID, user3, []string{s.user1}))
+		assert.EqualError(t, err, fmt.Sprintf("missing signature from the seller %s or buyer %s", s.user2, user3))
+
+		res, err := s.handler(ctx, types.NewMsgCancelValueOwnerTransferRequest(scopeID, user3, []string{user3}))
+		require.NoError(t, err, "handler cancel by buyer")
+		expectedEvent := &types.EventValueOwnerTransferCancelled{ScopeAddr: scopeID.String(), Seller: s.user2, Buyer: user3, Reason: "cancelled"}
+		assert.Equal(t, expectedEvent, lastEvent(res.Events), "last emitted event")
+		assert.Empty(t, offers(ctx, ""), "offers for scope")
+	})
+
+	s.T().Run("end blocker prunes expired offers", func(t *testing.T) {
+		_, err := s.handler(ctx, types.NewMsgOfferValueOwnerTransferRequest(scopeID, user3, price, expiration, []string{s.user2}))
+		require.NoError(t, err, "handler offer")
+		metadata.EndBlocker(ctx, abci.RequestEndBlock{}, s.app.MetadataKeeper)
+		_, found := s.app.MetadataKeeper.GetValueOwnerTransferOffer(ctx, scopeID, user3)
+		assert.True(t, found, "offer found before expiration")
+
+		em := sdk.NewEventManager()
+		metadata.EndBlocker(ctx.WithBlockTime(expiration).WithEventManager(em), abci.RequestEndBlock{}, s.app.MetadataKeeper)
+		_, found = s.app.MetadataKeeper.GetValueOwnerTransferOffer(ctx, scopeID, user3)
+		assert.False(t, found, "offer found after expiration")
+		expectedEvent := &types.EventValueOwnerTransferCancelled{ScopeAddr: scopeID.String(), Seller: s.user2, Buyer: user3, Reason: "expired"}
+		assert.Equal(t, expectedEvent, lastEvent(em.ABCIEvents()), "last emitted event")
+		_, err = s.handler(ctx.WithBlockTime(expiration), types.NewMsgAcceptValueOwnerTransferRequest(scopeID, user3, price, []string{user3}))
+		assert.EqualError(t, err, fmt.Sprintf("no open value owner transfer offer for scope %s to %s", scopeID, user3))
+	})
+}
+
+func (s MetadataHandlerTestSuite) TestExecuteContractSession() {
+	cSpecUUID := uuid.New()
+	cSpec := types.NewContractSpecification(types.ContractSpecMetadataAddress(cSpecUUID), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("somesource"), "someclass")
+	sSpec := types.NewScopeSpecification(types.ScopeSpecMetadataAddress(uuid.New()), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{cSpec.SpecificationId})
+	recSpec := types.NewRecordSpecification(types.RecordSpecMetadataAddress(cSpecUUID, "record"), "record",
+		[]*types.InputSpecification{}, "string", types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *cSpec)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *sSpec)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recSpec)
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	recordID := types.RecordMetadataAddress(scopeUUID, "record")
+	newMsg := func(owner string, dataAccess []string, outputHash string, originalOutputHashes ...string) *types.MsgExecuteContractSessionRequest {
+		scope := types.NewScope(scopeID, sSpec.SpecificationId, ownerPartyList(owner), dataAccess, owner)
+		session := types.NewSession("someclass", sessionID, cSpec.SpecificationId, ownerPartyList(s.user1), nil)
+		process := types.NewProcess("rproc", &types.Process_Hash{Hash: "rprochash"}, "rprocmethod")
+		record := types.NewRecord("record", sessionID, *process, []types.RecordInput{},
+			[]types.RecordOutput{*types.NewRecordOutput(outputHash, types.ResultStatus_RESULT_STATUS_PASS)}, recSpec.SpecificationId)
+		records := []types.ContractSessionRecord{{Record: *record, OriginalOutputHashes: originalOutputHashes}}
+		return types.NewMsgExecuteContractSessionRequest(*scope, *session, records, []string{s.user1})
+	}
+	setDisabled := func(disabled bool) {
+		params := types.DefaultParams()
+		params.DisableP8EMessages = disabled
+		s.app.MetadataKeeper.SetParams(s.ctx, params)
+	}
+	defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+	s.T().Run("new scope is written with its session and records", func(t *testing.T) {
+		res, err := s.handler(s.ctx, newMsg(s.user1, []string{}, "hash1"))
+		require.NoError(t, err, "handler")
+		var resp types.MsgExecuteContractSessionResponse
+		require.NoError(t, proto.Unmarshal(res.Data, &resp), "unmarshal response")
+		assert.Equal(t, scopeID, resp.ScopeIdInfo.ScopeId, "response scope id")
+		assert.Equal(t, sessionID, resp.SessionIdInfo.SessionId, "response session id")
+		require.Len(t, resp.RecordIdInfos, 1, "response record id infos")
+		assert.Equal(t, recordID, resp.RecordIdInfos[0].RecordId, "response record id")
+
+		_, found := s.app.MetadataKeeper.GetSession(s.ctx, sessionID)
+		assert.True(t, found, "session found")
+		record, found := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+		require.True(t, found, "record found")
+		assert.Equal(t, "hash1", record.Outputs[0].Hash, "record output hash")
+	})
+
+	s.T().Run("existing scope keeps its owners and value owner and gains data access", func(t *testing.T) {
+		_, err := s.handler(s.ctx, newMsg(s.user2, []string{s.user2}, "hash2", "hash1"))
+		require.NoError(t, err, "handler")
+		scope, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "scope found")
+		assert.Equal(t, ownerPartyList(s.user1), scope.Owners, "scope owners")
+		assert.Equal(t, s.user1, scope.ValueOwnerAddress, "scope value owner")
+		assert.Equal(t, []string{s.user2}, scope.DataAccess, "scope data access")
+	})
+
+	s.T().Run("original output hashes that are no longer current are rejected", func(t *testing.T) {
+		_, err := s.handler(s.ctx, newMsg(s.user1, []string{}, "hash3", "hash1"))
+		assert.ErrorIs(t, err, types.ErrConcurrentUpdate)
+		record, _ := s.app.MetadataKeeper.GetRecord(s.ctx, recordID)
+		assert.Equal(t, "hash2", record.Outputs[0].Hash, "record output hash")
+	})
+
+	s.T().Run("p8e msgs are rejected while disabled", func(t *testing.T) {
+		setDisabled(true)
+		_, err := s.handler(s.ctx, types.NewMsgP8EMemorializeContractRequest())
+		assert.EqualError(t, err, "/provenance.metadata.v1.MsgP8eMemorializeContractRequest is no longer accepted; "+
+			"use /provenance.metadata.v1.MsgExecuteContractSessionRequest instead: msg is disabled")
+		_, err = s.handler(s.ctx, types.NewMsgWriteP8EContractSpecRequest(p8e.ContractSpec{}, []string{s.user1}))
+		assert.EqualError(t, err, "/provenance.metadata.v1.MsgWriteP8eContractSpecRequest is no longer accepted; "+
+			"use /provenance.metadata.v1.MsgWriteContractSpecificationRequest instead: msg is disabled")
+	})
+
+	s.T().Run("execute contract session is still allowed while p8e msgs are disabled", func(t *testing.T) {
+		setDisabled(true)
+		_, err := s.handler(s.ctx, newMsg(s.user1, []string{}, "hash3", "hash2"))
+		assert.NoError(t, err, "handler")
+	})
+}
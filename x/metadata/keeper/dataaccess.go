@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetDataAccessGrant returns the limits placed on an address's access to a scope's data.
+func (k Keeper) GetDataAccessGrant(ctx sdk.Context, scopeID types.MetadataAddress, address string) (grant types.DataAccessGrant, found bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil || !scopeID.IsScopeAddress() {
+		return grant, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDataAccessGrantKey(scopeID, addr))
+	if b == nil {
+		return types.DataAccessGrant{}, false
+	}
+	k.cdc.MustUnmarshal(b, &grant)
+	return grant, true
+}
+
+// SetDataAccessGrant stores the limits placed on an address's access to a scope's data, replacing any existing ones.
+func (k Keeper) SetDataAccessGrant(ctx sdk.Context, grant types.DataAccessGrant) {
+	addr, err := sdk.AccAddressFromBech32(grant.Address)
+	if err != nil {
+		panic(err)
+	}
+	k.RemoveDataAccessGrant(ctx, grant.ScopeId, grant.Address)
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDataAccessGrantKey(grant.ScopeId, addr)
+	store.Set(key, k.cdc.MustMarshal(&grant))
+	if grant.Expiration != nil {
+		store.Set(types.GetDataAccessExpirationCacheKey(*grant.Expiration, grant.ScopeId, addr), key)
+	}
+}
+
+// RemoveDataAccessGrant removes the limits placed on an address's access to a scope's data.
+func (k Keeper) RemoveDataAccessGrant(ctx sdk.Context, scopeID types.MetadataAddress, address string) {
+	grant, found := k.GetDataAccessGrant(ctx, scopeID, address)
+	if !found {
+		return
+	}
+	addr, _ := sdk.AccAddressFromBech32(address)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDataAccessGrantKey(scopeID, addr))
+	if grant.Expiration != nil {
+		store.Delete(types.GetDataAccessExpirationCacheKey(*grant.Expiration, scopeID, addr))
+	}
+}
+
+// IterateDataAccessGrants processes the stored data access grants of a scope with the given handler.
+// If the scope id is empty, all stored data access grants are processed.
+func (k Keeper) IterateDataAccessGrants(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.DataAccessGrant) (stop bool)) error {
+	prefix := types.DataAccessGrantKeyPrefix
+	if !scopeID.Empty() {
+		prefix = types.GetDataAccessGrantIteratorPrefix(scopeID)
+	}
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var grant types.DataAccessGrant
+		if err := k.cdc.Unmarshal(it.Value(), &grant); err != nil {
+			k.Logger(ctx).Error("could not unmarshal data access grant", "key", it.Key(), "error", err)
+		} else if handler(grant) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetEffectiveDataAccess returns the scope's data access addresses that have not expired, along with any limits
+// placed on them. Addresses without limits are returned as grants with only the scope id and address.
+func (k Keeper) GetEffectiveDataAccess(ctx sdk.Context, scope types.Scope) []types.DataAccessGrant {
+	rv := make([]types.DataAccessGrant, 0, len(scope.DataAccess))
+	for _, address := range scope.DataAccess {
+		grant, found := k.GetDataAccessGrant(ctx, scope.ScopeId, address)
+		if !found {
+			grant = types.DataAccessGrant{ScopeId: scope.ScopeId, Address: address}
+		}
+		if !grant.IsExpired(ctx.BlockTime()) {
+			rv = append(rv, grant)
+		}
+	}
+	return rv
+}
+
+// PruneExpiredDataAccess removes addresses from the data access lists of scopes once their data access grants have
+// expired. Expirations are applied even if the scope is locked.
+func (k Keeper) PruneExpiredDataAccess(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var grantKeys [][]byte
+	it := store.Iterator(types.DataAccessExpirationCacheKeyPrefix,
+		sdk.PrefixEndBytes(types.GetDataAccessExpirationCacheIteratorPrefix(ctx.BlockTime())))
+	for ; it.Valid(); it.Next() {
+		grantKeys = append(grantKeys, it.Value())
+	}
+	it.Close()
+
+	for _, key := range grantKeys {
+		b := store.Get(key)
+		if b == nil {
+			continue
+		}
+		var grant types.DataAccessGrant
+		k.cdc.MustUnmarshal(b, &grant)
+		if scope, found := k.GetScope(ctx, grant.ScopeId); found {
+			scope.RemoveDataAccess([]string{grant.Address})
+			k.SetScope(ctx, scope)
+		}
+		k.RemoveDataAccessGrant(ctx, grant.ScopeId, grant.Address)
+		k.EmitEvent(ctx, types.NewEventScopeDataAccessExpired(grant))
+	}
+}
+
+// removeStaleDataAccessGrants removes the data access grants of addresses that are no longer in a scope's data
+// access list. A nil new scope indicates that the scope is being removed.
+func (k Keeper) removeStaleDataAccessGrants(ctx sdk.Context, newScope, oldScope *types.Scope) {
+	if oldScope == nil {
+		return
+	}
+	stale := oldScope.DataAccess
+	if newScope != nil {
+		stale = FindMissing(oldScope.DataAccess, newScope.DataAccess)
+	}
+	for _, address := range stale {
+		k.RemoveDataAccessGrant(ctx, oldScope.ScopeId, address)
+	}
+}
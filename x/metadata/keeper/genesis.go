@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strings"
-
 	"github.com/provenance-io/provenance/x/metadata/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +13,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	if err := data.Validate(); err != nil {
 		panic(err)
 	}
+	// The history of the imported entries is imported below, so don't record their creation as new history.
+	ctx = withoutHistory(ctx)
 	if data.Scopes != nil {
 		for _, s := range data.Scopes {
 			k.SetScope(ctx, s)
@@ -30,6 +30,31 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			k.SetRecord(ctx, r)
 		}
 	}
+	if data.ScopeLocks != nil {
+		for _, l := range data.ScopeLocks {
+			k.SetScopeLock(ctx, l)
+		}
+	}
+	if data.MissingResponsibleParties != nil {
+		for _, m := range data.MissingResponsibleParties {
+			k.SetMissingResponsibleParties(ctx, m)
+		}
+	}
+	if data.DataAccessGrants != nil {
+		for _, g := range data.DataAccessGrants {
+			k.SetDataAccessGrant(ctx, g)
+		}
+	}
+	if data.ValueOwnerTransferOffers != nil {
+		for _, o := range data.ValueOwnerTransferOffers {
+			k.SetValueOwnerTransferOffer(ctx, o)
+		}
+	}
+	if data.RecordTypes != nil {
+		for _, t := range data.RecordTypes {
+			k.SetRecordType(ctx, t)
+		}
+	}
 	if data.ScopeSpecifications != nil {
 		for _, s := range data.ScopeSpecifications {
 			k.SetScopeSpecification(ctx, s)
@@ -47,20 +72,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 	if data.ObjectStoreLocators != nil {
 		for _, s := range data.ObjectStoreLocators {
-			addr, err := sdk.AccAddressFromBech32(s.Owner)
-			if err != nil {
-				panic(err)
-			}
-			encryptionKey := sdk.AccAddress{}
-			if strings.TrimSpace(s.EncryptionKey) != "" {
-				encryptionKey, _ = sdk.AccAddressFromBech32(s.EncryptionKey)
-			}
-			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri)
-			if err != nil {
+			if err := k.ImportOSLocatorRecord(ctx, s); err != nil {
 				panic(err)
 			}
 		}
 	}
+	if data.HistoryEntries != nil {
+		for _, e := range data.HistoryEntries {
+			k.SetHistoryEntry(ctx, e)
+		}
+	}
+	if data.HistorySequence != 0 {
+		k.SetHistorySequence(ctx, data.HistorySequence)
+	}
+	if data.HistoryFloor != nil {
+		k.SetHistoryFloor(ctx, data.HistoryFloor.Height)
+	}
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -74,6 +101,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	contractSpecs := make([]types.ContractSpecification, 0)
 	recordSpecs := make([]types.RecordSpecification, 0)
 	objectStoreLocators := make([]types.ObjectStoreLocator, 0)
+	scopeLocks := make([]types.ScopeLock, 0)
+	recordTypes := make([]types.RecordType, 0)
+	missingResponsibleParties := make([]types.MissingResponsibleParties, 0)
+	dataAccessGrants := make([]types.DataAccessGrant, 0)
+	valueOwnerTransferOffers := make([]types.ValueOwnerTransferOffer, 0)
+	historyEntries := make([]types.HistoryEntry, 0)
+	var historyFloor *types.HistoryFloor
 
 	appendToScopes := func(scope types.Scope) bool {
 		scopes = append(scopes, scope)
@@ -90,6 +124,36 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	}
 
+	appendToScopeLocks := func(lock types.ScopeLock) bool {
+		scopeLocks = append(scopeLocks, lock)
+		return false
+	}
+
+	appendToRecordTypes := func(recordType types.RecordType) bool {
+		recordTypes = append(recordTypes, recordType)
+		return false
+	}
+
+	appendToMissingResponsibleParties := func(entry types.MissingResponsibleParties) bool {
+		missingResponsibleParties = append(missingResponsibleParties, entry)
+		return false
+	}
+
+	appendToDataAccessGrants := func(grant types.DataAccessGrant) bool {
+		dataAccessGrants = append(dataAccessGrants, grant)
+		return false
+	}
+
+	appendToValueOwnerTransferOffers := func(offer types.ValueOwnerTransferOffer) bool {
+		valueOwnerTransferOffers = append(valueOwnerTransferOffers, offer)
+		return false
+	}
+
+	appendToHistoryEntries := func(entry types.HistoryEntry) bool {
+		historyEntries = append(historyEntries, entry)
+		return false
+	}
+
 	appendToScopeSpecs := func(scopeSpec types.ScopeSpecification) bool {
 		scopeSpecs = append(scopeSpecs, scopeSpec)
 		return false
@@ -119,6 +183,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	if err := k.IterateRecords(ctx, types.MetadataAddress{}, appendToRecords); err != nil {
 		panic(err)
 	}
+	if err := k.IterateScopeLocks(ctx, appendToScopeLocks); err != nil {
+		panic(err)
+	}
+	if err := k.IterateRecordTypes(ctx, appendToRecordTypes); err != nil {
+		panic(err)
+	}
+	if err := k.IterateMissingResponsibleParties(ctx, appendToMissingResponsibleParties); err != nil {
+		panic(err)
+	}
+	if err := k.IterateDataAccessGrants(ctx, types.MetadataAddress{}, appendToDataAccessGrants); err != nil {
+		panic(err)
+	}
+	if err := k.IterateValueOwnerTransferOffers(ctx, types.MetadataAddress{}, appendToValueOwnerTransferOffers); err != nil {
+		panic(err)
+	}
+	if err := k.IterateHistory(ctx, appendToHistoryEntries); err != nil {
+		panic(err)
+	}
+	if floor, found := k.GetHistoryFloor(ctx); found {
+		historyFloor = &types.HistoryFloor{Height: floor}
+	}
 	if err := k.IterateScopeSpecs(ctx, appendToScopeSpecs); err != nil {
 		panic(err)
 	}
@@ -134,5 +219,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, scopeLocks, recordTypes, missingResponsibleParties, dataAccessGrants, valueOwnerTransferOffers,
+		historyEntries, k.GetHistorySequence(ctx), historyFloor)
 }
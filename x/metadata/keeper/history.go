@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// historySignersKey is the context key used to identify the signers responsible for changes being made.
+type historySignersKey struct{}
+
+// WithHistorySigners returns a copy of the provided context that attributes history entries to the given signers.
+func WithHistorySigners(ctx sdk.Context, signers []string) sdk.Context {
+	return ctx.WithValue(historySignersKey{}, signers)
+}
+
+// getHistorySigners gets the signers attributed to changes being made in the provided context.
+func getHistorySigners(ctx sdk.Context) []string {
+	if signers, ok := ctx.Value(historySignersKey{}).([]string); ok {
+		return signers
+	}
+	return nil
+}
+
+// historyDisabledKey is the context key used to turn off history recording, e.g. while importing genesis.
+type historyDisabledKey struct{}
+
+// withoutHistory returns a copy of the provided context in which changes are not recorded as history entries.
+func withoutHistory(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(historyDisabledKey{}, true)
+}
+
+// recordHistory appends a history entry for a change to a scope, session, or record.
+// The previous value is the stored bytes of the object before the change, and should be nil when creating an object.
+// Nothing is recorded if history retention is disabled.
+func (k Keeper) recordHistory(ctx sdk.Context, objectID types.MetadataAddress, action types.HistoryAction, previous []byte) {
+	if disabled, _ := ctx.Value(historyDisabledKey{}).(bool); disabled {
+		return
+	}
+	if k.GetHistoryRetentionBlocks(ctx) == 0 {
+		return
+	}
+	scopeID, err := objectID.AsScopeAddress()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.HistoryFloorKey) {
+		// Changes made before now weren't recorded, so the current state is as far back as can be reconstructed.
+		floor := ctx.BlockHeight() - 1
+		if floor < 0 {
+			floor = 0
+		}
+		store.Set(types.HistoryFloorKey, sdk.Uint64ToBigEndian(uint64(floor)))
+	}
+
+	sequence := k.GetHistorySequence(ctx) + 1
+	k.SetHistorySequence(ctx, sequence)
+
+	entry := types.HistoryEntry{
+		ScopeId:  scopeID,
+		ObjectId: objectID,
+		Action:   action,
+		Sequence: sequence,
+		Signers:  getHistorySigners(ctx),
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+	}
+	if previous != nil {
+		hash := sha256.Sum256(previous)
+		entry.PreviousValueHash = hash[:]
+		if objectID.IsRecordAddress() {
+			entry.PreviousRecord = &types.Record{}
+			k.cdc.MustUnmarshal(previous, entry.PreviousRecord)
+		}
+	}
+
+	k.SetHistoryEntry(ctx, entry)
+}
+
+// SetHistoryEntry stores a history entry along with its block height index entry.
+func (k Keeper) SetHistoryEntry(ctx sdk.Context, entry types.HistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHistoryEntryKey(entry.ScopeId, entry.Sequence), k.cdc.MustMarshal(&entry))
+	store.Set(types.GetHistoryHeightCacheKey(entry.Height, entry.Sequence), entry.ScopeId)
+}
+
+// GetHistorySequence gets the last history entry sequence number used.
+func (k Keeper) GetHistorySequence(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get(types.HistorySequenceKey); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
+
+// SetHistorySequence sets the last history entry sequence number used.
+func (k Keeper) SetHistorySequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.HistorySequenceKey, sdk.Uint64ToBigEndian(sequence))
+}
+
+// IterateHistory processes all history entries, ordered by scope then sequence, with the given handler.
+func (k Keeper) IterateHistory(ctx sdk.Context, handler func(types.HistoryEntry) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.HistoryEntryKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.HistoryEntry
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			return err
+		}
+		if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateScopeHistory processes the history entries of a scope, oldest first, with the given handler.
+func (k Keeper) IterateScopeHistory(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.HistoryEntry) (stop bool)) error {
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("invalid scope id: %s", scopeID)
+	}
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.GetHistoryEntryIteratorPrefix(scopeID))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.HistoryEntry
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			k.Logger(ctx).Error("could not unmarshal history entry", "key", it.Key(), "error", err)
+		} else if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetHistoryFloor gets the lowest block height that a scope's records can currently be reconstructed at.
+// The found flag is false if history is not currently being recorded.
+func (k Keeper) GetHistoryFloor(ctx sdk.Context) (height int64, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.HistoryFloorKey)
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetHistoryFloor sets the lowest block height that a scope's records can currently be reconstructed at.
+func (k Keeper) SetHistoryFloor(ctx sdk.Context, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.HistoryFloorKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetScopeRecordsAtHeight reconstructs the records of a scope as they were at the end of the given block height.
+// An error is returned if the history needed to do so is not available.
+func (k Keeper) GetScopeRecordsAtHeight(ctx sdk.Context, scopeID types.MetadataAddress, height int64) ([]*types.Record, error) {
+	if !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("invalid scope id: %s", scopeID)
+	}
+	if height > ctx.BlockHeight() {
+		return nil, fmt.Errorf("height %d is greater than the current height %d", height, ctx.BlockHeight())
+	}
+	floor, found := k.GetHistoryFloor(ctx)
+	if !found || height < floor {
+		return nil, fmt.Errorf("history needed to reconstruct scope %s at height %d is not available", scopeID, height)
+	}
+
+	records := map[string]*types.Record{}
+	err := k.IterateRecords(ctx, scopeID, func(r types.Record) (stop bool) {
+		rec := r
+		records[string(rec.SessionId.MustGetAsRecordAddress(rec.Name))] = &rec
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Undo the changes made after the requested height, newest first.
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStoreReversePrefixIterator(store, types.GetHistoryEntryIteratorPrefix(scopeID))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.HistoryEntry
+		if err = k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("could not unmarshal history entry: %w", err)
+		}
+		if entry.Height <= height {
+			break
+		}
+		if !entry.ObjectId.IsRecordAddress() {
+			continue
+		}
+		if entry.Action == types.HistoryAction_Created {
+			delete(records, string(entry.ObjectId))
+		} else {
+			records[string(entry.ObjectId)] = entry.PreviousRecord
+		}
+	}
+
+	keys := make([]string, 0, len(records))
+	for key := range records {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare([]byte(keys[i]), []byte(keys[j])) < 0
+	})
+	rv := make([]*types.Record, len(keys))
+	for i, key := range keys {
+		rv[i] = records[key]
+	}
+	return rv, nil
+}
+
+// PruneHistory removes history entries that are older than the history retention param allows.
+// If history retention is disabled, all history entries and the reconstruction floor are removed since changes are no
+// longer being recorded. If it is enabled and there isn't a floor yet, the floor is set to the current height.
+func (k Keeper) PruneHistory(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	retention := k.GetHistoryRetentionBlocks(ctx)
+	if retention == 0 {
+		k.deleteHistoryBefore(ctx, sdk.PrefixEndBytes(types.HistoryHeightCacheKeyPrefix))
+		if store.Has(types.HistoryFloorKey) {
+			store.Delete(types.HistoryFloorKey)
+		}
+		return
+	}
+	if !store.Has(types.HistoryFloorKey) {
+		// Nothing has been recorded yet, so the current state is as far back as can be reconstructed.
+		k.SetHistoryFloor(ctx, ctx.BlockHeight())
+	}
+	cutoff := ctx.BlockHeight() - int64(retention)
+	if cutoff < 0 {
+		return
+	}
+
+	pruned := k.deleteHistoryBefore(ctx, types.GetHistoryHeightCacheIteratorPrefix(cutoff+1))
+	if floor, found := k.GetHistoryFloor(ctx); found && pruned > floor {
+		k.SetHistoryFloor(ctx, pruned)
+	}
+}
+
+// deleteHistoryBefore deletes the history entries (and their block height index entries) with a block height index
+// key before the provided end key. The highest block height of the deleted entries is returned, or -1 if none were deleted.
+func (k Keeper) deleteHistoryBefore(ctx sdk.Context, end []byte) int64 {
+	store := ctx.KVStore(k.storeKey)
+	var toDelete [][]byte
+	pruned := int64(-1)
+	it := store.Iterator(types.HistoryHeightCacheKeyPrefix, end)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		height := int64(sdk.BigEndianToUint64(key[1:9]))
+		sequence := sdk.BigEndianToUint64(key[9:])
+		toDelete = append(toDelete, key, types.GetHistoryEntryKey(it.Value(), sequence))
+		if height > pruned {
+			pruned = height
+		}
+	}
+	it.Close()
+
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+	return pruned
+}
@@ -0,0 +1,263 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+type HistoryKeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.App
+	ctx         sdk.Context
+	queryClient types.QueryClient
+
+	user1 string
+
+	scopeUUID uuid.UUID
+	scopeID   types.MetadataAddress
+	sessionID types.MetadataAddress
+	recSpecID types.MetadataAddress
+}
+
+func (s *HistoryKeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.MetadataKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+
+	s.user1 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	s.scopeUUID = uuid.New()
+	s.scopeID = types.ScopeMetadataAddress(s.scopeUUID)
+	s.sessionID = types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+	s.recSpecID = types.RecordSpecMetadataAddress(uuid.New(), "record")
+
+	s.setHistoryRetentionBlocks(100)
+}
+
+func TestHistoryKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(HistoryKeeperTestSuite))
+}
+
+func (s *HistoryKeeperTestSuite) newRecord(name, hash string) types.Record {
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	outputs := []types.RecordOutput{{Hash: hash, Status: types.ResultStatus_RESULT_STATUS_PASS}}
+	return *types.NewRecord(name, s.sessionID, *process, []types.RecordInput{}, outputs, s.recSpecID)
+}
+
+func (s *HistoryKeeperTestSuite) getHistory() []types.HistoryEntry {
+	var entries []types.HistoryEntry
+	err := s.app.MetadataKeeper.IterateScopeHistory(s.ctx, s.scopeID, func(entry types.HistoryEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	s.Require().NoError(err, "IterateScopeHistory")
+	return entries
+}
+
+func (s *HistoryKeeperTestSuite) TestHistoryRecordedOnWriteAndDelete() {
+	ctx := keeper.WithHistorySigners(s.ctx, []string{s.user1})
+	scope := *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, "")
+	s.app.MetadataKeeper.SetScope(ctx, scope)
+	scope.DataAccess = []string{s.user1}
+	s.app.MetadataKeeper.SetScope(ctx, scope)
+	s.app.MetadataKeeper.RemoveScope(ctx, s.scopeID)
+
+	entries := s.getHistory()
+	s.Require().Len(entries, 3, "history entries")
+
+	s.Assert().Equal(types.HistoryAction_Created, entries[0].Action, "entry 0 action")
+	s.Assert().Empty(entries[0].PreviousValueHash, "entry 0 previous value hash")
+	s.Assert().Equal([]string{s.user1}, entries[0].Signers, "entry 0 signers")
+	s.Assert().Equal(int64(10), entries[0].Height, "entry 0 height")
+
+	s.Assert().Equal(types.HistoryAction_Updated, entries[1].Action, "entry 1 action")
+	firstScope := *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, "")
+	expectedHash := sha256.Sum256(s.app.AppCodec().MustMarshal(&firstScope))
+	s.Assert().Equal(expectedHash[:], entries[1].PreviousValueHash, "entry 1 previous value hash")
+
+	s.Assert().Equal(types.HistoryAction_Deleted, entries[2].Action, "entry 2 action")
+	s.Assert().True(entries[2].ObjectId.Equals(s.scopeID), "entry 2 object id")
+	s.Assert().Less(entries[1].Sequence, entries[2].Sequence, "sequence order")
+}
+
+func (s *HistoryKeeperTestSuite) setHistoryRetentionBlocks(blocks uint64) {
+	params := types.DefaultParams()
+	params.HistoryRetentionBlocks = blocks
+	s.app.MetadataKeeper.SetParams(s.ctx, params)
+}
+
+func (s *HistoryKeeperTestSuite) TestHistoryNotRecordedWhenDisabled() {
+	s.setHistoryRetentionBlocks(0)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, ""))
+	s.Assert().Empty(s.getHistory(), "history entries")
+	_, found := s.app.MetadataKeeper.GetHistoryFloor(s.ctx)
+	s.Assert().False(found, "history floor found")
+}
+
+func (s *HistoryKeeperTestSuite) TestScopeRecordsAtHeight() {
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, ""))
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", s.sessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil))
+
+	// height 10: rec1 v1 and rec2 v1
+	s.app.MetadataKeeper.SetRecord(s.ctx, s.newRecord("rec1", "v1"))
+	s.app.MetadataKeeper.SetRecord(s.ctx, s.newRecord("rec2", "v1"))
+	// height 11: rec1 v2
+	s.ctx = s.ctx.WithBlockHeight(11)
+	s.app.MetadataKeeper.SetRecord(s.ctx, s.newRecord("rec1", "v2"))
+	// height 12: rec2 deleted, rec3 created
+	s.ctx = s.ctx.WithBlockHeight(12)
+	s.app.MetadataKeeper.RemoveRecord(s.ctx, types.RecordMetadataAddress(s.scopeUUID, "rec2"))
+	s.app.MetadataKeeper.SetRecord(s.ctx, s.newRecord("rec3", "v1"))
+
+	outputsOf := func(records []*types.Record) map[string]string {
+		rv := map[string]string{}
+		for _, r := range records {
+			rv[r.Name] = r.Outputs[0].Hash
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		height   int64
+		expected map[string]string
+		err      string
+	}{
+		{"before history", 8, nil, "is not available"},
+		{"before any records", 9, map[string]string{}, ""},
+		{"height 10", 10, map[string]string{"rec1": "v1", "rec2": "v1"}, ""},
+		{"height 11", 11, map[string]string{"rec1": "v2", "rec2": "v1"}, ""},
+		{"height 12", 12, map[string]string{"rec1": "v2", "rec3": "v1"}, ""},
+		{"future height", 13, nil, "is greater than the current height"},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			records, err := s.app.MetadataKeeper.GetScopeRecordsAtHeight(s.ctx, s.scopeID, tc.height)
+			if len(tc.err) > 0 {
+				s.Assert().ErrorContains(err, tc.err, "GetScopeRecordsAtHeight error")
+				return
+			}
+			s.Require().NoError(err, "GetScopeRecordsAtHeight")
+			s.Assert().Equal(tc.expected, outputsOf(records), "record outputs")
+		})
+	}
+}
+
+func (s *HistoryKeeperTestSuite) TestPruneHistory() {
+	s.setHistoryRetentionBlocks(5)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, ""))
+	s.ctx = s.ctx.WithBlockHeight(12)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{s.user1}, ""))
+
+	s.ctx = s.ctx.WithBlockHeight(15)
+	s.app.MetadataKeeper.PruneHistory(s.ctx)
+	entries := s.getHistory()
+	s.Require().Len(entries, 1, "history entries after pruning")
+	s.Assert().Equal(int64(12), entries[0].Height, "remaining entry height")
+	floor, found := s.app.MetadataKeeper.GetHistoryFloor(s.ctx)
+	s.Require().True(found, "history floor found")
+	s.Assert().Equal(int64(10), floor, "history floor")
+
+	s.setHistoryRetentionBlocks(0)
+	s.app.MetadataKeeper.PruneHistory(s.ctx)
+	_, found = s.app.MetadataKeeper.GetHistoryFloor(s.ctx)
+	s.Assert().False(found, "history floor found after disabling")
+	s.Assert().Empty(s.getHistory(), "history entries after disabling")
+}
+
+func (s *HistoryKeeperTestSuite) TestPruneHistorySetsFloor() {
+	s.setHistoryRetentionBlocks(0)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, ""))
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", s.sessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil))
+	s.app.MetadataKeeper.SetRecord(s.ctx, s.newRecord("rec1", "v1"))
+
+	s.setHistoryRetentionBlocks(100)
+	s.ctx = s.ctx.WithBlockHeight(11)
+	_, err := s.app.MetadataKeeper.GetScopeRecordsAtHeight(s.ctx, s.scopeID, 11)
+	s.Assert().ErrorContains(err, "is not available", "GetScopeRecordsAtHeight before pruning")
+
+	s.app.MetadataKeeper.PruneHistory(s.ctx)
+	floor, found := s.app.MetadataKeeper.GetHistoryFloor(s.ctx)
+	s.Require().True(found, "history floor found")
+	s.Assert().Equal(int64(11), floor, "history floor")
+
+	records, err := s.app.MetadataKeeper.GetScopeRecordsAtHeight(s.ctx, s.scopeID, 11)
+	s.Require().NoError(err, "GetScopeRecordsAtHeight at floor")
+	s.Require().Len(records, 1, "records at floor")
+	s.Assert().Equal("rec1", records[0].Name, "record name at floor")
+	_, err = s.app.MetadataKeeper.GetScopeRecordsAtHeight(s.ctx, s.scopeID, 10)
+	s.Assert().ErrorContains(err, "is not available", "GetScopeRecordsAtHeight before floor")
+
+	// An existing floor isn't moved up when there's nothing to prune.
+	s.ctx = s.ctx.WithBlockHeight(12)
+	s.app.MetadataKeeper.PruneHistory(s.ctx)
+	floor, _ = s.app.MetadataKeeper.GetHistoryFloor(s.ctx)
+	s.Assert().Equal(int64(11), floor, "history floor at next height")
+}
+
+func (s *HistoryKeeperTestSuite) TestScopeHistoryQuery() {
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, ""))
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{s.user1}, ""))
+
+	res, err := s.queryClient.ScopeHistory(s.ctx.Context(), &types.ScopeHistoryRequest{ScopeId: s.scopeUUID.String()})
+	s.Require().NoError(err, "ScopeHistory")
+	s.Require().Len(res.Entries, 2, "history entries")
+	s.Assert().Equal(types.HistoryAction_Created, res.Entries[0].Action, "first entry action")
+	s.Assert().Equal(types.HistoryAction_Updated, res.Entries[1].Action, "second entry action")
+
+	_, err = s.queryClient.ScopeHistory(s.ctx.Context(), &types.ScopeHistoryRequest{ScopeId: ""})
+	s.Assert().ErrorContains(err, "scope id cannot be empty", "ScopeHistory empty scope id")
+
+	recRes, err := s.queryClient.ScopeRecordsAtHeight(s.ctx.Context(), &types.ScopeRecordsAtHeightRequest{ScopeId: s.scopeID.String(), Height: 1})
+	s.Assert().ErrorContains(err, "is not available", "ScopeRecordsAtHeight before floor")
+	s.Assert().Empty(recRes, "ScopeRecordsAtHeight response before floor")
+}
+
+func (s *HistoryKeeperTestSuite) TestHistoryGenesisRoundTrip() {
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{}, ""))
+	s.ctx = s.ctx.WithBlockHeight(12)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{s.user1}, ""))
+	expected := s.getHistory()
+	s.Require().Len(expected, 2, "history entries before export")
+
+	genesis := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(expected, genesis.HistoryEntries, "exported history entries")
+	s.Assert().Equal(uint64(2), genesis.HistorySequence, "exported history sequence")
+	s.Require().NotNil(genesis.HistoryFloor, "exported history floor")
+	s.Assert().Equal(int64(9), genesis.HistoryFloor.Height, "exported history floor height")
+
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 20})
+	s.app.MetadataKeeper.InitGenesis(s.ctx, genesis)
+	s.Assert().Equal(expected, s.getHistory(), "history entries after import")
+	s.Assert().Equal(uint64(2), s.app.MetadataKeeper.GetHistorySequence(s.ctx), "history sequence after import")
+	floor, found := s.app.MetadataKeeper.GetHistoryFloor(s.ctx)
+	s.Require().True(found, "history floor found after import")
+	s.Assert().Equal(int64(9), floor, "history floor after import")
+
+	// The block height index is rebuilt too, so imported entries are still pruned.
+	params := genesis.Params
+	params.HistoryRetentionBlocks = 5
+	s.app.MetadataKeeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(16)
+	s.app.MetadataKeeper.PruneHistory(s.ctx)
+	entries := s.getHistory()
+	s.Require().Len(entries, 1, "history entries after pruning")
+	s.Assert().Equal(uint64(2), entries[0].Sequence, "remaining entry sequence")
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const (
+	// The name of the invariant that checks the index entries and spec usage counts.
+	indexInvariantName = "index-consistency"
+	// The name of the invariant that checks the sessions and record specs used by records.
+	recordReferenceInvariantName = "record-references"
+	// The name of the repair plan check that finds sessions without any records.
+	// It is not an invariant since a session is written before its records.
+	emptySessionCheckName = "empty-sessions"
+)
+
+// indexKeyPrefixes are the prefixes of all the index entries that are checked by the index invariant.
+var indexKeyPrefixes = [][]byte{
+	types.AddressScopeCacheKeyPrefix,
+	types.ScopeSpecScopeCacheKeyPrefix,
+	types.ValueOwnerScopeCacheKeyPrefix,
+	types.DataAccessScopeCacheKeyPrefix,
+	types.AddressScopeSpecCacheKeyPrefix,
+	types.ContractSpecScopeSpecCacheKeyPrefix,
+	types.AddressContractSpecCacheKeyPrefix,
+	types.ContractSpecSessionCacheKeyPrefix,
+	types.RecordSpecRecordCacheKeyPrefix,
+	types.SpecVersionCacheKeyPrefix,
+	types.OSLocatorURICacheKeyPrefix,
+	types.DataAccessExpirationCacheKeyPrefix,
+	types.AddressValueOwnerTransferOfferCacheKeyPrefix,
+	types.ValueOwnerTransferExpirationCacheKeyPrefix,
+}
+
+// RepairStep is a single change needed to fix a problem found by one of the metadata consistency checks.
+type RepairStep struct {
+	// Check is the name of the invariant (or other check) that found the problem.
+	Check string
+	// Description describes the problem and what needs to be done about it.
+	Description string
+}
+
+// String gets a single line representation of this repair step.
+func (s RepairStep) String() string {
+	return fmt.Sprintf("[%s] %s", s.Check, s.Description)
+}
+
+// RegisterInvariants registers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, indexInvariantName, IndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, recordReferenceInvariantName, RecordReferenceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the metadata module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := IndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return RecordReferenceInvariant(k)(ctx)
+	}
+}
+
+// IndexInvariant checks that every index entry points to an existing object, that every object is fully indexed,
+// and that the usage count of each contract and record specification matches the number of sessions and records using it.
+func IndexInvariant(k Keeper) sdk.Invariant {
+	return repairStepsInvariant(indexInvariantName, k.checkIndexes)
+}
+
+// RecordReferenceInvariant checks that every record's session and record specification exist.
+func RecordReferenceInvariant(k Keeper) sdk.Invariant {
+	return repairStepsInvariant(recordReferenceInvariantName, k.checkRecordReferences)
+}
+
+// repairStepsInvariant creates an invariant that is broken if the given check returns any repair steps.
+func repairStepsInvariant(name string, check func(ctx sdk.Context) []RepairStep) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		steps := check(ctx)
+		var msg strings.Builder
+		msg.WriteString(fmt.Sprintf("found %d problem(s)\n", len(steps)))
+		for _, step := range steps {
+			msg.WriteString(step.Description)
+			msg.WriteString("\n")
+		}
+		return sdk.FormatInvariant(types.ModuleName, name, msg.String()), len(steps) > 0
+	}
+}
+
+// GetRepairPlan runs all of the metadata consistency checks and returns the steps needed to fix the problems found.
+func (k Keeper) GetRepairPlan(ctx sdk.Context) []RepairStep {
+	var plan []RepairStep
+	plan = append(plan, k.checkIndexes(ctx)...)
+	plan = append(plan, k.checkRecordReferences(ctx)...)
+	plan = append(plan, k.checkEmptySessions(ctx)...)
+	return plan
+}
+
+// checkIndexes compares the index entries and spec usage counts in the store with the ones expected from the stored objects.
+func (k Keeper) checkIndexes(ctx sdk.Context) []RepairStep {
+	expected := newKeyLookup()
+	usage := make(map[string]uint64)
+	_ = k.IterateScopes(ctx, func(scope types.Scope) (stop bool) {
+		expected.add(getScopeIndexValues(&scope).IndexKeys()...)
+		return false
+	})
+	_ = k.IterateScopeSpecs(ctx, func(spec types.ScopeSpecification) (stop bool) {
+		expected.add(getScopeSpecIndexValues(&spec).IndexKeys()...)
+		return false
+	})
+	_ = k.IterateContractSpecs(ctx, func(spec types.ContractSpecification) (stop bool) {
+		expected.add(getContractSpecIndexValues(&spec).IndexKeys()...)
+		return false
+	})
+	_ = k.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) (stop bool) {
+		if !session.SpecificationId.Empty() {
+			expected.add(types.GetContractSpecSessionCacheKey(session.SpecificationId, session.SessionId))
+			usage[string(session.SpecificationId)]++
+		}
+		return false
+	})
+	_ = k.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) (stop bool) {
+		recordID, err := record.SessionId.AsRecordAddress(record.Name)
+		if err == nil && !record.SpecificationId.Empty() {
+			expected.add(types.GetRecordSpecRecordCacheKey(record.SpecificationId, recordID))
+			usage[string(record.SpecificationId)]++
+			if contractSpecID, csErr := record.SpecificationId.AsContractSpecAddress(); csErr == nil {
+				usage[string(contractSpecID)]++
+			}
+		}
+		return false
+	})
+	_ = k.IterateOSLocators(ctx, func(locator types.ObjectStoreLocator) (stop bool) {
+		if owner, err := sdk.AccAddressFromBech32(locator.Owner); err == nil {
+			expected.add(types.GetOSLocatorURICacheKey(locator.LocatorUri, owner, locator.Name))
+		}
+		return false
+	})
+	_ = k.IterateDataAccessGrants(ctx, types.MetadataAddress{}, func(grant types.DataAccessGrant) (stop bool) {
+		if addr, err := sdk.AccAddressFromBech32(grant.Address); err == nil && grant.Expiration != nil {
+			expected.add(types.GetDataAccessExpirationCacheKey(*grant.Expiration, grant.ScopeId, addr))
+		}
+		return false
+	})
+	_ = k.IterateValueOwnerTransferOffers(ctx, types.MetadataAddress{}, func(offer types.ValueOwnerTransferOffer) (stop bool) {
+		buyerAddr, err := sdk.AccAddressFromBech32(offer.Buyer)
+		if err != nil {
+			return false
+		}
+		if sellerAddr, sErr := sdk.AccAddressFromBech32(offer.Seller); sErr == nil {
+			expected.add(types.GetAddressValueOwnerTransferOfferCacheKey(sellerAddr, offer.ScopeId, buyerAddr))
+		}
+		expected.add(types.GetAddressValueOwnerTransferOfferCacheKey(buyerAddr, offer.ScopeId, buyerAddr),
+			types.GetValueOwnerTransferExpirationCacheKey(offer.Expiration, offer.ScopeId, buyerAddr))
+		return false
+	})
+
+	var steps []RepairStep
+	store := ctx.KVStore(k.storeKey)
+	found := newKeyLookup()
+	for _, pre := range indexKeyPrefixes {
+		it := sdk.KVStorePrefixIterator(store, pre)
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			found.add(key)
+			if !expected.has(key) {
+				steps = append(steps, RepairStep{indexInvariantName,
+					fmt.Sprintf("delete index entry %X: it does not match any stored object", key)})
+			}
+		}
+		it.Close()
+	}
+	for _, key := range expected.sortedKeys() {
+		if !found.has(key) {
+			steps = append(steps, RepairStep{indexInvariantName,
+				fmt.Sprintf("add index entry %X: it is missing for a stored object", key)})
+		}
+	}
+
+	counted := newKeyLookup()
+	it := sdk.KVStorePrefixIterator(store, types.SpecUsageCountKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		specID := types.MetadataAddress(it.Key()[len(types.SpecUsageCountKeyPrefix):])
+		counted.add(specID)
+		if actual, want := sdk.BigEndianToUint64(it.Value()), usage[string(specID)]; actual != want {
+			steps = append(steps, RepairStep{indexInvariantName,
+				fmt.Sprintf("set the usage count of %s to %d: it is %d", specID, want, actual)})
+		}
+	}
+	it.Close()
+	for _, specID := range sortedMapKeys(usage) {
+		if !counted.has([]byte(specID)) {
+			steps = append(steps, RepairStep{indexInvariantName,
+				fmt.Sprintf("set the usage count of %s to %d: it is missing", types.MetadataAddress(specID), usage[specID])})
+		}
+	}
+
+	return steps
+}
+
+// checkRecordReferences identifies records that use sessions or record specifications that do not exist.
+func (k Keeper) checkRecordReferences(ctx sdk.Context) []RepairStep {
+	var steps []RepairStep
+	_ = k.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) (stop bool) {
+		recordID, err := record.SessionId.AsRecordAddress(record.Name)
+		if err != nil {
+			steps = append(steps, RepairStep{recordReferenceInvariantName,
+				fmt.Sprintf("delete record %q with invalid session id %s: %v", record.Name, record.SessionId, err)})
+			return false
+		}
+		if _, found := k.GetSession(ctx, record.SessionId); !found {
+			steps = append(steps, RepairStep{recordReferenceInvariantName,
+				fmt.Sprintf("write session %s or delete record %s: the session does not exist", record.SessionId, recordID)})
+		}
+		if !record.SpecificationId.Empty() {
+			if _, found := k.GetRecordSpecification(ctx, record.SpecificationId); !found {
+				steps = append(steps, RepairStep{recordReferenceInvariantName,
+					fmt.Sprintf("write record specification %s or delete record %s: the record specification does not exist",
+						record.SpecificationId, recordID)})
+			}
+		}
+		return false
+	})
+	return steps
+}
+
+// checkEmptySessions identifies sessions that do not have any records.
+func (k Keeper) checkEmptySessions(ctx sdk.Context) []RepairStep {
+	withRecords := newKeyLookup()
+	_ = k.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) (stop bool) {
+		withRecords.add(record.SessionId)
+		return false
+	})
+	var steps []RepairStep
+	_ = k.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) (stop bool) {
+		if !withRecords.has(session.SessionId) {
+			steps = append(steps, RepairStep{emptySessionCheckName,
+				fmt.Sprintf("delete session %s if no records will be written to it: it does not have any records", session.SessionId)})
+		}
+		return false
+	})
+	return steps
+}
+
+// sortedKeys gets all the keys in this keyLookup, sorted.
+func (m keyLookup) sortedKeys() [][]byte {
+	rv := make([][]byte, 0, len(m))
+	for k := range m {
+		rv = append(rv, []byte(k))
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		return bytes.Compare(rv[i], rv[j]) < 0
+	})
+	return rv
+}
+
+// sortedMapKeys gets the keys of the provided map, sorted.
+func sortedMapKeys(m map[string]uint64) []string {
+	rv := make([]string, 0, len(m))
+	for k := range m {
+		rv = append(rv, k)
+	}
+	sort.Strings(rv)
+	return rv
+}
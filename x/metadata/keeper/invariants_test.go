@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type InvariantsTestSuite struct {
+	suite.Suite
+
+	app   *simapp.App
+	ctx   sdk.Context
+	store sdk.KVStore
+
+	user1Addr sdk.AccAddress
+	user1     string
+
+	scopeID        types.MetadataAddress
+	scopeSpecID    types.MetadataAddress
+	sessionID      types.MetadataAddress
+	contractSpecID types.MetadataAddress
+	recordSpecID   types.MetadataAddress
+	recordName     string
+	recordID       types.MetadataAddress
+}
+
+func (s *InvariantsTestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.store = s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+
+	s.user1Addr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.user1 = s.user1Addr.String()
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user1Addr))
+
+	scopeUUID := uuid.New()
+	contractSpecUUID := uuid.New()
+	s.scopeID = types.ScopeMetadataAddress(scopeUUID)
+	s.scopeSpecID = types.ScopeSpecMetadataAddress(uuid.New())
+	s.sessionID = types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.contractSpecID = types.ContractSpecMetadataAddress(contractSpecUUID)
+	s.recordName = "invariantrecord"
+	s.recordSpecID = types.RecordSpecMetadataAddress(contractSpecUUID, s.recordName)
+	s.recordID = types.RecordMetadataAddress(scopeUUID, s.recordName)
+
+	mdKeeper := s.app.MetadataKeeper
+	mdKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(
+		s.contractSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		types.NewContractSpecificationSourceHash("contractspechash"), "contractspecclass",
+	))
+	mdKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(
+		s.scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		[]types.MetadataAddress{s.contractSpecID},
+	))
+	mdKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		s.recordSpecID, s.recordName, []*types.InputSpecification{}, "recordspectype",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+	mdKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+	mdKeeper.SetSession(s.ctx, *types.NewSession("invariantsession", s.sessionID, s.contractSpecID, ownerPartyList(s.user1), nil))
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	mdKeeper.SetRecord(s.ctx, *types.NewRecord(s.recordName, s.sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID))
+	s.Require().NoError(mdKeeper.SetOSLocator(s.ctx, types.NewOSLocatorRecord(s.user1Addr, nil, "http://invariants.example.com")))
+}
+
+func TestInvariantsTestSuite(t *testing.T) {
+	suite.Run(t, new(InvariantsTestSuite))
+}
+
+// assertPlan asserts that the repair plan has exactly one step for each of the provided description substrings.
+func (s *InvariantsTestSuite) assertPlan(contains ...string) {
+	plan := s.app.MetadataKeeper.GetRepairPlan(s.ctx)
+	planStrs := make([]string, len(plan))
+	for i, step := range plan {
+		planStrs[i] = step.String()
+	}
+	s.Assert().Len(plan, len(contains), "repair plan:\n%s", strings.Join(planStrs, "\n"))
+	for _, exp := range contains {
+		s.Assert().Contains(strings.Join(planStrs, "\n"), exp, "repair plan")
+	}
+}
+
+func (s *InvariantsTestSuite) TestConsistentState() {
+	for name, inv := range map[string]func(keeper.Keeper) sdk.Invariant{
+		"all":              keeper.AllInvariants,
+		"index":            keeper.IndexInvariant,
+		"record reference": keeper.RecordReferenceInvariant,
+	} {
+		msg, broken := inv(s.app.MetadataKeeper)(s.ctx)
+		s.Assert().False(broken, "%s invariant broken: %s", name, msg)
+	}
+	s.assertPlan()
+}
+
+func (s *InvariantsTestSuite) TestMissingIndexEntry() {
+	key := types.GetValueOwnerScopeCacheKey(s.user1Addr, s.scopeID)
+	s.store.Delete(key)
+
+	msg, broken := keeper.IndexInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "index invariant broken")
+	s.Assert().Contains(msg, fmt.Sprintf("add index entry %X", key), "index invariant message")
+	s.assertPlan(fmt.Sprintf("[index-consistency] add index entry %X", key))
+}
+
+func (s *InvariantsTestSuite) TestDanglingIndexEntry() {
+	key := types.GetAddressScopeCacheKey(s.user1Addr, types.ScopeMetadataAddress(uuid.New()))
+	s.store.Set(key, []byte{0x01})
+
+	_, broken := keeper.IndexInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "index invariant broken")
+	_, broken = keeper.AllInvariants(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "all invariants broken")
+	s.assertPlan(fmt.Sprintf("[index-consistency] delete index entry %X", key))
+}
+
+func (s *InvariantsTestSuite) TestDataAccessExpirationIndexEntry() {
+	expiration := s.ctx.BlockTime().Add(time.Hour).UTC()
+	s.app.MetadataKeeper.SetDataAccessGrant(s.ctx, types.DataAccessGrant{ScopeId: s.scopeID, Address: s.user1, Expiration: &expiration})
+	s.assertPlan()
+
+	key := types.GetDataAccessExpirationCacheKey(expiration, s.scopeID, s.user1Addr)
+	s.store.Delete(key)
+	_, broken := keeper.IndexInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "index invariant broken after deleting the expiration entry")
+	s.assertPlan(fmt.Sprintf("[index-consistency] add index entry %X", key))
+
+	s.app.MetadataKeeper.RemoveDataAccessGrant(s.ctx, s.scopeID, s.user1)
+	s.store.Set(key, []byte{0x01})
+	_, broken = keeper.IndexInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "index invariant broken after deleting the grant")
+	s.assertPlan(fmt.Sprintf("[index-consistency] delete index entry %X", key))
+}
+
+func (s *InvariantsTestSuite) TestValueOwnerTransferOfferIndexEntries() {
+	buyerAddr := sdk.AccAddress("buyer_______________")
+	offer := types.ValueOwnerTransferOffer{ScopeId: s.scopeID, Seller: s.user1, Buyer: buyerAddr.String(),
+		Expiration: s.ctx.BlockTime().Add(time.Hour).UTC()}
+	s.app.MetadataKeeper.SetValueOwnerTransferOffer(s.ctx, offer)
+	s.assertPlan()
+
+	sellerKey := types.GetAddressValueOwnerTransferOfferCacheKey(s.user1Addr, s.scopeID, buyerAddr)
+	buyerKey := types.GetAddressValueOwnerTransferOfferCacheKey(buyerAddr, s.scopeID, buyerAddr)
+	expirationKey := types.GetValueOwnerTransferExpirationCacheKey(offer.Expiration, s.scopeID, buyerAddr)
+	s.store.Delete(sellerKey)
+	s.store.Delete(expirationKey)
+	_, broken := keeper.IndexInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "index invariant broken after deleting the offer entries")
+	s.assertPlan(
+		fmt.Sprintf("[index-consistency] add index entry %X", sellerKey),
+		fmt.Sprintf("[index-consistency] add index entry %X", expirationKey),
+	)
+
+	s.app.MetadataKeeper.RemoveValueOwnerTransferOffer(s.ctx, s.scopeID, offer.Buyer)
+	s.store.Set(buyerKey, []byte{0x01})
+	_, broken = keeper.IndexInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "index invariant broken after deleting the offer")
+	s.assertPlan(fmt.Sprintf("[index-consistency] delete index entry %X", buyerKey))
+}
+
+func (s *InvariantsTestSuite) TestWrongSpecUsageCount() {
+	s.store.Set(types.GetSpecUsageCountKey(s.recordSpecID), sdk.Uint64ToBigEndian(3))
+
+	_, broken := keeper.IndexInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "index invariant broken")
+	s.assertPlan(fmt.Sprintf("set the usage count of %s to 1: it is 3", s.recordSpecID))
+}
+
+func (s *InvariantsTestSuite) TestRecordWithMissingReferences() {
+	s.store.Delete(s.sessionID)
+	s.store.Delete(s.recordSpecID)
+
+	msg, broken := keeper.RecordReferenceInvariant(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().True(broken, "record reference invariant broken")
+	s.Assert().Contains(msg, "found 2 problem(s)", "record reference invariant message")
+	s.assertPlan(
+		// The session's index entry and usage are left behind when it's deleted directly.
+		// The record still counts towards the contract spec, though.
+		"delete index entry",
+		fmt.Sprintf("set the usage count of %s to 1: it is 2", s.contractSpecID),
+		fmt.Sprintf("[record-references] write session %s or delete record %s", s.sessionID, s.recordID),
+		fmt.Sprintf("[record-references] write record specification %s or delete record %s", s.recordSpecID, s.recordID),
+	)
+}
+
+func (s *InvariantsTestSuite) TestEmptySession() {
+	emptySessionID := s.scopeID.MustGetAsSessionAddress(uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("empty", emptySessionID, s.contractSpecID, ownerPartyList(s.user1), nil))
+
+	// A session is written before its records, so a session without records doesn't break any invariant.
+	msg, broken := keeper.AllInvariants(s.app.MetadataKeeper)(s.ctx)
+	s.Assert().False(broken, "all invariants broken: %s", msg)
+	s.assertPlan(fmt.Sprintf("[empty-sessions] delete session %s if no records will be written to it", emptySessionID))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
@@ -10,10 +11,13 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzKeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/libs/log"
 
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -97,16 +101,60 @@ type MetadataKeeperI interface {
 	// GetRecordSpecificationsForContractSpecificationID returns all the record specifications associated with given contractSpecID
 	GetRecordSpecificationsForContractSpecificationID(ctx sdk.Context, contractSpecID types.MetadataAddress) ([]*types.RecordSpecification, error)
 
-	// GetOsLocatorRecord returns the OS locator records for a given name record.
-	GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (types.ObjectStoreLocator, bool)
-	// return if OSLocator exists for a given owner addr
-	OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool
+	// GetOsLocatorRecord returns the OS locator record with the given name for an owner.
+	GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) (types.ObjectStoreLocator, bool)
+	// GetOSLocatorsByOwner returns all of an owner's OS locator records.
+	GetOSLocatorsByOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) ([]types.ObjectStoreLocator, error)
+	// return if OSLocator exists for a given owner addr and name
+	OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) bool
 	// add OSLocator instance
-	SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error
+	SetOSLocator(ctx sdk.Context, locator types.ObjectStoreLocator) error
 	// get OS locator by scope UUID.
 	GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.ObjectStoreLocator, error)
 }
 
+// AttrKeeper defines the attribute keeper functionality needed by the metadata module.
+type AttrKeeper interface {
+	// GetAllAttributes gets all attributes attached to an address.
+	GetAllAttributes(ctx sdk.Context, addr string) ([]attrtypes.Attribute, error)
+	// DeleteAllAttributes removes all attributes attached to an address.
+	DeleteAllAttributes(ctx sdk.Context, addr string) error
+}
+
+// MarkerKeeper defines the marker keeper functionality needed by the metadata module.
+type MarkerKeeper interface {
+	// AddMarkerAccount persists a new marker.
+	AddMarkerAccount(ctx sdk.Context, marker markertypes.MarkerAccountI) error
+	// FinalizeMarker moves a proposed marker to the finalized status.
+	FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string) error
+	// ActivateMarker moves a finalized marker to the active status and mints its supply.
+	ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string) error
+	// AddAccess grants access on a marker.
+	AddAccess(ctx sdk.Context, caller sdk.AccAddress, denom string, grant markertypes.AccessGrantI) error
+	// RemoveAccess revokes all of an address's access on a marker.
+	RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom string, remove sdk.AccAddress) error
+	// MintCoin increases a marker's supply, placing the new coin in the marker.
+	MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) error
+	// BurnCoin decreases a marker's supply using coin held by the marker.
+	BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) error
+	// WithdrawCoins sends coins held by a marker to a recipient.
+	WithdrawCoins(ctx sdk.Context, caller sdk.AccAddress, recipient sdk.AccAddress, denom string, coins sdk.Coins) error
+}
+
+// BankKeeper defines the bank keeper functionality needed by the metadata module.
+type BankKeeper interface {
+	// GetBalance gets an account's balance of a denom.
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	// SendCoins moves coins from one account to another.
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	// AllBalances queries a page of an account's balances, in denom order.
+	AllBalances(ctx context.Context, req *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error)
+	// IsSendEnabledCoins returns an error if any of the coins cannot be sent (e.g. restricted marker denoms).
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	// BlockedAddr returns true if the address is not allowed to receive funds.
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
 // Keeper is the concrete state-based API for the metadata module.
 type Keeper struct {
 	// Key to access the key-value store from sdk.Context
@@ -119,6 +167,15 @@ type Keeper struct {
 
 	// To check granter grantee authorization of messages.
 	authzKeeper authzKeeper.Keeper
+
+	// To look up and clean up the attributes attached to scopes, sessions, and records.
+	attrKeeper AttrKeeper
+
+	// To manage the markers that scopes are tokenized with.
+	markerKeeper MarkerKeeper
+
+	// To find and move the coins of the markers that scopes are tokenized with.
+	bankKeeper BankKeeper
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
@@ -126,17 +183,23 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	authKeeper authkeeper.AccountKeeper,
 	authzKeeper authzKeeper.Keeper,
+	attrKeeper AttrKeeper,
+	markerKeeper MarkerKeeper,
+	bankKeeper BankKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.OSParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable().RegisterParamSet(&types.OSLocatorParams{}))
 	}
 	return Keeper{
-		storeKey:    key,
-		cdc:         cdc,
-		paramSpace:  paramSpace,
-		authKeeper:  authKeeper,
-		authzKeeper: authzKeeper,
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		authKeeper:   authKeeper,
+		authzKeeper:  authzKeeper,
+		attrKeeper:   attrKeeper,
+		markerKeeper: markerKeeper,
+		bankKeeper:   bankKeeper,
 	}
 }
 
@@ -145,6 +208,17 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// removeAttributes deletes all the attributes attached to the given scope, session, or record.
+// Failures are logged since the object itself is already gone.
+func (k Keeper) removeAttributes(ctx sdk.Context, id types.MetadataAddress) {
+	if k.attrKeeper == nil {
+		return
+	}
+	if err := k.attrKeeper.DeleteAllAttributes(ctx, id.String()); err != nil {
+		k.Logger(ctx).Error("could not delete attributes", "id", id.String(), "error", err)
+	}
+}
+
 var _ MetadataKeeperI = &Keeper{}
 
 // GetAccount looks up an account by address
@@ -337,8 +411,8 @@ func FindMissingMdAddr(required, entries []types.MetadataAddress) []types.Metada
 }
 
 // VerifyCorrectOwner to determines whether the signer resolves to the owner of the OSLocator record.
-func (k Keeper) VerifyCorrectOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) bool { // nolint:interfacer
-	stored, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+func (k Keeper) VerifyCorrectOwner(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) bool { // nolint:interfacer
+	stored, found := k.GetOsLocatorRecord(ctx, ownerAddr, name)
 	if !found {
 		return false
 	}
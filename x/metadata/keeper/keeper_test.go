@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/google/uuid"
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/x/metadata/keeper"
@@ -748,12 +749,12 @@ func (s *KeeperTestSuite) TestParams() {
 
 func (s *KeeperTestSuite) TestGetOSLocator() {
 	s.Run("get os locator by owner address", func() {
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().NotEmpty(r)
 		s.Require().True(found)
 	})
 	s.Run("not found by owner address", func() {
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), "")
 		s.Require().Empty(r)
 		s.Require().False(found)
 	})
@@ -772,16 +773,16 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 		acc1 := s.app.AccountKeeper.GetAccount(s.ctx, s.user3Addr)
 		s.Require().NotNil(acc1)
 		// create os locator with ^^ account
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, metadatatypes.NewOSLocatorRecord(s.user3Addr, sdk.AccAddress{}, "https://bob.com/alice"))
 		s.Require().Empty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().NotEmpty(r)
 		s.Require().True(found)
 	})
 
 	s.Run("add os locator account does not exist.", func() {
 		// create account and check default values
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, metadatatypes.NewOSLocatorRecord(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "https://bob.com/alice"))
 		s.Require().NotEmpty(err)
 	})
 
@@ -799,9 +800,9 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 		acc1 := s.app.AccountKeeper.GetAccount(s.ctx, user4Addr)
 		s.Require().NotNil(acc1)
 		// create os locator with ^^ account
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, user4Addr, s.encryptionKey, "foo.com")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, metadatatypes.NewOSLocatorRecord(user4Addr, s.encryptionKey, "foo.com"))
 		s.Require().NotEmpty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, user4Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, user4Addr, "")
 		s.Require().Empty(r)
 		s.Require().False(found)
 	})
@@ -810,9 +811,9 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 func (s *KeeperTestSuite) TestModifyOSLocator() {
 	s.Run("modify os locator", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, metadatatypes.NewOSLocatorRecord(s.user1Addr, s.encryptionKey, "https://bob.com/alice"))
 		s.Require().Empty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().NotEmpty(r)
 		s.Require().True(found)
 		s.Require().Equal(s.encryptionKey.String(), r.EncryptionKey)
@@ -820,13 +821,13 @@ func (s *KeeperTestSuite) TestModifyOSLocator() {
 	})
 	s.Run("modify os locator invalid uri", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "://bob.com/alice")
+		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, metadatatypes.NewOSLocatorRecord(s.user1Addr, s.encryptionKey, "://bob.com/alice"))
 		s.Require().NotEmpty(err)
 	})
 
 	s.Run("modify os locator invalid uri length", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey1, "https://www.google.com/search?q=long+url+example&oq=long+uril+&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8")
+		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, metadatatypes.NewOSLocatorRecord(s.user1Addr, s.encryptionKey1, "https://www.google.com/search?q=long+url+example&oq=long+uril+&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8"))
 		s.Require().NotEmpty(err)
 		s.Require().Equal("uri length greater than allowed", err.Error())
 	})
@@ -835,15 +836,75 @@ func (s *KeeperTestSuite) TestModifyOSLocator() {
 func (s *KeeperTestSuite) TestDeleteOSLocator() {
 	s.Run("delete os locator", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr)
+		err := s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr, "")
 		s.Require().Empty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().Empty(r)
 		s.Require().False(found)
 
 	})
 }
 
+func (s *KeeperTestSuite) TestMultipleOSLocators() {
+	specID := types.ScopeSpecMetadataAddress(uuid.New())
+	otherSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	backup := metadatatypes.NewOSLocatorRecord(s.user1Addr, sdk.AccAddress{}, "http://backup.com")
+	backup.Name = "backup"
+	backup.Priority = 5
+	primary := metadatatypes.NewOSLocatorRecord(s.user1Addr, sdk.AccAddress{}, "http://bar.com")
+	primary.Name = "primary"
+	routed := metadatatypes.NewOSLocatorRecord(s.user1Addr, sdk.AccAddress{}, "http://routed.com")
+	routed.Name = "routed"
+	routed.Priority = 1
+	routed.ScopeSpecIds = []types.MetadataAddress{otherSpecID}
+	for _, loc := range []types.ObjectStoreLocator{backup, primary, routed} {
+		s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, loc), "SetOSLocator %s", loc.Name)
+	}
+	s.Require().EqualError(s.app.MetadataKeeper.SetOSLocator(s.ctx, backup), types.ErrOSLocatorAlreadyBound.Error(), "SetOSLocator duplicate name")
+
+	s.Run("owner locators are ordered by priority", func() {
+		res, err := s.queryClient.OSLocator(s.ctx.Context(), &types.OSLocatorRequest{Owner: s.user1})
+		s.Require().NoError(err, "OSLocator")
+		s.Assert().Equal([]types.ObjectStoreLocator{s.objectLocator, primary, routed, backup}, res.Locators, "locators")
+		s.Assert().Equal(&s.objectLocator, res.Locator, "locator")
+
+		res, err = s.queryClient.OSLocator(s.ctx.Context(), &types.OSLocatorRequest{Owner: s.user1, Name: "backup"})
+		s.Require().NoError(err, "OSLocator by name")
+		s.Assert().Equal(&backup, res.Locator, "named locator")
+	})
+
+	s.Run("locators by uri use the index", func() {
+		res, err := s.queryClient.OSLocatorsByURI(s.ctx.Context(), &types.OSLocatorsByURIRequest{Uri: "http://bar.com"})
+		s.Require().NoError(err, "OSLocatorsByURI")
+		s.Assert().ElementsMatch([]types.ObjectStoreLocator{s.objectLocator1, primary}, res.Locators, "locators")
+
+		primary.LocatorUri = "http://primary.com"
+		s.Require().NoError(s.app.MetadataKeeper.ModifyOSLocator(s.ctx, primary), "ModifyOSLocator")
+		res, err = s.queryClient.OSLocatorsByURI(s.ctx.Context(), &types.OSLocatorsByURIRequest{Uri: "http://bar.com"})
+		s.Require().NoError(err, "OSLocatorsByURI after modify")
+		s.Assert().Equal([]types.ObjectStoreLocator{s.objectLocator1}, res.Locators, "locators after modify")
+
+		s.Require().NoError(s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr, "primary"), "RemoveOSLocator")
+		_, err = s.queryClient.OSLocatorsByURI(s.ctx.Context(), &types.OSLocatorsByURIRequest{Uri: "http://primary.com"})
+		s.Assert().EqualError(err, types.ErrNoRecordsFound.Error(), "OSLocatorsByURI after remove")
+	})
+
+	s.Run("locators by scope include data access parties and routing", func() {
+		scope := *types.NewScope(types.ScopeMetadataAddress(uuid.New()), specID, ownerPartyList(s.user1), []string{s.user2}, "")
+		s.app.MetadataKeeper.SetScope(s.ctx, scope)
+		locators, err := s.app.MetadataKeeper.GetOSLocatorByScope(s.ctx, scope.ScopeId.String())
+		s.Require().NoError(err, "GetOSLocatorByScope")
+		s.Assert().Equal([]types.ObjectStoreLocator{s.objectLocator, backup, s.objectLocator1}, locators, "locators")
+
+		scope.ScopeId = types.ScopeMetadataAddress(uuid.New())
+		scope.SpecificationId = otherSpecID
+		s.app.MetadataKeeper.SetScope(s.ctx, scope)
+		locators, err = s.app.MetadataKeeper.GetOSLocatorByScope(s.ctx, scope.ScopeId.String())
+		s.Require().NoError(err, "GetOSLocatorByScope other spec")
+		s.Assert().Equal([]types.ObjectStoreLocator{s.objectLocator, routed, backup, s.objectLocator1}, locators, "locators other spec")
+	})
+}
+
 func (s *KeeperTestSuite) TestUnionDistinct() {
 	tests := []struct {
 		name   string
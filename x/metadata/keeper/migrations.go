@@ -93,6 +93,30 @@ func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate3to4 migrates from version 3 to 4 to add the scope data access indexes.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 3 to 4")
+	err := indexScopeDataAccess(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 3 to 4")
+	return err
+}
+
+// Migrate4to5 migrates from version 4 to 5 to add the session and record specification indexes and usage counts.
+func (m *Migrator) Migrate4to5(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 4 to 5")
+	err := indexSpecUsage(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 4 to 5")
+	return err
+}
+
+// Migrate5to6 migrates from version 5 to 6 to add the object store locator uri indexes.
+func (m *Migrator) Migrate5to6(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 5 to 6")
+	err := indexOSLocatorURIs(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 5 to 6")
+	return err
+}
+
 // keyLookup is a map used to identify known keys.
 type keyLookup map[string]struct{}
 
@@ -319,3 +343,83 @@ func deleteSessions(ctx sdk.Context, mdKeeper Keeper, sessionsToDelete []types.M
 	ctx.Logger().Info(fmt.Sprintf("Done deleting %d empty sessions.", len(sessionsToDelete)))
 	return nil
 }
+
+// indexScopeDataAccess creates the data access indexes for all scopes.
+// This is a function for a migration, not intended for outside use.
+func indexScopeDataAccess(ctx sdk.Context, mdKeeper Keeper) error {
+	store := ctx.KVStore(mdKeeper.storeKey)
+	i := 0
+	ri := 0
+	rv := mdKeeper.IterateScopes(ctx, func(scope types.Scope) (stop bool) {
+		i++
+		if len(scope.DataAccess) > 0 {
+			for _, key := range getScopeIndexValues(&scope).IndexKeys() {
+				if key[0] == types.DataAccessScopeCacheKeyPrefix[0] {
+					store.Set(key, []byte{0x01})
+				}
+			}
+			ri++
+		}
+		if i%10000 == 0 {
+			ctx.Logger().Info(fmt.Sprintf("Checked %d scopes and indexed data access for %d of them.", i, ri))
+		}
+		return false
+	})
+	ctx.Logger().Info(fmt.Sprintf("Done indexing data access for %d scopes out of %d.", ri, i))
+	return rv
+}
+
+// indexSpecUsage creates the contract spec index entries for all sessions and the record spec index entries for all records,
+// and counts the number of uses of each spec.
+// This is a function for a migration, not intended for outside use.
+func indexSpecUsage(ctx sdk.Context, mdKeeper Keeper) error {
+	store := ctx.KVStore(mdKeeper.storeKey)
+	i := 0
+	err := mdKeeper.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) (stop bool) {
+		i++
+		mdKeeper.indexSessionSpec(store, session.SessionId, nil, session.SpecificationId)
+		if i%10000 == 0 {
+			ctx.Logger().Info(fmt.Sprintf("Indexed the contract specs of %d sessions.", i))
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done indexing the contract specs of %d sessions.", i))
+
+	i = 0
+	err = mdKeeper.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) (stop bool) {
+		i++
+		mdKeeper.indexRecordSpec(store, record.SessionId.MustGetAsRecordAddress(record.Name), nil, record.SpecificationId)
+		if i%10000 == 0 {
+			ctx.Logger().Info(fmt.Sprintf("Indexed the record specs of %d records.", i))
+		}
+		return false
+	})
+	ctx.Logger().Info(fmt.Sprintf("Done indexing the record specs of %d records.", i))
+	return err
+}
+
+// indexOSLocatorURIs creates the uri index entries for all object store locators.
+// This is a function for a migration, not intended for outside use.
+func indexOSLocatorURIs(ctx sdk.Context, mdKeeper Keeper) error {
+	store := ctx.KVStore(mdKeeper.storeKey)
+	i := 0
+	var ownerErr error
+	err := mdKeeper.IterateOSLocators(ctx, func(locator types.ObjectStoreLocator) (stop bool) {
+		var ownerAddr sdk.AccAddress
+		ownerAddr, ownerErr = sdk.AccAddressFromBech32(locator.Owner)
+		if ownerErr != nil {
+			return true
+		}
+		i++
+		store.Set(types.GetOSLocatorURICacheKey(locator.LocatorUri, ownerAddr, locator.Name), []byte{0x01})
+		return false
+	})
+	if err == nil {
+		err = ownerErr
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done indexing the uris of %d object store locators.", i))
+	return err
+}
@@ -1091,3 +1091,114 @@ func (s *MigrationsTestSuite) Test2To3() {
 		// but it would deadlock with scopeCount := ScopeSpecCount * 2 * 8.
 	})
 }
+
+func (s *MigrationsTestSuite) TestMigrate3to4() {
+	dataAccess1 := randomUser()
+	dataAccess2 := randomUser()
+	scopeWith := types.Scope{
+		ScopeId:    types.ScopeMetadataAddress(uuid.New()),
+		Owners:     ownerPartyList(randomUser().Bech32),
+		DataAccess: []string{dataAccess1.Bech32, dataAccess2.Bech32},
+	}
+	scopeWithout := types.Scope{
+		ScopeId: types.ScopeMetadataAddress(uuid.New()),
+		Owners:  ownerPartyList(randomUser().Bech32),
+	}
+
+	// Write the scopes directly so that they're stored without any data access indexes.
+	for i, scope := range []types.Scope{scopeWith, scopeWithout} {
+		bz, err := s.app.AppCodec().Marshal(&scope)
+		s.Require().NoError(err, "marshalling scope %d", i)
+		s.store.Set(scope.ScopeId, bz)
+	}
+	expectedKeys := [][]byte{
+		types.GetDataAccessScopeCacheKey(dataAccess1.Addr, scopeWith.ScopeId),
+		types.GetDataAccessScopeCacheKey(dataAccess2.Addr, scopeWith.ScopeId),
+	}
+	for i, key := range expectedKeys {
+		s.Require().False(s.store.Has(key), "data access index %d before migration", i)
+	}
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate3to4(s.ctx), "running migration v3 to v4")
+
+	for i, key := range expectedKeys {
+		s.Assert().True(s.store.Has(key), "data access index %d after migration", i)
+	}
+	count := 0
+	iter := sdk.KVStorePrefixIterator(s.store, types.DataAccessScopeCacheKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	s.Assert().Equal(len(expectedKeys), count, "number of data access indexes after migration")
+}
+
+func (s *MigrationsTestSuite) TestMigrate4to5() {
+	scopeUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	recSpecID := contractSpecID.MustGetAsRecordSpecAddress("record")
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := types.Session{
+		SessionId:       sessionID,
+		SpecificationId: contractSpecID,
+		Parties:         ownerPartyList(randomUser().Bech32),
+		Name:            "session",
+	}
+	records := []types.Record{
+		{Name: "record1", SessionId: sessionID, SpecificationId: recSpecID},
+		{Name: "record2", SessionId: sessionID, SpecificationId: recSpecID},
+	}
+
+	// Write the session and records directly so that they're stored without any spec indexes.
+	bz, err := s.app.AppCodec().Marshal(&session)
+	s.Require().NoError(err, "marshalling session")
+	s.store.Set(session.SessionId, bz)
+	for i, record := range records {
+		bz, err = s.app.AppCodec().Marshal(&record)
+		s.Require().NoError(err, "marshalling record %d", i)
+		s.store.Set(record.SessionId.MustGetAsRecordAddress(record.Name), bz)
+	}
+	s.Require().Zero(s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, contractSpecID), "contract spec usage count before migration")
+	s.Require().Zero(s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, recSpecID), "record spec usage count before migration")
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate4to5(s.ctx), "running migration v4 to v5")
+
+	// The contract spec count includes the session and both records.
+	s.Assert().Equal(uint64(3), s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, contractSpecID), "contract spec usage count after migration")
+	s.Assert().Equal(uint64(2), s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, recSpecID), "record spec usage count after migration")
+	s.Assert().True(s.store.Has(types.GetContractSpecSessionCacheKey(contractSpecID, sessionID)), "session index after migration")
+	for i, record := range records {
+		key := types.GetRecordSpecRecordCacheKey(recSpecID, record.SessionId.MustGetAsRecordAddress(record.Name))
+		s.Assert().True(s.store.Has(key), "record %d index after migration", i)
+	}
+}
+
+func (s *MigrationsTestSuite) TestMigrate5to6() {
+	owner := randomUser()
+	locators := []types.ObjectStoreLocator{
+		{Owner: owner.Bech32, LocatorUri: "https://one.example.com"},
+		{Owner: owner.Bech32, LocatorUri: "https://two.example.com", Name: "backup", Priority: 1},
+	}
+
+	// Write the locators directly so that they're stored without any uri indexes.
+	for i, locator := range locators {
+		bz, err := s.app.AppCodec().Marshal(&locator)
+		s.Require().NoError(err, "marshalling locator %d", i)
+		s.store.Set(types.GetOSLocatorKey(owner.Addr, locator.Name), bz)
+	}
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate5to6(s.ctx), "running migration v5 to v6")
+
+	for i, locator := range locators {
+		var found []types.ObjectStoreLocator
+		err := s.app.MetadataKeeper.IterateOSLocatorsByURI(s.ctx, locator.LocatorUri, func(loc types.ObjectStoreLocator) bool {
+			found = append(found, loc)
+			return false
+		})
+		s.Require().NoError(err, "IterateOSLocatorsByURI %d", i)
+		s.Assert().Equal([]types.ObjectStoreLocator{locator}, found, "locators found by uri %d after migration", i)
+	}
+}
@@ -32,13 +32,13 @@ func (k msgServer) WriteScope(
 	msg *types.MsgWriteScopeRequest,
 ) (*types.MsgWriteScopeResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteScope")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
 
 	existing, _ := k.GetScope(ctx, msg.Scope.ScopeId)
-	if err := k.ValidateScopeUpdate(ctx, existing, msg.Scope, msg.Signers, msg.MsgTypeURL()); err != nil {
+	if err := k.ValidateScopeUpdate(ctx, existing, msg.Scope, msg.Signers, msg.ExpectedValueHash, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
 
@@ -53,7 +53,7 @@ func (k msgServer) DeleteScope(
 	msg *types.MsgDeleteScopeRequest,
 ) (*types.MsgDeleteScopeResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteScope")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	if len(msg.ScopeId) == 0 {
 		return nil, errors.New("scope id cannot be empty")
@@ -66,8 +66,11 @@ func (k msgServer) DeleteScope(
 	if err := k.ValidateScopeRemove(ctx, existing, msg.Signers, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
-
-	k.RemoveScope(ctx, msg.ScopeId)
+	if msg.Cascade {
+		k.RemoveScopeCascade(ctx, msg.ScopeId)
+	} else {
+		k.RemoveScope(ctx, msg.ScopeId)
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteScope, msg.GetSigners()))
 	return types.NewMsgDeleteScopeResponse(), nil
@@ -78,7 +81,7 @@ func (k msgServer) AddScopeDataAccess(
 	msg *types.MsgAddScopeDataAccessRequest,
 ) (*types.MsgAddScopeDataAccessResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AddScopeDataAccess")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
@@ -88,10 +91,20 @@ func (k msgServer) AddScopeDataAccess(
 	if err := k.ValidateScopeAddDataAccess(ctx, msg.DataAccess, existing, msg.Signers, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
+	if msg.Expiration != nil && !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, fmt.Errorf("data access expiration %s must be after the current block time %s",
+			msg.Expiration.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
 
 	existing.AddDataAccess(msg.DataAccess)
 
 	k.SetScope(ctx, existing)
+	for _, da := range msg.DataAccess {
+		grant := types.DataAccessGrant{ScopeId: msg.ScopeId, Address: da, Expiration: msg.Expiration, RecordNames: msg.RecordNames}
+		if grant.IsLimited() {
+			k.SetDataAccessGrant(ctx, grant)
+		}
+	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AddScopeDataAccess, msg.GetSigners()))
 	return types.NewMsgAddScopeDataAccessResponse(), nil
@@ -102,7 +115,7 @@ func (k msgServer) DeleteScopeDataAccess(
 	msg *types.MsgDeleteScopeDataAccessRequest,
 ) (*types.MsgDeleteScopeDataAccessResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteScopeDataAccess")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
@@ -126,7 +139,7 @@ func (k msgServer) AddScopeOwner(
 	msg *types.MsgAddScopeOwnerRequest,
 ) (*types.MsgAddScopeOwnerResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AddScopeOwner")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
@@ -158,7 +171,7 @@ func (k msgServer) DeleteScopeOwner(
 	msg *types.MsgDeleteScopeOwnerRequest,
 ) (*types.MsgDeleteScopeOwnerResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteScopeOwner")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
@@ -185,12 +198,224 @@ func (k msgServer) DeleteScopeOwner(
 	return types.NewMsgDeleteScopeOwnerResponse(), nil
 }
 
+func (k msgServer) LockScope(
+	goCtx context.Context,
+	msg *types.MsgLockScopeRequest,
+) (*types.MsgLockScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "LockScope")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateScopeLock(ctx, msg.ScopeId, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	k.SetScopeLock(ctx, types.ScopeLock{
+		ScopeId:    msg.ScopeId,
+		Locker:     msg.Locker,
+		Reason:     msg.Reason,
+		Height:     ctx.BlockHeight(),
+		LockedDate: ctx.BlockTime(),
+	})
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_LockScope, msg.GetSigners()))
+	return types.NewMsgLockScopeResponse(), nil
+}
+
+func (k msgServer) UnlockScope(
+	goCtx context.Context,
+	msg *types.MsgUnlockScopeRequest,
+) (*types.MsgUnlockScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "UnlockScope")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateScopeUnlock(ctx, msg.ScopeId, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	k.RemoveScopeLock(ctx, msg.ScopeId)
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_UnlockScope, msg.GetSigners()))
+	return types.NewMsgUnlockScopeResponse(), nil
+}
+
+func (k msgServer) TokenizeScope(
+	goCtx context.Context,
+	msg *types.MsgTokenizeScopeRequest,
+) (*types.MsgTokenizeScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "TokenizeScope")
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
+
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+
+	recipient, err := k.ValidateScopeTokenize(ctx, existing, msg.Recipient, msg.Signers, msg.MsgTypeURL())
+	if err != nil {
+		return nil, err
+	}
+
+	transferAgents := []sdk.AccAddress{recipient}
+	if len(msg.TransferAgents) > 0 {
+		transferAgents = make([]sdk.AccAddress, len(msg.TransferAgents))
+		for i, agent := range msg.TransferAgents {
+			transferAgents[i] = types.MustAccAddressFromBech32(agent)
+		}
+	}
+
+	denom, err := k.AddScopeToken(ctx, existing, recipient, transferAgents)
+	if err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_TokenizeScope, msg.GetSigners()))
+	return types.NewMsgTokenizeScopeResponse(denom), nil
+}
+
+func (k msgServer) DetokenizeScope(
+	goCtx context.Context,
+	msg *types.MsgDetokenizeScopeRequest,
+) (*types.MsgDetokenizeScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DetokenizeScope")
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
+
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+
+	holder, err := k.ValidateScopeDetokenize(ctx, existing, msg.ValueOwnerAddress, msg.Signers, msg.MsgTypeURL())
+	if err != nil {
+		return nil, err
+	}
+
+	valueOwner := msg.ValueOwnerAddress
+	if len(valueOwner) == 0 {
+		valueOwner = holder.String()
+	}
+
+	if err = k.RemoveScopeToken(ctx, existing, holder, valueOwner); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DetokenizeScope, msg.GetSigners()))
+	return types.NewMsgDetokenizeScopeResponse(), nil
+}
+
+func (k msgServer) CloneScope(
+	goCtx context.Context,
+	msg *types.MsgCloneScopeRequest,
+) (*types.MsgCloneScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "CloneScope")
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
+
+	source, found := k.GetScope(ctx, msg.SourceScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.SourceScopeId)
+	}
+
+	scope, err := k.ValidateScopeClone(ctx, source, msg.ScopeId, msg.Signers, msg.MsgTypeURL())
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetScope(ctx, scope)
+	k.CopyScopeDataAccessGrants(ctx, source.ScopeId, scope.ScopeId)
+
+	var recordIDs []types.MetadataAddress
+	if msg.IncludeRecords {
+		recordIDs, err = k.CloneScopeRecords(ctx, source.ScopeId, msg.SessionId, msg.Signers, msg.MsgTypeURL())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_CloneScope, msg.GetSigners()))
+	return types.NewMsgCloneScopeResponse(scope.ScopeId, recordIDs), nil
+}
+
+func (k msgServer) OfferValueOwnerTransfer(
+	goCtx context.Context,
+	msg *types.MsgOfferValueOwnerTransferRequest,
+) (*types.MsgOfferValueOwnerTransferResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "OfferValueOwnerTransfer")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.ValidateValueOwnerTransferOffer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetValueOwnerTransferOffer(ctx, offer)
+
+	k.EmitEvent(ctx, types.NewEventValueOwnerTransferOffered(offer))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_OfferValueOwnerTransfer, msg.GetSigners()))
+	return types.NewMsgOfferValueOwnerTransferResponse(), nil
+}
+
+func (k msgServer) AcceptValueOwnerTransfer(
+	goCtx context.Context,
+	msg *types.MsgAcceptValueOwnerTransferRequest,
+) (*types.MsgAcceptValueOwnerTransferResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AcceptValueOwnerTransfer")
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
+
+	offer, scope, err := k.ValidateValueOwnerTransferAccept(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	buyerAddr, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		return nil, err
+	}
+	sellerAddr, err := sdk.AccAddressFromBech32(offer.Seller)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.bankKeeper.SendCoins(ctx, buyerAddr, sellerAddr, offer.Price); err != nil {
+		return nil, fmt.Errorf("could not pay %s to seller %s: %w", offer.Price, offer.Seller, err)
+	}
+
+	// The scope's other offers are for a value owner that it no longer has.
+	for _, other := range k.RemoveValueOwnerTransferOffers(ctx, scope.ScopeId) {
+		if other.Buyer != offer.Buyer {
+			k.EmitEvent(ctx, types.NewEventValueOwnerTransferCancelled(other, types.ValueOwnerTransferReasonCancelled))
+		}
+	}
+	scope.ValueOwnerAddress = offer.Buyer
+	k.SetScope(ctx, scope)
+
+	k.EmitEvent(ctx, types.NewEventValueOwnerTransferAccepted(offer))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AcceptValueOwnerTransfer, msg.GetSigners()))
+	return types.NewMsgAcceptValueOwnerTransferResponse(), nil
+}
+
+func (k msgServer) CancelValueOwnerTransfer(
+	goCtx context.Context,
+	msg *types.MsgCancelValueOwnerTransferRequest,
+) (*types.MsgCancelValueOwnerTransferResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "CancelValueOwnerTransfer")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.ValidateValueOwnerTransferCancel(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemoveValueOwnerTransferOffer(ctx, offer.ScopeId, offer.Buyer)
+
+	k.EmitEvent(ctx, types.NewEventValueOwnerTransferCancelled(offer, types.ValueOwnerTransferReasonCancelled))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_CancelValueOwnerTransfer, msg.GetSigners()))
+	return types.NewMsgCancelValueOwnerTransferResponse(), nil
+}
+
 func (k msgServer) WriteSession(
 	goCtx context.Context,
 	msg *types.MsgWriteSessionRequest,
 ) (*types.MsgWriteSessionResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteSession")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
@@ -201,7 +426,7 @@ func (k msgServer) WriteSession(
 		existing = &e
 		existingAudit = existing.Audit
 	}
-	if err := k.ValidateSessionUpdate(ctx, existing, &msg.Session, msg.Signers, msg.MsgTypeURL()); err != nil {
+	if err := k.ValidateSessionUpdate(ctx, existing, &msg.Session, msg.Signers, msg.ExpectedAuditVersion, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
 
@@ -218,7 +443,7 @@ func (k msgServer) WriteRecord(
 	msg *types.MsgWriteRecordRequest,
 ) (*types.MsgWriteRecordResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteRecord")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
@@ -234,11 +459,12 @@ func (k msgServer) WriteRecord(
 	if e, found := k.GetRecord(ctx, recordID); found {
 		existing = &e
 	}
-	if err := k.ValidateRecordUpdate(ctx, existing, &msg.Record, msg.Signers, msg.Parties, msg.MsgTypeURL()); err != nil {
+	if err := k.ValidateRecordUpdate(ctx, existing, &msg.Record, msg.Signers, msg.ExpectedOutputHashes, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
 
 	k.SetRecord(ctx, msg.Record)
+	k.updateMissingResponsibleParties(ctx, msg.Record, msg.Signers, msg.MsgTypeURL())
 
 	// Remove the old session if it doesn't have any records in it anymore.
 	// Note that the RemoveSession does the record checking part.
@@ -255,7 +481,7 @@ func (k msgServer) DeleteRecord(
 	msg *types.MsgDeleteRecordRequest,
 ) (*types.MsgDeleteRecordResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteRecord")
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := WithHistorySigners(sdk.UnwrapSDKContext(goCtx), msg.Signers)
 
 	existing, _ := k.GetRecord(ctx, msg.RecordId)
 	if err := k.ValidateRecordRemove(ctx, existing, msg.RecordId, msg.Signers, msg.MsgTypeURL()); err != nil {
@@ -268,6 +494,95 @@ func (k msgServer) DeleteRecord(
 	return types.NewMsgDeleteRecordResponse(), nil
 }
 
+func (k msgServer) ExecuteContractSession(
+	goCtx context.Context,
+	msg *types.MsgExecuteContractSessionRequest,
+) (*types.MsgExecuteContractSessionResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "ExecuteContractSession")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scopeIDInfo, sessionIDInfo, recordIDInfos, err := k.writeContractSession(goCtx, msg.Scope, msg.Session, msg.Records, msg.Signers)
+	if err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ExecuteContractSession, msg.GetSigners()))
+	return types.NewMsgExecuteContractSessionResponse(scopeIDInfo, sessionIDInfo, recordIDInfos), nil
+}
+
+// writeContractSession writes the scope, session, and records resulting from a contract execution.
+// If the scope already exists, its owners and value owner are kept, and the given data access is added to its own.
+func (k msgServer) writeContractSession(
+	goCtx context.Context,
+	scope types.Scope,
+	session types.Session,
+	records []types.ContractSessionRecord,
+	signers []string,
+) (*types.ScopeIdInfo, *types.SessionIdInfo, []*types.RecordIdInfo, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existingScope, found := k.GetScope(ctx, scope.ScopeId)
+	if found {
+		// We don't want to update the scope's owners or value owner.
+		scope.Owners = existingScope.Owners
+		scope.ValueOwnerAddress = existingScope.ValueOwnerAddress
+		// We only want to add to the data access list.
+		scope.DataAccess = k.UnionDistinct(existingScope.DataAccess, scope.DataAccess)
+	}
+
+	scopeResp, err := k.WriteScope(goCtx, &types.MsgWriteScopeRequest{
+		Scope:   scope,
+		Signers: signers,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	sessionResp, err := k.WriteSession(goCtx, &types.MsgWriteSessionRequest{
+		Session: session,
+		Signers: signers,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	recordIDInfos := make([]*types.RecordIdInfo, len(records))
+	for i, record := range records {
+		// The original output hashes (if any) are what the contract was executed against, so they must still be current.
+		recordResp, err := k.WriteRecord(goCtx, &types.MsgWriteRecordRequest{
+			Record:               record.Record,
+			Signers:              signers,
+			Parties:              session.Parties,
+			ExpectedOutputHashes: record.OriginalOutputHashes,
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		recordIDInfos[i] = recordResp.RecordIdInfo
+	}
+
+	return scopeResp.ScopeIdInfo, sessionResp.SessionIdInfo, recordIDInfos, nil
+}
+
+func (k msgServer) MigrateScopeSpec(
+	goCtx context.Context,
+	msg *types.MsgMigrateScopeSpecRequest,
+) (*types.MsgMigrateScopeSpecResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "MigrateScopeSpec")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateScopeSpecMigration(ctx, msg.ScopeId, msg.SpecificationId, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	scope, _ := k.GetScope(ctx, msg.ScopeId)
+	scope.SpecificationId = msg.SpecificationId
+	k.SetScope(WithHistorySigners(ctx, msg.Signers), scope)
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpec, msg.GetSigners()))
+	return types.NewMsgMigrateScopeSpecResponse(), nil
+}
+
 func (k msgServer) WriteScopeSpecification(
 	goCtx context.Context,
 	msg *types.MsgWriteScopeSpecificationRequest,
@@ -411,6 +726,9 @@ func (k msgServer) AddContractSpecToScopeSpec(
 	if err := k.ValidateAllOwnersAreSignersWithAuthz(ctx, scopeSpec.OwnerAddresses, msg.Signers, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
+	if err := k.validateScopeSpecChangeable(ctx, scopeSpec.SpecificationId); err != nil {
+		return nil, err
+	}
 
 	for _, cSpecID := range scopeSpec.ContractSpecIds {
 		if cSpecID.Equals(msg.ContractSpecificationId) {
@@ -443,6 +761,9 @@ func (k msgServer) DeleteContractSpecFromScopeSpec(
 	if err := k.ValidateAllOwnersAreSignersWithAuthz(ctx, scopeSpec.OwnerAddresses, msg.Signers, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
+	if err := k.validateScopeSpecChangeable(ctx, scopeSpec.SpecificationId); err != nil {
+		return nil, err
+	}
 
 	updateContractSpecIds := []types.MetadataAddress{}
 	found = false
@@ -535,6 +856,50 @@ func (k msgServer) DeleteRecordSpecification(
 	return types.NewMsgDeleteRecordSpecificationResponse(), nil
 }
 
+func (k msgServer) WriteRecordType(
+	goCtx context.Context,
+	msg *types.MsgWriteRecordTypeRequest,
+) (*types.MsgWriteRecordTypeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteRecordType")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var existing *types.RecordType
+	if e, found := k.GetRecordType(ctx, msg.RecordType.Name); found {
+		existing = &e
+	}
+	if err := k.ValidateRecordTypeUpdate(ctx, existing, msg.RecordType, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	k.SetRecordType(ctx, msg.RecordType)
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteRecordType, msg.GetSigners()))
+	return types.NewMsgWriteRecordTypeResponse(), nil
+}
+
+func (k msgServer) DeleteRecordType(
+	goCtx context.Context,
+	msg *types.MsgDeleteRecordTypeRequest,
+) (*types.MsgDeleteRecordTypeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteRecordType")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetRecordType(ctx, msg.Name)
+	if !found {
+		return nil, fmt.Errorf("record type %q not found", msg.Name)
+	}
+	if err := k.ValidateAllOwnersAreSignersWithAuthz(ctx, existing.OwnerAddresses, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveRecordType(ctx, msg.Name); err != nil {
+		return nil, fmt.Errorf("cannot delete record type %q: %w", msg.Name, err)
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_DeleteRecordType, msg.GetSigners()))
+	return types.NewMsgDeleteRecordTypeResponse(), nil
+}
+
 func (k msgServer) WriteP8EContractSpec(
 	goCtx context.Context,
 	msg *types.MsgWriteP8EContractSpecRequest,
@@ -542,6 +907,10 @@ func (k msgServer) WriteP8EContractSpec(
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteP8EContractSpec")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.validateP8eMessagesEnabled(ctx, msg.MsgTypeURL(), types.TypeURLMsgWriteContractSpecificationRequest); err != nil {
+		return nil, err
+	}
+
 	proposed, newrecords, err := types.ConvertP8eContractSpec(&msg.Contractspec, msg.Signers)
 	if err != nil {
 		return nil, err
@@ -586,52 +955,38 @@ func (k msgServer) P8EMemorializeContract(
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "P8EMemorializeContract")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.validateP8eMessagesEnabled(ctx, msg.MsgTypeURL(), types.TypeURLMsgExecuteContractSessionRequest); err != nil {
+		return nil, err
+	}
+
 	p8EData, err := types.ConvertP8eMemorializeContractRequest(msg)
 	if err != nil {
 		return nil, err
 	}
 
-	existingScope, found := k.GetScope(ctx, p8EData.Scope.ScopeId)
-	if found {
-		// We don't want to update the scope's owners or value owner.
-		p8EData.Scope.Owners = existingScope.Owners
-		p8EData.Scope.ValueOwnerAddress = existingScope.ValueOwnerAddress
-		// We only want to add to the data access list.
-		p8EData.Scope.DataAccess = k.UnionDistinct(existingScope.DataAccess, p8EData.Scope.DataAccess)
+	records := make([]types.ContractSessionRecord, len(p8EData.RecordReqs))
+	for i, recordReq := range p8EData.RecordReqs {
+		records[i] = types.ContractSessionRecord{
+			Record:               *recordReq.Record,
+			OriginalOutputHashes: recordReq.OriginalOutputHashes,
+		}
 	}
 
-	scopeResp, err := k.WriteScope(goCtx, &types.MsgWriteScopeRequest{
-		Scope:   *p8EData.Scope,
-		Signers: p8EData.Signers,
-	})
+	scopeIDInfo, sessionIDInfo, recordIDInfos, err := k.writeContractSession(goCtx, *p8EData.Scope, *p8EData.Session, records, p8EData.Signers)
 	if err != nil {
 		return nil, err
 	}
 
-	sessionResp, err := k.WriteSession(goCtx, &types.MsgWriteSessionRequest{
-		Session: *p8EData.Session,
-		Signers: p8EData.Signers,
-	})
-	if err != nil {
-		return nil, err
-	}
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_P8eMemorializeContract, msg.GetSigners()))
+	return types.NewMsgP8EMemorializeContractResponse(scopeIDInfo, sessionIDInfo, recordIDInfos), nil
+}
 
-	recordIDInfos := make([]*types.RecordIdInfo, len(p8EData.RecordReqs))
-	for i, recordReq := range p8EData.RecordReqs {
-		// TODO: If there is a OriginalOutputHashes value, get the existing record and make sure it's output is as expected.
-		recordResp, err := k.WriteRecord(goCtx, &types.MsgWriteRecordRequest{
-			Record:  *recordReq.Record,
-			Signers: p8EData.Signers,
-			Parties: p8EData.Session.Parties,
-		})
-		if err != nil {
-			return nil, err
-		}
-		recordIDInfos[i] = recordResp.RecordIdInfo
+// validateP8eMessagesEnabled returns an error if the p8e msgs have been turned off by the module params.
+func (k msgServer) validateP8eMessagesEnabled(ctx sdk.Context, msgTypeURL, replacementTypeURL string) error {
+	if k.GetDisableP8eMessages(ctx) {
+		return sdkerrors.Wrapf(types.ErrMsgDisabled, "%s is no longer accepted; use %s instead", msgTypeURL, replacementTypeURL)
 	}
-
-	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_P8eMemorializeContract, msg.GetSigners()))
-	return types.NewMsgP8EMemorializeContractResponse(scopeResp.ScopeIdInfo, sessionResp.SessionIdInfo, recordIDInfos), nil
+	return nil
 }
 
 func (k msgServer) BindOSLocator(
@@ -648,17 +1003,13 @@ func (k msgServer) BindOSLocator(
 
 	// already valid address, checked in ValidateBasic
 	ownerAddress, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
-	encryptionKey := sdk.AccAddress{}
-	if strings.TrimSpace(msg.Locator.EncryptionKey) != "" {
-		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
-	}
-	if k.Keeper.OSLocatorExists(ctx, ownerAddress) {
-		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner)
+	if k.Keeper.OSLocatorExists(ctx, ownerAddress, msg.Locator.Name) {
+		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrOSLocatorAlreadyBound.Error())
 	}
 
 	// Bind owner to URI
-	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.SetOSLocator(ctx, msg.Locator); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -682,18 +1033,18 @@ func (k msgServer) DeleteOSLocator(
 	// already valid address, checked in ValidateBasic
 	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
 
-	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+	if !k.Keeper.OSLocatorExists(ctx, ownerAddr, msg.Locator.Name) {
+		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrOSLocatorAlreadyBound.Error())
 	}
 
-	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
+	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr, msg.Locator.Name) {
 		ctx.Logger().Error("msg sender cannot delete os locator", "owner", ownerAddr)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot delete os locator.")
 	}
 
 	// Delete
-	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr); err != nil {
+	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr, msg.Locator.Name); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -716,22 +1067,18 @@ func (k msgServer) ModifyOSLocator(
 
 	// already valid address(es), checked in ValidateBasic
 	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
-	encryptionKey := sdk.AccAddress{}
-	if strings.TrimSpace(msg.Locator.EncryptionKey) != "" {
-		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
-	}
 
-	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+	if !k.Keeper.OSLocatorExists(ctx, ownerAddr, msg.Locator.Name) {
+		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, types.ErrOSLocatorAlreadyBound.Error())
 	}
 
-	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
+	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr, msg.Locator.Name) {
 		ctx.Logger().Error("msg sender cannot modify os locator", "owner", ownerAddr)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot delete os locator.")
 	}
 	// Modify
-	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.ModifyOSLocator(ctx, msg.Locator); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -2,15 +2,16 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
-// GetOsLocatorRecord Gets the object store locator entry from the kvstore for the given owner address.
-func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (osLocator types.ObjectStoreLocator, found bool) {
-	key := types.GetOSLocatorKey(ownerAddr)
+// GetOsLocatorRecord Gets the object store locator entry from the kvstore for the given owner address and locator name.
+func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) (osLocator types.ObjectStoreLocator, found bool) {
+	key := types.GetOSLocatorKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(key)
 	if b == nil {
@@ -24,42 +25,85 @@ func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (o
 	return osLocator, true
 }
 
-// OSLocatorExists checks if the provided bech32 owner address has a OSL entry in the kvstore.
-func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool {
-	key := types.GetOSLocatorKey(ownerAddr)
+// GetOSLocatorsByOwner gets all of the object store locator entries for the given owner address, ordered by priority.
+func (k Keeper) GetOSLocatorsByOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) ([]types.ObjectStoreLocator, error) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.GetOSLocatorIteratorPrefix(ownerAddr))
+	defer it.Close()
+	locators := []types.ObjectStoreLocator{}
+	for ; it.Valid(); it.Next() {
+		record := types.ObjectStoreLocator{}
+		if err := k.cdc.Unmarshal(it.Value(), &record); err != nil {
+			return nil, err
+		}
+		locators = append(locators, record)
+	}
+	sortOSLocators(locators)
+	return locators, nil
+}
+
+// sortOSLocators sorts locators by priority (lowest first), then by name.
+func sortOSLocators(locators []types.ObjectStoreLocator) {
+	sort.SliceStable(locators, func(i, j int) bool {
+		if locators[i].Priority != locators[j].Priority {
+			return locators[i].Priority < locators[j].Priority
+		}
+		return locators[i].Name < locators[j].Name
+	})
+}
+
+// OSLocatorExists checks if the provided bech32 owner address has a OSL entry with the given name in the kvstore.
+func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) bool {
+	key := types.GetOSLocatorKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(key)
 }
 
 // SetOSLocator binds an OS Locator to an address in the kvstore.
 // An error is returned if no account exists for the address.
-// An error is returned if an OS Locator already exists for the address.
-func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	urlToPersist, err := k.checkValidURI(uri, ctx)
+// An error is returned if an OS Locator with the same name already exists for the address.
+func (k Keeper) SetOSLocator(ctx sdk.Context, locator types.ObjectStoreLocator) error {
+	urlToPersist, err := k.checkValidURI(locator.LocatorUri, ctx)
 	if err != nil {
 		return err
 	}
+	ownerAddr, err := sdk.AccAddressFromBech32(locator.Owner)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
 	if account := k.authKeeper.GetAccount(ctx, ownerAddr); account == nil {
 		return types.ErrInvalidAddress
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
 	store := ctx.KVStore(k.storeKey)
-	if store.Has(key) {
+	if store.Has(types.GetOSLocatorKey(ownerAddr, locator.Name)) {
 		return types.ErrOSLocatorAlreadyBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
-	bz, err := k.cdc.Marshal(&record)
-	if err != nil {
+	locator.LocatorUri = urlToPersist.String()
+	if err = k.writeOSLocator(store, ownerAddr, locator, nil); err != nil {
 		return err
 	}
-	store.Set(key, bz)
 
-	k.EmitEvent(ctx, types.NewEventOSLocatorCreated(record.Owner))
+	k.EmitEvent(ctx, types.NewEventOSLocatorCreated(locator.Owner))
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Created)
 	return nil
 }
 
+// writeOSLocator stores the locator and updates the uri index.
+// The existing locator should be nil if a locator with the same owner and name isn't already stored.
+func (k Keeper) writeOSLocator(store sdk.KVStore, ownerAddr sdk.AccAddress, locator types.ObjectStoreLocator, existing *types.ObjectStoreLocator) error {
+	bz, err := k.cdc.Marshal(&locator)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		store.Delete(types.GetOSLocatorURICacheKey(existing.LocatorUri, ownerAddr, existing.Name))
+	}
+	store.Set(types.GetOSLocatorKey(ownerAddr, locator.Name), bz)
+	store.Set(types.GetOSLocatorURICacheKey(locator.LocatorUri, ownerAddr, locator.Name), []byte{0x01})
+	return nil
+}
+
 // IterateOSLocators runs a function for every ObjectStoreLocator entry in the kvstore.
 func (k Keeper) IterateOSLocators(ctx sdk.Context, cb func(account types.ObjectStoreLocator) (stop bool)) error {
 	store := ctx.KVStore(k.storeKey)
@@ -80,7 +124,41 @@ func (k Keeper) IterateOSLocators(ctx sdk.Context, cb func(account types.ObjectS
 	return nil
 }
 
+// IterateOSLocatorsByURI runs a function for every ObjectStoreLocator entry with the given uri.
+func (k Keeper) IterateOSLocatorsByURI(ctx sdk.Context, uri string, cb func(locator types.ObjectStoreLocator) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetOSLocatorURICacheIteratorPrefix(uri)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		record, err := k.getOSLocatorFromURICacheKey(store, it.Key()[len(prefix):])
+		if err != nil {
+			return err
+		}
+		if cb(record) {
+			break
+		}
+	}
+	return nil
+}
+
+// getOSLocatorFromURICacheKey gets the locator that a uri cache entry points to.
+// The keySuffix is the part of the cache key after the uri hash.
+func (k Keeper) getOSLocatorFromURICacheKey(store sdk.KVStore, keySuffix []byte) (types.ObjectStoreLocator, error) {
+	record := types.ObjectStoreLocator{}
+	bz := store.Get(append(types.OSLocatorAddressKeyPrefix, keySuffix...))
+	if bz == nil {
+		return record, fmt.Errorf("object store locator not found for uri index entry %X", keySuffix)
+	}
+	err := k.cdc.Unmarshal(bz, &record)
+	return record, err
+}
+
 // GetOSLocatorByScope gets all Object Store Locators associated with a scope.
+// These are the locators of the scope's owners and effective data access parties (in that order), excluding those
+// that are limited to other scope specifications. Each party's locators are ordered by priority.
 func (k Keeper) GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.ObjectStoreLocator, error) {
 	scopeAddr, err := ParseScopeID(scopeID)
 	if err != nil {
@@ -92,82 +170,91 @@ func (k Keeper) GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.Ob
 		return []types.ObjectStoreLocator{}, fmt.Errorf("scope [%s] not found", scopeID)
 	}
 
-	// should always have valid owners, hence creating it with capacity
-	signers := make([]sdk.AccAddress, len(scope.Owners))
+	effectiveDataAccess := k.GetEffectiveDataAccess(ctx, scope)
+	dataAccess := make([]string, len(effectiveDataAccess))
+	for i, grant := range effectiveDataAccess {
+		dataAccess[i] = grant.Address
+	}
+	parties := make([]string, 0, len(scope.Owners)+len(dataAccess))
+	for _, p := range scope.Owners {
+		parties = append(parties, p.Address)
+	}
+	parties = k.UnionDistinct(parties, dataAccess)
 
-	for i, p := range scope.Owners {
-		addr, err := sdk.AccAddressFromBech32(p.Address)
+	// may not have object locators defined for all parties
+	locators := make([]types.ObjectStoreLocator, 0, len(parties))
+	for _, party := range parties {
+		addr, err := sdk.AccAddressFromBech32(party)
 		if err != nil {
 			panic(err)
 		}
-		signers[i] = addr
-	}
-
-	// may not have object locators defined for all owners
-	locators := make([]types.ObjectStoreLocator, 0, len(signers))
-	for _, addr := range signers {
-		loc, found := k.GetOsLocatorRecord(ctx, addr)
-		if !found {
-			continue
+		partyLocators, err := k.GetOSLocatorsByOwner(ctx, addr)
+		if err != nil {
+			return []types.ObjectStoreLocator{}, err
+		}
+		for _, loc := range partyLocators {
+			if loc.AppliesToScopeSpec(scope.SpecificationId) {
+				locators = append(locators, loc)
+			}
 		}
-		locators = append(locators, loc)
 	}
 	return locators, nil
 }
 
 // RemoveOSLocator removes an os locator record from the kvstore.
-func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error {
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
+func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) error {
+	existing, found := k.GetOsLocatorRecord(ctx, ownerAddr, name)
+	if !found {
 		return types.ErrAddressNotBound
 	}
-	store.Delete(key)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOSLocatorKey(ownerAddr, name))
+	store.Delete(types.GetOSLocatorURICacheKey(existing.LocatorUri, ownerAddr, name))
 	k.EmitEvent(ctx, types.NewEventOSLocatorDeleted(ownerAddr.String()))
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Deleted)
 	return nil
 }
 
 // ModifyOSLocator updates an existing os locator entry in the kvstore, returns an error if it doesn't exist.
-func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	urlToPersist, err := k.checkValidURI(uri, ctx)
+func (k Keeper) ModifyOSLocator(ctx sdk.Context, locator types.ObjectStoreLocator) error {
+	urlToPersist, err := k.checkValidURI(locator.LocatorUri, ctx)
 	if err != nil {
 		return err
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
+	ownerAddr, err := sdk.AccAddressFromBech32(locator.Owner)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
+	existing, found := k.GetOsLocatorRecord(ctx, ownerAddr, locator.Name)
+	if !found {
 		return types.ErrAddressNotBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
-	bz, err := k.cdc.Marshal(&record)
-	if err != nil {
+	locator.LocatorUri = urlToPersist.String()
+	if err = k.writeOSLocator(ctx.KVStore(k.storeKey), ownerAddr, locator, &existing); err != nil {
 		return err
 	}
-	store.Set(key, bz)
-	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(locator.Owner))
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Updated)
 	return nil
 }
 
-// ImportOSLocatorRecord binds a name to an address in the kvstore.
+// ImportOSLocatorRecord binds a locator to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
 // This also does not emit any events.
-func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	key := types.GetOSLocatorKey(ownerAddr)
+func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, locator types.ObjectStoreLocator) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(locator.Owner)
+	if err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
-	if store.Has(key) {
+	if store.Has(types.GetOSLocatorKey(ownerAddr, locator.Name)) {
 		return types.ErrOSLocatorAlreadyBound
 	}
-
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri)
-	bz, err := k.cdc.Marshal(&record)
-	if err != nil {
+	if err = k.writeOSLocator(store, ownerAddr, locator, nil); err != nil {
 		return err
 	}
-	store.Set(key, bz)
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Created)
 	return nil
 }
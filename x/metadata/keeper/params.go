@@ -2,14 +2,128 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
 // GetParams returns the total set of metadata parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	return types.Params{} // there are currently no params so no further action required here.
+	return types.Params{
+		HistoryRetentionBlocks:       k.GetHistoryRetentionBlocks(ctx),
+		RecordTypeRegistrars:         k.GetRecordTypeRegistrars(ctx),
+		RequireRegisteredRecordTypes: k.GetRequireRegisteredRecordTypes(ctx),
+		MaxScopeOwners:               k.GetMaxScopeOwners(ctx),
+		MaxScopeDataAccess:           k.GetMaxScopeDataAccess(ctx),
+		MaxScopeRecords:              k.GetMaxScopeRecords(ctx),
+		MaxRecordInputs:              k.GetMaxRecordInputs(ctx),
+		MaxRecordOutputs:             k.GetMaxRecordOutputs(ctx),
+		MaxSessionParties:            k.GetMaxSessionParties(ctx),
+		MaxSessionContextBytes:       k.GetMaxSessionContextBytes(ctx),
+		EnforceResponsibleParties:    k.GetEnforceResponsibleParties(ctx),
+		DisableP8EMessages:           k.GetDisableP8eMessages(ctx),
+	}
 }
 
 // SetParams sets the metadata parameters to the param space.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {} // currently no params are supported
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetHistoryRetentionBlocks gets the configured number of blocks to retain history for (or the default if unset)
+func (k Keeper) GetHistoryRetentionBlocks(ctx sdk.Context) (blocks uint64) {
+	blocks = types.DefaultHistoryRetentionBlocks
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyHistoryRetentionBlocks) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyHistoryRetentionBlocks, &blocks)
+	}
+	return
+}
+
+// GetRecordTypeRegistrars gets the accounts allowed to register new record types (or the default if unset)
+func (k Keeper) GetRecordTypeRegistrars(ctx sdk.Context) (registrars []string) {
+	registrars = types.DefaultRecordTypeRegistrars
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyRecordTypeRegistrars) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyRecordTypeRegistrars, &registrars)
+	}
+	return
+}
+
+// GetRequireRegisteredRecordTypes gets whether specifications must use registered record types (or the default if unset)
+func (k Keeper) GetRequireRegisteredRecordTypes(ctx sdk.Context) (required bool) {
+	required = types.DefaultRequireRegisteredRecordTypes
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyRequireRegisteredRecordTypes) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyRequireRegisteredRecordTypes, &required)
+	}
+	return
+}
+
+// GetMaxScopeOwners gets the maximum number of owners a scope can have (or the default if unset)
+func (k Keeper) GetMaxScopeOwners(ctx sdk.Context) uint32 {
+	return k.getLimitParam(ctx, types.ParamStoreKeyMaxScopeOwners, types.DefaultMaxScopeOwners)
+}
+
+// GetMaxScopeDataAccess gets the maximum number of data access addresses a scope can have (or the default if unset)
+func (k Keeper) GetMaxScopeDataAccess(ctx sdk.Context) uint32 {
+	return k.getLimitParam(ctx, types.ParamStoreKeyMaxScopeDataAccess, types.DefaultMaxScopeDataAccess)
+}
+
+// GetMaxScopeRecords gets the maximum number of records a scope can have (or the default if unset)
+func (k Keeper) GetMaxScopeRecords(ctx sdk.Context) uint32 {
+	return k.getLimitParam(ctx, types.ParamStoreKeyMaxScopeRecords, types.DefaultMaxScopeRecords)
+}
+
+// GetMaxRecordInputs gets the maximum number of inputs a record can have (or the default if unset)
+func (k Keeper) GetMaxRecordInputs(ctx sdk.Context) uint32 {
+	return k.getLimitParam(ctx, types.ParamStoreKeyMaxRecordInputs, types.DefaultMaxRecordInputs)
+}
+
+// GetMaxRecordOutputs gets the maximum number of outputs a record can have (or the default if unset)
+func (k Keeper) GetMaxRecordOutputs(ctx sdk.Context) uint32 {
+	return k.getLimitParam(ctx, types.ParamStoreKeyMaxRecordOutputs, types.DefaultMaxRecordOutputs)
+}
+
+// GetMaxSessionParties gets the maximum number of parties a session can have (or the default if unset)
+func (k Keeper) GetMaxSessionParties(ctx sdk.Context) uint32 {
+	return k.getLimitParam(ctx, types.ParamStoreKeyMaxSessionParties, types.DefaultMaxSessionParties)
+}
+
+// GetMaxSessionContextBytes gets the maximum length of a session's context (or the default if unset)
+func (k Keeper) GetMaxSessionContextBytes(ctx sdk.Context) uint32 {
+	return k.getLimitParam(ctx, types.ParamStoreKeyMaxSessionContextBytes, types.DefaultMaxSessionContextBytes)
+}
+
+// GetEnforceResponsibleParties gets whether records must be signed by their responsible parties (or the default if unset)
+func (k Keeper) GetEnforceResponsibleParties(ctx sdk.Context) (enforce bool) {
+	enforce = types.DefaultEnforceResponsibleParties
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyEnforceResponsibleParties) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyEnforceResponsibleParties, &enforce)
+	}
+	return
+}
+
+// GetDisableP8eMessages gets whether the deprecated p8e msgs are rejected (or the default if unset)
+func (k Keeper) GetDisableP8eMessages(ctx sdk.Context) (disabled bool) {
+	disabled = types.DefaultDisableP8eMessages
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyDisableP8eMessages) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyDisableP8eMessages, &disabled)
+	}
+	return
+}
+
+func (k Keeper) getLimitParam(ctx sdk.Context, key []byte, defaultValue uint32) (limit uint32) {
+	limit = defaultValue
+	if k.paramSpace.Has(ctx, key) {
+		k.paramSpace.Get(ctx, key, &limit)
+	}
+	return
+}
+
+// validateLimit returns an error if the proposed count is over the limit and more than the existing count.
+// Entries that were already over a limit (e.g. from before the limit was lowered) can still be changed,
+// as long as the change doesn't make them bigger.
+func validateLimit(what string, limit uint32, existingCount, proposedCount int) error {
+	if limit == 0 || proposedCount <= int(limit) || proposedCount <= existingCount {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrLimitExceeded, "%s %d is more than the maximum %d", what, proposedCount, limit)
+}
@@ -158,7 +158,7 @@ func queryOSGet(ctx sdk.Context, path []string, keeper Keeper, legacyQuerierCdc
 	if err != nil {
 		return nil, types.ErrInvalidAddress
 	}
-	msgs, _ := keeper.GetOsLocatorRecord(ctx, accAddr)
+	msgs, _ := keeper.GetOsLocatorRecord(ctx, accAddr, "")
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, msgs)
 	if err != nil {
@@ -173,13 +173,10 @@ func queryOSGetByURI(ctx sdk.Context, path []string, keeper Keeper, legacyQuerie
 	var records []types.ObjectStoreLocator
 
 	appendToRecords := func(record types.ObjectStoreLocator) bool {
-		if record.LocatorUri == path[1] {
-			records = append(records, record)
-			// have to get all the uri associated with an address..imo..check
-		}
+		records = append(records, record)
 		return false
 	}
-	if err := keeper.IterateOSLocators(ctx, appendToRecords); err != nil {
+	if err := keeper.IterateOSLocatorsByURI(ctx, path[1], appendToRecords); err != nil {
 		return nil, err
 	}
 	uniqueRecords := uniqueRecords(records)
@@ -5,6 +5,7 @@ import (
 	b64 "encoding/base64"
 	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"time"
 
@@ -28,8 +29,7 @@ var _ types.QueryServer = Keeper{}
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Params")
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params, Request: req}, nil
 }
@@ -93,7 +93,12 @@ func (k Keeper) Scope(c context.Context, req *types.ScopeRequest) (*types.ScopeR
 	ctx := sdk.UnwrapSDKContext(c)
 	scope, found := k.GetScope(ctx, scopeAddr)
 	if found {
-		retval.Scope = types.WrapScope(&scope)
+		retval.Scope = k.wrapScope(ctx, &scope)
+		if req.IncludeAttributes {
+			if err := k.addScopeAttributes(ctx, retval.Scope); err != nil {
+				return &retval, status.Error(codes.Unavailable, err.Error())
+			}
+		}
 	} else {
 		retval.Scope = types.WrapScopeNotFound(scopeAddr)
 	}
@@ -152,7 +157,7 @@ func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*type
 		var scope types.Scope
 		vErr := scope.Unmarshal(value)
 		if vErr == nil {
-			retval.Scopes = append(retval.Scopes, types.WrapScope(&scope))
+			retval.Scopes = append(retval.Scopes, k.wrapScope(ctx, &scope))
 			return nil
 		}
 		// Something's wrong. Let's do what we can to give indications of it.
@@ -187,6 +192,11 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	filter, err := newSessionFilter(req.PartyAddress, req.PartyRole, req.UpdatedAfter, req.UpdatedBefore)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var scopeAddr, sessionAddr, recordAddr types.MetadataAddress
 
 	if len(req.ScopeId) > 0 {
@@ -269,14 +279,17 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 	switch {
 	case !sessionAddr.Empty():
 		session, found := k.GetSession(ctx, sessionAddr)
-		if found {
-			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
-		} else {
+		switch {
+		case !found:
 			retval.Sessions = append(retval.Sessions, types.WrapSessionNotFound(sessionAddr))
+		case filter.matches(session):
+			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
 		}
 	case !scopeAddr.Empty():
 		itErr := k.IterateSessions(ctx, scopeAddr, func(s types.Session) (stop bool) {
-			retval.Sessions = append(retval.Sessions, types.WrapSession(&s))
+			if filter.matches(s) {
+				retval.Sessions = append(retval.Sessions, types.WrapSession(&s))
+			}
 			return false
 		})
 		if itErr != nil {
@@ -289,7 +302,7 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 	if req.IncludeScope {
 		scope, found := k.GetScope(ctx, scopeAddr)
 		if found {
-			retval.Scope = types.WrapScope(&scope)
+			retval.Scope = k.wrapScope(ctx, &scope)
 		} else {
 			retval.Scope = types.WrapScopeNotFound(scopeAddr)
 		}
@@ -330,18 +343,35 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "SessionsAll")
 	retval := types.SessionsAllResponse{Request: req}
 
+	filter := &sessionFilter{}
+	if req != nil {
+		var fErr error
+		filter, fErr = newSessionFilter(req.PartyAddress, req.PartyRole, req.UpdatedAfter, req.UpdatedBefore)
+		if fErr != nil {
+			return &retval, status.Error(codes.InvalidArgument, fErr.Error())
+		}
+	}
+
 	pageRequest := getPageRequest(req)
 
 	ctx := sdk.UnwrapSDKContext(c)
 	kvStore := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(kvStore, types.SessionKeyPrefix)
 
-	pageRes, err := query.Paginate(prefixStore, pageRequest, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(prefixStore, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
 		var session types.Session
 		vErr := session.Unmarshal(value)
 		if vErr == nil {
-			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
-			return nil
+			if !filter.matches(session) {
+				return false, nil
+			}
+			if accumulate {
+				retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
+			}
+			return true, nil
+		}
+		if !accumulate {
+			return true, nil
 		}
 		// Something's wrong. Let's do what we can to give indications of it.
 		var addr types.MetadataAddress
@@ -355,7 +385,7 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 				"key error", kErr, "value error", vErr, "key (base64)", k64)
 			retval.Sessions = append(retval.Sessions, &types.SessionWrapper{})
 		}
-		return nil // Still want to move on to the next.
+		return true, nil // Still want to move on to the next.
 	})
 	if err != nil {
 		return &retval, status.Error(codes.Unavailable, err.Error())
@@ -374,6 +404,11 @@ func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.Re
 	retval := types.RecordsResponse{Request: req}
 	ctx := sdk.UnwrapSDKContext(c)
 
+	filter, err := k.newRecordFilter(ctx, req.PartyAddress, req.PartyRole, req.OutputStatus, req.InputStatus)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var scopeAddr, sessionAddr, recordAddr types.MetadataAddress
 
 	if len(req.ScopeId) > 0 {
@@ -435,10 +470,11 @@ func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.Re
 	switch {
 	case !recordAddr.Empty():
 		record, found := k.GetRecord(ctx, recordAddr)
-		if found {
-			retval.Records = append(retval.Records, types.WrapRecord(&record))
-		} else {
+		switch {
+		case !found:
 			retval.Records = append(retval.Records, types.WrapRecordNotFound(recordAddr))
+		case filter.matches(record):
+			retval.Records = append(retval.Records, types.WrapRecord(&record))
 		}
 	case !scopeAddr.Empty():
 		var records []*types.Record
@@ -452,7 +488,7 @@ func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.Re
 		if len(records) > 0 {
 			haveSessionAddr := !sessionAddr.Empty()
 			for _, r := range records {
-				if !haveSessionAddr || sessionAddr.Equals(r.SessionId) {
+				if (!haveSessionAddr || sessionAddr.Equals(r.SessionId)) && filter.matches(*r) {
 					retval.Records = append(retval.Records, types.WrapRecord(r))
 				}
 			}
@@ -464,7 +500,7 @@ func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.Re
 	if req.IncludeScope {
 		scope, found := k.GetScope(ctx, scopeAddr)
 		if found {
-			retval.Scope = types.WrapScope(&scope)
+			retval.Scope = k.wrapScope(ctx, &scope)
 		} else {
 			retval.Scope = types.WrapScopeNotFound(scopeAddr)
 		}
@@ -506,18 +542,36 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordsAll")
 	retval := types.RecordsAllResponse{Request: req}
 
+	ctx := sdk.UnwrapSDKContext(c)
+
+	filter := &recordFilter{}
+	if req != nil {
+		var fErr error
+		filter, fErr = k.newRecordFilter(ctx, req.PartyAddress, req.PartyRole, req.OutputStatus, req.InputStatus)
+		if fErr != nil {
+			return &retval, status.Error(codes.InvalidArgument, fErr.Error())
+		}
+	}
+
 	pageRequest := getPageRequest(req)
 
-	ctx := sdk.UnwrapSDKContext(c)
 	kvStore := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(kvStore, types.RecordKeyPrefix)
 
-	pageRes, err := query.Paginate(prefixStore, pageRequest, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(prefixStore, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
 		var record types.Record
 		vErr := record.Unmarshal(value)
 		if vErr == nil {
-			retval.Records = append(retval.Records, types.WrapRecord(&record))
-			return nil
+			if !filter.matches(record) {
+				return false, nil
+			}
+			if accumulate {
+				retval.Records = append(retval.Records, types.WrapRecord(&record))
+			}
+			return true, nil
+		}
+		if !accumulate {
+			return true, nil
 		}
 		// Something's wrong. Let's do what we can to give indications of it.
 		var addr types.MetadataAddress
@@ -531,7 +585,49 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 				"key error", kErr, "value error", vErr, "key (base64)", k64)
 			retval.Records = append(retval.Records, &types.RecordWrapper{})
 		}
-		return nil // Still want to move on to the next.
+		return true, nil // Still want to move on to the next.
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// RecordsMissingResponsibleParties returns the records that were last written without all of their responsible parties.
+func (k Keeper) RecordsMissingResponsibleParties(
+	c context.Context,
+	req *types.RecordsMissingResponsiblePartiesRequest,
+) (*types.RecordsMissingResponsiblePartiesResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordsMissingResponsibleParties")
+	retval := types.RecordsMissingResponsiblePartiesResponse{Request: req}
+
+	keyPrefix := types.MissingResponsiblePartiesKeyPrefix
+	if req != nil && len(req.ScopeId) > 0 {
+		scopeAddr, err := ParseScopeID(req.ScopeId)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keyPrefix, err = types.GetMissingResponsiblePartiesIteratorPrefix(scopeAddr)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	pageRequest := getPageRequest(req)
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(kvStore, keyPrefix)
+
+	pageRes, err := query.Paginate(prefixStore, pageRequest, func(key, value []byte) error {
+		var entry types.MissingResponsibleParties
+		if vErr := entry.Unmarshal(value); vErr != nil {
+			k.Logger(ctx).Error("failed to unmarshal missing responsible parties", "key", key, "error", vErr)
+			return nil // Still want to move on to the next.
+		}
+		retval.Entries = append(retval.Entries, entry)
+		return nil
 	})
 	if err != nil {
 		return &retval, status.Error(codes.Unavailable, err.Error())
@@ -562,17 +658,22 @@ func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*type
 	store := ctx.KVStore(k.storeKey)
 	scopeStore := prefix.NewStore(store, types.GetAddressScopeCacheIteratorPrefix(addr))
 
-	pageRes, err := query.Paginate(scopeStore, req.Pagination, func(key, _ []byte) error {
+	pageRes, err := query.FilteredPaginate(scopeStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
 		var ma types.MetadataAddress
 		if mErr := ma.Unmarshal(key); mErr != nil {
-			return mErr
+			return false, mErr
 		}
-		scopeUUID, sErr := ma.ScopeUUID()
-		if sErr != nil {
-			return sErr
+		if req.Role != types.PartyType_PARTY_TYPE_UNSPECIFIED && !k.scopeHasOwnerWithRole(ctx, ma, req.Address, req.Role) {
+			return false, nil
 		}
-		retval.ScopeUuids = append(retval.ScopeUuids, scopeUUID.String())
-		return nil
+		if accumulate {
+			scopeUUID, sErr := ma.ScopeUUID()
+			if sErr != nil {
+				return false, sErr
+			}
+			retval.ScopeUuids = append(retval.ScopeUuids, scopeUUID.String())
+		}
+		return true, nil
 	})
 	if err != nil {
 		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
@@ -582,7 +683,25 @@ func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*type
 	return &retval, nil
 }
 
-// ValueOwnership returns a list of scope identifiers that list the given address as a value owner.
+// scopeHasOwnerWithRole returns true if the scope with the given id has an owner with the given address and role.
+func (k Keeper) scopeHasOwnerWithRole(ctx sdk.Context, scopeID types.MetadataAddress, address string, role types.PartyType) bool {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return false
+	}
+	for _, owner := range scope.Owners {
+		if owner.Address == address && owner.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ValueOwnership returns a list of scope identifiers that list the given address as a value owner,
+// followed by the tokenized scopes whose marker coin is held by the given address.
+//
+// The page key is either a scope id from the value owner index, or a scope token denom once those are done.
+// A scope id always starts with a 0x00 byte, and a scope token denom never does, so they can't be confused.
 func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipRequest) (*types.ValueOwnershipResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ValueOwnership")
 	if req == nil {
@@ -600,9 +719,108 @@ func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipReque
 		return &retval, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) > 0 {
+		return &retval, status.Error(codes.InvalidArgument, "paginate: invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return &retval, status.Error(codes.InvalidArgument, "paginate: reverse is not supported")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	scopeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetValueOwnerScopeCacheIteratorPrefix(addr))
+	addScopeID := func(scopeID types.MetadataAddress) error {
+		scopeUUID, sErr := scopeID.ScopeUUID()
+		if sErr != nil {
+			return sErr
+		}
+		retval.ScopeUuids = append(retval.ScopeUuids, scopeUUID.String())
+		return nil
+	}
+
+	retval.Pagination = &query.PageResponse{}
+	skip := pageReq.Offset
+	startDenom := ""
+	if len(pageReq.Key) == 0 || pageReq.Key[0] == types.ScopeKeyPrefix[0] {
+		// First, the scopes that list the address as the value owner.
+		it := scopeStore.Iterator(pageReq.Key, nil)
+		for ; it.Valid() && len(retval.Pagination.NextKey) == 0; it.Next() {
+			switch {
+			case skip > 0:
+				skip--
+			case uint64(len(retval.ScopeUuids)) == limit:
+				retval.Pagination.NextKey = it.Key()
+			default:
+				if err = addScopeID(it.Key()); err != nil {
+					it.Close()
+					return &retval, status.Errorf(codes.Internal, "invalid scope id: %v", err)
+				}
+			}
+		}
+		it.Close()
+	} else {
+		startDenom = string(pageReq.Key)
+	}
+
+	if len(retval.Pagination.NextKey) == 0 {
+		// Then, the tokenized scopes whose marker coin is held by the address.
+		scopeIDs, nextDenom, tErr := k.GetTokenizedScopesHeldBy(ctx, addr, startDenom, skip, limit-uint64(len(retval.ScopeUuids)))
+		if tErr != nil {
+			return &retval, status.Errorf(codes.Internal, "could not get tokenized scopes: %v", tErr)
+		}
+		for _, scopeID := range scopeIDs {
+			if err = addScopeID(scopeID); err != nil {
+				return &retval, status.Errorf(codes.Internal, "invalid tokenized scope id: %v", err)
+			}
+		}
+		if len(nextDenom) > 0 {
+			retval.Pagination.NextKey = []byte(nextDenom)
+		}
+	}
+
+	if countTotal && len(pageReq.Key) == 0 {
+		it := scopeStore.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			retval.Pagination.Total++
+		}
+		it.Close()
+		tokenized, _, tErr := k.GetTokenizedScopesHeldBy(ctx, addr, "", 0, math.MaxUint64-1)
+		if tErr != nil {
+			return &retval, status.Errorf(codes.Internal, "could not get tokenized scopes: %v", tErr)
+		}
+		retval.Pagination.Total += uint64(len(tokenized))
+	}
+	return &retval, nil
+}
+
+// AccessibleScopes returns a list of scope identifiers that list the given address in their data access list.
+func (k Keeper) AccessibleScopes(c context.Context, req *types.AccessibleScopesRequest) (*types.AccessibleScopesResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "AccessibleScopes")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.AccessibleScopesResponse{Request: req}
+
+	if req.Address == "" {
+		return &retval, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	store := ctx.KVStore(k.storeKey)
-	scopeStore := prefix.NewStore(store, types.GetValueOwnerScopeCacheIteratorPrefix(addr))
+	scopeStore := prefix.NewStore(store, types.GetDataAccessScopeCacheIteratorPrefix(addr))
 
 	pageRes, err := query.Paginate(scopeStore, req.Pagination, func(key, _ []byte) error {
 		var ma types.MetadataAddress
@@ -623,6 +841,245 @@ func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipReque
 	return &retval, nil
 }
 
+// ValueOwnerTransferOffers returns the open offers to transfer a scope's value ownership.
+func (k Keeper) ValueOwnerTransferOffers(c context.Context, req *types.ValueOwnerTransferOffersRequest) (*types.ValueOwnerTransferOffersResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ValueOwnerTransferOffers")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ValueOwnerTransferOffersResponse{Request: req}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(kvStore, types.GetValueOwnerTransferOfferIteratorPrefix(scopeAddr))
+
+	pageRes, err := query.FilteredPaginate(prefixStore, getPageRequest(req), func(_, value []byte, accumulate bool) (bool, error) {
+		var offer types.ValueOwnerTransferOffer
+		if vErr := k.cdc.Unmarshal(value, &offer); vErr != nil {
+			return false, vErr
+		}
+		if offer.IsExpired(ctx.BlockTime()) {
+			return false, nil
+		}
+		if accumulate {
+			retval.Offers = append(retval.Offers, offer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// ValueOwnerTransferOffersByAddress returns the open value owner transfer offers that an address is the seller or
+// buyer in.
+func (k Keeper) ValueOwnerTransferOffersByAddress(
+	c context.Context,
+	req *types.ValueOwnerTransferOffersByAddressRequest,
+) (*types.ValueOwnerTransferOffersByAddressResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ValueOwnerTransferOffersByAddress")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ValueOwnerTransferOffersByAddressResponse{Request: req}
+
+	if req.Address == "" {
+		return &retval, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(kvStore, types.GetAddressValueOwnerTransferOfferCacheIteratorPrefix(addr))
+
+	pageRes, err := query.FilteredPaginate(prefixStore, getPageRequest(req), func(key, _ []byte, accumulate bool) (bool, error) {
+		// The rest of the cache key is the offer's key without its prefix.
+		b := kvStore.Get(append(types.ValueOwnerTransferOfferKeyPrefix, key...))
+		if b == nil {
+			return false, nil
+		}
+		var offer types.ValueOwnerTransferOffer
+		if vErr := k.cdc.Unmarshal(b, &offer); vErr != nil {
+			return false, vErr
+		}
+		if offer.IsExpired(ctx.BlockTime()) {
+			return false, nil
+		}
+		if accumulate {
+			retval.Offers = append(retval.Offers, offer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// ScopeHistory returns the history entries for a scope.
+func (k Keeper) ScopeHistory(c context.Context, req *types.ScopeHistoryRequest) (*types.ScopeHistoryResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeHistory")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopeHistoryResponse{Request: req}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(kvStore, types.GetHistoryEntryIteratorPrefix(scopeAddr))
+
+	pageRes, err := query.Paginate(prefixStore, getPageRequest(req), func(_, value []byte) error {
+		var entry types.HistoryEntry
+		if vErr := k.cdc.Unmarshal(value, &entry); vErr != nil {
+			return vErr
+		}
+		retval.Entries = append(retval.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// ScopeRecordsAtHeight returns the records of a scope as they were at a given height.
+func (k Keeper) ScopeRecordsAtHeight(c context.Context, req *types.ScopeRecordsAtHeightRequest) (*types.ScopeRecordsAtHeightResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeRecordsAtHeight")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopeRecordsAtHeightResponse{Request: req}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Height < 0 {
+		return &retval, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records, err := k.GetScopeRecordsAtHeight(ctx, scopeAddr, req.Height)
+	if err != nil {
+		return &retval, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	for _, record := range records {
+		retval.Records = append(retval.Records, types.WrapRecord(record))
+	}
+	return &retval, nil
+}
+
+// SessionsBySpec returns the sessions created using a contract specification.
+func (k Keeper) SessionsBySpec(c context.Context, req *types.SessionsBySpecRequest) (*types.SessionsBySpecResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "SessionsBySpec")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.SessionsBySpecResponse{Request: req}
+
+	if len(req.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+	contractSpecAddr, err := ParseContractSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid input: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	sessionStore := prefix.NewStore(store, types.GetContractSpecSessionCacheIteratorPrefix(contractSpecAddr))
+
+	pageRes, err := query.Paginate(sessionStore, req.Pagination, func(key, _ []byte) error {
+		var sessionAddr types.MetadataAddress
+		if mErr := sessionAddr.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		if session, found := k.GetSession(ctx, sessionAddr); found {
+			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
+		} else {
+			retval.Sessions = append(retval.Sessions, types.WrapSessionNotFound(sessionAddr))
+		}
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// RecordsBySpec returns the records created using a record specification.
+func (k Keeper) RecordsBySpec(c context.Context, req *types.RecordsBySpecRequest) (*types.RecordsBySpecResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordsBySpec")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.RecordsBySpecResponse{Request: req}
+
+	if len(req.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+	recSpecAddr, err := ParseRecordSpecID(req.SpecificationId, req.Name)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid input: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.GetRecordSpecRecordCacheIteratorPrefix(recSpecAddr))
+
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key, _ []byte) error {
+		var recordAddr types.MetadataAddress
+		if mErr := recordAddr.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		if record, found := k.GetRecord(ctx, recordAddr); found {
+			retval.Records = append(retval.Records, types.WrapRecord(&record))
+		} else {
+			retval.Records = append(retval.Records, types.WrapRecordNotFound(recordAddr))
+		}
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
 // ScopeSpecification returns a specific scope specification by id.
 func (k Keeper) ScopeSpecification(c context.Context, req *types.ScopeSpecificationRequest) (*types.ScopeSpecificationResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeSpecification")
@@ -652,6 +1109,41 @@ func (k Keeper) ScopeSpecification(c context.Context, req *types.ScopeSpecificat
 	return &retval, nil
 }
 
+// ScopeSpecificationVersions returns all versions of a scope specification, oldest first.
+func (k Keeper) ScopeSpecificationVersions(c context.Context, req *types.ScopeSpecificationVersionsRequest) (*types.ScopeSpecificationVersionsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeSpecificationVersions")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopeSpecificationVersionsResponse{Request: req}
+
+	if len(req.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+
+	specAddr, err := ParseScopeSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	versionIDs, err := k.GetSpecVersionLineage(ctx, specAddr)
+	if err != nil {
+		return &retval, status.Error(codes.NotFound, err.Error())
+	}
+	for _, versionID := range versionIDs {
+		spec, found := k.GetScopeSpecification(ctx, versionID)
+		if found {
+			retval.Versions = append(retval.Versions, types.WrapScopeSpec(&spec))
+		} else {
+			retval.Versions = append(retval.Versions, types.WrapScopeSpecNotFound(versionID))
+		}
+	}
+
+	return &retval, nil
+}
+
 // ScopeSpecificationsAll returns all scope specifications (limited by pagination).
 func (k Keeper) ScopeSpecificationsAll(c context.Context, req *types.ScopeSpecificationsAllRequest) (*types.ScopeSpecificationsAllResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeSpecificationsAll")
@@ -729,6 +1221,41 @@ func (k Keeper) ContractSpecification(c context.Context, req *types.ContractSpec
 	return &retval, nil
 }
 
+// ContractSpecificationVersions returns all versions of a contract specification, oldest first.
+func (k Keeper) ContractSpecificationVersions(c context.Context, req *types.ContractSpecificationVersionsRequest) (*types.ContractSpecificationVersionsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ContractSpecificationVersions")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ContractSpecificationVersionsResponse{Request: req}
+
+	if len(req.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+
+	specAddr, err := ParseContractSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid specification id: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	versionIDs, err := k.GetSpecVersionLineage(ctx, specAddr)
+	if err != nil {
+		return &retval, status.Error(codes.NotFound, err.Error())
+	}
+	for _, versionID := range versionIDs {
+		spec, found := k.GetContractSpecification(ctx, versionID)
+		if found {
+			retval.Versions = append(retval.Versions, types.WrapContractSpec(&spec))
+		} else {
+			retval.Versions = append(retval.Versions, types.WrapContractSpecNotFound(versionID))
+		}
+	}
+
+	return &retval, nil
+}
+
 // ContractSpecificationsAll returns all contract specifications (limited by pagination).
 func (k Keeper) ContractSpecificationsAll(c context.Context, req *types.ContractSpecificationsAllRequest) (*types.ContractSpecificationsAllResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ContractSpecificationsAll")
@@ -875,6 +1402,56 @@ func (k Keeper) RecordSpecificationsAll(c context.Context, req *types.RecordSpec
 	return &retval, nil
 }
 
+// RecordType returns a registered record type.
+func (k Keeper) RecordType(c context.Context, req *types.RecordTypeRequest) (*types.RecordTypeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordType")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.RecordTypeResponse{Request: req}
+
+	if len(req.Name) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "record type name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	recordType, found := k.GetRecordType(ctx, req.Name)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "record type %q not found", req.Name)
+	}
+	retval.RecordType = &recordType
+
+	return &retval, nil
+}
+
+// RecordTypesAll returns all registered record types (limited by pagination).
+func (k Keeper) RecordTypesAll(c context.Context, req *types.RecordTypesAllRequest) (*types.RecordTypesAllResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordTypesAll")
+	retval := types.RecordTypesAllResponse{Request: req}
+
+	pageRequest := getPageRequest(req)
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(kvStore, types.RecordTypeKeyPrefix)
+
+	pageRes, err := query.Paginate(prefixStore, pageRequest, func(key, value []byte) error {
+		var recordType types.RecordType
+		if vErr := recordType.Unmarshal(value); vErr != nil {
+			k.Logger(ctx).Error("failed to unmarshal record type", "name", string(key), "error", vErr)
+			return nil // Still want to move on to the next.
+		}
+		retval.RecordTypes = append(retval.RecordTypes, recordType)
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
 func (k Keeper) OSLocatorParams(c context.Context, request *types.OSLocatorParamsRequest) (*types.OSLocatorParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "OSLocatorParams")
 	ctx := sdk.UnwrapSDKContext(c)
@@ -898,11 +1475,22 @@ func (k Keeper) OSLocator(c context.Context, request *types.OSLocatorRequest) (*
 		return &retval, types.ErrInvalidAddress
 	}
 
-	record, exists := k.GetOsLocatorRecord(ctx, accAddr)
-	if !exists {
-		return &retval, types.ErrAddressNotBound
+	if len(request.Name) > 0 {
+		record, exists := k.GetOsLocatorRecord(ctx, accAddr, request.Name)
+		if !exists {
+			return &retval, types.ErrAddressNotBound
+		}
+		retval.Locators = []types.ObjectStoreLocator{record}
+	} else {
+		retval.Locators, err = k.GetOSLocatorsByOwner(ctx, accAddr)
+		if err != nil {
+			return &retval, err
+		}
+		if len(retval.Locators) == 0 {
+			return &retval, types.ErrAddressNotBound
+		}
 	}
-	retval.Locator = &record
+	retval.Locator = &retval.Locators[0]
 
 	return &retval, nil
 }
@@ -927,19 +1515,15 @@ func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsBy
 	}
 	uriStr := uri.String()
 
-	osLocatorStore := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), types.OSLocatorAddressKeyPrefix)
-	retval.Pagination, err = query.FilteredPaginate(osLocatorStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		record := types.ObjectStoreLocator{}
-		if rerr := k.cdc.Unmarshal(value, &record); rerr != nil {
-			return false, rerr
-		}
-		if record.LocatorUri != uriStr {
-			return false, nil
-		}
-		if accumulate {
-			retval.Locators = append(retval.Locators, record)
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	uriStore := prefix.NewStore(store, types.GetOSLocatorURICacheIteratorPrefix(uriStr))
+	retval.Pagination, err = query.Paginate(uriStore, request.Pagination, func(key []byte, _ []byte) error {
+		record, rerr := k.getOSLocatorFromURICacheKey(store, key)
+		if rerr != nil {
+			return rerr
 		}
-		return true, nil
+		retval.Locators = append(retval.Locators, record)
+		return nil
 	})
 	if err != nil {
 		return &retval, err
@@ -1150,6 +1734,36 @@ func ParseRecordSpecID(specID string, name string) (types.MetadataAddress, error
 	return types.RecordSpecMetadataAddress(uid, name), nil
 }
 
+// wrapScope wraps a scope in a ScopeWrapper and includes the lock on the scope if there is one
+// along with the scope's effective data access.
+func (k Keeper) wrapScope(ctx sdk.Context, scope *types.Scope) *types.ScopeWrapper {
+	wrapper := types.WrapScope(scope)
+	if lock, found := k.GetScopeLock(ctx, scope.ScopeId); found {
+		wrapper.Lock = &lock
+	}
+	wrapper.EffectiveDataAccess = k.GetEffectiveDataAccess(ctx, *scope)
+	return wrapper
+}
+
+// addScopeAttributes adds the attributes attached to the wrapped scope to the wrapper.
+func (k Keeper) addScopeAttributes(ctx sdk.Context, wrapper *types.ScopeWrapper) error {
+	if k.attrKeeper == nil {
+		return nil
+	}
+	attrs, err := k.attrKeeper.GetAllAttributes(ctx, wrapper.Scope.ScopeId.String())
+	if err != nil {
+		return fmt.Errorf("error getting scope [%s] attributes: %w", wrapper.Scope.ScopeId, err)
+	}
+	for _, attr := range attrs {
+		wrapper.Attributes = append(wrapper.Attributes, &types.ScopeAttribute{
+			Name:          attr.Name,
+			Value:         attr.Value,
+			AttributeType: attr.AttributeType.String(),
+		})
+	}
+	return nil
+}
+
 // hasPageRequest is just for use with the getPageRequest func below.
 type hasPageRequest interface {
 	GetPagination() *query.PageRequest
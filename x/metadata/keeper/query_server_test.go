@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/stretchr/testify/assert"
@@ -19,6 +20,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	simapp "github.com/provenance-io/provenance/app"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -168,6 +170,26 @@ func (s *QueryServerTestSuite) TestScopeQuery() {
 	s.Len(ownerResponse.ScopeUuids, 1)
 }
 
+func (s *QueryServerTestSuite) TestScopeQueryIncludeAttributes() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "scopeattr", s.user1Addr, false), "SetNameRecord")
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxValueLength = 100
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+	attr := attrtypes.NewAttribute("scopeattr", s.scopeID.String(), attrtypes.AttributeType_String, []byte("value"))
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute")
+
+	res, err := s.queryClient.Scope(gocontext.Background(), &types.ScopeRequest{ScopeId: s.scopeID.String()})
+	s.Require().NoError(err, "Scope without attributes")
+	s.Assert().Empty(res.Scope.Attributes, "attributes when not requested")
+
+	res, err = s.queryClient.Scope(gocontext.Background(), &types.ScopeRequest{ScopeId: s.scopeID.String(), IncludeAttributes: true})
+	s.Require().NoError(err, "Scope with attributes")
+	expected := []*types.ScopeAttribute{{Name: "scopeattr", Value: []byte("value"), AttributeType: "ATTRIBUTE_TYPE_STRING"}}
+	s.Assert().Equal(expected, res.Scope.Attributes, "attributes when requested")
+}
+
 // TODO: ScopesAll tests
 
 func (s *QueryServerTestSuite) TestSessionsQuery() {
@@ -823,8 +845,409 @@ func (s *QueryServerTestSuite) TestRecordsQuery() {
 	s.Equal(recordNames[0], rsID.Records[0].Record.Name)
 }
 
+func (s *QueryServerTestSuite) TestSessionsQueryFilters() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	march := time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2022, 4, 15, 0, 0, 0, 0, time.UTC)
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	newSession := func(parties []types.Party, updated *time.Time) types.MetadataAddress {
+		sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+		var audit *types.AuditFields
+		if updated != nil {
+			audit = &types.AuditFields{CreatedDate: *updated, UpdatedDate: *updated}
+		}
+		app.MetadataKeeper.SetSession(ctx, *types.NewSession("name", sessionID, s.cSpecID, parties, audit))
+		return sessionID
+	}
+	originator := newSession([]types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_ORIGINATOR}}, &march)
+	servicer := newSession([]types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_SERVICER}}, &april)
+	other := newSession([]types.Party{{Address: s.user2, Role: types.PartyType_PARTY_TYPE_SERVICER}}, nil)
+
+	sessionIDs := func(wrappers []*types.SessionWrapper) []types.MetadataAddress {
+		var rv []types.MetadataAddress
+		for _, w := range wrappers {
+			rv = append(rv, w.Session.SessionId)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		req      types.SessionsRequest
+		expected []types.MetadataAddress
+		errorMsg string
+	}{
+		{
+			name:     "no filters",
+			req:      types.SessionsRequest{},
+			expected: []types.MetadataAddress{originator, servicer, other},
+		},
+		{
+			name:     "party address",
+			req:      types.SessionsRequest{PartyAddress: s.user1},
+			expected: []types.MetadataAddress{originator, servicer},
+		},
+		{
+			name:     "party role",
+			req:      types.SessionsRequest{PartyRole: types.PartyType_PARTY_TYPE_SERVICER},
+			expected: []types.MetadataAddress{servicer, other},
+		},
+		{
+			name:     "party address and role",
+			req:      types.SessionsRequest{PartyAddress: s.user1, PartyRole: types.PartyType_PARTY_TYPE_SERVICER},
+			expected: []types.MetadataAddress{servicer},
+		},
+		{
+			name:     "updated after",
+			req:      types.SessionsRequest{UpdatedAfter: "2022-04-01T00:00:00Z"},
+			expected: []types.MetadataAddress{servicer},
+		},
+		{
+			name:     "updated after is inclusive",
+			req:      types.SessionsRequest{UpdatedAfter: "2022-03-15T00:00:00Z"},
+			expected: []types.MetadataAddress{originator, servicer},
+		},
+		{
+			name:     "updated before is exclusive",
+			req:      types.SessionsRequest{UpdatedBefore: "2022-04-15T00:00:00Z"},
+			expected: []types.MetadataAddress{originator},
+		},
+		{
+			name:     "nothing matches",
+			req:      types.SessionsRequest{PartyAddress: s.user2, PartyRole: types.PartyType_PARTY_TYPE_ORIGINATOR},
+			expected: nil,
+		},
+		{
+			name:     "invalid party address",
+			req:      types.SessionsRequest{PartyAddress: "notanaddress"},
+			errorMsg: "rpc error: code = InvalidArgument desc = invalid party_address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:     "invalid updated after",
+			req:      types.SessionsRequest{UpdatedAfter: "yesterday"},
+			errorMsg: `rpc error: code = InvalidArgument desc = invalid updated_after: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`,
+		},
+		{
+			name:     "updated range is backwards",
+			req:      types.SessionsRequest{UpdatedAfter: "2022-04-01T00:00:00Z", UpdatedBefore: "2022-03-01T00:00:00Z"},
+			errorMsg: "rpc error: code = InvalidArgument desc = updated_after 2022-04-01T00:00:00Z must be before updated_before 2022-03-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			req := tc.req
+			req.ScopeId = scopeID.String()
+			res, err := queryClient.Sessions(gocontext.Background(), &req)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg, "Sessions")
+			} else if assert.NoError(t, err, "Sessions") {
+				assert.ElementsMatch(t, tc.expected, sessionIDs(res.Sessions), "Sessions session ids")
+			}
+
+			allReq := types.SessionsAllRequest{
+				PartyAddress:  tc.req.PartyAddress,
+				PartyRole:     tc.req.PartyRole,
+				UpdatedAfter:  tc.req.UpdatedAfter,
+				UpdatedBefore: tc.req.UpdatedBefore,
+			}
+			allRes, err := queryClient.SessionsAll(gocontext.Background(), &allReq)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg, "SessionsAll")
+			} else if assert.NoError(t, err, "SessionsAll") {
+				assert.ElementsMatch(t, tc.expected, sessionIDs(allRes.Sessions), "SessionsAll session ids")
+			}
+		})
+	}
+
+	s.T().Run("single session that does not match", func(t *testing.T) {
+		res, err := queryClient.Sessions(gocontext.Background(), &types.SessionsRequest{SessionId: other.String(), PartyAddress: s.user1})
+		require.NoError(t, err, "Sessions")
+		assert.Empty(t, res.Sessions, "sessions")
+	})
+
+	s.T().Run("all sessions paginated with a filter", func(t *testing.T) {
+		res, err := queryClient.SessionsAll(gocontext.Background(), &types.SessionsAllRequest{
+			PartyAddress: s.user1,
+			Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err, "SessionsAll")
+		assert.Len(t, res.Sessions, 1, "sessions in first page")
+		assert.Equal(t, uint64(2), res.Pagination.Total, "total")
+	})
+}
+
+func (s *QueryServerTestSuite) TestRecordsQueryFilters() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	user1Session := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	user2Session := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	app.MetadataKeeper.SetSession(ctx, *types.NewSession("name", user1Session, s.cSpecID,
+		[]types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_ORIGINATOR}}, nil))
+	app.MetadataKeeper.SetSession(ctx, *types.NewSession("name", user2Session, s.cSpecID,
+		[]types.Party{{Address: s.user2, Role: types.PartyType_PARTY_TYPE_SERVICER}}, nil))
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	newRecord := func(name string, sessionID types.MetadataAddress, inputStatus types.RecordInputStatus, outputStatus types.ResultStatus) string {
+		inputs := []types.RecordInput{{Name: "input", Source: &types.RecordInput_Hash{Hash: "inhash"}, TypeName: "string", Status: inputStatus}}
+		outputs := []types.RecordOutput{{Hash: "outhash", Status: outputStatus}}
+		app.MetadataKeeper.SetRecord(ctx, *types.NewRecord(name, sessionID, *process, inputs, outputs,
+			types.RecordSpecMetadataAddress(s.cSpecUUID, name)))
+		return name
+	}
+	passed := newRecord("passed", user1Session, types.RecordInputStatus_Proposed, types.ResultStatus_RESULT_STATUS_PASS)
+	failed := newRecord("failed", user1Session, types.RecordInputStatus_Record, types.ResultStatus_RESULT_STATUS_FAIL)
+	skipped := newRecord("skipped", user2Session, types.RecordInputStatus_Proposed, types.ResultStatus_RESULT_STATUS_SKIP)
+
+	recordNames := func(wrappers []*types.RecordWrapper) []string {
+		var rv []string
+		for _, w := range wrappers {
+			rv = append(rv, w.Record.Name)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		req      types.RecordsRequest
+		expected []string
+		errorMsg string
+	}{
+		{
+			name:     "no filters",
+			req:      types.RecordsRequest{},
+			expected: []string{passed, failed, skipped},
+		},
+		{
+			name:     "output status",
+			req:      types.RecordsRequest{OutputStatus: types.ResultStatus_RESULT_STATUS_FAIL},
+			expected: []string{failed},
+		},
+		{
+			name:     "input status",
+			req:      types.RecordsRequest{InputStatus: types.RecordInputStatus_Proposed},
+			expected: []string{passed, skipped},
+		},
+		{
+			name:     "session party address",
+			req:      types.RecordsRequest{PartyAddress: s.user1},
+			expected: []string{passed, failed},
+		},
+		{
+			name:     "session party role",
+			req:      types.RecordsRequest{PartyRole: types.PartyType_PARTY_TYPE_SERVICER},
+			expected: []string{skipped},
+		},
+		{
+			name:     "party and statuses",
+			req:      types.RecordsRequest{PartyAddress: s.user1, InputStatus: types.RecordInputStatus_Proposed, OutputStatus: types.ResultStatus_RESULT_STATUS_PASS},
+			expected: []string{passed},
+		},
+		{
+			name:     "nothing matches",
+			req:      types.RecordsRequest{PartyAddress: s.user2, OutputStatus: types.ResultStatus_RESULT_STATUS_FAIL},
+			expected: nil,
+		},
+		{
+			name:     "invalid output status",
+			req:      types.RecordsRequest{OutputStatus: 88},
+			errorMsg: "rpc error: code = InvalidArgument desc = invalid output_status: 88",
+		},
+		{
+			name:     "invalid party role",
+			req:      types.RecordsRequest{PartyRole: 88},
+			errorMsg: "rpc error: code = InvalidArgument desc = invalid party_role: 88",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			req := tc.req
+			req.ScopeId = scopeID.String()
+			res, err := queryClient.Records(gocontext.Background(), &req)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg, "Records")
+			} else if assert.NoError(t, err, "Records") {
+				assert.ElementsMatch(t, tc.expected, recordNames(res.Records), "Records record names")
+			}
+
+			allReq := types.RecordsAllRequest{
+				PartyAddress: tc.req.PartyAddress,
+				PartyRole:    tc.req.PartyRole,
+				OutputStatus: tc.req.OutputStatus,
+				InputStatus:  tc.req.InputStatus,
+			}
+			allRes, err := queryClient.RecordsAll(gocontext.Background(), &allReq)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg, "RecordsAll")
+			} else if assert.NoError(t, err, "RecordsAll") {
+				assert.ElementsMatch(t, tc.expected, recordNames(allRes.Records), "RecordsAll record names")
+			}
+		})
+	}
+
+	s.T().Run("included sessions only come from matching records", func(t *testing.T) {
+		res, err := queryClient.Records(gocontext.Background(), &types.RecordsRequest{
+			ScopeId:         scopeID.String(),
+			OutputStatus:    types.ResultStatus_RESULT_STATUS_SKIP,
+			IncludeSessions: true,
+		})
+		require.NoError(t, err, "Records")
+		require.Len(t, res.Sessions, 1, "sessions")
+		assert.Equal(t, user2Session, res.Sessions[0].Session.SessionId, "session id")
+	})
+}
+
 // TODO: RecordsAll tests
-// TODO: Ownership tests
+func (s *QueryServerTestSuite) TestOwnershipQueryWithRole() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	originatorScope := types.ScopeMetadataAddress(uuid.New())
+	servicerScope := types.ScopeMetadataAddress(uuid.New())
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(originatorScope, nil,
+		[]types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_ORIGINATOR}}, []string{}, ""))
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(servicerScope, nil,
+		[]types.Party{{Address: s.user1, Role: types.PartyType_PARTY_TYPE_SERVICER}, {Address: s.user2, Role: types.PartyType_PARTY_TYPE_ORIGINATOR}}, []string{}, ""))
+
+	uuidOf := func(id types.MetadataAddress) string {
+		scopeUUID, err := id.ScopeUUID()
+		s.Require().NoError(err, "ScopeUUID")
+		return scopeUUID.String()
+	}
+
+	tests := []struct {
+		name     string
+		address  string
+		role     types.PartyType
+		expected []string
+	}{
+		{"no role", s.user1, types.PartyType_PARTY_TYPE_UNSPECIFIED, []string{uuidOf(originatorScope), uuidOf(servicerScope)}},
+		{"originator role", s.user1, types.PartyType_PARTY_TYPE_ORIGINATOR, []string{uuidOf(originatorScope)}},
+		{"servicer role", s.user1, types.PartyType_PARTY_TYPE_SERVICER, []string{uuidOf(servicerScope)}},
+		{"unused role", s.user1, types.PartyType_PARTY_TYPE_CUSTODIAN, nil},
+		{"role belonging to another owner", s.user2, types.PartyType_PARTY_TYPE_SERVICER, nil},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := queryClient.Ownership(gocontext.Background(), &types.OwnershipRequest{Address: tc.address, Role: tc.role})
+			require.NoError(t, err, "Ownership")
+			assert.ElementsMatch(t, tc.expected, res.ScopeUuids, "scope uuids")
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestAccessibleScopesQuery() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	var expected []string
+	for i := 0; i < 5; i++ {
+		scopeUUID := uuid.New()
+		dataAccess := []string{s.user2}
+		if i%2 == 0 {
+			dataAccess = append(dataAccess, s.user1)
+			expected = append(expected, scopeUUID.String())
+		}
+		app.MetadataKeeper.SetScope(ctx, *types.NewScope(types.ScopeMetadataAddress(scopeUUID), nil, ownerPartyList(s.user2), dataAccess, ""))
+	}
+
+	_, err := queryClient.AccessibleScopes(gocontext.Background(), &types.AccessibleScopesRequest{})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = address cannot be empty", "empty address error")
+
+	res, err := queryClient.AccessibleScopes(gocontext.Background(), &types.AccessibleScopesRequest{Address: s.user1})
+	s.Require().NoError(err, "AccessibleScopes")
+	s.ElementsMatch(expected, res.ScopeUuids, "scope uuids")
+
+	pageRes, err := queryClient.AccessibleScopes(gocontext.Background(), &types.AccessibleScopesRequest{
+		Address:    s.user2,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err, "AccessibleScopes with pagination")
+	s.Len(pageRes.ScopeUuids, 2, "scope uuids in first page")
+	s.Equal(uint64(5), pageRes.Pagination.Total, "total")
+
+	// Removing data access should remove it from the index too.
+	for _, scopeUUIDStr := range expected {
+		scopeID := types.ScopeMetadataAddress(uuid.MustParse(scopeUUIDStr))
+		scope, found := app.MetadataKeeper.GetScope(ctx, scopeID)
+		s.Require().True(found, "GetScope")
+		scope.DataAccess = []string{s.user2}
+		app.MetadataKeeper.SetScope(ctx, scope)
+	}
+	res, err = queryClient.AccessibleScopes(gocontext.Background(), &types.AccessibleScopesRequest{Address: s.user1})
+	s.Require().NoError(err, "AccessibleScopes after removing data access")
+	s.Empty(res.ScopeUuids, "scope uuids after removing data access")
+}
+
+func (s *QueryServerTestSuite) TestSessionsAndRecordsBySpecQueries() {
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
+
+	contractSpecUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	recSpecID := contractSpecID.MustGetAsRecordSpecAddress("record")
+	otherSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+
+	var expectedSessions, expectedRecords []types.MetadataAddress
+	for i := 0; i < 3; i++ {
+		scopeUUID := uuid.New()
+		app.MetadataKeeper.SetScope(ctx, *types.NewScope(types.ScopeMetadataAddress(scopeUUID), nil, ownerPartyList(s.user1), []string{}, ""))
+		sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+		specID := contractSpecID
+		if i == 2 {
+			specID = otherSpecID
+		} else {
+			expectedSessions = append(expectedSessions, sessionID)
+			expectedRecords = append(expectedRecords, sessionID.MustGetAsRecordAddress("record"))
+		}
+		app.MetadataKeeper.SetSession(ctx, *types.NewSession("session", sessionID, specID, ownerPartyList(s.user1), nil))
+		app.MetadataKeeper.SetRecord(ctx, *types.NewRecord("record", sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, specID.MustGetAsRecordSpecAddress("record")))
+	}
+
+	// The contract spec count includes both the sessions and the records using its record spec.
+	s.Equal(uint64(4), app.MetadataKeeper.GetSpecUsageCount(ctx, contractSpecID), "contract spec usage count")
+	s.Equal(uint64(2), app.MetadataKeeper.GetSpecUsageCount(ctx, recSpecID), "record spec usage count")
+
+	_, err := queryClient.SessionsBySpec(gocontext.Background(), &types.SessionsBySpecRequest{})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = specification id cannot be empty", "empty sessions spec id error")
+	sessRes, err := queryClient.SessionsBySpec(gocontext.Background(), &types.SessionsBySpecRequest{SpecificationId: contractSpecUUID.String()})
+	s.Require().NoError(err, "SessionsBySpec")
+	var sessionIDs []types.MetadataAddress
+	for _, wrapper := range sessRes.Sessions {
+		sessionIDs = append(sessionIDs, wrapper.Session.SessionId)
+	}
+	s.ElementsMatch(expectedSessions, sessionIDs, "session ids")
+
+	_, err = queryClient.RecordsBySpec(gocontext.Background(), &types.RecordsBySpecRequest{SpecificationId: contractSpecID.String()})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = invalid input: a name is required when providing a contract spec address", "missing record spec name error")
+	recRes, err := queryClient.RecordsBySpec(gocontext.Background(), &types.RecordsBySpecRequest{
+		SpecificationId: recSpecID.String(),
+		Pagination:      &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err, "RecordsBySpec")
+	var recordIDs []types.MetadataAddress
+	for _, wrapper := range recRes.Records {
+		recordIDs = append(recordIDs, wrapper.Record.SessionId.MustGetAsRecordAddress(wrapper.Record.Name))
+	}
+	s.ElementsMatch(expectedRecords, recordIDs, "record ids")
+	s.Equal(uint64(2), recRes.Pagination.Total, "records total")
+
+	// Removing a record removes its session too, and both should leave the indexes and counts.
+	app.MetadataKeeper.RemoveRecord(ctx, expectedRecords[0])
+	s.Equal(uint64(2), app.MetadataKeeper.GetSpecUsageCount(ctx, contractSpecID), "contract spec usage count after removal")
+	s.Equal(uint64(1), app.MetadataKeeper.GetSpecUsageCount(ctx, recSpecID), "record spec usage count after removal")
+	recRes, err = queryClient.RecordsBySpec(gocontext.Background(), &types.RecordsBySpecRequest{SpecificationId: contractSpecID.String(), Name: "record"})
+	s.Require().NoError(err, "RecordsBySpec after removal")
+	s.Len(recRes.Records, 1, "records after removal")
+	sessRes, err = queryClient.SessionsBySpec(gocontext.Background(), &types.SessionsBySpecRequest{SpecificationId: contractSpecID.String()})
+	s.Require().NoError(err, "SessionsBySpec after removal")
+	s.Len(sessRes.Sessions, 1, "sessions after removal")
+}
+
 // TODO: ValueOwnership tests
 // TODO: ScopeSpecification tests
 // TODO: ScopeSpecificationsAll tests
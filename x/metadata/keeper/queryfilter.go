@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// sessionFilter holds the optional criteria that a session must meet to be included in query results.
+type sessionFilter struct {
+	partyAddress  string
+	partyRole     types.PartyType
+	updatedAfter  *time.Time
+	updatedBefore *time.Time
+}
+
+// newSessionFilter validates and parses session filter request fields.
+func newSessionFilter(partyAddress string, partyRole types.PartyType, updatedAfter, updatedBefore string) (*sessionFilter, error) {
+	if err := validatePartyFilter(partyAddress, partyRole); err != nil {
+		return nil, err
+	}
+	rv := &sessionFilter{partyAddress: partyAddress, partyRole: partyRole}
+	var err error
+	if rv.updatedAfter, err = parseFilterTime("updated_after", updatedAfter); err != nil {
+		return nil, err
+	}
+	if rv.updatedBefore, err = parseFilterTime("updated_before", updatedBefore); err != nil {
+		return nil, err
+	}
+	if rv.updatedAfter != nil && rv.updatedBefore != nil && !rv.updatedAfter.Before(*rv.updatedBefore) {
+		return nil, fmt.Errorf("updated_after %s must be before updated_before %s", updatedAfter, updatedBefore)
+	}
+	return rv, nil
+}
+
+// matches returns true if the session meets all of the filter's criteria.
+func (f sessionFilter) matches(session types.Session) bool {
+	if !hasMatchingParty(session.Parties, f.partyAddress, f.partyRole) {
+		return false
+	}
+	if f.updatedAfter == nil && f.updatedBefore == nil {
+		return true
+	}
+	if session.Audit == nil {
+		return false
+	}
+	updated := session.Audit.UpdatedDate
+	if f.updatedAfter != nil && updated.Before(*f.updatedAfter) {
+		return false
+	}
+	if f.updatedBefore != nil && !updated.Before(*f.updatedBefore) {
+		return false
+	}
+	return true
+}
+
+// recordFilter holds the optional criteria that a record must meet to be included in query results.
+type recordFilter struct {
+	partyAddress string
+	partyRole    types.PartyType
+	outputStatus types.ResultStatus
+	inputStatus  types.RecordInputStatus
+
+	// getSession looks up a record's session for the party criteria.
+	getSession func(sessionID types.MetadataAddress) (types.Session, bool)
+	// sessionParties caches the parties of each session that has been looked up (by session id string).
+	sessionParties map[string][]types.Party
+}
+
+// newRecordFilter validates record filter request fields and creates a filter that looks up sessions in this keeper.
+func (k Keeper) newRecordFilter(
+	ctx sdk.Context,
+	partyAddress string,
+	partyRole types.PartyType,
+	outputStatus types.ResultStatus,
+	inputStatus types.RecordInputStatus,
+) (*recordFilter, error) {
+	if err := validatePartyFilter(partyAddress, partyRole); err != nil {
+		return nil, err
+	}
+	if _, ok := types.ResultStatus_name[int32(outputStatus)]; !ok {
+		return nil, fmt.Errorf("invalid output_status: %d", outputStatus)
+	}
+	if _, ok := types.RecordInputStatus_name[int32(inputStatus)]; !ok {
+		return nil, fmt.Errorf("invalid input_status: %d", inputStatus)
+	}
+	return &recordFilter{
+		partyAddress: partyAddress,
+		partyRole:    partyRole,
+		outputStatus: outputStatus,
+		inputStatus:  inputStatus,
+		getSession: func(sessionID types.MetadataAddress) (types.Session, bool) {
+			return k.GetSession(ctx, sessionID)
+		},
+		sessionParties: make(map[string][]types.Party),
+	}, nil
+}
+
+// matches returns true if the record meets all of the filter's criteria.
+func (f *recordFilter) matches(record types.Record) bool {
+	if f.outputStatus != types.ResultStatus_RESULT_STATUS_UNSPECIFIED {
+		found := false
+		for _, output := range record.Outputs {
+			if output.Status == f.outputStatus {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.inputStatus != types.RecordInputStatus_Unknown {
+		found := false
+		for _, input := range record.Inputs {
+			if input.Status == f.inputStatus {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.partyAddress) == 0 && f.partyRole == types.PartyType_PARTY_TYPE_UNSPECIFIED {
+		return true
+	}
+	key := record.SessionId.String()
+	parties, cached := f.sessionParties[key]
+	if !cached {
+		if session, found := f.getSession(record.SessionId); found {
+			parties = session.Parties
+		}
+		f.sessionParties[key] = parties
+	}
+	return hasMatchingParty(parties, f.partyAddress, f.partyRole)
+}
+
+// validatePartyFilter makes sure the party filter fields are valid if provided.
+func validatePartyFilter(partyAddress string, partyRole types.PartyType) error {
+	if len(partyAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(partyAddress); err != nil {
+			return fmt.Errorf("invalid party_address: %w", err)
+		}
+	}
+	if _, ok := types.PartyType_name[int32(partyRole)]; !ok {
+		return fmt.Errorf("invalid party_role: %d", partyRole)
+	}
+	return nil
+}
+
+// hasMatchingParty returns true if one of the parties has the given address and role.
+// An empty address or unspecified role matches any party address or role respectively.
+func hasMatchingParty(parties []types.Party, address string, role types.PartyType) bool {
+	if len(address) == 0 && role == types.PartyType_PARTY_TYPE_UNSPECIFIED {
+		return true
+	}
+	for _, party := range parties {
+		if (len(address) == 0 || party.Address == address) && (role == types.PartyType_PARTY_TYPE_UNSPECIFIED || party.Role == role) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseFilterTime parses an optional RFC 3339 time from a query request field.
+func parseFilterTime(field, value string) (*time.Time, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", field, err)
+	}
+	return &t, nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -63,12 +64,23 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
 	action := types.TLAction_Created
-	if store.Has(recordID) {
+	historyAction := types.HistoryAction_Created
+	var oldSpecID types.MetadataAddress
+	oldRecordBytes := store.Get(recordID)
+	if oldRecordBytes != nil {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
 		action = types.TLAction_Updated
+		historyAction = types.HistoryAction_Updated
+		var oldRecord types.Record
+		if err := k.cdc.Unmarshal(oldRecordBytes, &oldRecord); err == nil {
+			oldSpecID = oldRecord.SpecificationId
+			event = types.NewEventRecordUpdatedWithDiff(oldRecord, record)
+		}
 	}
 
 	store.Set(recordID, b)
+	k.indexRecordSpec(store, recordID, oldSpecID, record.SpecificationId)
+	k.recordHistory(ctx, recordID, historyAction, oldRecordBytes)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Record, action)
 }
@@ -78,17 +90,38 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	if !id.IsRecordAddress() {
 		panic(fmt.Errorf("invalid address, address must be for a record"))
 	}
-	record, found := k.GetRecord(ctx, id)
+	record, found := k.removeRecord(ctx, id)
 	if !found {
 		return
 	}
+
+	// Remove the session too if there are no more records in it.
+	k.RemoveSession(ctx, record.SessionId)
+}
+
+// removeRecord removes a record and its index entries without touching its session.
+// It returns the record that was removed and whether it was found.
+func (k Keeper) removeRecord(ctx sdk.Context, id types.MetadataAddress) (types.Record, bool) {
+	record, found := k.GetRecord(ctx, id)
+	if !found {
+		return record, false
+	}
 	store := ctx.KVStore(k.storeKey)
+	k.recordHistory(ctx, id, types.HistoryAction_Deleted, store.Get(id))
+	k.indexRecordSpec(store, id, record.SpecificationId, nil)
 	store.Delete(id)
+	k.RemoveMissingResponsibleParties(ctx, id)
+	k.removeAttributes(ctx, id)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Record, types.TLAction_Deleted)
+	return record, true
+}
 
-	// Remove the session too if there are no more records in it.
-	k.RemoveSession(ctx, record.SessionId)
+// indexRecordSpec updates the record spec index entry and usage counts for a record whose spec is changing.
+func (k Keeper) indexRecordSpec(store sdk.KVStore, recordID, oldSpecID, newSpecID types.MetadataAddress) {
+	updateSpecUsage(store, oldSpecID, newSpecID, func(specID types.MetadataAddress) []byte {
+		return types.GetRecordSpecRecordCacheKey(specID, recordID)
+	})
 }
 
 // IterateRecords processes stored records with the given handler.
@@ -114,14 +147,56 @@ func (k Keeper) IterateRecords(ctx sdk.Context, scopeID types.MetadataAddress, h
 	return nil
 }
 
+// validateScopeRecordLimit returns an error if the scope already has as many records as it's allowed to have.
+func (k Keeper) validateScopeRecordLimit(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	limit := k.GetMaxScopeRecords(ctx)
+	if limit == 0 {
+		return nil
+	}
+	prefix, err := scopeID.ScopeRecordIteratorPrefix()
+	if err != nil {
+		return err
+	}
+	// Only count up to the limit since that's all that's needed to know if another record can be added.
+	count := 0
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid() && count < int(limit); it.Next() {
+		count++
+	}
+	return validateLimit("scope record count", limit, count, count+1)
+}
+
+// validateRecordOutputHashes returns an error if the existing record's output hashes aren't the expected ones.
+func validateRecordOutputHashes(existing *types.Record, name string, expectedOutputHashes []string) error {
+	if existing == nil {
+		return sdkerrors.Wrapf(types.ErrConcurrentUpdate, "record %q does not exist, but existing outputs were expected", name)
+	}
+	actual := make([]string, len(existing.Outputs))
+	for i, output := range existing.Outputs {
+		actual[i] = output.Hash
+	}
+	matches := len(actual) == len(expectedOutputHashes)
+	for i := 0; matches && i < len(actual); i++ {
+		matches = actual[i] == expectedOutputHashes[i]
+	}
+	if !matches {
+		return sdkerrors.Wrapf(types.ErrConcurrentUpdate, "record %q output hashes %q do not equal the expected output hashes %q",
+			name, actual, expectedOutputHashes)
+	}
+	return nil
+}
+
 // ValidateRecordUpdate checks the current record and the proposed record to determine if the the proposed changes are valid
 // based on the existing state
 // Note: The proposed parameter is a reference here so that the SpecificationId can be set in cases when it's not provided.
+// The parties of the proposed record's session are the ones that must sign and that are used to identify the
+// responsible parties.
 func (k Keeper) ValidateRecordUpdate(
 	ctx sdk.Context,
 	existing, proposed *types.Record,
 	signers []string,
-	partiesInvolved []types.Party,
+	expectedOutputHashes []string,
 	msgTypeURL string,
 ) error {
 	if proposed == nil {
@@ -132,6 +207,23 @@ func (k Keeper) ValidateRecordUpdate(
 		return err
 	}
 
+	if len(expectedOutputHashes) > 0 {
+		if err := validateRecordOutputHashes(existing, proposed.Name, expectedOutputHashes); err != nil {
+			return err
+		}
+	}
+
+	var existingInputCount, existingOutputCount int
+	if existing != nil {
+		existingInputCount, existingOutputCount = len(existing.Inputs), len(existing.Outputs)
+	}
+	if err := validateLimit("record input count", k.GetMaxRecordInputs(ctx), existingInputCount, len(proposed.Inputs)); err != nil {
+		return err
+	}
+	if err := validateLimit("record output count", k.GetMaxRecordOutputs(ctx), existingOutputCount, len(proposed.Outputs)); err != nil {
+		return err
+	}
+
 	if existing != nil {
 		if existing.Name != proposed.Name {
 			return fmt.Errorf("the Name field of records cannot be changed")
@@ -163,6 +255,14 @@ func (k Keeper) ValidateRecordUpdate(
 	if _, found := k.GetScope(ctx, scopeID); !found {
 		return fmt.Errorf("scope not found with id %s", scopeID)
 	}
+	if err := k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
+	if existing == nil {
+		if err := k.validateScopeRecordLimit(ctx, scopeID); err != nil {
+			return err
+		}
+	}
 
 	// Get the session.
 	session, found := k.GetSession(ctx, proposed.SessionId)
@@ -195,6 +295,11 @@ func (k Keeper) ValidateRecordUpdate(
 			recSpecID, contractSpecUUID, proposed.Name)
 	}
 
+	// Make sure there's a signer for each of the responsible party types (if that's being enforced).
+	if err := k.validateResponsibleParties(ctx, recSpec, session.Parties, signers, msgTypeURL); err != nil {
+		return err
+	}
+
 	// Make sure all input specs are present as inputs.
 	inputNames := make([]string, len(proposed.Inputs))
 	inputMap := make(map[string]types.RecordInput)
@@ -308,6 +413,9 @@ func (k Keeper) ValidateRecordRemove(
 	if !found {
 		return fmt.Errorf("unable to find scope %s", scope.ScopeId)
 	}
+	if err := k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
 	recordID := types.RecordMetadataAddress(scopeUUID, existing.Name)
 	if !recordID.Equals(proposedID) {
 		return fmt.Errorf("cannot remove record. expected %s, got %s", recordID, proposedID)
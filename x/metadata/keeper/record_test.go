@@ -347,23 +347,20 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 		existing         *types.Record
 		origOutputHashes []string
 		proposed         *types.Record
-		partiesInvolved  []types.Party
 		signers          []string
 		errorMsg         string
 	}{
 		"validate basic called on proposed": {
-			existing:        nil,
-			proposed:        &types.Record{},
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "address is empty",
+			existing: nil,
+			proposed: &types.Record{},
+			signers:  []string{s.user1},
+			errorMsg: "address is empty",
 		},
 		"existing and proposed names do not match": {
 			existing:         types.NewRecord("notamatch", sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
 			origOutputHashes: []string{},
 			proposed:         types.NewRecord("not-a-match", sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
 			signers:          []string{s.user1},
-			partiesInvolved:  ownerPartyList(s.user1),
 			errorMsg:         "the Name field of records cannot be changed",
 		},
 		"original session id not found": {
@@ -371,58 +368,50 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 			origOutputHashes: []string{},
 			proposed:         types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
 			signers:          []string{s.user1},
-			partiesInvolved:  ownerPartyList(s.user1),
 			errorMsg:         fmt.Sprintf("original session %s not found for existing record", randomSessionID),
 		},
 		"scope not found": {
-			existing:        nil,
-			proposed:        types.NewRecord(s.recordName, randomSessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        fmt.Sprintf("scope not found with id %s", randomScopeID),
+			existing: nil,
+			proposed: types.NewRecord(s.recordName, randomSessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("scope not found with id %s", randomScopeID),
 		},
 		"missing signature from existing owner": {
-			existing:        nil,
-			proposed:        types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
-			signers:         []string{},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+			existing: nil,
+			proposed: types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
+			signers:  []string{},
+			errorMsg: fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
 		},
 		"session not found": {
-			existing:        nil,
-			proposed:        types.NewRecord(s.recordName, randomInScopeSessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        fmt.Sprintf("session not found for session id %s", randomInScopeSessionID),
+			existing: nil,
+			proposed: types.NewRecord(s.recordName, randomInScopeSessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID),
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("session not found for session id %s", randomInScopeSessionID),
 		},
 		"record specification not found": {
-			existing:        nil,
-			proposed:        types.NewRecord(missingRecordSpecName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, missingRecordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
+			existing: nil,
+			proposed: types.NewRecord(missingRecordSpecName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, missingRecordSpecID),
+			signers:  []string{s.user1},
 			errorMsg: fmt.Sprintf("record specification not found for record specification id %s (contract spec uuid %s and record name %s)",
 				missingRecordSpecID, s.contractSpecUUID, missingRecordSpecName),
 		},
 		"missing input": {
-			existing:        nil,
-			proposed:        types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{*otherInput}, []types.RecordOutput{}, s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        fmt.Sprintf("missing input [%s]", goodInput.Name),
+			existing: nil,
+			proposed: types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{*otherInput}, []types.RecordOutput{}, s.recordSpecID),
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("missing input [%s]", goodInput.Name),
 		},
 		"extra input": {
-			existing:        nil,
-			proposed:        types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{*goodInput, *otherInput}, []types.RecordOutput{}, s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        fmt.Sprintf("extra input [%s]", otherInput.Name),
+			existing: nil,
+			proposed: types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{*goodInput, *otherInput}, []types.RecordOutput{}, s.recordSpecID),
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("extra input [%s]", otherInput.Name),
 		},
 		"duplicate input": {
-			existing:        nil,
-			proposed:        types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{*goodInput, *goodInput}, []types.RecordOutput{}, s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        fmt.Sprintf("input name %s provided twice", goodInput.Name),
+			existing: nil,
+			proposed: types.NewRecord(s.recordName, sessionID, *process, []types.RecordInput{*goodInput, *goodInput}, []types.RecordOutput{}, s.recordSpecID),
+			signers:  []string{s.user1},
+			errorMsg: fmt.Sprintf("input name %s provided twice", goodInput.Name),
 		},
 		"input type name wrong": {
 			existing: nil,
@@ -438,8 +427,7 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 				},
 				[]types.RecordOutput{},
 				s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
+			signers: []string{s.user1},
 			errorMsg: fmt.Sprintf("input %s has TypeName %s but spec calls for %s",
 				goodInput.Name, "bad type name", inputSpec.TypeName),
 		},
@@ -457,8 +445,7 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 				},
 				[]types.RecordOutput{},
 				s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
+			signers: []string{s.user1},
 			errorMsg: fmt.Sprintf("input %s has source type %s but spec calls for %s",
 				goodInput.Name, "record", "hash"),
 		},
@@ -476,8 +463,7 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 				},
 				[]types.RecordOutput{},
 				s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
+			signers: []string{s.user1},
 			errorMsg: fmt.Sprintf("input %s source record id %s not found",
 				goodInput.Name, missingRecordID),
 		},
@@ -485,9 +471,8 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 			existing: nil,
 			proposed: types.NewRecord(
 				s.recordName, sessionID, *process, []types.RecordInput{*goodInput}, []types.RecordOutput{}, s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "invalid output count (expected: 1, got: 0)",
+			signers:  []string{s.user1},
+			errorMsg: "invalid output count (expected: 1, got: 0)",
 		},
 		"output count wrong - record - two": {
 			existing: nil,
@@ -504,16 +489,14 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 					},
 				},
 				s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "invalid output count (expected: 1, got: 2)",
+			signers:  []string{s.user1},
+			errorMsg: "invalid output count (expected: 1, got: 2)",
 		},
 		"output count wrong - record list - zero": {
-			existing:        nil,
-			proposed:        types.NewRecord(recordName2, sessionID, *process, []types.RecordInput{*goodInput2}, []types.RecordOutput{}, recordSpec2ID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "invalid output count (expected > 0, got: 0)",
+			existing: nil,
+			proposed: types.NewRecord(recordName2, sessionID, *process, []types.RecordInput{*goodInput2}, []types.RecordOutput{}, recordSpec2ID),
+			signers:  []string{s.user1},
+			errorMsg: "invalid output count (expected > 0, got: 0)",
 		},
 		"valid - empty specification id": {
 			existing: nil,
@@ -526,9 +509,8 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 					},
 				},
 				nil),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "",
+			signers:  []string{s.user1},
+			errorMsg: "",
 		},
 		"valid - single output": {
 			existing: nil,
@@ -541,9 +523,8 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 					},
 				},
 				s.recordSpecID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "",
+			signers:  []string{s.user1},
+			errorMsg: "",
 		},
 		"valid - list output": {
 			existing: nil,
@@ -560,15 +541,14 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 					},
 				},
 				recordSpec2ID),
-			signers:         []string{s.user1},
-			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "",
+			signers:  []string{s.user1},
+			errorMsg: "",
 		},
 	}
 
 	for n, tc := range cases {
 		s.T().Run(n, func(t *testing.T) {
-			err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, tc.existing, tc.proposed, tc.signers, tc.partiesInvolved, types.TypeURLMsgWriteRecordRequest)
+			err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, tc.existing, tc.proposed, tc.signers, nil, types.TypeURLMsgWriteRecordRequest)
 			if len(tc.errorMsg) != 0 {
 				assert.EqualError(t, err, tc.errorMsg, "ValidateRecordUpdate expected error")
 			} else {
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetRecordType returns the registered record type with the given name.
+func (k Keeper) GetRecordType(ctx sdk.Context, name string) (recordType types.RecordType, found bool) {
+	if len(name) == 0 {
+		return recordType, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetRecordTypeKey(name))
+	if b == nil {
+		return types.RecordType{}, false
+	}
+	k.cdc.MustUnmarshal(b, &recordType)
+	return recordType, true
+}
+
+// SetRecordType stores a record type in the module kv store.
+func (k Keeper) SetRecordType(ctx sdk.Context, recordType types.RecordType) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRecordTypeKey(recordType.Name)
+
+	var event proto.Message = types.NewEventRecordTypeCreated(recordType.Name)
+	if store.Has(key) {
+		event = types.NewEventRecordTypeUpdated(recordType.Name)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&recordType))
+	k.EmitEvent(ctx, event)
+}
+
+// RemoveRecordType removes a record type from the module kv store.
+// Record types that are used by a record or input specification cannot be removed.
+func (k Keeper) RemoveRecordType(ctx sdk.Context, name string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRecordTypeKey(name)
+	if !store.Has(key) {
+		return fmt.Errorf("record type %q not found", name)
+	}
+	if specID, used := k.findRecordTypeUsage(ctx, name); used {
+		return fmt.Errorf("record type %q is still used by record specification %s", name, specID)
+	}
+
+	store.Delete(key)
+	k.EmitEvent(ctx, types.NewEventRecordTypeDeleted(name))
+	return nil
+}
+
+// IterateRecordTypes processes all stored record types with the given handler.
+func (k Keeper) IterateRecordTypes(ctx sdk.Context, handler func(types.RecordType) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RecordTypeKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var recordType types.RecordType
+		if err := k.cdc.Unmarshal(it.Value(), &recordType); err != nil {
+			k.Logger(ctx).Error("could not unmarshal record type", "key", it.Key(), "error", err)
+		} else if handler(recordType) {
+			break
+		}
+	}
+	return nil
+}
+
+// ValidateRecordTypeUpdate full validation of a proposed record type possibly against an existing one.
+// A new record type must be signed by a registrar and all of its owners.
+// Changes to an existing record type must be signed by all of its existing owners.
+func (k Keeper) ValidateRecordTypeUpdate(
+	ctx sdk.Context,
+	existing *types.RecordType,
+	proposed types.RecordType,
+	signers []string,
+	msgTypeURL string,
+) error {
+	if err := proposed.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		if proposed.Name != existing.Name {
+			return fmt.Errorf("cannot update record type name. expected %s, got %s", existing.Name, proposed.Name)
+		}
+		return k.ValidateAllOwnersAreSignersWithAuthz(ctx, existing.OwnerAddresses, signers, msgTypeURL)
+	}
+
+	if !k.hasRecordTypeRegistrarSigner(ctx, signers) {
+		return fmt.Errorf("a record type registrar must sign to register record type %q", proposed.Name)
+	}
+	return k.ValidateAllOwnersAreSignersWithAuthz(ctx, proposed.OwnerAddresses, signers, msgTypeURL)
+}
+
+// hasRecordTypeRegistrarSigner returns true if any of the signers is a record type registrar.
+func (k Keeper) hasRecordTypeRegistrarSigner(ctx sdk.Context, signers []string) bool {
+	for _, registrar := range k.GetRecordTypeRegistrars(ctx) {
+		for _, signer := range signers {
+			if registrar == signer {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validateRecordSpecTypesRegistered returns an error if registered record types are required,
+// and the record specification or any of its inputs use a type that is not registered.
+func (k Keeper) validateRecordSpecTypesRegistered(ctx sdk.Context, spec types.RecordSpecification) error {
+	if !k.GetRequireRegisteredRecordTypes(ctx) {
+		return nil
+	}
+	if _, found := k.GetRecordType(ctx, spec.TypeName); !found {
+		return fmt.Errorf("record specification type name %q is not a registered record type", spec.TypeName)
+	}
+	for _, input := range spec.Inputs {
+		if _, found := k.GetRecordType(ctx, input.TypeName); !found {
+			return fmt.Errorf("input specification %q type name %q is not a registered record type", input.Name, input.TypeName)
+		}
+	}
+	return nil
+}
+
+// findRecordTypeUsage finds a record specification that uses the given record type name,
+// either as its own type or as the type of one of its inputs.
+func (k Keeper) findRecordTypeUsage(ctx sdk.Context, name string) (specID types.MetadataAddress, used bool) {
+	_ = k.IterateRecordSpecs(ctx, func(spec types.RecordSpecification) (stop bool) {
+		if spec.TypeName == name {
+			specID, used = spec.SpecificationId, true
+			return true
+		}
+		for _, input := range spec.Inputs {
+			if input.TypeName == name {
+				specID, used = spec.SpecificationId, true
+				return true
+			}
+		}
+		return false
+	})
+	return specID, used
+}
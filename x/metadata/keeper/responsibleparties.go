@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetMissingResponsibleParties returns the responsible party types that a record was last written without.
+func (k Keeper) GetMissingResponsibleParties(ctx sdk.Context, recordID types.MetadataAddress) (entry types.MissingResponsibleParties, found bool) {
+	if !recordID.IsRecordAddress() {
+		return entry, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetMissingResponsiblePartiesKey(recordID))
+	if b == nil {
+		return types.MissingResponsibleParties{}, false
+	}
+	k.cdc.MustUnmarshal(b, &entry)
+	return entry, true
+}
+
+// SetMissingResponsibleParties stores the responsible party types that a record was written without.
+func (k Keeper) SetMissingResponsibleParties(ctx sdk.Context, entry types.MissingResponsibleParties) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMissingResponsiblePartiesKey(entry.RecordId), k.cdc.MustMarshal(&entry))
+}
+
+// RemoveMissingResponsibleParties removes the missing responsible party types entry of a record.
+func (k Keeper) RemoveMissingResponsibleParties(ctx sdk.Context, recordID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMissingResponsiblePartiesKey(recordID))
+}
+
+// IterateMissingResponsibleParties processes all stored missing responsible party entries with the given handler.
+func (k Keeper) IterateMissingResponsibleParties(ctx sdk.Context, handler func(types.MissingResponsibleParties) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.MissingResponsiblePartiesKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.MissingResponsibleParties
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			k.Logger(ctx).Error("could not unmarshal missing responsible parties", "key", it.Key(), "error", err)
+		} else if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// FindMissingResponsibleParties returns the responsible party types that do not have a party that is a signer.
+// A party that is not a signer still counts if it has granted one of the signers authorization for the msg type.
+func (k Keeper) FindMissingResponsibleParties(
+	ctx sdk.Context,
+	responsibleParties []types.PartyType,
+	parties []types.Party,
+	signers []string,
+	msgTypeURL string,
+) []types.PartyType {
+	var missing []types.PartyType
+roleLoop:
+	for _, role := range responsibleParties {
+		for _, m := range missing {
+			if m == role {
+				continue roleLoop
+			}
+		}
+		var addrs []string
+		for _, party := range parties {
+			if party.Role == role {
+				addrs = append(addrs, party.Address)
+			}
+		}
+		if len(addrs) > 0 && len(FindMissing(addrs, signers)) < len(addrs) {
+			continue
+		}
+		if len(addrs) > 0 && len(msgTypeURL) > 0 && len(k.checkAuthzForMissing(ctx, addrs, signers, msgTypeURL)) < len(addrs) {
+			continue
+		}
+		missing = append(missing, role)
+	}
+	return missing
+}
+
+// validateResponsibleParties returns an error if responsible parties are being enforced and
+// the signers do not include a party of each of the record specification's responsible party types.
+func (k Keeper) validateResponsibleParties(
+	ctx sdk.Context,
+	recSpec types.RecordSpecification,
+	parties []types.Party,
+	signers []string,
+	msgTypeURL string,
+) error {
+	if !k.GetEnforceResponsibleParties(ctx) {
+		return nil
+	}
+	missing := k.FindMissingResponsibleParties(ctx, recSpec.ResponsibleParties, parties, signers, msgTypeURL)
+	if len(missing) > 0 {
+		return fmt.Errorf("missing signature from responsible party type%s %v", pluralEnding(len(missing)), missing)
+	}
+	return nil
+}
+
+// updateMissingResponsibleParties keeps track of whether a record that was just written was missing any of its
+// responsible parties. The parties of its session are used to identify the responsible parties.
+func (k Keeper) updateMissingResponsibleParties(
+	ctx sdk.Context,
+	record types.Record,
+	signers []string,
+	msgTypeURL string,
+) {
+	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
+	// When enforced, the record couldn't have been written without them.
+	if k.GetEnforceResponsibleParties(ctx) {
+		k.RemoveMissingResponsibleParties(ctx, recordID)
+		return
+	}
+	recSpec, found := k.GetRecordSpecification(ctx, record.SpecificationId)
+	if !found || len(recSpec.ResponsibleParties) == 0 {
+		k.RemoveMissingResponsibleParties(ctx, recordID)
+		return
+	}
+	var parties []types.Party
+	if session, sessionFound := k.GetSession(ctx, record.SessionId); sessionFound {
+		parties = session.Parties
+	}
+	missing := k.FindMissingResponsibleParties(ctx, recSpec.ResponsibleParties, parties, signers, msgTypeURL)
+	if len(missing) == 0 {
+		k.RemoveMissingResponsibleParties(ctx, recordID)
+		return
+	}
+	k.SetMissingResponsibleParties(ctx, types.MissingResponsibleParties{
+		RecordId:   recordID,
+		PartyTypes: missing,
+		Height:     ctx.BlockHeight(),
+	})
+}
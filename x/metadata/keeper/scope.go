@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
@@ -83,28 +86,48 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) {
 	b := k.cdc.MustMarshal(&scope)
 
 	var oldScope *types.Scope
+	var oldScopeBytes []byte
 	var event proto.Message = types.NewEventScopeCreated(scope.ScopeId)
 	action := types.TLAction_Created
+	historyAction := types.HistoryAction_Created
 	if store.Has(scope.ScopeId) {
 		event = types.NewEventScopeUpdated(scope.ScopeId)
 		action = types.TLAction_Updated
-		if oldScopeBytes := store.Get(scope.ScopeId); oldScopeBytes != nil {
+		historyAction = types.HistoryAction_Updated
+		if oldScopeBytes = store.Get(scope.ScopeId); oldScopeBytes != nil {
 			oldScope = &types.Scope{}
 			if err := k.cdc.Unmarshal(oldScopeBytes, oldScope); err != nil {
 				k.Logger(ctx).Error("could not unmarshal old scope", "err", err, "scopeId", scope.ScopeId.String(), "oldScopeBytes", oldScopeBytes)
 				oldScope = nil
 			}
 		}
+		if oldScope != nil {
+			event = types.NewEventScopeUpdatedWithDiff(*oldScope, scope)
+		}
 	}
 
 	store.Set(scope.ScopeId, b)
 	k.indexScope(ctx, &scope, oldScope)
+	k.removeStaleDataAccessGrants(ctx, &scope, oldScope)
+	k.recordHistory(ctx, scope.ScopeId, historyAction, oldScopeBytes)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Scope, action)
 }
 
 // RemoveScope removes a scope from the module kv store along with all its records and sessions.
 func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
+	k.removeScope(ctx, id, false)
+}
+
+// RemoveScopeCascade removes a scope from the module kv store along with all its records and sessions,
+// including any sessions that don't have any records.
+func (k Keeper) RemoveScopeCascade(ctx sdk.Context, id types.MetadataAddress) {
+	k.removeScope(ctx, id, true)
+}
+
+// removeScope removes a scope and all its records. Sessions are removed as the last record in each is deleted.
+// If cascade is true, all of the scope's sessions are also removed, including those without any records.
+func (k Keeper) removeScope(ctx sdk.Context, id types.MetadataAddress, cascade bool) {
 	if !id.IsScopeAddress() {
 		panic(fmt.Errorf("invalid address, address must be for a scope"))
 	}
@@ -116,29 +139,55 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 		return
 	}
 
-	// Remove all records
-	prefix, err := id.ScopeRecordIteratorPrefix()
-	if err != nil {
-		panic(err)
-	}
-	iter := sdk.KVStorePrefixIterator(store, prefix)
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		k.RemoveRecord(ctx, iter.Key())
+	// The keys are collected first so that nothing is deleted while iterating.
+	recordIDs, sessionIDs := k.getScopeChildIDs(ctx, id)
+	if cascade {
+		// Remove all records, then all sessions, including any that never had records.
+		for _, recordID := range recordIDs {
+			k.removeRecord(ctx, recordID)
+		}
+		for _, sessionID := range sessionIDs {
+			k.removeSession(ctx, sessionID)
+		}
+	} else {
+		// Sessions will be removed as the last record in each is deleted.
+		for _, recordID := range recordIDs {
+			k.RemoveRecord(ctx, recordID)
+		}
 	}
 
-	// Sessions will be removed as the last record in each is deleted.
-
 	k.indexScope(ctx, nil, &scope)
+	k.removeStaleDataAccessGrants(ctx, nil, &scope)
+	k.recordHistory(ctx, id, types.HistoryAction_Deleted, store.Get(id))
 	store.Delete(id)
+	k.removeAttributes(ctx, id)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	defer types.GetIncObjFunc(types.TLType_Scope, types.TLAction_Deleted)
 }
 
+// getScopeChildIDs gets the ids of all records and sessions in the scope with the given id.
+func (k Keeper) getScopeChildIDs(ctx sdk.Context, id types.MetadataAddress) (recordIDs, sessionIDs []types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	getKeys := func(prefix []byte, err error) []types.MetadataAddress {
+		if err != nil {
+			panic(err)
+		}
+		var rv []types.MetadataAddress
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			rv = append(rv, types.MetadataAddress(iter.Key()))
+		}
+		return rv
+	}
+	return getKeys(id.ScopeRecordIteratorPrefix()), getKeys(id.ScopeSessionIteratorPrefix())
+}
+
 // scopeIndexValues is a struct containing the values used to index a scope.
 type scopeIndexValues struct {
 	ScopeID         types.MetadataAddress
 	Addresses       []string
+	DataAccess      []string
 	ValueOwner      string
 	SpecificationID types.MetadataAddress
 }
@@ -154,6 +203,9 @@ func getScopeIndexValues(scope *types.Scope) *scopeIndexValues {
 		SpecificationID: scope.SpecificationId,
 	}
 	rv.Addresses = append(rv.Addresses, scope.DataAccess...)
+	for _, da := range scope.DataAccess {
+		rv.DataAccess = appendIfNew(rv.DataAccess, da)
+	}
 	for _, p := range scope.Owners {
 		rv.Addresses = appendIfNew(rv.Addresses, p.Address)
 	}
@@ -184,6 +236,7 @@ func getMissingScopeIndexValues(required, found *scopeIndexValues) scopeIndexVal
 	}
 	rv.ScopeID = required.ScopeID
 	rv.Addresses = FindMissing(required.Addresses, found.Addresses)
+	rv.DataAccess = FindMissing(required.DataAccess, found.DataAccess)
 	if required.ValueOwner != found.ValueOwner {
 		rv.ValueOwner = required.ValueOwner
 	}
@@ -204,6 +257,11 @@ func (v scopeIndexValues) IndexKeys() [][]byte {
 			rv = append(rv, types.GetAddressScopeCacheKey(addr, v.ScopeID))
 		}
 	}
+	for _, addrStr := range v.DataAccess {
+		if addr, err := sdk.AccAddressFromBech32(addrStr); err == nil {
+			rv = append(rv, types.GetDataAccessScopeCacheKey(addr, v.ScopeID))
+		}
+	}
 	if len(v.ValueOwner) > 0 {
 		if addr, err := sdk.AccAddressFromBech32(v.ValueOwner); err == nil {
 			rv = append(rv, types.GetValueOwnerScopeCacheKey(addr, v.ScopeID))
@@ -245,6 +303,26 @@ func (k Keeper) indexScope(ctx sdk.Context, newScope, oldScope *types.Scope) {
 	}
 }
 
+// GetScopeValueHash gets the sha256 hash of the encoded scope.
+// It is the value expected in a MsgWriteScopeRequest's expected_value_hash, and in history entries' previous_value_hash.
+func (k Keeper) GetScopeValueHash(scope types.Scope) []byte {
+	hash := sha256.Sum256(k.cdc.MustMarshal(&scope))
+	return hash[:]
+}
+
+// validateScopeValueHash returns an error if the existing scope doesn't have the expected value hash.
+// The existing scope should be empty if one doesn't exist with the given scope id.
+func (k Keeper) validateScopeValueHash(existing types.Scope, scopeID types.MetadataAddress, expectedValueHash []byte) error {
+	if len(existing.ScopeId) == 0 {
+		return sdkerrors.Wrapf(types.ErrConcurrentUpdate, "scope %s does not exist, but an existing value was expected", scopeID)
+	}
+	if actual := k.GetScopeValueHash(existing); !bytes.Equal(actual, expectedValueHash) {
+		return sdkerrors.Wrapf(types.ErrConcurrentUpdate, "scope %s value hash %X does not equal the expected value hash %X",
+			scopeID, actual, expectedValueHash)
+	}
+	return nil
+}
+
 // ValidateScopeUpdate checks the current scope and the proposed scope to determine if the the proposed changes are valid
 // based on the existing state
 func (k Keeper) ValidateScopeUpdate(
@@ -252,6 +330,7 @@ func (k Keeper) ValidateScopeUpdate(
 	existing,
 	proposed types.Scope,
 	signers []string,
+	expectedValueHash []byte,
 	msgTypeURL string,
 ) error {
 	if err := proposed.ValidateBasic(); err != nil {
@@ -265,6 +344,20 @@ func (k Keeper) ValidateScopeUpdate(
 		}
 	}
 
+	if err := k.validateScopeLimits(ctx, existing, proposed); err != nil {
+		return err
+	}
+
+	if len(expectedValueHash) > 0 {
+		if err := k.validateScopeValueHash(existing, proposed.ScopeId, expectedValueHash); err != nil {
+			return err
+		}
+	}
+
+	if err := k.validateScopeNotLocked(ctx, proposed.ScopeId); err != nil {
+		return err
+	}
+
 	if err := proposed.SpecificationId.Validate(); err != nil {
 		return fmt.Errorf("invalid specification id: %w", err)
 	}
@@ -295,6 +388,14 @@ func (k Keeper) ValidateScopeUpdate(
 		}
 	}
 
+	if existing.IsTokenized() && existing.ValueOwnerAddress != proposed.ValueOwnerAddress {
+		return fmt.Errorf("scope %s is tokenized; its value owner can only be changed by detokenizing it", existing.ScopeId)
+	}
+	if existing.ValueOwnerAddress != proposed.ValueOwnerAddress {
+		if err := k.validateScopeNoValueOwnerTransferOffers(ctx, existing.ScopeId); err != nil {
+			return err
+		}
+	}
 	if err := k.validateScopeUpdateValueOwner(ctx, existing.ValueOwnerAddress, proposed.ValueOwnerAddress, signers, msgTypeURL); err != nil {
 		return err
 	}
@@ -305,6 +406,18 @@ func (k Keeper) ValidateScopeUpdate(
 // ValidateScopeRemove checks the current scope and the proposed removal scope to determine if the the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateScopeRemove(ctx sdk.Context, scope types.Scope, signers []string, msgTypeURL string) error {
+	if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+		return err
+	}
+
+	if scope.IsTokenized() {
+		return fmt.Errorf("scope %s is tokenized; it must be detokenized before it can be deleted", scope.ScopeId)
+	}
+
+	if err := k.validateScopeNoValueOwnerTransferOffers(ctx, scope.ScopeId); err != nil {
+		return err
+	}
+
 	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
@@ -372,6 +485,9 @@ func (k Keeper) ValidateScopeAddDataAccess(
 	if len(dataAccessAddrs) < 1 {
 		return fmt.Errorf("data access list cannot be empty")
 	}
+	if err := k.validateScopeNotLocked(ctx, existing.ScopeId); err != nil {
+		return err
+	}
 
 	for _, da := range dataAccessAddrs {
 		_, err := sdk.AccAddressFromBech32(da)
@@ -384,6 +500,10 @@ func (k Keeper) ValidateScopeAddDataAccess(
 			}
 		}
 	}
+	if err := validateLimit("scope data access count", k.GetMaxScopeDataAccess(ctx),
+		len(existing.DataAccess), len(existing.DataAccess)+len(dataAccessAddrs)); err != nil {
+		return err
+	}
 
 	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.Owners, signers, msgTypeURL); err != nil {
 		return err
@@ -403,6 +523,9 @@ func (k Keeper) ValidateScopeDeleteDataAccess(
 	if len(dataAccessAddrs) < 1 {
 		return fmt.Errorf("data access list cannot be empty")
 	}
+	if err := k.validateScopeNotLocked(ctx, existing.ScopeId); err != nil {
+		return err
+	}
 	for _, da := range dataAccessAddrs {
 		_, err := sdk.AccAddressFromBech32(da)
 		if err != nil {
@@ -438,6 +561,12 @@ func (k Keeper) ValidateScopeUpdateOwners(
 	if err := proposed.ValidateOwnersBasic(); err != nil {
 		return err
 	}
+	if err := k.validateScopeLimits(ctx, existing, proposed); err != nil {
+		return err
+	}
+	if err := k.validateScopeNotLocked(ctx, existing.ScopeId); err != nil {
+		return err
+	}
 
 	scopeSpec, found := k.GetScopeSpecification(ctx, proposed.SpecificationId)
 	if !found {
@@ -452,6 +581,14 @@ func (k Keeper) ValidateScopeUpdateOwners(
 	return nil
 }
 
+// validateScopeLimits makes sure the proposed scope doesn't go over the owner and data access limits.
+func (k Keeper) validateScopeLimits(ctx sdk.Context, existing, proposed types.Scope) error {
+	if err := validateLimit("scope owner count", k.GetMaxScopeOwners(ctx), len(existing.Owners), len(proposed.Owners)); err != nil {
+		return err
+	}
+	return validateLimit("scope data access count", k.GetMaxScopeDataAccess(ctx), len(existing.DataAccess), len(proposed.DataAccess))
+}
+
 // ValidateScopeOwners is stateful validation for scope owners against a scope specification.
 // This does NOT involve the Scope.ValidateOwnersBasic() function.
 func (k Keeper) ValidateScopeOwners(owners []types.Party, spec types.ScopeSpecification) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	simapp "github.com/provenance-io/provenance/app"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 
@@ -117,6 +118,61 @@ func (s *ScopeKeeperTestSuite) TestMetadataScopeGetSet() {
 	s.NotNil(scope)
 }
 
+func (s *ScopeKeeperTestSuite) TestSetScopeUpdatedEvent() {
+	ns := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, ns)
+
+	updated := ns
+	updated.Owners = ownerPartyList(s.user2)
+	updated.DataAccess = []string{s.user1, s.user3}
+	updated.ValueOwnerAddress = s.user2
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.app.MetadataKeeper.SetScope(ctx, updated)
+
+	events := ctx.EventManager().ABCIEvents()
+	s.Require().Len(events, 1, "events emitted by SetScope")
+	msg, err := sdk.ParseTypedEvent(events[0])
+	s.Require().NoError(err, "ParseTypedEvent")
+	expected := &types.EventScopeUpdated{
+		ScopeAddr:         s.scopeID.String(),
+		OwnersAdded:       ownerPartyList(s.user2),
+		OwnersRemoved:     ownerPartyList(s.user1),
+		DataAccessAdded:   []string{s.user3},
+		DataAccessRemoved: []string{},
+		ValueOwnerBefore:  s.user1,
+		ValueOwnerAfter:   s.user2,
+	}
+	s.Assert().Equal(expected, msg, "emitted event")
+}
+
+func (s *ScopeKeeperTestSuite) TestRemoveScopeAttributes() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "scopeattr", s.user1Addr, false), "SetNameRecord")
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxValueLength = 100
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	sessionID := s.scopeID.MustGetAsSessionAddress(uuid.New())
+	recordID := s.scopeID.MustGetAsRecordAddress("attrrecord")
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, nil, ownerPartyList(s.user1), []string{s.user1}, s.user1))
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("attrsession", sessionID, nil, ownerPartyList(s.user1), nil))
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord("attrrecord", sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, nil))
+
+	ids := []types.MetadataAddress{s.scopeID, sessionID, recordID}
+	for _, id := range ids {
+		attr := attrtypes.NewAttribute("scopeattr", id.String(), attrtypes.AttributeType_String, []byte("value"))
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute %s", id)
+	}
+
+	s.app.MetadataKeeper.RemoveScope(s.ctx, s.scopeID)
+
+	for _, id := range ids {
+		attrs, err := s.app.AttributeKeeper.GetAllAttributes(s.ctx, id.String())
+		s.Assert().NoError(err, "GetAllAttributes %s", id)
+		s.Assert().Empty(attrs, "attributes of %s", id)
+	}
+}
+
 func (s *ScopeKeeperTestSuite) TestMetadataScopeIterator() {
 	for i := 1; i <= 10; i++ {
 		valueOwner := ""
@@ -385,7 +441,7 @@ func (s *ScopeKeeperTestSuite) TestValidateScopeUpdate() {
 
 	for _, tc := range cases {
 		s.T().Run(tc.name, func(t *testing.T) {
-			err = s.app.MetadataKeeper.ValidateScopeUpdate(s.ctx, tc.existing, tc.proposed, tc.signers, types.TypeURLMsgWriteScopeRequest)
+			err = s.app.MetadataKeeper.ValidateScopeUpdate(s.ctx, tc.existing, tc.proposed, tc.signers, nil, types.TypeURLMsgWriteScopeRequest)
 			if len(tc.errorMsg) > 0 {
 				assert.EqualError(t, err, tc.errorMsg, "ValidateScopeUpdate expected error")
 			} else {
@@ -790,19 +846,22 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 	ownerToRemove := randomUser()
 	valueOwnerOrig := randomUser()
 	valueOwnerNew := randomUser()
+	dataAccessConstant := randomUser()
+	dataAccessToAdd := randomUser()
+	dataAccessToRemove := randomUser()
 
 	scopeV1 := types.Scope{
 		ScopeId:           scopeID,
 		SpecificationId:   specIDOrig,
 		Owners:            ownerPartyList(ownerConstant.Bech32, ownerToRemove.Bech32),
-		DataAccess:        nil,
+		DataAccess:        []string{dataAccessConstant.Bech32, dataAccessToRemove.Bech32},
 		ValueOwnerAddress: valueOwnerOrig.Bech32,
 	}
 	scopeV2 := types.Scope{
 		ScopeId:           scopeID,
 		SpecificationId:   specIDNew,
 		Owners:            ownerPartyList(ownerConstant.Bech32, ownerToAdd.Bech32),
-		DataAccess:        nil,
+		DataAccess:        []string{dataAccessConstant.Bech32, dataAccessToAdd.Bech32},
 		ValueOwnerAddress: valueOwnerNew.Bech32,
 	}
 
@@ -819,6 +878,9 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 
 			{types.GetValueOwnerScopeCacheKey(valueOwnerOrig.Addr, scopeID), "valueOwnerOrig value owner index"},
 
+			{types.GetDataAccessScopeCacheKey(dataAccessConstant.Addr, scopeID), "dataAccessConstant data access index"},
+			{types.GetDataAccessScopeCacheKey(dataAccessToRemove.Addr, scopeID), "dataAccessToRemove data access index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDOrig, scopeID), "specIDOrig spec index"},
 		}
 
@@ -840,6 +902,9 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 
 			{types.GetValueOwnerScopeCacheKey(valueOwnerNew.Addr, scopeID), "valueOwnerNew value owner index"},
 
+			{types.GetDataAccessScopeCacheKey(dataAccessConstant.Addr, scopeID), "dataAccessConstant data access index"},
+			{types.GetDataAccessScopeCacheKey(dataAccessToAdd.Addr, scopeID), "dataAccessToAdd data access index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDNew, scopeID), "specIDNew spec index"},
 		}
 		unexpectedIndexes := []struct {
@@ -851,6 +916,8 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 
 			{types.GetValueOwnerScopeCacheKey(valueOwnerOrig.Addr, scopeID), "valueOwnerOrig value owner index"},
 
+			{types.GetDataAccessScopeCacheKey(dataAccessToRemove.Addr, scopeID), "dataAccessToRemove data access index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDOrig, scopeID), "specIDOrig spec index"},
 		}
 
@@ -878,6 +945,10 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 			{types.GetValueOwnerScopeCacheKey(valueOwnerOrig.Addr, scopeID), "valueOwnerOrig value owner index"},
 			{types.GetValueOwnerScopeCacheKey(valueOwnerNew.Addr, scopeID), "valueOwnerNew value owner index"},
 
+			{types.GetDataAccessScopeCacheKey(dataAccessConstant.Addr, scopeID), "dataAccessConstant data access index"},
+			{types.GetDataAccessScopeCacheKey(dataAccessToAdd.Addr, scopeID), "dataAccessToAdd data access index"},
+			{types.GetDataAccessScopeCacheKey(dataAccessToRemove.Addr, scopeID), "dataAccessToRemove data access index"},
+
 			{types.GetScopeSpecScopeCacheKey(specIDOrig, scopeID), "specIDOrig spec index"},
 			{types.GetScopeSpecScopeCacheKey(specIDNew, scopeID), "specIDNew spec index"},
 		}
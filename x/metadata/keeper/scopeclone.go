@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ValidateScopeClone checks that a new scope can be made from the source scope by the signers, and returns it.
+// The new scope has the source's specification, owners, data access, and value owner, and is validated the same way
+// as writing a new scope.
+func (k Keeper) ValidateScopeClone(
+	ctx sdk.Context,
+	source types.Scope,
+	scopeID types.MetadataAddress,
+	signers []string,
+	msgTypeURL string,
+) (types.Scope, error) {
+	if _, found := k.GetScope(ctx, scopeID); found {
+		return types.Scope{}, fmt.Errorf("scope %s already exists", scopeID)
+	}
+	if source.IsTokenized() {
+		return types.Scope{}, fmt.Errorf("scope %s is tokenized; its value owner cannot be copied", source.ScopeId)
+	}
+
+	scope := types.Scope{
+		ScopeId:           scopeID,
+		SpecificationId:   source.SpecificationId,
+		Owners:            append([]types.Party{}, source.Owners...),
+		DataAccess:        append([]string{}, source.DataAccess...),
+		ValueOwnerAddress: source.ValueOwnerAddress,
+	}
+	if err := k.ValidateScopeUpdate(ctx, types.Scope{}, scope, signers, nil, msgTypeURL); err != nil {
+		return types.Scope{}, err
+	}
+	return scope, nil
+}
+
+// CopyScopeDataAccessGrants gives a scope the same data access grants as another scope.
+func (k Keeper) CopyScopeDataAccessGrants(ctx sdk.Context, sourceScopeID, scopeID types.MetadataAddress) {
+	var grants []types.DataAccessGrant
+	// The error is ignored because the handler never returns one.
+	_ = k.IterateDataAccessGrants(ctx, sourceScopeID, func(grant types.DataAccessGrant) (stop bool) {
+		grants = append(grants, grant)
+		return false
+	})
+	for _, grant := range grants {
+		grant.ScopeId = scopeID
+		k.SetDataAccessGrant(ctx, grant)
+	}
+}
+
+// GetClonableRecords returns the records of a scope whose record specifications are marked as clonable,
+// along with the session that they're in. All of them must be in sessions with the same contract specification.
+func (k Keeper) GetClonableRecords(ctx sdk.Context, scopeID types.MetadataAddress) (*types.Session, []types.Record, error) {
+	var records []types.Record
+	err := k.IterateRecords(ctx, scopeID, func(record types.Record) (stop bool) {
+		if spec, found := k.GetRecordSpecification(ctx, record.SpecificationId); found && spec.Clonable {
+			records = append(records, record)
+		}
+		return false
+	})
+	if err != nil || len(records) == 0 {
+		return nil, nil, err
+	}
+
+	session, found := k.GetSession(ctx, records[0].SessionId)
+	if !found {
+		return nil, nil, fmt.Errorf("session %s not found for record %s", records[0].SessionId, records[0].Name)
+	}
+	for _, record := range records[1:] {
+		if record.SessionId.Equals(session.SessionId) {
+			continue
+		}
+		other, found := k.GetSession(ctx, record.SessionId)
+		if !found {
+			return nil, nil, fmt.Errorf("session %s not found for record %s", record.SessionId, record.Name)
+		}
+		if !other.SpecificationId.Equals(session.SpecificationId) {
+			return nil, nil, fmt.Errorf("clonable records of scope %s are in sessions with different contract specifications: %s, %s",
+				scopeID, session.SpecificationId, other.SpecificationId)
+		}
+	}
+	return &session, records, nil
+}
+
+// CloneScopeRecords copies a scope's clonable records into a new session, and returns the ids of the new records.
+// The new session has the same name, parties, specification, and context as the session of the first clonable record.
+// The session and records are validated the same way as writing them directly.
+func (k Keeper) CloneScopeRecords(
+	ctx sdk.Context,
+	sourceScopeID types.MetadataAddress,
+	sessionID types.MetadataAddress,
+	signers []string,
+	msgTypeURL string,
+) ([]types.MetadataAddress, error) {
+	sourceSession, sourceRecords, err := k.GetClonableRecords(ctx, sourceScopeID)
+	if err != nil || len(sourceRecords) == 0 {
+		return nil, err
+	}
+
+	if _, found := k.GetSession(ctx, sessionID); found {
+		return nil, fmt.Errorf("session %s already exists", sessionID)
+	}
+	session := types.Session{
+		SessionId:       sessionID,
+		SpecificationId: sourceSession.SpecificationId,
+		Parties:         append([]types.Party{}, sourceSession.Parties...),
+		Name:            sourceSession.Name,
+		Context:         sourceSession.Context,
+	}
+	if err = k.ValidateSessionUpdate(ctx, nil, &session, signers, 0, msgTypeURL); err != nil {
+		return nil, err
+	}
+	session.Audit = session.Audit.UpdateAudit(ctx.BlockTime(), strings.Join(signers, ", "), "")
+	k.SetSession(ctx, session)
+
+	recordIDs := make([]types.MetadataAddress, 0, len(sourceRecords))
+	for _, source := range sourceRecords {
+		record := types.Record{
+			Name:            source.Name,
+			SessionId:       sessionID,
+			Process:         source.Process,
+			Inputs:          append([]types.RecordInput{}, source.Inputs...),
+			Outputs:         append([]types.RecordOutput{}, source.Outputs...),
+			SpecificationId: source.SpecificationId,
+		}
+		if err = k.ValidateRecordUpdate(ctx, nil, &record, signers, nil, msgTypeURL); err != nil {
+			return nil, fmt.Errorf("cannot copy record %s: %w", source.Name, err)
+		}
+		k.SetRecord(ctx, record)
+		k.updateMissingResponsibleParties(ctx, record, signers, msgTypeURL)
+		recordIDs = append(recordIDs, record.GetRecordAddress())
+	}
+	return recordIDs, nil
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeLock returns the lock on the scope with the given id.
+func (k Keeper) GetScopeLock(ctx sdk.Context, scopeID types.MetadataAddress) (lock types.ScopeLock, found bool) {
+	if !scopeID.IsScopeAddress() {
+		return lock, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetScopeLockKey(scopeID))
+	if b == nil {
+		return types.ScopeLock{}, false
+	}
+	k.cdc.MustUnmarshal(b, &lock)
+	return lock, true
+}
+
+// SetScopeLock stores a scope lock in the module kv store.
+func (k Keeper) SetScopeLock(ctx sdk.Context, lock types.ScopeLock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScopeLockKey(lock.ScopeId), k.cdc.MustMarshal(&lock))
+	k.EmitEvent(ctx, types.NewEventScopeLocked(lock))
+}
+
+// RemoveScopeLock removes the lock on a scope from the module kv store.
+func (k Keeper) RemoveScopeLock(ctx sdk.Context, scopeID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetScopeLockKey(scopeID)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	k.EmitEvent(ctx, types.NewEventScopeUnlocked(scopeID))
+}
+
+// IterateScopeLocks processes all stored scope locks with the given handler.
+func (k Keeper) IterateScopeLocks(ctx sdk.Context, handler func(types.ScopeLock) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ScopeLockKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var lock types.ScopeLock
+		if err := k.cdc.Unmarshal(it.Value(), &lock); err != nil {
+			k.Logger(ctx).Error("could not unmarshal scope lock", "key", it.Key(), "error", err)
+		} else if handler(lock) {
+			break
+		}
+	}
+	return nil
+}
+
+// validateScopeNotLocked returns an error if the scope with the given id is locked.
+func (k Keeper) validateScopeNotLocked(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	if lock, found := k.GetScopeLock(ctx, scopeID); found {
+		return sdkerrors.Wrapf(types.ErrScopeLocked, "scope %s was locked by %s: %s", scopeID, lock.Locker, lock.Reason)
+	}
+	return nil
+}
+
+// ValidateScopeLock checks that the scope exists and is not already locked, and that all of its owners have signed.
+func (k Keeper) ValidateScopeLock(ctx sdk.Context, scopeID types.MetadataAddress, signers []string, msgTypeURL string) error {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	if err := k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
+	return k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.Owners, signers, msgTypeURL)
+}
+
+// ValidateScopeUnlock checks that the scope is locked and that the party that locked it has signed.
+func (k Keeper) ValidateScopeUnlock(ctx sdk.Context, scopeID types.MetadataAddress, signers []string, msgTypeURL string) error {
+	lock, found := k.GetScopeLock(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope %s is not locked", scopeID)
+	}
+	if err := k.ValidateAllOwnersAreSignersWithAuthz(ctx, []string{lock.Locker}, signers, msgTypeURL); err != nil {
+		return fmt.Errorf("only the locker %s can unlock scope %s: %w", lock.Locker, scopeID, err)
+	}
+	return nil
+}
+
+// HandleUnlockScopeProposal removes the lock from a scope as directed by governance.
+func HandleUnlockScopeProposal(ctx sdk.Context, k Keeper, p *types.UnlockScopeProposal) error {
+	scopeID, err := types.MetadataAddressFromBech32(p.ScopeId)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetScopeLock(ctx, scopeID); !found {
+		return fmt.Errorf("scope %s is not locked", scopeID)
+	}
+	k.RemoveScopeLock(ctx, scopeID)
+	logger := k.Logger(ctx)
+	logger.Info("scope unlocked by governance proposal", "scope", scopeID.String())
+	return nil
+}
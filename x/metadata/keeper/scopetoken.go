@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// scopeTokenAdminAccess is the access that the metadata module has on the markers that scopes are tokenized with.
+var scopeTokenAdminAccess = markertypes.AccessList{
+	markertypes.Access_Admin,
+	markertypes.Access_Mint,
+	markertypes.Access_Burn,
+	markertypes.Access_Withdraw,
+}
+
+// GetModuleAddress returns the address of the metadata module.
+// It manages the markers that scopes are tokenized with.
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// ValidateScopeTokenize checks that a scope can be tokenized by the signers, and returns who should get the marker's coin.
+// Tokenizing a scope has the same signer requirements as changing its value owner.
+func (k Keeper) ValidateScopeTokenize(
+	ctx sdk.Context,
+	existing types.Scope,
+	recipient string,
+	signers []string,
+	msgTypeURL string,
+) (sdk.AccAddress, error) {
+	if existing.IsTokenized() {
+		return nil, fmt.Errorf("scope %s is already tokenized", existing.ScopeId)
+	}
+
+	markerAddr := types.ScopeTokenMarkerAddress(existing.ScopeId)
+	if marker, isMarker := k.authKeeper.GetAccount(ctx, markerAddr).(markertypes.MarkerAccountI); isMarker && !k.isScopeTokenMarker(marker) {
+		return nil, fmt.Errorf("a marker already exists for denom %s", types.ScopeTokenDenom(existing.ScopeId))
+	}
+
+	if len(recipient) == 0 {
+		recipient = existing.ValueOwnerAddress
+	}
+	if len(recipient) == 0 {
+		return nil, fmt.Errorf("a recipient is required to tokenize scope %s since it does not have a value owner", existing.ScopeId)
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	// The metadata module is the one depositing the scope into the marker, so no signer needs deposit access on it.
+	// Validating the update as a removal of the value owner still requires the existing value owner's signature.
+	proposed := existing
+	proposed.ValueOwnerAddress = ""
+	if err = k.ValidateScopeUpdate(ctx, existing, proposed, signers, nil, msgTypeURL); err != nil {
+		return nil, err
+	}
+	// Setting a value owner where there wasn't one requires the scope owners' signatures.
+	if len(existing.ValueOwnerAddress) == 0 {
+		if err = k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.Owners, signers, msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+	return recipientAddr, nil
+}
+
+// AddScopeToken makes a unit-supply restricted marker the scope's value owner and sends its coin to the recipient.
+// The transfer agents are allowed to transfer the coin. The denom of the marker is returned.
+// The marker is created the first time a scope is tokenized, and reused after that.
+func (k Keeper) AddScopeToken(
+	ctx sdk.Context,
+	scope types.Scope,
+	recipient sdk.AccAddress,
+	transferAgents []sdk.AccAddress,
+) (string, error) {
+	denom := types.ScopeTokenDenom(scope.ScopeId)
+	markerAddr := types.ScopeTokenMarkerAddress(scope.ScopeId)
+	moduleAddr := k.GetModuleAddress()
+
+	var agentGrants []markertypes.AccessGrant
+	seen := make(map[string]bool)
+	for _, agent := range transferAgents {
+		if seen[agent.String()] {
+			continue
+		}
+		seen[agent.String()] = true
+		agentGrants = append(agentGrants, *markertypes.NewAccessGrant(agent, markertypes.AccessList{markertypes.Access_Transfer}))
+	}
+
+	coin := sdk.NewInt64Coin(denom, 1)
+	if marker, isMarker := k.authKeeper.GetAccount(ctx, markerAddr).(markertypes.MarkerAccountI); isMarker {
+		if err := k.renewScopeTokenMarker(ctx, marker, coin, agentGrants); err != nil {
+			return "", err
+		}
+	} else if err := k.createScopeTokenMarker(ctx, markerAddr, coin, agentGrants); err != nil {
+		return "", err
+	}
+	if err := k.markerKeeper.WithdrawCoins(ctx, moduleAddr, recipient, denom, sdk.NewCoins(coin)); err != nil {
+		return "", fmt.Errorf("could not send %s to %s: %w", coin, recipient, err)
+	}
+
+	scope.ValueOwnerAddress = markerAddr.String()
+	k.SetScope(ctx, scope)
+
+	k.EmitEvent(ctx, types.NewEventScopeTokenized(scope.ScopeId, denom, recipient.String()))
+	return denom, nil
+}
+
+// createScopeTokenMarker adds and activates a new restricted marker managed by the metadata module.
+func (k Keeper) createScopeTokenMarker(ctx sdk.Context, markerAddr sdk.AccAddress, coin sdk.Coin, agentGrants []markertypes.AccessGrant) error {
+	moduleAddr := k.GetModuleAddress()
+	grants := append([]markertypes.AccessGrant{*markertypes.NewAccessGrant(moduleAddr, scopeTokenAdminAccess)}, agentGrants...)
+	marker := markertypes.NewMarkerAccount(authtypes.NewBaseAccountWithAddress(markerAddr), coin, moduleAddr, grants,
+		markertypes.StatusProposed, markertypes.MarkerType_RestrictedCoin)
+	// Only the metadata module manages this marker so that its supply always stays at one or zero.
+	marker.AllowGovernanceControl = false
+
+	if err := k.markerKeeper.AddMarkerAccount(ctx, marker); err != nil {
+		return fmt.Errorf("could not create marker %s: %w", coin.Denom, err)
+	}
+	if err := k.markerKeeper.FinalizeMarker(ctx, moduleAddr, coin.Denom); err != nil {
+		return fmt.Errorf("could not finalize marker %s: %w", coin.Denom, err)
+	}
+	if err := k.markerKeeper.ActivateMarker(ctx, moduleAddr, coin.Denom); err != nil {
+		return fmt.Errorf("could not activate marker %s: %w", coin.Denom, err)
+	}
+	return nil
+}
+
+// renewScopeTokenMarker replaces the transfer agents of a marker left from a previous tokenization and mints its coin again.
+func (k Keeper) renewScopeTokenMarker(ctx sdk.Context, marker markertypes.MarkerAccountI, coin sdk.Coin, agentGrants []markertypes.AccessGrant) error {
+	moduleAddr := k.GetModuleAddress()
+	for _, grant := range marker.GetAccessList() {
+		addr := grant.GetAddress()
+		if addr.Equals(moduleAddr) {
+			continue
+		}
+		if err := k.markerKeeper.RemoveAccess(ctx, moduleAddr, coin.Denom, addr); err != nil {
+			return fmt.Errorf("could not remove %s access on marker %s: %w", addr, coin.Denom, err)
+		}
+	}
+	for i := range agentGrants {
+		if err := k.markerKeeper.AddAccess(ctx, moduleAddr, coin.Denom, &agentGrants[i]); err != nil {
+			return fmt.Errorf("could not grant %s access on marker %s: %w", agentGrants[i].Address, coin.Denom, err)
+		}
+	}
+	if err := k.markerKeeper.MintCoin(ctx, moduleAddr, coin); err != nil {
+		return fmt.Errorf("could not mint %s: %w", coin, err)
+	}
+	return nil
+}
+
+// isScopeTokenMarker returns true if the marker was created by the metadata module to tokenize a scope.
+func (k Keeper) isScopeTokenMarker(marker markertypes.MarkerAccountI) bool {
+	return marker.AddressHasAccess(k.GetModuleAddress(), markertypes.Access_Admin) &&
+		marker.GetStatus() == markertypes.StatusActive &&
+		marker.GetMarkerType() == markertypes.MarkerType_RestrictedCoin
+}
+
+// ValidateScopeDetokenize checks that a scope can be detokenized by the signers,
+// and returns the signer holding its marker's coin.
+func (k Keeper) ValidateScopeDetokenize(
+	ctx sdk.Context,
+	scope types.Scope,
+	valueOwner string,
+	signers []string,
+	msgTypeURL string,
+) (sdk.AccAddress, error) {
+	if !scope.IsTokenized() {
+		return nil, fmt.Errorf("scope %s is not tokenized", scope.ScopeId)
+	}
+	if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+		return nil, err
+	}
+
+	denom := types.ScopeTokenDenom(scope.ScopeId)
+	var holder sdk.AccAddress
+	for _, signer := range signers {
+		addr, err := sdk.AccAddressFromBech32(signer)
+		if err == nil && k.bankKeeper.GetBalance(ctx, addr, denom).IsPositive() {
+			holder = addr
+			break
+		}
+	}
+	if holder == nil {
+		return nil, fmt.Errorf("none of the signers hold the %s coin", denom)
+	}
+
+	if err := k.validateScopeUpdateValueOwner(ctx, "", valueOwner, signers, msgTypeURL); err != nil {
+		return nil, err
+	}
+	return holder, nil
+}
+
+// RemoveScopeToken takes the coin from its holder, burns it, and sets the scope's value owner.
+// The marker is left in place (with no supply) so that the scope can be tokenized again.
+func (k Keeper) RemoveScopeToken(ctx sdk.Context, scope types.Scope, holder sdk.AccAddress, valueOwner string) error {
+	denom := types.ScopeTokenDenom(scope.ScopeId)
+	markerAddr := types.ScopeTokenMarkerAddress(scope.ScopeId)
+	coin := sdk.NewInt64Coin(denom, 1)
+
+	if err := k.bankKeeper.SendCoins(ctx, holder, markerAddr, sdk.NewCoins(coin)); err != nil {
+		return fmt.Errorf("could not return %s coin from %s: %w", denom, holder, err)
+	}
+	if err := k.markerKeeper.BurnCoin(ctx, k.GetModuleAddress(), coin); err != nil {
+		return fmt.Errorf("could not burn %s: %w", coin, err)
+	}
+
+	scope.ValueOwnerAddress = valueOwner
+	k.SetScope(ctx, scope)
+
+	k.EmitEvent(ctx, types.NewEventScopeDetokenized(scope.ScopeId, denom, valueOwner))
+	return nil
+}
+
+// GetTokenizedScopesHeldBy returns up to limit ids of the tokenized scopes whose marker coin is held by the given address.
+// The holder's scope token balances are visited in denom order, starting at startDenom (or the first one if it's empty),
+// and the first skip tokenized scopes found are left out.
+// The returned denom is where the next page should start, and is empty when there are no more.
+func (k Keeper) GetTokenizedScopesHeldBy(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	startDenom string,
+	skip uint64,
+	limit uint64,
+) ([]types.MetadataAddress, string, error) {
+	var scopeIDs []types.MetadataAddress
+	key := startDenom
+	if len(key) == 0 {
+		key = types.ScopeTokenDenomPrefix
+	}
+	for {
+		// The bank balances of an account are keyed by denom, so this only reads balances from the key on.
+		res, err := k.bankKeeper.AllBalances(sdk.WrapSDKContext(ctx), &banktypes.QueryAllBalancesRequest{
+			Address:    addr.String(),
+			Pagination: &query.PageRequest{Key: []byte(key), Limit: limit + 1},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, coin := range res.Balances {
+			if !strings.HasPrefix(coin.Denom, types.ScopeTokenDenomPrefix) {
+				return scopeIDs, "", nil
+			}
+			scopeID, isScopeToken := types.ParseScopeTokenDenom(coin.Denom)
+			if !isScopeToken || !coin.IsPositive() {
+				continue
+			}
+			if scope, found := k.GetScope(ctx, scopeID); !found || !scope.IsTokenized() {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			if uint64(len(scopeIDs)) == limit {
+				return scopeIDs, coin.Denom, nil
+			}
+			scopeIDs = append(scopeIDs, scopeID)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return scopeIDs, "", nil
+		}
+		key = string(res.Pagination.NextKey)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -31,12 +32,23 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 
 	var event proto.Message = types.NewEventSessionCreated(session.SessionId)
 	action := types.TLAction_Created
-	if store.Has(session.SessionId) {
+	historyAction := types.HistoryAction_Created
+	var oldSpecID types.MetadataAddress
+	oldSessionBytes := store.Get(session.SessionId)
+	if oldSessionBytes != nil {
 		event = types.NewEventSessionUpdated(session.SessionId)
 		action = types.TLAction_Updated
+		historyAction = types.HistoryAction_Updated
+		var oldSession types.Session
+		if err := k.cdc.Unmarshal(oldSessionBytes, &oldSession); err == nil {
+			oldSpecID = oldSession.SpecificationId
+			event = types.NewEventSessionUpdatedWithDiff(oldSession, session)
+		}
 	}
 
 	store.Set(session.SessionId, b)
+	k.indexSessionSpec(store, session.SessionId, oldSpecID, session.SpecificationId)
+	k.recordHistory(ctx, session.SessionId, historyAction, oldSessionBytes)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Session, action)
 }
@@ -46,17 +58,38 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 	if !id.IsSessionAddress() {
 		panic(fmt.Errorf("invalid address, address must be for a session"))
 	}
-	store := ctx.KVStore(k.storeKey)
+	if !ctx.KVStore(k.storeKey).Has(id) || k.sessionHasRecords(ctx, id) {
+		return
+	}
+	k.removeSession(ctx, id)
+}
 
-	if !store.Has(id) || k.sessionHasRecords(ctx, id) {
+// removeSession removes a session and its index entries without checking for records that are still in it.
+func (k Keeper) removeSession(ctx sdk.Context, id types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	oldSessionBytes := store.Get(id)
+	if oldSessionBytes == nil {
 		return
 	}
 
+	k.recordHistory(ctx, id, types.HistoryAction_Deleted, oldSessionBytes)
+	var oldSession types.Session
+	if err := k.cdc.Unmarshal(oldSessionBytes, &oldSession); err == nil {
+		k.indexSessionSpec(store, id, oldSession.SpecificationId, nil)
+	}
 	store.Delete(id)
+	k.removeAttributes(ctx, id)
 	k.EmitEvent(ctx, types.NewEventSessionDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Session, types.TLAction_Deleted)
 }
 
+// indexSessionSpec updates the contract spec index entry and usage counts for a session whose spec is changing.
+func (k Keeper) indexSessionSpec(store sdk.KVStore, sessionID, oldSpecID, newSpecID types.MetadataAddress) {
+	updateSpecUsage(store, oldSpecID, newSpecID, func(specID types.MetadataAddress) []byte {
+		return types.GetContractSpecSessionCacheKey(specID, sessionID)
+	})
+}
+
 func (k Keeper) sessionHasRecords(ctx sdk.Context, id types.MetadataAddress) bool {
 	if !id.IsSessionAddress() {
 		return false
@@ -104,11 +137,43 @@ func (k Keeper) IterateSessions(ctx sdk.Context, scopeID types.MetadataAddress,
 
 // ValidateSessionUpdate checks the current session and the proposed session to determine if the the proposed changes are valid
 // based on the existing state
-func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed *types.Session, signers []string, msgTypeURL string) error {
+func (k Keeper) ValidateSessionUpdate(
+	ctx sdk.Context,
+	existing, proposed *types.Session,
+	signers []string,
+	expectedAuditVersion uint32,
+	msgTypeURL string,
+) error {
 	if err := proposed.ValidateBasic(); err != nil {
 		return err
 	}
 
+	if expectedAuditVersion != 0 {
+		if existing == nil {
+			return sdkerrors.Wrapf(types.ErrConcurrentUpdate, "session %s does not exist, but audit version %d was expected",
+				proposed.SessionId, expectedAuditVersion)
+		}
+		var version uint32
+		if existing.Audit != nil {
+			version = existing.Audit.Version
+		}
+		if version != expectedAuditVersion {
+			return sdkerrors.Wrapf(types.ErrConcurrentUpdate, "session %s audit version %d does not equal the expected audit version %d",
+				proposed.SessionId, version, expectedAuditVersion)
+		}
+	}
+
+	var existingPartyCount, existingContextLength int
+	if existing != nil {
+		existingPartyCount, existingContextLength = len(existing.Parties), len(existing.Context)
+	}
+	if err := validateLimit("session party count", k.GetMaxSessionParties(ctx), existingPartyCount, len(proposed.Parties)); err != nil {
+		return err
+	}
+	if err := validateLimit("session context length", k.GetMaxSessionContextBytes(ctx), existingContextLength, len(proposed.Context)); err != nil {
+		return err
+	}
+
 	if existing != nil {
 		if !proposed.SessionId.Equals(existing.SessionId) {
 			return fmt.Errorf("cannot update session identifier. expected %s, got %s", existing.SessionId, proposed.SessionId)
@@ -132,6 +197,9 @@ func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed *types
 	if !found {
 		return fmt.Errorf("scope not found for scope id %s", scopeID)
 	}
+	if err := k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
 
 	contractSpec, found := k.GetContractSpecification(ctx, proposed.SpecificationId)
 	if !found {
@@ -155,12 +155,109 @@ func (k Keeper) ValidateRecordSpecUpdate(ctx sdk.Context, existing *types.Record
 		}
 	}
 
+	// Record specs are part of their contract spec, so they can't be added or changed once it is in use.
+	if existing == nil || !k.isUnchanged(existing, &proposed) {
+		if contractSpecID, err := proposed.SpecificationId.AsContractSpecAddress(); err == nil {
+			if err = k.validateContractSpecChangeable(ctx, contractSpecID); err != nil {
+				return err
+			}
+		}
+		if err := k.validateRecordSpecTypesRegistered(ctx, proposed); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// isRecordSpecUsed checks to see if any records were created from a record spec.
 func (k Keeper) isRecordSpecUsed(ctx sdk.Context, recordSpecID types.MetadataAddress) bool {
-	// TODO: Check for records created from this spec.
-	return false
+	return k.GetSpecUsageCount(ctx, recordSpecID) > 0
+}
+
+// GetSpecUsageCount gets the number of records using a record spec, or, for a contract spec,
+// the number of sessions using it plus the number of records using one of its record specs.
+func (k Keeper) GetSpecUsageCount(ctx sdk.Context, specID types.MetadataAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSpecUsageCountKey(specID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// updateSpecUsage moves an object's spec index entry from the old spec to the new one, updating both usage counts.
+// The indexKey function should return the index key for the object under the given spec id.
+// Empty spec ids are ignored, so this can also be used for objects being created or deleted.
+func updateSpecUsage(store sdk.KVStore, oldSpecID, newSpecID types.MetadataAddress, indexKey func(specID types.MetadataAddress) []byte) {
+	if oldSpecID.Equals(newSpecID) {
+		return
+	}
+	if !oldSpecID.Empty() {
+		key := indexKey(oldSpecID)
+		if store.Has(key) {
+			store.Delete(key)
+			addToSpecUsageCount(store, oldSpecID, -1)
+		}
+	}
+	if !newSpecID.Empty() {
+		key := indexKey(newSpecID)
+		if !store.Has(key) {
+			store.Set(key, []byte{0x01})
+			addToSpecUsageCount(store, newSpecID, 1)
+		}
+	}
+}
+
+// addToSpecUsageCount adjusts the usage count of a spec by the given amount, deleting it once it gets to zero.
+// A record spec's usage also counts towards the contract spec it belongs to.
+func addToSpecUsageCount(store sdk.KVStore, specID types.MetadataAddress, amount int64) {
+	if specID.IsRecordSpecificationAddress() {
+		if contractSpecID, err := specID.AsContractSpecAddress(); err == nil {
+			adjustSpecUsageCount(store, contractSpecID, amount)
+		}
+	}
+	adjustSpecUsageCount(store, specID, amount)
+}
+
+// adjustSpecUsageCount adjusts the stored usage count of a single spec by the given amount, deleting it once it gets to zero.
+func adjustSpecUsageCount(store sdk.KVStore, specID types.MetadataAddress, amount int64) {
+	key := types.GetSpecUsageCountKey(specID)
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	if amount < 0 && uint64(-amount) >= count {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(int64(count)+amount)))
+}
+
+// IterateSessionsForContractSpec processes the ids of all sessions created from a contract spec using a given handler.
+func (k Keeper) IterateSessionsForContractSpec(ctx sdk.Context, contractSpecID types.MetadataAddress, handler func(sessionID types.MetadataAddress) (stop bool)) error {
+	return k.iterateSpecUsageIndex(ctx, types.GetContractSpecSessionCacheIteratorPrefix(contractSpecID), handler)
+}
+
+// IterateRecordsForRecordSpec processes the ids of all records created from a record spec using a given handler.
+func (k Keeper) IterateRecordsForRecordSpec(ctx sdk.Context, recordSpecID types.MetadataAddress, handler func(recordID types.MetadataAddress) (stop bool)) error {
+	return k.iterateSpecUsageIndex(ctx, types.GetRecordSpecRecordCacheIteratorPrefix(recordSpecID), handler)
+}
+
+// iterateSpecUsageIndex processes the metadata addresses at the end of the index keys with the given prefix.
+func (k Keeper) iterateSpecUsageIndex(ctx sdk.Context, prefix []byte, handler func(id types.MetadataAddress) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var id types.MetadataAddress
+		if err := id.Unmarshal(it.Key()[len(prefix):]); err != nil {
+			return err
+		}
+		if handler(id) {
+			break
+		}
+	}
+	return nil
 }
 
 // IterateContractSpecs processes all contract specs using a given handler.
@@ -244,6 +341,9 @@ func (k Keeper) RemoveContractSpecification(ctx sdk.Context, contractSpecID type
 	if k.isContractSpecUsed(ctx, contractSpecID) {
 		return fmt.Errorf("contract specification with id %s still in use", contractSpecID)
 	}
+	if err := k.validateSpecHasNoNewerVersion(ctx, contractSpecID); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 
@@ -261,8 +361,9 @@ func (k Keeper) RemoveContractSpecification(ctx sdk.Context, contractSpecID type
 
 // contractSpecIndexValues is a struct containing the values used to index a contract specification.
 type contractSpecIndexValues struct {
-	SpecificationID types.MetadataAddress
-	OwnerAddresses  []string
+	SpecificationID   types.MetadataAddress
+	OwnerAddresses    []string
+	PreviousVersionID types.MetadataAddress
 }
 
 // getContractSpecIndexValues extracts the values used to index a contract specification.
@@ -271,8 +372,9 @@ func getContractSpecIndexValues(spec *types.ContractSpecification) *contractSpec
 		return nil
 	}
 	return &contractSpecIndexValues{
-		SpecificationID: spec.SpecificationId,
-		OwnerAddresses:  spec.OwnerAddresses,
+		SpecificationID:   spec.SpecificationId,
+		OwnerAddresses:    spec.OwnerAddresses,
+		PreviousVersionID: spec.PreviousVersionId,
 	}
 }
 
@@ -287,6 +389,9 @@ func getMissingContractSpecIndexValues(required, found *contractSpecIndexValues)
 	}
 	rv.SpecificationID = required.SpecificationID
 	rv.OwnerAddresses = FindMissing(required.OwnerAddresses, found.OwnerAddresses)
+	if !required.PreviousVersionID.Equals(found.PreviousVersionID) {
+		rv.PreviousVersionID = required.PreviousVersionID
+	}
 	return rv
 }
 
@@ -301,6 +406,9 @@ func (v contractSpecIndexValues) IndexKeys() [][]byte {
 			rv = append(rv, types.GetAddressContractSpecCacheKey(addr, v.SpecificationID))
 		}
 	}
+	if !v.PreviousVersionID.Empty() {
+		rv = append(rv, types.GetSpecVersionCacheKey(v.PreviousVersionID, v.SpecificationID))
+	}
 	return rv
 }
 
@@ -347,16 +455,8 @@ func (k Keeper) isContractSpecUsed(ctx sdk.Context, contractSpecID types.Metadat
 		return true
 	}
 
-	// TODO: Look for sessions used by this contractSpecID.
-
-	// Look for a used record spec that is part of this contract spec
-	hasUsedRecordSpec := false
-	itRecSpecErr := k.IterateRecordSpecsForContractSpec(ctx, contractSpecID, func(recordSpecID types.MetadataAddress) bool {
-		hasUsedRecordSpec = k.isRecordSpecUsed(ctx, recordSpecID)
-		return hasUsedRecordSpec
-	})
-
-	return itRecSpecErr != nil || hasUsedRecordSpec
+	// The usage count includes the records using any of this contract spec's record specs.
+	return k.GetSpecUsageCount(ctx, contractSpecID) > 0
 }
 
 // ValidateContractSpecUpdate full validation of a proposed contract spec possibly against an existing one.
@@ -372,7 +472,16 @@ func (k Keeper) ValidateContractSpecUpdate(ctx sdk.Context, existing *types.Cont
 		return err
 	}
 
-	return nil
+	var existingPrevious *types.MetadataAddress
+	if existing != nil {
+		existingPrevious = &existing.PreviousVersionId
+		if !k.isUnchanged(existing, &proposed) {
+			if err := k.validateContractSpecChangeable(ctx, existing.SpecificationId); err != nil {
+				return err
+			}
+		}
+	}
+	return k.validateSpecVersion(ctx, proposed.SpecificationId, existingPrevious, proposed.PreviousVersionId)
 }
 
 // IterateScopeSpecs processes all scope specs using a given handler.
@@ -474,6 +583,9 @@ func (k Keeper) RemoveScopeSpecification(ctx sdk.Context, scopeSpecID types.Meta
 	if k.isScopeSpecUsed(ctx, scopeSpecID) {
 		return fmt.Errorf("scope specification with id %s still in use", scopeSpecID)
 	}
+	if err := k.validateSpecHasNoNewerVersion(ctx, scopeSpecID); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 
@@ -491,9 +603,10 @@ func (k Keeper) RemoveScopeSpecification(ctx sdk.Context, scopeSpecID types.Meta
 
 // scopeSpecIndexValues is a struct containing the values used to index a scope specification.
 type scopeSpecIndexValues struct {
-	SpecificationID types.MetadataAddress
-	OwnerAddresses  []string
-	ContractSpecIDs []types.MetadataAddress
+	SpecificationID   types.MetadataAddress
+	OwnerAddresses    []string
+	ContractSpecIDs   []types.MetadataAddress
+	PreviousVersionID types.MetadataAddress
 }
 
 // getScopeSpecIndexValues extracts the values used to index a scope specification.
@@ -502,9 +615,10 @@ func getScopeSpecIndexValues(spec *types.ScopeSpecification) *scopeSpecIndexValu
 		return nil
 	}
 	return &scopeSpecIndexValues{
-		SpecificationID: spec.SpecificationId,
-		OwnerAddresses:  spec.OwnerAddresses,
-		ContractSpecIDs: spec.ContractSpecIds,
+		SpecificationID:   spec.SpecificationId,
+		OwnerAddresses:    spec.OwnerAddresses,
+		ContractSpecIDs:   spec.ContractSpecIds,
+		PreviousVersionID: spec.PreviousVersionId,
 	}
 }
 
@@ -520,6 +634,9 @@ func getMissingScopeSpecIndexValues(required, found *scopeSpecIndexValues) scope
 	rv.SpecificationID = required.SpecificationID
 	rv.OwnerAddresses = FindMissing(required.OwnerAddresses, found.OwnerAddresses)
 	rv.ContractSpecIDs = FindMissingMdAddr(required.ContractSpecIDs, found.ContractSpecIDs)
+	if !required.PreviousVersionID.Equals(found.PreviousVersionID) {
+		rv.PreviousVersionID = required.PreviousVersionID
+	}
 	return rv
 }
 
@@ -537,6 +654,9 @@ func (v scopeSpecIndexValues) IndexKeys() [][]byte {
 	for _, specID := range v.ContractSpecIDs {
 		rv = append(rv, types.GetContractSpecScopeSpecCacheKey(specID, v.SpecificationID))
 	}
+	if !v.PreviousVersionID.Empty() {
+		rv = append(rv, types.GetSpecVersionCacheKey(v.PreviousVersionID, v.SpecificationID))
+	}
 	return rv
 }
 
@@ -595,6 +715,19 @@ func (k Keeper) ValidateScopeSpecUpdate(ctx sdk.Context, existing *types.ScopeSp
 		return err
 	}
 
+	var existingPrevious *types.MetadataAddress
+	if existing != nil {
+		existingPrevious = &existing.PreviousVersionId
+		if !k.isUnchanged(existing, &proposed) {
+			if err := k.validateScopeSpecChangeable(ctx, existing.SpecificationId); err != nil {
+				return err
+			}
+		}
+	}
+	if err := k.validateSpecVersion(ctx, proposed.SpecificationId, existingPrevious, proposed.PreviousVersionId); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 
 	// Validate the proposed contract spec ids.
@@ -186,6 +186,119 @@ func (s *SpecKeeperTestSuite) TestGetSetRemoveRecordSpecification() {
 	)
 }
 
+func (s *SpecKeeperTestSuite) TestRemoveSpecificationsInUse() {
+	recordName := "record name"
+	recSpecID := s.contractSpecID1.MustGetAsRecordSpecAddress(recordName)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(
+		s.contractSpecID1, types.NewDescription("name", "description", "", ""), []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("hash"), "class",
+	))
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		recSpecID, recordName, []*types.InputSpecification{}, "type name",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+
+	scopeUUID := uuid.New()
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	recordID := sessionID.MustGetAsRecordAddress(recordName)
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", sessionID, s.contractSpecID1, ownerPartyList(s.user1), nil))
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord(recordName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, recSpecID))
+
+	err := s.app.MetadataKeeper.RemoveRecordSpecification(s.ctx, recSpecID)
+	s.EqualError(err, fmt.Sprintf("record specification with id %s still in use", recSpecID), "removing a used record spec")
+	err = s.app.MetadataKeeper.RemoveContractSpecification(s.ctx, s.contractSpecID1)
+	s.EqualError(err, fmt.Sprintf("contract specification with id %s still in use", s.contractSpecID1), "removing a used contract spec")
+
+	// Removing the record also removes the now empty session, so neither spec is in use anymore.
+	s.app.MetadataKeeper.RemoveRecord(s.ctx, recordID)
+	s.Zero(s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, recSpecID), "record spec usage count")
+	s.Zero(s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, s.contractSpecID1), "contract spec usage count")
+	s.NoError(s.app.MetadataKeeper.RemoveRecordSpecification(s.ctx, recSpecID), "removing an unused record spec")
+	s.NoError(s.app.MetadataKeeper.RemoveContractSpecification(s.ctx, s.contractSpecID1), "removing an unused contract spec")
+}
+
+func (s *SpecKeeperTestSuite) TestContractSpecUsedOnlyByRecords() {
+	recordName := "record name"
+	recSpecID := s.contractSpecID1.MustGetAsRecordSpecAddress(recordName)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(
+		s.contractSpecID1, types.NewDescription("name", "description", "", ""), []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("hash"), "class",
+	))
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		recSpecID, recordName, []*types.InputSpecification{}, "type name",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+
+	// The session uses a different contract spec, so only the record counts towards the first one.
+	sessionID := types.SessionMetadataAddress(uuid.New(), uuid.New())
+	recordID := sessionID.MustGetAsRecordAddress(recordName)
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", sessionID, s.contractSpecID2, ownerPartyList(s.user1), nil))
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord(recordName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, recSpecID))
+
+	s.Equal(uint64(1), s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, s.contractSpecID1), "contract spec usage count")
+	err := s.app.MetadataKeeper.RemoveContractSpecification(s.ctx, s.contractSpecID1)
+	s.EqualError(err, fmt.Sprintf("contract specification with id %s still in use", s.contractSpecID1), "removing a contract spec used by a record")
+
+	s.app.MetadataKeeper.RemoveRecord(s.ctx, recordID)
+	s.Zero(s.app.MetadataKeeper.GetSpecUsageCount(s.ctx, s.contractSpecID1), "contract spec usage count after removing the record")
+}
+
+func (s *SpecKeeperTestSuite) TestSpecsInUseAreImmutable() {
+	recordName := "record name"
+	recSpecID := s.contractSpecID1.MustGetAsRecordSpecAddress(recordName)
+	contractSpec := *types.NewContractSpecification(
+		s.contractSpecID1, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		types.NewContractSpecificationSourceHash("hash"), "class",
+	)
+	recSpec := *types.NewRecordSpecification(
+		recSpecID, recordName, []*types.InputSpecification{}, "type name",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, contractSpec)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, recSpec)
+
+	changedRecSpec := recSpec
+	changedRecSpec.TypeName = "new type name"
+	newRecSpec := *types.NewRecordSpecification(
+		s.contractSpecID1.MustGetAsRecordSpecAddress("other"), "other", []*types.InputSpecification{}, "type name",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	)
+	s.NoError(s.app.MetadataKeeper.ValidateRecordSpecUpdate(s.ctx, &recSpec, changedRecSpec), "changing an unused record spec")
+
+	sessionID := types.SessionMetadataAddress(uuid.New(), uuid.New())
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", sessionID, s.contractSpecID1, ownerPartyList(s.user1), nil))
+	s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord(recordName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, recSpecID))
+
+	inUseErr := fmt.Sprintf("contract specification %s is in use and cannot be changed, write a new version of it instead", s.contractSpecID1)
+	s.NoError(s.app.MetadataKeeper.ValidateRecordSpecUpdate(s.ctx, &recSpec, recSpec), "rewriting a used record spec without changes")
+	s.EqualError(s.app.MetadataKeeper.ValidateRecordSpecUpdate(s.ctx, &recSpec, changedRecSpec), inUseErr, "changing a used record spec")
+	s.EqualError(s.app.MetadataKeeper.ValidateRecordSpecUpdate(s.ctx, nil, newRecSpec), inUseErr, "adding a record spec to a used contract spec")
+	s.NoError(s.app.MetadataKeeper.ValidateContractSpecUpdate(s.ctx, &contractSpec, contractSpec), "rewriting a used contract spec without changes")
+
+	// Versions can be looked up from any spec in the lineage.
+	contractSpecV2 := contractSpec
+	contractSpecV2.SpecificationId = s.contractSpecID2
+	contractSpecV2.PreviousVersionId = s.contractSpecID1
+	s.NoError(s.app.MetadataKeeper.ValidateContractSpecUpdate(s.ctx, nil, contractSpecV2), "writing a new contract spec version")
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, contractSpecV2)
+	for _, specID := range []types.MetadataAddress{s.contractSpecID1, s.contractSpecID2} {
+		lineage, err := s.app.MetadataKeeper.GetSpecVersionLineage(s.ctx, specID)
+		s.NoError(err, "GetSpecVersionLineage(%s)", specID)
+		s.Equal([]types.MetadataAddress{s.contractSpecID1, s.contractSpecID2}, lineage, "GetSpecVersionLineage(%s)", specID)
+	}
+	newer, found := s.app.MetadataKeeper.GetNewerSpecVersion(s.ctx, s.contractSpecID1)
+	s.True(found, "GetNewerSpecVersion found")
+	s.Equal(s.contractSpecID2, newer, "GetNewerSpecVersion")
+
+	// Removing the newer version frees up the older one to get a different newer version.
+	s.NoError(s.app.MetadataKeeper.RemoveContractSpecification(s.ctx, s.contractSpecID2), "removing the newest contract spec version")
+	_, found = s.app.MetadataKeeper.GetNewerSpecVersion(s.ctx, s.contractSpecID1)
+	s.False(found, "GetNewerSpecVersion found after removing the newer version")
+}
+
 func (s *SpecKeeperTestSuite) TestIterateRecordSpecs() {
 	size := 10
 	specs := make([]*types.RecordSpecification, size)
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetNewerSpecVersion gets the id of the scope or contract spec that is the next version of the provided one.
+func (k Keeper) GetNewerSpecVersion(ctx sdk.Context, specID types.MetadataAddress) (newerID types.MetadataAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetSpecVersionCacheIteratorPrefix(specID)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	if !it.Valid() {
+		return nil, false
+	}
+	if err := newerID.Unmarshal(it.Key()[len(prefix):]); err != nil {
+		k.Logger(ctx).Error("could not unmarshal spec version index key", "key", it.Key(), "error", err)
+		return nil, false
+	}
+	return newerID, true
+}
+
+// getPreviousSpecVersion gets the id of the spec that the provided scope or contract spec is a newer version of.
+func (k Keeper) getPreviousSpecVersion(ctx sdk.Context, specID types.MetadataAddress) (types.MetadataAddress, bool) {
+	switch {
+	case specID.IsScopeSpecificationAddress():
+		spec, found := k.GetScopeSpecification(ctx, specID)
+		return spec.PreviousVersionId, found
+	case specID.IsContractSpecificationAddress():
+		spec, found := k.GetContractSpecification(ctx, specID)
+		return spec.PreviousVersionId, found
+	}
+	return nil, false
+}
+
+// GetSpecVersionLineage gets the ids of all versions of a scope or contract spec, ordered from oldest to newest.
+// The provided specID can be the id of any version in the lineage.
+func (k Keeper) GetSpecVersionLineage(ctx sdk.Context, specID types.MetadataAddress) ([]types.MetadataAddress, error) {
+	if !specID.IsScopeSpecificationAddress() && !specID.IsContractSpecificationAddress() {
+		return nil, fmt.Errorf("invalid specification id: %s is not a scope or contract specification id", specID)
+	}
+	previous, found := k.getPreviousSpecVersion(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("specification %s not found", specID)
+	}
+
+	// The lineage cannot be cyclic (a spec's previous version must exist before it does), but don't trust that blindly.
+	seen := map[string]bool{string(specID): true}
+	older := []types.MetadataAddress{}
+	for !previous.Empty() && !seen[string(previous)] {
+		seen[string(previous)] = true
+		older = append(older, previous)
+		if previous, found = k.getPreviousSpecVersion(ctx, previous); !found {
+			break
+		}
+	}
+
+	rv := make([]types.MetadataAddress, 0, len(older)+1)
+	for i := len(older) - 1; i >= 0; i-- {
+		rv = append(rv, older[i])
+	}
+	rv = append(rv, specID)
+	for newer, hasNewer := k.GetNewerSpecVersion(ctx, specID); hasNewer && !seen[string(newer)]; newer, hasNewer = k.GetNewerSpecVersion(ctx, newer) {
+		seen[string(newer)] = true
+		rv = append(rv, newer)
+	}
+	return rv, nil
+}
+
+// isNewerSpecVersion returns true if the candidate spec is a later version of the base spec.
+func (k Keeper) isNewerSpecVersion(ctx sdk.Context, baseID, candidateID types.MetadataAddress) bool {
+	seen := map[string]bool{string(baseID): true}
+	for newer, found := k.GetNewerSpecVersion(ctx, baseID); found && !seen[string(newer)]; newer, found = k.GetNewerSpecVersion(ctx, newer) {
+		if newer.Equals(candidateID) {
+			return true
+		}
+		seen[string(newer)] = true
+	}
+	return false
+}
+
+// validateSpecVersion checks the previous version of a scope or contract spec being written.
+// The existingPrevious should be nil if the spec doesn't exist yet.
+func (k Keeper) validateSpecVersion(ctx sdk.Context, specID types.MetadataAddress, existingPrevious *types.MetadataAddress, proposedPrevious types.MetadataAddress) error {
+	if existingPrevious != nil {
+		if !proposedPrevious.Equals(*existingPrevious) {
+			return fmt.Errorf("cannot change the previous version of specification %s", specID)
+		}
+		return nil
+	}
+	if proposedPrevious.Empty() {
+		return nil
+	}
+	if !ctx.KVStore(k.storeKey).Has(proposedPrevious) {
+		return fmt.Errorf("previous version %s of specification %s not found", proposedPrevious, specID)
+	}
+	if newer, found := k.GetNewerSpecVersion(ctx, proposedPrevious); found && !newer.Equals(specID) {
+		return fmt.Errorf("specification %s already has a newer version %s", proposedPrevious, newer)
+	}
+	return nil
+}
+
+// validateSpecHasNoNewerVersion returns an error if the scope or contract spec has a newer version.
+// Removing such a spec would break the lineage of the versions after it.
+func (k Keeper) validateSpecHasNoNewerVersion(ctx sdk.Context, specID types.MetadataAddress) error {
+	if newer, found := k.GetNewerSpecVersion(ctx, specID); found {
+		return fmt.Errorf("specification %s has a newer version %s", specID, newer)
+	}
+	return nil
+}
+
+// validateScopeSpecChangeable returns an error if a scope spec is being used by scopes.
+func (k Keeper) validateScopeSpecChangeable(ctx sdk.Context, scopeSpecID types.MetadataAddress) error {
+	if k.isScopeSpecUsed(ctx, scopeSpecID) {
+		return fmt.Errorf("scope specification %s is in use and cannot be changed, write a new version of it instead", scopeSpecID)
+	}
+	return nil
+}
+
+// validateContractSpecChangeable returns an error if a contract spec (or one of its record specs) is in use.
+func (k Keeper) validateContractSpecChangeable(ctx sdk.Context, contractSpecID types.MetadataAddress) error {
+	if k.isContractSpecInUse(ctx, contractSpecID) {
+		return fmt.Errorf("contract specification %s is in use and cannot be changed, write a new version of it instead", contractSpecID)
+	}
+	return nil
+}
+
+// isUnchanged returns true if the proposed value is encoded exactly the same as the existing one.
+func (k Keeper) isUnchanged(existing, proposed codec.ProtoMarshaler) bool {
+	return bytes.Equal(k.cdc.MustMarshal(existing), k.cdc.MustMarshal(proposed))
+}
+
+// isContractSpecInUse returns true if any sessions were created from a contract spec,
+// or any records were created from one of its record specs.
+// A contract spec that is in use (along with its record specs) cannot be changed.
+func (k Keeper) isContractSpecInUse(ctx sdk.Context, contractSpecID types.MetadataAddress) bool {
+	return k.GetSpecUsageCount(ctx, contractSpecID) > 0
+}
+
+// ValidateScopeSpecMigration checks that a scope can be moved to a newer version of its scope spec.
+// The new spec must be a later version of the scope's current spec, the scope's owners must still satisfy it,
+// and all of the scope's sessions and records must use one of its contract specs (or an older version of one).
+func (k Keeper) ValidateScopeSpecMigration(
+	ctx sdk.Context,
+	scopeID types.MetadataAddress,
+	specID types.MetadataAddress,
+	signers []string,
+	msgTypeURL string,
+) error {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	newSpec, found := k.GetScopeSpecification(ctx, specID)
+	if !found {
+		return fmt.Errorf("scope specification %s not found", specID)
+	}
+	if !k.isNewerSpecVersion(ctx, scope.SpecificationId, specID) {
+		return fmt.Errorf("scope specification %s is not a newer version of %s", specID, scope.SpecificationId)
+	}
+
+	proposed := scope
+	proposed.SpecificationId = specID
+	if err := k.ValidateScopeUpdate(ctx, scope, proposed, signers, nil, msgTypeURL); err != nil {
+		return err
+	}
+
+	// Sessions (and their records) created from an older version of an allowed contract spec still satisfy the new spec.
+	isAllowed := func(contractSpecID types.MetadataAddress) bool {
+		for _, allowedID := range newSpec.ContractSpecIds {
+			if allowedID.Equals(contractSpecID) || k.isNewerSpecVersion(ctx, contractSpecID, allowedID) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var usageErr error
+	err := k.IterateSessions(ctx, scopeID, func(session types.Session) (stop bool) {
+		if !isAllowed(session.SpecificationId) {
+			usageErr = fmt.Errorf("session %s uses contract specification %s which is not allowed by scope specification %s",
+				session.SessionId, session.SpecificationId, specID)
+		}
+		return usageErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	if usageErr != nil {
+		return usageErr
+	}
+	err = k.IterateRecords(ctx, scopeID, func(record types.Record) (stop bool) {
+		if record.SpecificationId.Empty() {
+			return false
+		}
+		contractSpecID, csErr := record.SpecificationId.AsContractSpecAddress()
+		if csErr != nil || !isAllowed(contractSpecID) {
+			usageErr = fmt.Errorf("record %s uses record specification %s which is not allowed by scope specification %s",
+				record.Name, record.SpecificationId, specID)
+		}
+		return usageErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	return usageErr
+}
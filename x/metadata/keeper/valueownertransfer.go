@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetValueOwnerTransferOffer returns the offer to transfer a scope's value ownership to a buyer.
+func (k Keeper) GetValueOwnerTransferOffer(ctx sdk.Context, scopeID types.MetadataAddress, buyer string) (offer types.ValueOwnerTransferOffer, found bool) {
+	buyerAddr, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil || !scopeID.IsScopeAddress() {
+		return offer, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValueOwnerTransferOfferKey(scopeID, buyerAddr))
+	if b == nil {
+		return types.ValueOwnerTransferOffer{}, false
+	}
+	k.cdc.MustUnmarshal(b, &offer)
+	return offer, true
+}
+
+// SetValueOwnerTransferOffer stores an offer to transfer a scope's value ownership, replacing any existing offer
+// to the same buyer.
+func (k Keeper) SetValueOwnerTransferOffer(ctx sdk.Context, offer types.ValueOwnerTransferOffer) {
+	sellerAddr, err := sdk.AccAddressFromBech32(offer.Seller)
+	if err != nil {
+		panic(err)
+	}
+	buyerAddr, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	k.RemoveValueOwnerTransferOffer(ctx, offer.ScopeId, offer.Buyer)
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValueOwnerTransferOfferKey(offer.ScopeId, buyerAddr)
+	store.Set(key, k.cdc.MustMarshal(&offer))
+	store.Set(types.GetAddressValueOwnerTransferOfferCacheKey(sellerAddr, offer.ScopeId, buyerAddr), []byte{0x01})
+	store.Set(types.GetAddressValueOwnerTransferOfferCacheKey(buyerAddr, offer.ScopeId, buyerAddr), []byte{0x01})
+	store.Set(types.GetValueOwnerTransferExpirationCacheKey(offer.Expiration, offer.ScopeId, buyerAddr), key)
+}
+
+// RemoveValueOwnerTransferOffer removes the offer to transfer a scope's value ownership to a buyer.
+func (k Keeper) RemoveValueOwnerTransferOffer(ctx sdk.Context, scopeID types.MetadataAddress, buyer string) {
+	offer, found := k.GetValueOwnerTransferOffer(ctx, scopeID, buyer)
+	if !found {
+		return
+	}
+	buyerAddr, _ := sdk.AccAddressFromBech32(buyer)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValueOwnerTransferOfferKey(scopeID, buyerAddr))
+	if sellerAddr, err := sdk.AccAddressFromBech32(offer.Seller); err == nil {
+		store.Delete(types.GetAddressValueOwnerTransferOfferCacheKey(sellerAddr, scopeID, buyerAddr))
+	}
+	store.Delete(types.GetAddressValueOwnerTransferOfferCacheKey(buyerAddr, scopeID, buyerAddr))
+	store.Delete(types.GetValueOwnerTransferExpirationCacheKey(offer.Expiration, scopeID, buyerAddr))
+}
+
+// IterateValueOwnerTransferOffers processes the stored value owner transfer offers of a scope with the given handler.
+// If the scope id is empty, all stored offers are processed.
+func (k Keeper) IterateValueOwnerTransferOffers(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.ValueOwnerTransferOffer) (stop bool)) error {
+	prefix := types.ValueOwnerTransferOfferKeyPrefix
+	if !scopeID.Empty() {
+		prefix = types.GetValueOwnerTransferOfferIteratorPrefix(scopeID)
+	}
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var offer types.ValueOwnerTransferOffer
+		if err := k.cdc.Unmarshal(it.Value(), &offer); err != nil {
+			k.Logger(ctx).Error("could not unmarshal value owner transfer offer", "key", it.Key(), "error", err)
+		} else if handler(offer) {
+			break
+		}
+	}
+	return nil
+}
+
+// HasValueOwnerTransferOffers returns true if there are any value owner transfer offers stored for a scope.
+// Expired offers that have not been pruned yet are included.
+func (k Keeper) HasValueOwnerTransferOffers(ctx sdk.Context, scopeID types.MetadataAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.GetValueOwnerTransferOfferIteratorPrefix(scopeID))
+	defer it.Close()
+	return it.Valid()
+}
+
+// validateScopeNoValueOwnerTransferOffers returns an error if a scope's value ownership is being held for open offers.
+func (k Keeper) validateScopeNoValueOwnerTransferOffers(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	if k.HasValueOwnerTransferOffers(ctx, scopeID) {
+		return fmt.Errorf("scope %s has open value owner transfer offers; they must be accepted or cancelled first", scopeID)
+	}
+	return nil
+}
+
+// RemoveValueOwnerTransferOffers removes all of a scope's value owner transfer offers.
+func (k Keeper) RemoveValueOwnerTransferOffers(ctx sdk.Context, scopeID types.MetadataAddress) []types.ValueOwnerTransferOffer {
+	var offers []types.ValueOwnerTransferOffer
+	_ = k.IterateValueOwnerTransferOffers(ctx, scopeID, func(offer types.ValueOwnerTransferOffer) bool {
+		offers = append(offers, offer)
+		return false
+	})
+	for _, offer := range offers {
+		k.RemoveValueOwnerTransferOffer(ctx, offer.ScopeId, offer.Buyer)
+	}
+	return offers
+}
+
+// PruneExpiredValueOwnerTransferOffers removes the value owner transfer offers that have expired.
+func (k Keeper) PruneExpiredValueOwnerTransferOffers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var offerKeys [][]byte
+	it := store.Iterator(types.ValueOwnerTransferExpirationCacheKeyPrefix,
+		sdk.PrefixEndBytes(types.GetValueOwnerTransferExpirationCacheIteratorPrefix(ctx.BlockTime())))
+	for ; it.Valid(); it.Next() {
+		offerKeys = append(offerKeys, it.Value())
+	}
+	it.Close()
+
+	for _, key := range offerKeys {
+		b := store.Get(key)
+		if b == nil {
+			continue
+		}
+		var offer types.ValueOwnerTransferOffer
+		k.cdc.MustUnmarshal(b, &offer)
+		k.RemoveValueOwnerTransferOffer(ctx, offer.ScopeId, offer.Buyer)
+		k.EmitEvent(ctx, types.NewEventValueOwnerTransferCancelled(offer, types.ValueOwnerTransferReasonExpired))
+	}
+}
+
+// ValidateValueOwnerTransferOffer checks that the signers can offer a scope's value ownership to the buyer
+// for the price, and returns the offer to store.
+func (k Keeper) ValidateValueOwnerTransferOffer(
+	ctx sdk.Context,
+	msg *types.MsgOfferValueOwnerTransferRequest,
+) (types.ValueOwnerTransferOffer, error) {
+	offer := types.ValueOwnerTransferOffer{}
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return offer, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+	if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+		return offer, err
+	}
+	if scope.IsTokenized() {
+		return offer, fmt.Errorf("scope %s is tokenized; its value owner can only be changed by detokenizing it", scope.ScopeId)
+	}
+	if len(scope.ValueOwnerAddress) == 0 {
+		return offer, fmt.Errorf("scope %s does not have a value owner to transfer from", scope.ScopeId)
+	}
+	if scope.ValueOwnerAddress == msg.Buyer {
+		return offer, fmt.Errorf("buyer %s is already the value owner of scope %s", msg.Buyer, scope.ScopeId)
+	}
+	if err := k.validateScopeUpdateValueOwner(ctx, scope.ValueOwnerAddress, msg.Buyer, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return offer, err
+	}
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return offer, fmt.Errorf("offer expiration %s must be after the current block time %s",
+			msg.Expiration.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Price...); err != nil {
+		return offer, fmt.Errorf("invalid price %s: %w", msg.Price, err)
+	}
+	offer = types.ValueOwnerTransferOffer{
+		ScopeId:    scope.ScopeId,
+		Seller:     scope.ValueOwnerAddress,
+		Buyer:      msg.Buyer,
+		Price:      msg.Price,
+		Expiration: msg.Expiration,
+	}
+	return offer, offer.ValidateBasic()
+}
+
+// ValidateValueOwnerTransferAccept checks that the buyer has signed and can accept an offer for a scope's value
+// ownership at the price, and returns the offer and scope.
+func (k Keeper) ValidateValueOwnerTransferAccept(
+	ctx sdk.Context,
+	msg *types.MsgAcceptValueOwnerTransferRequest,
+) (types.ValueOwnerTransferOffer, types.Scope, error) {
+	offer, found := k.GetValueOwnerTransferOffer(ctx, msg.ScopeId, msg.Buyer)
+	if !found || offer.IsExpired(ctx.BlockTime()) {
+		return offer, types.Scope{}, fmt.Errorf("no open value owner transfer offer for scope %s to %s", msg.ScopeId, msg.Buyer)
+	}
+	if err := k.validateValueOwnerTransferParty(ctx, msg.Buyer, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return offer, types.Scope{}, err
+	}
+	// Coins.IsEqual panics if the denoms differ, so the prices are compared in both directions instead.
+	if !msg.Price.IsAllGTE(offer.Price) || !offer.Price.IsAllGTE(msg.Price) {
+		return offer, types.Scope{}, fmt.Errorf("price %s does not equal the offered price %s", msg.Price, offer.Price)
+	}
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return offer, scope, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+	if scope.ValueOwnerAddress != offer.Seller {
+		return offer, scope, fmt.Errorf("offer seller %s is no longer the value owner of scope %s", offer.Seller, scope.ScopeId)
+	}
+	if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+		return offer, scope, err
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, offer.Price...); err != nil {
+		return offer, scope, fmt.Errorf("invalid price %s: %w", offer.Price, err)
+	}
+	sellerAddr, err := sdk.AccAddressFromBech32(offer.Seller)
+	if err != nil {
+		return offer, scope, fmt.Errorf("invalid seller address %s: %w", offer.Seller, err)
+	}
+	if k.bankKeeper.BlockedAddr(sellerAddr) {
+		return offer, scope, fmt.Errorf("seller %s is not allowed to receive funds", offer.Seller)
+	}
+	return offer, scope, nil
+}
+
+// ValidateValueOwnerTransferCancel checks that the seller or buyer of an offer has signed to cancel it, and returns
+// the offer.
+func (k Keeper) ValidateValueOwnerTransferCancel(
+	ctx sdk.Context,
+	msg *types.MsgCancelValueOwnerTransferRequest,
+) (types.ValueOwnerTransferOffer, error) {
+	offer, found := k.GetValueOwnerTransferOffer(ctx, msg.ScopeId, msg.Buyer)
+	if !found {
+		return offer, fmt.Errorf("no value owner transfer offer for scope %s to %s", msg.ScopeId, msg.Buyer)
+	}
+	// The seller is checked the same way as removing a value owner so that a marker's withdraw authority is honored.
+	if k.validateValueOwnerTransferParty(ctx, offer.Buyer, msg.Signers, msg.MsgTypeURL()) != nil &&
+		k.validateScopeUpdateValueOwner(ctx, offer.Seller, "", msg.Signers, msg.MsgTypeURL()) != nil {
+		return offer, fmt.Errorf("missing signature from the seller %s or buyer %s", offer.Seller, offer.Buyer)
+	}
+	return offer, nil
+}
+
+// validateValueOwnerTransferParty returns an error if the address has not signed, either directly or through authz.
+func (k Keeper) validateValueOwnerTransferParty(ctx sdk.Context, address string, signers []string, msgTypeURL string) error {
+	for _, signer := range signers {
+		if address == signer {
+			return nil
+		}
+	}
+	if len(k.checkAuthzForMissing(ctx, []string{address}, signers, msgTypeURL)) == 0 {
+		return nil
+	}
+	return fmt.Errorf("missing signature from %s", address)
+}
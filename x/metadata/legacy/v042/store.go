@@ -50,7 +50,7 @@ func MigrateOSLocatorKeys(ctx sdk.Context, storeKey sdk.StoreKey) error {
 			return err
 		}
 
-		newStoreKey := types.GetOSLocatorKey(legacyAddress)
+		newStoreKey := types.GetOSLocatorKey(legacyAddress, "")
 
 		bz, err := types.ModuleCdc.Marshal(&osLocator)
 		if err != nil {
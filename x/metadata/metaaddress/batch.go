@@ -0,0 +1,224 @@
+package metaaddress
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// The output formats supported by an EntryWriter.
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// maxLineLength is the longest newline-delimited input line that a RowReader will read.
+const maxLineLength = 1024 * 1024
+
+// RowReader reads rows of fields from newline-delimited or CSV input.
+//
+// Newline-delimited fields are separated by whitespace, so names containing spaces require CSV input.
+// Blank lines and lines starting with # are skipped.
+type RowReader struct {
+	lines   *bufio.Scanner
+	records *csv.Reader
+	line    int
+}
+
+// NewRowReader creates a RowReader that reads CSV if csvInput is true, or newline-delimited rows otherwise.
+func NewRowReader(r io.Reader, csvInput bool) *RowReader {
+	if csvInput {
+		records := csv.NewReader(r)
+		records.FieldsPerRecord = -1
+		records.TrimLeadingSpace = true
+		records.Comment = '#'
+		return &RowReader{records: records}
+	}
+	lines := bufio.NewScanner(r)
+	lines.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+	return &RowReader{lines: lines}
+}
+
+// Read returns the fields of the next row. It returns io.EOF when there are no more rows.
+func (r *RowReader) Read() ([]string, error) {
+	for {
+		fields, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range fields {
+			if len(strings.TrimSpace(field)) > 0 {
+				return fields, nil
+			}
+		}
+	}
+}
+
+// Line returns the input line number of the row most recently read, starting at 1.
+func (r *RowReader) Line() int {
+	return r.line
+}
+
+// next reads the next row without skipping blank rows.
+func (r *RowReader) next() ([]string, error) {
+	if r.records != nil {
+		fields, err := r.records.Read()
+		if err == nil {
+			r.line, _ = r.records.FieldPos(0)
+		}
+		return fields, err
+	}
+	for r.lines.Scan() {
+		r.line++
+		line := strings.TrimSpace(r.lines.Text())
+		if !strings.HasPrefix(line, "#") {
+			return strings.Fields(line), nil
+		}
+	}
+	if err := r.lines.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// csvHeader is the first row written by an EntryWriter in CSV format.
+var csvHeader = []string{"type", "address", "primary_uuid", "secondary_uuid", "name", "name_hash_hex", "parent_address"}
+
+// EntryWriter writes entries as JSON lines (one object per line) or as CSV with a header row.
+type EntryWriter struct {
+	json          *json.Encoder
+	csv           *csv.Writer
+	headerWritten bool
+}
+
+// NewEntryWriter creates an EntryWriter for the given format, either FormatJSON or FormatCSV.
+func NewEntryWriter(w io.Writer, format string) (*EntryWriter, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case FormatJSON:
+		return &EntryWriter{json: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return &EntryWriter{csv: csv.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown format: %s, Supported formats: %s %s", format, FormatJSON, FormatCSV)
+}
+
+// Write writes each of the entries.
+func (w *EntryWriter) Write(entries ...Entry) error {
+	for _, entry := range entries {
+		if w.json != nil {
+			if err := w.json.Encode(entry); err != nil {
+				return err
+			}
+			continue
+		}
+		if !w.headerWritten {
+			if err := w.csv.Write(csvHeader); err != nil {
+				return err
+			}
+			w.headerWritten = true
+		}
+		row := []string{entry.Type, entry.Address, entry.PrimaryUUID, entry.SecondaryUUID, entry.Name, entry.NameHashHex, entry.ParentAddress}
+		if err := w.csv.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *EntryWriter) Flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// EncodeAll reads rows of "type uuid [uuid|name]" and writes the entry for each.
+func EncodeAll(r *RowReader, w *EntryWriter) error {
+	return processAll(r, w, func(fields []string) ([]Entry, error) {
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("expected 2 or 3 fields, found %d", len(fields))
+		}
+		primaryUUID, err := uuid.Parse(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, err
+		}
+		uuidOrName := ""
+		if len(fields) == 3 {
+			uuidOrName = fields[2]
+		}
+		entry, err := EncodeEntry(fields[0], primaryUUID, uuidOrName)
+		if err != nil {
+			return nil, err
+		}
+		return []Entry{entry}, nil
+	})
+}
+
+// DecodeAll reads rows of bech32 metadata addresses and writes the entry for each address.
+func DecodeAll(r *RowReader, w *EntryWriter) error {
+	return processAll(r, w, func(fields []string) ([]Entry, error) {
+		entries := make([]Entry, 0, len(fields))
+		for _, field := range fields {
+			if len(strings.TrimSpace(field)) == 0 {
+				continue
+			}
+			entry, err := Decode(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid address %q: %w", field, err)
+			}
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	})
+}
+
+// DeriveAll reads rows of "type uuid [uuid|name ...]" and writes all of the entries derived from each.
+func DeriveAll(r *RowReader, w *EntryWriter) error {
+	return processAll(r, w, func(fields []string) ([]Entry, error) {
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("expected at least 2 fields, found %d", len(fields))
+		}
+		primaryUUID, err := uuid.Parse(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, err
+		}
+		return Derive(fields[0], primaryUUID, fields[2:])
+	})
+}
+
+// processAll converts each row read from r into entries and writes them to w.
+// Processing stops at the first row that cannot be converted, but the entries of the rows before it are still written.
+func processAll(r *RowReader, w *EntryWriter, convert func(fields []string) ([]Entry, error)) error {
+	err := convertRows(r, w, convert)
+	if flushErr := w.Flush(); err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// convertRows does the reading, converting, and writing for processAll.
+func convertRows(r *RowReader, w *EntryWriter, convert func(fields []string) ([]Entry, error)) error {
+	for {
+		fields, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entries, err := convert(fields)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", r.Line(), err)
+		}
+		if err = w.Write(entries...); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Package metaaddress converts between metadata uuids and names and their bech32 MetadataAddress strings.
+//
+// It provides single conversions (Encode, Decode, Derive) as well as streaming batch conversions
+// (EncodeAll, DecodeAll, DeriveAll) that read rows with a RowReader and write entries with an EntryWriter.
+package metaaddress
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// The canonical names of each metadata address type.
+const (
+	TypeScope                 = "scope"
+	TypeSession               = "session"
+	TypeRecord                = "record"
+	TypeScopeSpecification    = "scope-specification"
+	TypeContractSpecification = "contract-specification"
+	TypeRecordSpecification   = "record-specification"
+	TypeUnknown               = "unknown"
+)
+
+// nonAlphaRx matches the characters that are ignored in a type name, e.g. "Scope-Spec" is the same as "scopespec".
+var nonAlphaRx = regexp.MustCompile("[^[:alpha:]]+")
+
+// NormalizeType converts a type name or one of its aliases into its canonical name.
+func NormalizeType(addrType string) (string, error) {
+	switch strings.ToLower(nonAlphaRx.ReplaceAllString(addrType, "")) {
+	case "scope":
+		return TypeScope, nil
+	case "session":
+		return TypeSession, nil
+	case "record":
+		return TypeRecord, nil
+	case "scopespecification", "scopespec":
+		return TypeScopeSpecification, nil
+	case "contractspecification", "contractspec", "cspec":
+		return TypeContractSpecification, nil
+	case "recordspecification", "recordspec", "recspec":
+		return TypeRecordSpecification, nil
+	}
+	return "", fmt.Errorf("unknown type: %s, Supported types: scope session record scope-specification contract-specification record-specification", addrType)
+}
+
+// Encode creates the metadata address of the given type.
+// The uuidOrName must be empty for scope and specification types, a uuid for sessions, and a name for records and
+// record specifications.
+func Encode(addrType string, primaryUUID uuid.UUID, uuidOrName string) (types.MetadataAddress, error) {
+	canonical, err := NormalizeType(addrType)
+	if err != nil {
+		return nil, err
+	}
+	uuidOrName = strings.TrimSpace(uuidOrName)
+	switch canonical {
+	case TypeScope, TypeScopeSpecification, TypeContractSpecification:
+		if len(uuidOrName) > 0 {
+			return nil, fmt.Errorf("too many arguments for %s address encoder", canonical)
+		}
+	default:
+		if len(uuidOrName) == 0 {
+			return nil, fmt.Errorf("not enough arguments for %s address encoder", canonical)
+		}
+	}
+	switch canonical {
+	case TypeScope:
+		return types.ScopeMetadataAddress(primaryUUID), nil
+	case TypeSession:
+		secondaryUUID, err := uuid.Parse(uuidOrName)
+		if err != nil {
+			return nil, err
+		}
+		return types.SessionMetadataAddress(primaryUUID, secondaryUUID), nil
+	case TypeRecord:
+		return types.RecordMetadataAddress(primaryUUID, uuidOrName), nil
+	case TypeScopeSpecification:
+		return types.ScopeSpecMetadataAddress(primaryUUID), nil
+	case TypeContractSpecification:
+		return types.ContractSpecMetadataAddress(primaryUUID), nil
+	default:
+		return types.RecordSpecMetadataAddress(primaryUUID, uuidOrName), nil
+	}
+}
+
+// Entry is a metadata address along with the uuids and name hash that it contains.
+type Entry struct {
+	// Type is the canonical name of the address type, e.g. "scope".
+	Type string `json:"type"`
+	// Address is the bech32 address string.
+	Address string `json:"address"`
+	// PrimaryUUID is the scope uuid or specification uuid in the address.
+	PrimaryUUID string `json:"primary_uuid"`
+	// SecondaryUUID is the session uuid of a session address.
+	SecondaryUUID string `json:"secondary_uuid,omitempty"`
+	// Name is the record or record specification name. It is only known when the address was encoded from it.
+	Name string `json:"name,omitempty"`
+	// NameHashHex is the hex encoded name hash of a record or record specification address.
+	NameHashHex string `json:"name_hash_hex,omitempty"`
+	// ParentAddress is the scope address of a session or record, or the contract specification address of a record
+	// specification.
+	ParentAddress string `json:"parent_address,omitempty"`
+}
+
+// NewEntry creates an Entry for the given address. The name is included as provided.
+func NewEntry(addr types.MetadataAddress, name string) Entry {
+	details := addr.GetDetails()
+	rv := Entry{
+		Type:          TypeUnknown,
+		Address:       addr.String(),
+		PrimaryUUID:   details.PrimaryUUID,
+		SecondaryUUID: details.SecondaryUUID,
+		Name:          name,
+		NameHashHex:   details.NameHashHex,
+	}
+	switch details.Prefix {
+	case types.PrefixScope:
+		rv.Type = TypeScope
+	case types.PrefixSession:
+		rv.Type = TypeSession
+	case types.PrefixRecord:
+		rv.Type = TypeRecord
+	case types.PrefixScopeSpecification:
+		rv.Type = TypeScopeSpecification
+	case types.PrefixContractSpecification:
+		rv.Type = TypeContractSpecification
+	case types.PrefixRecordSpecification:
+		rv.Type = TypeRecordSpecification
+	}
+	if !details.ParentAddress.Empty() {
+		rv.ParentAddress = details.ParentAddress.String()
+	}
+	return rv
+}
+
+// EncodeEntry encodes a metadata address and returns its Entry.
+func EncodeEntry(addrType string, primaryUUID uuid.UUID, uuidOrName string) (Entry, error) {
+	addr, err := Encode(addrType, primaryUUID, uuidOrName)
+	if err != nil {
+		return Entry{}, err
+	}
+	name := ""
+	if addr.IsRecordAddress() || addr.IsRecordSpecificationAddress() {
+		name = strings.TrimSpace(uuidOrName)
+	}
+	return NewEntry(addr, name), nil
+}
+
+// Decode parses a bech32 metadata address string and returns its Entry.
+func Decode(bech32 string) (Entry, error) {
+	addr, err := types.MetadataAddressFromBech32(strings.TrimSpace(bech32))
+	if err != nil {
+		return Entry{}, err
+	}
+	return NewEntry(addr, ""), nil
+}
+
+// Derive returns the entry for a scope or contract specification followed by the entries of its children.
+// For a scope, each of the uuidsOrNames that is a uuid is a session uuid, and the others are record names.
+// For a contract specification, each of the uuidsOrNames is a record specification name.
+func Derive(addrType string, primaryUUID uuid.UUID, uuidsOrNames []string) ([]Entry, error) {
+	canonical, err := NormalizeType(addrType)
+	if err != nil {
+		return nil, err
+	}
+	var childType func(string) string
+	switch canonical {
+	case TypeScope:
+		childType = func(uuidOrName string) string {
+			if _, err := uuid.Parse(uuidOrName); err == nil {
+				return TypeSession
+			}
+			return TypeRecord
+		}
+	case TypeContractSpecification:
+		childType = func(string) string { return TypeRecordSpecification }
+	default:
+		return nil, fmt.Errorf("cannot derive ids from a %s; only scope and contract-specification are supported", canonical)
+	}
+
+	parent, err := EncodeEntry(canonical, primaryUUID, "")
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]Entry, 1, len(uuidsOrNames)+1)
+	rv[0] = parent
+	for _, uuidOrName := range uuidsOrNames {
+		uuidOrName = strings.TrimSpace(uuidOrName)
+		if len(uuidOrName) == 0 {
+			continue
+		}
+		child, err := EncodeEntry(childType(uuidOrName), primaryUUID, uuidOrName)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, child)
+	}
+	return rv, nil
+}
@@ -0,0 +1,221 @@
+package metaaddress_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/provenance-io/provenance/x/metadata/metaaddress"
+)
+
+// These come from the output of x/metadata/types/address_test.go TestGenerateExamples().
+const (
+	scopeUUIDStr        = "91978ba2-5f35-459a-86a7-feca1b0512e0"
+	sessionUUIDStr      = "5803f8bc-6067-4eb5-951f-2121671c2ec0"
+	contractSpecUUIDStr = "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	recordName          = "recordname"
+	recordNameHashHex   = "eaa9a0549acdb7a2e3858eb5b7b9d1be"
+
+	scopeIDStr        = "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"
+	sessionIDStr      = "session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr"
+	recordIDStr       = "record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3"
+	contractSpecIDStr = "contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn"
+	recordSpecIDStr   = "recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44"
+)
+
+func TestNormalizeType(t *testing.T) {
+	tests := []struct {
+		in  string
+		exp string
+	}{
+		{"scope", metaaddress.TypeScope},
+		{"Session", metaaddress.TypeSession},
+		{"RECORD", metaaddress.TypeRecord},
+		{"scope-spec", metaaddress.TypeScopeSpecification},
+		{"ScopeSpecification", metaaddress.TypeScopeSpecification},
+		{"cspec", metaaddress.TypeContractSpecification},
+		{"contract_specification", metaaddress.TypeContractSpecification},
+		{"rec-spec", metaaddress.TypeRecordSpecification},
+		{"record specification", metaaddress.TypeRecordSpecification},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			actual, err := metaaddress.NormalizeType(tc.in)
+			require.NoError(t, err, "NormalizeType")
+			assert.Equal(t, tc.exp, actual, "NormalizeType")
+		})
+	}
+
+	_, err := metaaddress.NormalizeType("owner")
+	require.EqualError(t, err, "unknown type: owner, Supported types: scope session record scope-specification contract-specification record-specification")
+}
+
+func TestEncodeEntry(t *testing.T) {
+	scopeUUID := uuid.MustParse(scopeUUIDStr)
+
+	entry, err := metaaddress.EncodeEntry("record", scopeUUID, " "+recordName+" ")
+	require.NoError(t, err, "EncodeEntry record")
+	assert.Equal(t, metaaddress.Entry{
+		Type:          metaaddress.TypeRecord,
+		Address:       recordIDStr,
+		PrimaryUUID:   scopeUUIDStr,
+		Name:          recordName,
+		NameHashHex:   recordNameHashHex,
+		ParentAddress: scopeIDStr,
+	}, entry, "EncodeEntry record")
+
+	entry, err = metaaddress.EncodeEntry("session", scopeUUID, sessionUUIDStr)
+	require.NoError(t, err, "EncodeEntry session")
+	assert.Equal(t, metaaddress.Entry{
+		Type:          metaaddress.TypeSession,
+		Address:       sessionIDStr,
+		PrimaryUUID:   scopeUUIDStr,
+		SecondaryUUID: sessionUUIDStr,
+		ParentAddress: scopeIDStr,
+	}, entry, "EncodeEntry session")
+
+	_, err = metaaddress.EncodeEntry("scope", scopeUUID, recordName)
+	require.EqualError(t, err, "too many arguments for scope address encoder")
+	_, err = metaaddress.EncodeEntry("record-spec", scopeUUID, "  ")
+	require.EqualError(t, err, "not enough arguments for record-specification address encoder")
+}
+
+func TestDecode(t *testing.T) {
+	entry, err := metaaddress.Decode(recordSpecIDStr)
+	require.NoError(t, err, "Decode")
+	assert.Equal(t, metaaddress.Entry{
+		Type:          metaaddress.TypeRecordSpecification,
+		Address:       recordSpecIDStr,
+		PrimaryUUID:   contractSpecUUIDStr,
+		NameHashHex:   recordNameHashHex,
+		ParentAddress: contractSpecIDStr,
+	}, entry, "Decode")
+
+	_, err = metaaddress.Decode("cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck")
+	require.Error(t, err, "Decode account address")
+}
+
+func TestDerive(t *testing.T) {
+	entries, err := metaaddress.Derive("scope", uuid.MustParse(scopeUUIDStr), []string{sessionUUIDStr, "", recordName})
+	require.NoError(t, err, "Derive scope")
+	addresses := make([]string, len(entries))
+	for i, entry := range entries {
+		addresses[i] = entry.Address
+	}
+	assert.Equal(t, []string{scopeIDStr, sessionIDStr, recordIDStr}, addresses, "Derive scope addresses")
+
+	entries, err = metaaddress.Derive("cspec", uuid.MustParse(contractSpecUUIDStr), []string{recordName})
+	require.NoError(t, err, "Derive contract spec")
+	require.Len(t, entries, 2, "Derive contract spec")
+	assert.Equal(t, contractSpecIDStr, entries[0].Address, "Derive contract spec parent")
+	assert.Equal(t, recordSpecIDStr, entries[1].Address, "Derive contract spec child")
+
+	_, err = metaaddress.Derive("record", uuid.MustParse(scopeUUIDStr), []string{recordName})
+	require.EqualError(t, err, "cannot derive ids from a record; only scope and contract-specification are supported")
+}
+
+func TestRowReader(t *testing.T) {
+	t.Run("whitespace", func(t *testing.T) {
+		r := metaaddress.NewRowReader(strings.NewReader("# comment\n\n  a  b\tc \n#\nd\n"), false)
+		fields, err := r.Read()
+		require.NoError(t, err, "first Read")
+		assert.Equal(t, []string{"a", "b", "c"}, fields, "first Read")
+		assert.Equal(t, 3, r.Line(), "first Line")
+		fields, err = r.Read()
+		require.NoError(t, err, "second Read")
+		assert.Equal(t, []string{"d"}, fields, "second Read")
+		assert.Equal(t, 5, r.Line(), "second Line")
+		_, err = r.Read()
+		assert.Equal(t, io.EOF, err, "last Read")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		r := metaaddress.NewRowReader(strings.NewReader("# comment\na, \"b c\"\n,,\n\"d\ne\"\n"), true)
+		fields, err := r.Read()
+		require.NoError(t, err, "first Read")
+		assert.Equal(t, []string{"a", "b c"}, fields, "first Read")
+		assert.Equal(t, 2, r.Line(), "first Line")
+		fields, err = r.Read()
+		require.NoError(t, err, "second Read")
+		assert.Equal(t, []string{"d\ne"}, fields, "second Read")
+		assert.Equal(t, 4, r.Line(), "second Line")
+		_, err = r.Read()
+		assert.Equal(t, io.EOF, err, "last Read")
+	})
+}
+
+func TestNewEntryWriter(t *testing.T) {
+	_, err := metaaddress.NewEntryWriter(&bytes.Buffer{}, "yaml")
+	require.EqualError(t, err, "unknown format: yaml, Supported formats: json csv")
+
+	var out bytes.Buffer
+	w, err := metaaddress.NewEntryWriter(&out, " CSV ")
+	require.NoError(t, err, "NewEntryWriter CSV")
+	require.NoError(t, w.Flush(), "Flush with no entries")
+	assert.Empty(t, out.String(), "output with no entries")
+}
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		process func(*metaaddress.RowReader, *metaaddress.EntryWriter) error
+		input   string
+		format  string
+		exp     string
+		err     string
+	}{
+		{
+			name:    "encode",
+			process: metaaddress.EncodeAll,
+			input:   "scope " + scopeUUIDStr + "\nrecord-spec " + contractSpecUUIDStr + " " + recordName + "\n",
+			format:  metaaddress.FormatJSON,
+			exp: `{"type":"scope","address":"` + scopeIDStr + `","primary_uuid":"` + scopeUUIDStr + `"}` + "\n" +
+				`{"type":"record-specification","address":"` + recordSpecIDStr + `","primary_uuid":"` + contractSpecUUIDStr +
+				`","name":"` + recordName + `","name_hash_hex":"` + recordNameHashHex + `","parent_address":"` + contractSpecIDStr + `"}` + "\n",
+		},
+		{
+			name:    "encode wrong field count",
+			process: metaaddress.EncodeAll,
+			input:   "\nscope\n",
+			format:  metaaddress.FormatJSON,
+			err:     "line 2: expected 2 or 3 fields, found 1",
+		},
+		{
+			name:    "decode keeps earlier rows",
+			process: metaaddress.DecodeAll,
+			input:   scopeIDStr + "\nnotanaddress\n",
+			format:  metaaddress.FormatCSV,
+			exp: "type,address,primary_uuid,secondary_uuid,name,name_hash_hex,parent_address\n" +
+				"scope," + scopeIDStr + "," + scopeUUIDStr + ",,,,\n",
+			err: `line 2: invalid address "notanaddress"`,
+		},
+		{
+			name:    "derive bad uuid",
+			process: metaaddress.DeriveAll,
+			input:   "scope abc\n",
+			format:  metaaddress.FormatJSON,
+			err:     "line 1: invalid UUID length: 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w, err := metaaddress.NewEntryWriter(&out, tc.format)
+			require.NoError(t, err, "NewEntryWriter")
+			err = tc.process(metaaddress.NewRowReader(strings.NewReader(tc.input), false), w)
+			if len(tc.err) > 0 {
+				require.Error(t, err, "process")
+				assert.Contains(t, err.Error(), tc.err, "process error")
+			} else {
+				require.NoError(t, err, "process")
+			}
+			assert.Equal(t, tc.exp, out.String(), "output")
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshalls the KVPair's
+// Value
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ScopeKeyPrefix):
+			var a, b types.Scope
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.SessionKeyPrefix):
+			var a, b types.Session
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.RecordKeyPrefix):
+			var a, b types.Record
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.ContractSpecificationKeyPrefix):
+			var a, b types.ContractSpecification
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.ScopeSpecificationKeyPrefix):
+			var a, b types.ScopeSpecification
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.RecordSpecificationKeyPrefix):
+			var a, b types.RecordSpecification
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.OSLocatorAddressKeyPrefix):
+			var a, b types.ObjectStoreLocator
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.HistoryEntryKeyPrefix):
+			var a, b types.HistoryEntry
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.ScopeLockKeyPrefix):
+			var a, b types.ScopeLock
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.RecordTypeKeyPrefix):
+			var a, b types.RecordType
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.MissingResponsiblePartiesKeyPrefix):
+			var a, b types.MissingResponsibleParties
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.DataAccessGrantKeyPrefix):
+			var a, b types.DataAccessGrant
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.ValueOwnerTransferOfferKeyPrefix):
+			var a, b types.ValueOwnerTransferOffer
+			cdc.MustUnmarshal(kvA.Value, &a)
+			cdc.MustUnmarshal(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case bytes.Equal(kvA.Key[:1], types.DataAccessExpirationCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ValueOwnerTransferExpirationCacheKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.HistoryHeightCacheKeyPrefix):
+			return fmt.Sprintf("%s\n%s", types.MetadataAddress(kvA.Value), types.MetadataAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.HistorySequenceKey),
+			bytes.Equal(kvA.Key[:1], types.HistoryFloorKey),
+			bytes.Equal(kvA.Key[:1], types.SpecUsageCountKeyPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.AddressScopeCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ScopeSpecScopeCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ValueOwnerScopeCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.AddressScopeSpecCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ContractSpecScopeSpecCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.AddressContractSpecCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.DataAccessScopeCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ContractSpecSessionCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.RecordSpecRecordCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.SpecVersionCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.OSLocatorURICacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.AddressValueOwnerTransferOfferCacheKeyPrefix):
+			// The index entries have no meaningful value; the key is what matters.
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
+		}
+	}
+}
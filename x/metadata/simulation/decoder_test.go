@@ -0,0 +1,89 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/simulation"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	owner := sdk.AccAddress("owner_______________").String()
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	sessionID := scopeID.MustGetAsSessionAddress(uuid.New())
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+
+	scope := types.Scope{ScopeId: scopeID, SpecificationId: scopeSpecID, ValueOwnerAddress: owner}
+	session := types.Session{SessionId: sessionID, Name: "session"}
+	scopeSpec := types.ScopeSpecification{SpecificationId: scopeSpecID, OwnerAddresses: []string{owner}}
+	locator := types.ObjectStoreLocator{Owner: owner, LocatorUri: "http://example.com", Name: "primary"}
+	entry := types.HistoryEntry{ScopeId: scopeID, ObjectId: scopeID, Sequence: 3}
+	lock := types.ScopeLock{ScopeId: scopeID, Locker: owner, Reason: "audit"}
+	recordID := scopeID.MustGetAsRecordAddress("record")
+	missing := types.MissingResponsibleParties{RecordId: recordID, PartyTypes: []types.PartyType{types.PartyType_PARTY_TYPE_SERVICER}, Height: 5}
+	grant := types.DataAccessGrant{ScopeId: scopeID, Address: owner, RecordNames: []string{"record"}}
+	offer := types.ValueOwnerTransferOffer{ScopeId: scopeID, Seller: owner, Buyer: sdk.AccAddress("buyer_______________").String(),
+		Price: sdk.NewCoins(sdk.NewInt64Coin("nhash", 10)), Expiration: time.Unix(1650000000, 0).UTC()}
+	recordType := types.RecordType{Name: "io.provenance.Loan", SchemaType: types.SchemaType_SCHEMA_TYPE_JSON_SCHEMA, Schema: []byte(`{}`), OwnerAddresses: []string{owner}}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: scopeID.Bytes(), Value: cdc.MustMarshal(&scope)},
+			{Key: sessionID.Bytes(), Value: cdc.MustMarshal(&session)},
+			{Key: scopeSpecID.Bytes(), Value: cdc.MustMarshal(&scopeSpec)},
+			{Key: types.GetOSLocatorKey(sdk.AccAddress("owner_______________"), "primary"), Value: cdc.MustMarshal(&locator)},
+			{Key: types.HistoryEntryKeyPrefix, Value: cdc.MustMarshal(&entry)},
+			{Key: types.ScopeLockKeyPrefix, Value: cdc.MustMarshal(&lock)},
+			{Key: types.GetRecordTypeKey(recordType.Name), Value: cdc.MustMarshal(&recordType)},
+			{Key: types.GetMissingResponsiblePartiesKey(recordID), Value: cdc.MustMarshal(&missing)},
+			{Key: types.GetDataAccessGrantKey(scopeID, sdk.AccAddress("owner_______________")), Value: cdc.MustMarshal(&grant)},
+			{Key: types.GetValueOwnerTransferOfferKey(scopeID, sdk.AccAddress("buyer_______________")), Value: cdc.MustMarshal(&offer)},
+			{Key: types.HistorySequenceKey, Value: sdk.Uint64ToBigEndian(42)},
+			{Key: append(types.AddressScopeCacheKeyPrefix, 0x01), Value: []byte{0x01}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Scope", fmt.Sprintf("%v\n%v", scope, scope)},
+		{"Session", fmt.Sprintf("%v\n%v", session, session)},
+		{"ScopeSpecification", fmt.Sprintf("%v\n%v", scopeSpec, scopeSpec)},
+		{"ObjectStoreLocator", fmt.Sprintf("%v\n%v", locator, locator)},
+		{"HistoryEntry", fmt.Sprintf("%v\n%v", entry, entry)},
+		{"ScopeLock", fmt.Sprintf("%v\n%v", lock, lock)},
+		{"RecordType", fmt.Sprintf("%v\n%v", recordType, recordType)},
+		{"MissingResponsibleParties", fmt.Sprintf("%v\n%v", missing, missing)},
+		{"DataAccessGrant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"ValueOwnerTransferOffer", fmt.Sprintf("%v\n%v", offer, offer)},
+		{"HistorySequence", "42\n42"},
+		{"AddressScopeCache", fmt.Sprintf("%X\n%X", kvPairs.Pairs[11].Key, kvPairs.Pairs[11].Key)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
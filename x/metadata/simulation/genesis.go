@@ -5,17 +5,121 @@ package simulation
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
-// RandomizedGenState generates a random GenesisState for marker
+// Simulation parameter constants
+const (
+	HistoryRetentionBlocks = "history_retention_blocks"
+	RecordTypeRegistrars   = "record_type_registrars"
+	MaxURILength           = "max_uri_length"
+	MaxScopeOwners         = "max_scope_owners"
+	MaxScopeDataAccess     = "max_scope_data_access"
+	MaxScopeRecords        = "max_scope_records"
+	MaxRecordInputs        = "max_record_inputs"
+	MaxRecordOutputs       = "max_record_outputs"
+	MaxSessionParties      = "max_session_parties"
+	MaxSessionContextBytes = "max_session_context_bytes"
+)
+
+// GenHistoryRetentionBlocks randomized HistoryRetentionBlocks
+func GenHistoryRetentionBlocks(r *rand.Rand) uint64 {
+	// History is disabled about a third of the time.
+	if r.Intn(3) == 0 {
+		return 0
+	}
+	return uint64(r.Int63n(200) + 1)
+}
+
+// GenRecordTypeRegistrars randomized RecordTypeRegistrars
+func GenRecordTypeRegistrars(r *rand.Rand, accs []simtypes.Account) []string {
+	registrars := []string{}
+	for _, acc := range accs {
+		if r.Intn(4) == 0 {
+			registrars = append(registrars, acc.Address.String())
+		}
+	}
+	return registrars
+}
+
+// GenMaxCount randomized limit for the number of owners, data access addresses, inputs, outputs, or parties
+func GenMaxCount(r *rand.Rand) uint32 {
+	return uint32(r.Int31n(100) + 20)
+}
+
+// GenMaxScopeRecords randomized MaxScopeRecords
+func GenMaxScopeRecords(r *rand.Rand) uint32 {
+	return uint32(r.Int31n(1000) + 100)
+}
+
+// GenMaxSessionContextBytes randomized MaxSessionContextBytes
+func GenMaxSessionContextBytes(r *rand.Rand) uint32 {
+	return uint32(r.Int31n(int32(types.DefaultMaxSessionContextBytes)) + 1024)
+}
+
+// GenMaxURILength randomized MaxUriLength
+func GenMaxURILength(r *rand.Rand) uint32 {
+	return uint32(r.Int31n(types.DefaultMaxURILength-64) + 64)
+}
+
+// RandomizedGenState generates a random GenesisState for metadata
 func RandomizedGenState(simState *module.SimulationState) {
+	var historyRetentionBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HistoryRetentionBlocks, &historyRetentionBlocks, simState.Rand,
+		func(r *rand.Rand) { historyRetentionBlocks = GenHistoryRetentionBlocks(r) },
+	)
+
+	var recordTypeRegistrars []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RecordTypeRegistrars, &recordTypeRegistrars, simState.Rand,
+		func(r *rand.Rand) { recordTypeRegistrars = GenRecordTypeRegistrars(r, simState.Accounts) },
+	)
+
+	var maxURILength uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxURILength, &maxURILength, simState.Rand,
+		func(r *rand.Rand) { maxURILength = GenMaxURILength(r) },
+	)
+
+	genMaxCount := func(name string) uint32 {
+		var limit uint32
+		simState.AppParams.GetOrGenerate(
+			simState.Cdc, name, &limit, simState.Rand,
+			func(r *rand.Rand) { limit = GenMaxCount(r) },
+		)
+		return limit
+	}
+	maxScopeOwners := genMaxCount(MaxScopeOwners)
+	maxScopeDataAccess := genMaxCount(MaxScopeDataAccess)
+
+	var maxScopeRecords uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxScopeRecords, &maxScopeRecords, simState.Rand,
+		func(r *rand.Rand) { maxScopeRecords = GenMaxScopeRecords(r) },
+	)
+
+	maxRecordInputs := genMaxCount(MaxRecordInputs)
+	maxRecordOutputs := genMaxCount(MaxRecordOutputs)
+	maxSessionParties := genMaxCount(MaxSessionParties)
+
+	var maxSessionContextBytes uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSessionContextBytes, &maxSessionContextBytes, simState.Rand,
+		func(r *rand.Rand) { maxSessionContextBytes = GenMaxSessionContextBytes(r) },
+	)
+
 	metadataGenesis := types.GenesisState{
-		Params:          types.DefaultParams(),
-		OSLocatorParams: types.DefaultOSLocatorParams(),
+		Params: types.NewParams(historyRetentionBlocks, recordTypeRegistrars, types.DefaultRequireRegisteredRecordTypes,
+			maxScopeOwners, maxScopeDataAccess, maxScopeRecords, maxRecordInputs, maxRecordOutputs,
+			maxSessionParties, maxSessionContextBytes, types.DefaultEnforceResponsibleParties,
+			types.DefaultDisableP8eMessages),
+		OSLocatorParams: types.NewOSLocatorParams(maxURILength),
 	}
 
 	bz, err := json.MarshalIndent(&metadataGenesis, "", " ")
@@ -0,0 +1,1828 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/google/uuid"
+
+	simappparams "github.com/provenance-io/provenance/app/params"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/types/p8e"
+)
+
+// Simulation operation weights constants
+const (
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteScopeSpecification = "op_weight_msg_write_scope_specification"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteScopeSpecification = "op_weight_msg_delete_scope_specification"
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteContractSpecification = "op_weight_msg_write_contract_specification"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteContractSpecification = "op_weight_msg_delete_contract_specification"
+	//nolint:gosec // not credentials
+	OpWeightMsgAddContractSpecToScopeSpec = "op_weight_msg_add_contract_spec_to_scope_spec"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteContractSpecFromScopeSpec = "op_weight_msg_delete_contract_spec_from_scope_spec"
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteRecordSpecification = "op_weight_msg_write_record_specification"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteRecordSpecification = "op_weight_msg_delete_record_specification"
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteRecordType = "op_weight_msg_write_record_type"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteRecordType = "op_weight_msg_delete_record_type"
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteScope = "op_weight_msg_write_scope"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteScope = "op_weight_msg_delete_scope"
+	//nolint:gosec // not credentials
+	OpWeightMsgAddScopeDataAccess = "op_weight_msg_add_scope_data_access"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteScopeDataAccess = "op_weight_msg_delete_scope_data_access"
+	//nolint:gosec // not credentials
+	OpWeightMsgAddScopeOwner = "op_weight_msg_add_scope_owner"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteScopeOwner = "op_weight_msg_delete_scope_owner"
+	//nolint:gosec // not credentials
+	OpWeightMsgLockScope = "op_weight_msg_lock_scope"
+	//nolint:gosec // not credentials
+	OpWeightMsgUnlockScope = "op_weight_msg_unlock_scope"
+	//nolint:gosec // not credentials
+	OpWeightMsgTokenizeScope = "op_weight_msg_tokenize_scope"
+	//nolint:gosec // not credentials
+	OpWeightMsgDetokenizeScope = "op_weight_msg_detokenize_scope"
+	//nolint:gosec // not credentials
+	OpWeightMsgCloneScope = "op_weight_msg_clone_scope"
+	//nolint:gosec // not credentials
+	OpWeightMsgOfferValueOwnerTransfer = "op_weight_msg_offer_value_owner_transfer"
+	//nolint:gosec // not credentials
+	OpWeightMsgAcceptValueOwnerTransfer = "op_weight_msg_accept_value_owner_transfer"
+	//nolint:gosec // not credentials
+	OpWeightMsgCancelValueOwnerTransfer = "op_weight_msg_cancel_value_owner_transfer"
+	//nolint:gosec // not credentials
+	OpWeightMsgMigrateScopeSpec = "op_weight_msg_migrate_scope_spec"
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteSession = "op_weight_msg_write_session"
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteRecord = "op_weight_msg_write_record"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteRecord = "op_weight_msg_delete_record"
+	//nolint:gosec // not credentials
+	OpWeightMsgExecuteContractSession = "op_weight_msg_execute_contract_session"
+	//nolint:gosec // not credentials
+	OpWeightMsgWriteP8eContractSpec = "op_weight_msg_write_p8e_contract_spec"
+	//nolint:gosec // not credentials
+	OpWeightMsgP8eMemorializeContract = "op_weight_msg_p8e_memorialize_contract"
+	//nolint:gosec // not credentials
+	OpWeightMsgBindOSLocator = "op_weight_msg_bind_os_locator"
+	//nolint:gosec // not credentials
+	OpWeightMsgModifyOSLocator = "op_weight_msg_modify_os_locator"
+	//nolint:gosec // not credentials
+	OpWeightMsgDeleteOSLocator = "op_weight_msg_delete_os_locator"
+)
+
+// authzChance is the one-in-n chance that a required signer delegates signing to another account through authz.
+const authzChance = 5
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper,
+) simulation.WeightedOperations {
+	ops := []struct {
+		key           string
+		defaultWeight int
+		op            simtypes.Operation
+	}{
+		{OpWeightMsgWriteScopeSpecification, simappparams.DefaultWeightMsgWriteScopeSpecification, SimulateMsgWriteScopeSpecification(k, ak, bk)},
+		{OpWeightMsgDeleteScopeSpecification, simappparams.DefaultWeightMsgDeleteScopeSpecification, SimulateMsgDeleteScopeSpecification(k, ak, bk)},
+		{OpWeightMsgWriteContractSpecification, simappparams.DefaultWeightMsgWriteContractSpecification, SimulateMsgWriteContractSpecification(k, ak, bk)},
+		{OpWeightMsgDeleteContractSpecification, simappparams.DefaultWeightMsgDeleteContractSpecification, SimulateMsgDeleteContractSpecification(k, ak, bk)},
+		{OpWeightMsgAddContractSpecToScopeSpec, simappparams.DefaultWeightMsgAddContractSpecToScopeSpec, SimulateMsgAddContractSpecToScopeSpec(k, ak, bk)},
+		{OpWeightMsgDeleteContractSpecFromScopeSpec, simappparams.DefaultWeightMsgDeleteContractSpecFromScopeSpec, SimulateMsgDeleteContractSpecFromScopeSpec(k, ak, bk)},
+		{OpWeightMsgWriteRecordSpecification, simappparams.DefaultWeightMsgWriteRecordSpecification, SimulateMsgWriteRecordSpecification(k, ak, bk)},
+		{OpWeightMsgDeleteRecordSpecification, simappparams.DefaultWeightMsgDeleteRecordSpecification, SimulateMsgDeleteRecordSpecification(k, ak, bk)},
+		{OpWeightMsgWriteRecordType, simappparams.DefaultWeightMsgWriteRecordType, SimulateMsgWriteRecordType(k, ak, bk)},
+		{OpWeightMsgDeleteRecordType, simappparams.DefaultWeightMsgDeleteRecordType, SimulateMsgDeleteRecordType(k, ak, bk)},
+		{OpWeightMsgWriteScope, simappparams.DefaultWeightMsgWriteScope, SimulateMsgWriteScope(k, ak, bk)},
+		{OpWeightMsgDeleteScope, simappparams.DefaultWeightMsgDeleteScope, SimulateMsgDeleteScope(k, ak, bk)},
+		{OpWeightMsgAddScopeDataAccess, simappparams.DefaultWeightMsgAddScopeDataAccess, SimulateMsgAddScopeDataAccess(k, ak, bk)},
+		{OpWeightMsgDeleteScopeDataAccess, simappparams.DefaultWeightMsgDeleteScopeDataAccess, SimulateMsgDeleteScopeDataAccess(k, ak, bk)},
+		{OpWeightMsgAddScopeOwner, simappparams.DefaultWeightMsgAddScopeOwner, SimulateMsgAddScopeOwner(k, ak, bk)},
+		{OpWeightMsgDeleteScopeOwner, simappparams.DefaultWeightMsgDeleteScopeOwner, SimulateMsgDeleteScopeOwner(k, ak, bk)},
+		{OpWeightMsgLockScope, simappparams.DefaultWeightMsgLockScope, SimulateMsgLockScope(k, ak, bk)},
+		{OpWeightMsgUnlockScope, simappparams.DefaultWeightMsgUnlockScope, SimulateMsgUnlockScope(k, ak, bk)},
+		{OpWeightMsgTokenizeScope, simappparams.DefaultWeightMsgTokenizeScope, SimulateMsgTokenizeScope(k, ak, bk)},
+		{OpWeightMsgDetokenizeScope, simappparams.DefaultWeightMsgDetokenizeScope, SimulateMsgDetokenizeScope(k, ak, bk)},
+		{OpWeightMsgCloneScope, simappparams.DefaultWeightMsgCloneScope, SimulateMsgCloneScope(k, ak, bk)},
+		{OpWeightMsgOfferValueOwnerTransfer, simappparams.DefaultWeightMsgOfferValueOwnerTransfer, SimulateMsgOfferValueOwnerTransfer(k, ak, bk)},
+		{OpWeightMsgAcceptValueOwnerTransfer, simappparams.DefaultWeightMsgAcceptValueOwnerTransfer, SimulateMsgAcceptValueOwnerTransfer(k, ak, bk)},
+		{OpWeightMsgCancelValueOwnerTransfer, simappparams.DefaultWeightMsgCancelValueOwnerTransfer, SimulateMsgCancelValueOwnerTransfer(k, ak, bk)},
+		{OpWeightMsgMigrateScopeSpec, simappparams.DefaultWeightMsgMigrateScopeSpec, SimulateMsgMigrateScopeSpec(k, ak, bk)},
+		{OpWeightMsgWriteSession, simappparams.DefaultWeightMsgWriteSession, SimulateMsgWriteSession(k, ak, bk)},
+		{OpWeightMsgWriteRecord, simappparams.DefaultWeightMsgWriteRecord, SimulateMsgWriteRecord(k, ak, bk)},
+		{OpWeightMsgDeleteRecord, simappparams.DefaultWeightMsgDeleteRecord, SimulateMsgDeleteRecord(k, ak, bk)},
+		{OpWeightMsgExecuteContractSession, simappparams.DefaultWeightMsgExecuteContractSession, SimulateMsgExecuteContractSession(k, ak, bk)},
+		{OpWeightMsgWriteP8eContractSpec, simappparams.DefaultWeightMsgWriteP8eContractSpec, SimulateMsgWriteP8eContractSpec(k, ak, bk)},
+		{OpWeightMsgP8eMemorializeContract, simappparams.DefaultWeightMsgP8eMemorializeContract, SimulateMsgP8eMemorializeContract(k, ak, bk)},
+		{OpWeightMsgBindOSLocator, simappparams.DefaultWeightMsgBindOSLocator, SimulateMsgBindOSLocator(k, ak, bk)},
+		{OpWeightMsgModifyOSLocator, simappparams.DefaultWeightMsgModifyOSLocator, SimulateMsgModifyOSLocator(k, ak, bk)},
+		{OpWeightMsgDeleteOSLocator, simappparams.DefaultWeightMsgDeleteOSLocator, SimulateMsgDeleteOSLocator(k, ak, bk)},
+	}
+
+	weighted := make(simulation.WeightedOperations, len(ops))
+	for i, o := range ops {
+		var weight int
+		defaultWeight := o.defaultWeight
+		appParams.GetOrGenerate(cdc, o.key, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = defaultWeight
+			},
+		)
+		weighted[i] = simulation.NewWeightedOperation(weight, o.op)
+	}
+	return weighted
+}
+
+// SimulateMsgWriteScopeSpecification will create a new scope specification, or update or version an existing one.
+func SimulateMsgWriteScopeSpecification(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgWriteScopeSpecificationRequest
+		contractSpecIDs := randomContractSpecIDs(r, getAllContractSpecs(ctx, k))
+
+		specs := getAllScopeSpecs(ctx, k)
+		if len(specs) == 0 || r.Intn(3) != 0 {
+			owners := randomAccounts(r, accs, r.Intn(2)+1)
+			spec := types.NewScopeSpecification(
+				types.ScopeSpecMetadataAddress(randomUUID(r)),
+				randomDescription(r),
+				accountAddresses(owners),
+				randomPartyTypes(r),
+				contractSpecIDs,
+			)
+			msg := types.NewMsgWriteScopeSpecificationRequest(*spec, accountAddresses(owners))
+			return Dispatch(r, app, ctx, ak, bk, owners, chainID, msg)
+		}
+
+		existing := specs[r.Intn(len(specs))]
+		if _, hasNewer := k.GetNewerSpecVersion(ctx, existing.SpecificationId); hasNewer {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "scope specification already has a newer version"), nil, nil
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, existing.OwnerAddresses, nil, types.TypeURLMsgWriteScopeSpecificationRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		proposed := existing
+		proposed.Description = randomDescription(r)
+		proposed.ContractSpecIds = contractSpecIDs
+		// Specifications in use cannot change, so they get a new version instead.
+		if k.GetSpecUsageCount(ctx, existing.SpecificationId) > 0 {
+			proposed.SpecificationId = types.ScopeSpecMetadataAddress(randomUUID(r))
+			proposed.PreviousVersionId = existing.SpecificationId
+		}
+		msg := types.NewMsgWriteScopeSpecificationRequest(proposed, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteScopeSpecification will delete a random scope specification.
+func SimulateMsgDeleteScopeSpecification(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteScopeSpecificationRequest
+		specs := getAllScopeSpecs(ctx, k)
+		if len(specs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no scope specifications available to delete"), nil, nil
+		}
+
+		spec := specs[r.Intn(len(specs))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, spec.OwnerAddresses, nil, types.TypeURLMsgDeleteScopeSpecificationRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteScopeSpecificationRequest(spec.SpecificationId, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgWriteContractSpecification will create a new contract specification, or update or version an existing one.
+func SimulateMsgWriteContractSpecification(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgWriteContractSpecificationRequest
+		specs := getAllContractSpecs(ctx, k)
+		if len(specs) == 0 || r.Intn(3) != 0 {
+			owners := randomAccounts(r, accs, r.Intn(2)+1)
+			spec := types.NewContractSpecification(
+				types.ContractSpecMetadataAddress(randomUUID(r)),
+				randomDescription(r),
+				accountAddresses(owners),
+				randomPartyTypes(r),
+				types.NewContractSpecificationSourceHash(randomHash(r)),
+				randomClassName(r),
+			)
+			msg := types.NewMsgWriteContractSpecificationRequest(*spec, accountAddresses(owners))
+			return Dispatch(r, app, ctx, ak, bk, owners, chainID, msg)
+		}
+
+		existing := specs[r.Intn(len(specs))]
+		if _, hasNewer := k.GetNewerSpecVersion(ctx, existing.SpecificationId); hasNewer {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract specification already has a newer version"), nil, nil
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, existing.OwnerAddresses, nil, types.TypeURLMsgWriteContractSpecificationRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		proposed := existing
+		proposed.Description = randomDescription(r)
+		proposed.Source = types.NewContractSpecificationSourceHash(randomHash(r))
+		if k.GetSpecUsageCount(ctx, existing.SpecificationId) > 0 {
+			proposed.SpecificationId = types.ContractSpecMetadataAddress(randomUUID(r))
+			proposed.PreviousVersionId = existing.SpecificationId
+		}
+		msg := types.NewMsgWriteContractSpecificationRequest(proposed, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteContractSpecification will delete a random contract specification.
+func SimulateMsgDeleteContractSpecification(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteContractSpecificationRequest
+		specs := getAllContractSpecs(ctx, k)
+		if len(specs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no contract specifications available to delete"), nil, nil
+		}
+
+		spec := specs[r.Intn(len(specs))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, spec.OwnerAddresses, nil, types.TypeURLMsgDeleteContractSpecificationRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteContractSpecificationRequest(spec.SpecificationId, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgAddContractSpecToScopeSpec will add a random contract specification to a random scope specification.
+func SimulateMsgAddContractSpecToScopeSpec(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgAddContractSpecToScopeSpecRequest
+		scopeSpecs := getAllScopeSpecs(ctx, k)
+		contractSpecs := getAllContractSpecs(ctx, k)
+		if len(scopeSpecs) == 0 || len(contractSpecs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no specifications available to link"), nil, nil
+		}
+
+		scopeSpec := scopeSpecs[r.Intn(len(scopeSpecs))]
+		contractSpecID := contractSpecs[r.Intn(len(contractSpecs))].SpecificationId
+		for _, id := range scopeSpec.ContractSpecIds {
+			if id.Equals(contractSpecID) {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "contract specification already in scope specification"), nil, nil
+			}
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, scopeSpec.OwnerAddresses, nil, types.TypeURLMsgAddContractSpecToScopeSpecRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgAddContractSpecToScopeSpecRequest(contractSpecID, scopeSpec.SpecificationId, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteContractSpecFromScopeSpec will remove a random contract specification from a scope specification.
+func SimulateMsgDeleteContractSpecFromScopeSpec(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteContractSpecFromScopeSpecRequest
+		var scopeSpecs []types.ScopeSpecification
+		for _, spec := range getAllScopeSpecs(ctx, k) {
+			if len(spec.ContractSpecIds) > 0 {
+				scopeSpecs = append(scopeSpecs, spec)
+			}
+		}
+		if len(scopeSpecs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no scope specifications with contract specifications"), nil, nil
+		}
+
+		scopeSpec := scopeSpecs[r.Intn(len(scopeSpecs))]
+		contractSpecID := scopeSpec.ContractSpecIds[r.Intn(len(scopeSpec.ContractSpecIds))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, scopeSpec.OwnerAddresses, nil, types.TypeURLMsgDeleteContractSpecFromScopeSpecRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteContractSpecFromScopeSpecRequest(contractSpecID, scopeSpec.SpecificationId, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgWriteRecordSpecification will add or update a record specification on a random contract specification.
+func SimulateMsgWriteRecordSpecification(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgWriteRecordSpecificationRequest
+		contractSpecs := getAllContractSpecs(ctx, k)
+		if len(contractSpecs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no contract specifications available"), nil, nil
+		}
+
+		contractSpec := contractSpecs[r.Intn(len(contractSpecs))]
+		contractSpecUUID, err := contractSpec.SpecificationId.ContractSpecUUID()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+
+		name := randomName(r)
+		existing, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, contractSpec.SpecificationId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+		if len(existing) > 0 && r.Intn(3) == 0 {
+			name = existing[r.Intn(len(existing))].Name
+		}
+
+		inputs := make([]*types.InputSpecification, r.Intn(3))
+		for i := range inputs {
+			inputs[i] = types.NewInputSpecification(
+				fmt.Sprintf("%s-%d", randomName(r), i),
+				randomClassName(r),
+				types.NewInputSpecificationSourceHash(randomHash(r)),
+			)
+		}
+		spec := types.NewRecordSpecification(
+			types.RecordSpecMetadataAddress(contractSpecUUID, name),
+			name,
+			inputs,
+			randomClassName(r),
+			types.DefinitionType(r.Intn(3)+1),
+			randomSubset(r, contractSpec.PartiesInvolved),
+		)
+		spec.Clonable = r.Intn(2) == 0
+
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, contractSpec.OwnerAddresses, nil, types.TypeURLMsgWriteRecordSpecificationRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgWriteRecordSpecificationRequest(*spec, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteRecordSpecification will delete a random record specification.
+func SimulateMsgDeleteRecordSpecification(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteRecordSpecificationRequest
+		specs := getAllRecordSpecs(ctx, k)
+		if len(specs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no record specifications available to delete"), nil, nil
+		}
+
+		spec := specs[r.Intn(len(specs))]
+		contractSpecID, err := spec.SpecificationId.AsContractSpecAddress()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+		contractSpec, found := k.GetContractSpecification(ctx, contractSpecID)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract specification not found for record specification"), nil, nil
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, contractSpec.OwnerAddresses, nil, types.TypeURLMsgDeleteRecordSpecificationRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteRecordSpecificationRequest(spec.SpecificationId, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgWriteRecordType will register a new record type, or update an existing one, with a random json schema.
+func SimulateMsgWriteRecordType(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgWriteRecordTypeRequest
+		existing := getAllRecordTypes(ctx, k)
+		if len(existing) > 0 && r.Intn(3) == 0 {
+			recordType := existing[r.Intn(len(existing))]
+			recordType.Schema = randomJSONSchema(r)
+			signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, recordType.OwnerAddresses, nil, types.TypeURLMsgWriteRecordTypeRequest)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+			}
+			defer revoke()
+
+			msg := types.NewMsgWriteRecordTypeRequest(recordType, accountAddresses(signers))
+			return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+		}
+
+		registrars := k.GetRecordTypeRegistrars(ctx)
+		if len(registrars) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no record type registrars available"), nil, nil
+		}
+		owners := accountAddresses(randomAccounts(r, accs, r.Intn(2)+1))
+		recordType := types.NewRecordType(randomClassName(r), types.SchemaType_SCHEMA_TYPE_JSON_SCHEMA, randomJSONSchema(r), "", owners)
+
+		registrar := registrars[r.Intn(len(registrars))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, owners, []string{registrar}, types.TypeURLMsgWriteRecordTypeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgWriteRecordTypeRequest(*recordType, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteRecordType will delete a random record type.
+func SimulateMsgDeleteRecordType(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteRecordTypeRequest
+		recordTypes := getAllRecordTypes(ctx, k)
+		if len(recordTypes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no record types available to delete"), nil, nil
+		}
+
+		recordType := recordTypes[r.Intn(len(recordTypes))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, recordType.OwnerAddresses, nil, types.TypeURLMsgDeleteRecordTypeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteRecordTypeRequest(recordType.Name, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgWriteScope will create a new scope for a random scope specification, or update an existing scope.
+func SimulateMsgWriteScope(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgWriteScopeRequest
+		scopes := getUnlockedScopes(ctx, k)
+		if len(scopes) == 0 || r.Intn(3) != 0 {
+			specs := getAllScopeSpecs(ctx, k)
+			if len(specs) == 0 {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "no scope specifications available"), nil, nil
+			}
+			spec := specs[r.Intn(len(specs))]
+			owners := randomParties(r, accs, spec.PartiesInvolved)
+			ownerAccs, err := findAccounts(accs, partyAddresses(owners))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+			}
+			scope := types.NewScope(
+				types.ScopeMetadataAddress(randomUUID(r)),
+				spec.SpecificationId,
+				owners,
+				accountAddresses(randomAccounts(r, accs, r.Intn(3))),
+				owners[r.Intn(len(owners))].Address,
+			)
+			msg := types.NewMsgWriteScopeRequest(*scope, accountAddresses(ownerAccs))
+			return Dispatch(r, app, ctx, ak, bk, ownerAccs, chainID, msg)
+		}
+
+		existing := scopes[r.Intn(len(scopes))]
+		proposed := existing
+		proposed.DataAccess = accountAddresses(randomAccounts(r, accs, r.Intn(3)))
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(existing.Owners), nil, types.TypeURLMsgWriteScopeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgWriteScopeRequest(proposed, accountAddresses(signers))
+		if r.Intn(2) == 0 {
+			msg.ExpectedValueHash = k.GetScopeValueHash(existing)
+		}
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteScope will delete a random unlocked scope.
+func SimulateMsgDeleteScope(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteScopeRequest
+		scopes := getUnlockedScopes(ctx, k)
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlocked scopes available to delete"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		var direct []string
+		if len(scope.ValueOwnerAddress) > 0 {
+			direct = []string{scope.ValueOwnerAddress}
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scope.Owners), direct, types.TypeURLMsgDeleteScopeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteScopeRequest(scope.ScopeId, r.Intn(2) == 0, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgAddScopeDataAccess will add a random account to the data access list of a scope.
+func SimulateMsgAddScopeDataAccess(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgAddScopeDataAccessRequest
+		scopes := getUnlockedScopes(ctx, k)
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlocked scopes available"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		acc, _ := simtypes.RandomAcc(r, accs)
+		for _, da := range scope.DataAccess {
+			if da == acc.Address.String() {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "account already has data access"), nil, nil
+			}
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scope.Owners), nil, types.TypeURLMsgAddScopeDataAccessRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgAddScopeDataAccessRequest(scope.ScopeId, []string{acc.Address.String()}, accountAddresses(signers))
+		// Sometimes give the data access an expiration so that it gets pruned later on.
+		if r.Intn(2) == 0 {
+			expiration := ctx.BlockTime().Add(time.Duration(1+r.Intn(60)) * time.Minute)
+			msg.Expiration = &expiration
+		}
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteScopeDataAccess will remove a random address from the data access list of a scope.
+func SimulateMsgDeleteScopeDataAccess(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteScopeDataAccessRequest
+		var scopes []types.Scope
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			if len(scope.DataAccess) > 0 {
+				scopes = append(scopes, scope)
+			}
+		}
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlocked scopes with data access available"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		addr := scope.DataAccess[r.Intn(len(scope.DataAccess))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scope.Owners), nil, types.TypeURLMsgDeleteScopeDataAccessRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteScopeDataAccessRequest(scope.ScopeId, []string{addr}, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgAddScopeOwner will add a random account as an owner of a scope with a random role.
+func SimulateMsgAddScopeOwner(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgAddScopeOwnerRequest
+		scopes := getUnlockedScopes(ctx, k)
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlocked scopes available"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		acc, _ := simtypes.RandomAcc(r, accs)
+		newOwner := types.Party{Address: acc.Address.String(), Role: randomPartyType(r)}
+		for _, owner := range scope.Owners {
+			if owner.Equals(newOwner) {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "party is already an owner"), nil, nil
+			}
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scope.Owners), nil, types.TypeURLMsgAddScopeOwnerRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgAddScopeOwnerRequest(scope.ScopeId, []types.Party{newOwner}, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteScopeOwner will remove a random owner address from a scope that has more than one owner.
+func SimulateMsgDeleteScopeOwner(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteScopeOwnerRequest
+		var scopes []types.Scope
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			if len(scope.Owners) > 1 {
+				scopes = append(scopes, scope)
+			}
+		}
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlocked scopes with multiple owners available"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		addr := scope.Owners[r.Intn(len(scope.Owners))].Address
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scope.Owners), nil, types.TypeURLMsgDeleteScopeOwnerRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteScopeOwnerRequest(scope.ScopeId, []string{addr}, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgLockScope will have one of the owners of a random scope lock it.
+func SimulateMsgLockScope(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgLockScopeRequest
+		scopes := getUnlockedScopes(ctx, k)
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlocked scopes available to lock"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		locker := scope.Owners[r.Intn(len(scope.Owners))].Address
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scope.Owners), []string{locker}, types.TypeURLMsgLockScopeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgLockScopeRequest(scope.ScopeId, locker, simtypes.RandStringOfLength(r, 20), accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgUnlockScope will have the locker of a random locked scope unlock it.
+func SimulateMsgUnlockScope(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgUnlockScopeRequest
+		var locks []types.ScopeLock
+		if err := k.IterateScopeLocks(ctx, func(lock types.ScopeLock) (stop bool) {
+			locks = append(locks, lock)
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "iterator of existing scope locks failed"), nil, err
+		}
+		if len(locks) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no locked scopes available to unlock"), nil, nil
+		}
+
+		lock := locks[r.Intn(len(locks))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, []string{lock.Locker}, nil, types.TypeURLMsgUnlockScopeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgUnlockScopeRequest(lock.ScopeId, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgTokenizeScope will have the value owner of a random scope tokenize it and send the coin to a random account.
+func SimulateMsgTokenizeScope(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgTokenizeScopeRequest
+		var scopes []types.Scope
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			if len(scope.ValueOwnerAddress) > 0 && !scope.IsTokenized() {
+				scopes = append(scopes, scope)
+			}
+		}
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no scopes with a value owner available to tokenize"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, []string{scope.ValueOwnerAddress}, nil, types.TypeURLMsgTokenizeScopeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		transferAgents := accountAddresses(appendAccountIfNew(randomAccounts(r, accs, r.Intn(2)), recipient))
+		msg := types.NewMsgTokenizeScopeRequest(scope.ScopeId, recipient.Address.String(), transferAgents, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDetokenizeScope will have the holder of a random tokenized scope's coin detokenize it.
+func SimulateMsgDetokenizeScope(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDetokenizeScopeRequest
+		var scopes []types.Scope
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			if scope.IsTokenized() {
+				scopes = append(scopes, scope)
+			}
+		}
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no tokenized scopes available to detokenize"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		denom := types.ScopeTokenDenom(scope.ScopeId)
+		var holder *simtypes.Account
+		for i := range accs {
+			if bk.GetBalance(ctx, accs[i].Address, denom).IsPositive() {
+				holder = &accs[i]
+				break
+			}
+		}
+		if holder == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, fmt.Sprintf("no account holds the %s coin", denom)), nil, nil
+		}
+
+		// The holder always signs for itself since the coin has to come from one of the signers.
+		valueOwner := ""
+		if r.Intn(2) == 0 {
+			acc, _ := simtypes.RandomAcc(r, accs)
+			valueOwner = acc.Address.String()
+		}
+		signers := []simtypes.Account{*holder}
+		msg := types.NewMsgDetokenizeScopeRequest(scope.ScopeId, valueOwner, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgCloneScope will create a new scope from a random scope that isn't tokenized, sometimes copying its
+// clonable records.
+func SimulateMsgCloneScope(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgCloneScopeRequest
+		var scopes []types.Scope
+		if err := k.IterateScopes(ctx, func(scope types.Scope) (stop bool) {
+			if !scope.IsTokenized() {
+				scopes = append(scopes, scope)
+			}
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "iterator of existing scopes failed"), nil, err
+		}
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no untokenized scopes available to clone"), nil, nil
+		}
+
+		source := scopes[r.Intn(len(scopes))]
+		var direct []string
+		if len(source.ValueOwnerAddress) > 0 {
+			direct = []string{source.ValueOwnerAddress}
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(source.Owners), direct, types.TypeURLMsgCloneScopeRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		scopeID := types.ScopeMetadataAddress(randomUUID(r))
+		includeRecords := r.Intn(2) == 0
+		var sessionID types.MetadataAddress
+		if includeRecords {
+			sessionID = scopeID.MustGetAsSessionAddress(randomUUID(r))
+		}
+		msg := types.NewMsgCloneScopeRequest(source.ScopeId, scopeID, includeRecords, sessionID, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgOfferValueOwnerTransfer will have the value owner of a random scope offer its value ownership to
+// another account for some of that account's bond denom.
+func SimulateMsgOfferValueOwnerTransfer(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgOfferValueOwnerTransferRequest
+		var scopes []types.Scope
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			if len(scope.ValueOwnerAddress) > 0 && !scope.IsTokenized() {
+				scopes = append(scopes, scope)
+			}
+		}
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no scopes with a value owner available to offer"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		buyer, _ := simtypes.RandomAcc(r, accs)
+		if buyer.Address.String() == scope.ValueOwnerAddress {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "buyer is already the value owner"), nil, nil
+		}
+		balance := bk.SpendableCoins(ctx, buyer.Address).AmountOf(sdk.DefaultBondDenom)
+		if !balance.GT(sdk.NewInt(10)) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "buyer does not have enough funds"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, balance.QuoRaw(10))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, []string{scope.ValueOwnerAddress}, nil, types.TypeURLMsgOfferValueOwnerTransferRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		price := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+		expiration := ctx.BlockTime().Add(time.Duration(1+r.Intn(48)) * time.Hour)
+		msg := types.NewMsgOfferValueOwnerTransferRequest(scope.ScopeId, buyer.Address.String(), price, expiration, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgAcceptValueOwnerTransfer will have the buyer of a random open value owner transfer offer accept it.
+func SimulateMsgAcceptValueOwnerTransfer(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgAcceptValueOwnerTransferRequest
+		offers := getOpenValueOwnerTransferOffers(ctx, k)
+		if len(offers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open value owner transfer offers available to accept"), nil, nil
+		}
+
+		offer := offers[r.Intn(len(offers))]
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, []string{offer.Buyer}, nil, types.TypeURLMsgAcceptValueOwnerTransferRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgAcceptValueOwnerTransferRequest(offer.ScopeId, offer.Buyer, offer.Price, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgCancelValueOwnerTransfer will have either the seller or the buyer of a random open value owner transfer
+// offer cancel it.
+func SimulateMsgCancelValueOwnerTransfer(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgCancelValueOwnerTransferRequest
+		offers := getOpenValueOwnerTransferOffers(ctx, k)
+		if len(offers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open value owner transfer offers available to cancel"), nil, nil
+		}
+
+		offer := offers[r.Intn(len(offers))]
+		canceller := offer.Buyer
+		if r.Intn(2) == 0 {
+			canceller = offer.Seller
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, []string{canceller}, nil, types.TypeURLMsgCancelValueOwnerTransferRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgCancelValueOwnerTransferRequest(offer.ScopeId, offer.Buyer, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgMigrateScopeSpec will move a random scope to a newer version of its scope specification.
+func SimulateMsgMigrateScopeSpec(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgMigrateScopeSpecRequest
+		var scopes []types.Scope
+		var newSpecIDs []types.MetadataAddress
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			if newer, found := k.GetNewerSpecVersion(ctx, scope.SpecificationId); found {
+				scopes = append(scopes, scope)
+				newSpecIDs = append(newSpecIDs, newer)
+			}
+		}
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no scopes with a newer scope specification available"), nil, nil
+		}
+
+		i := r.Intn(len(scopes))
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scopes[i].Owners), nil, types.TypeURLMsgMigrateScopeSpecRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgMigrateScopeSpecRequest(scopes[i].ScopeId, newSpecIDs[i], accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgWriteSession will create or update a session in a random scope using one of its contract specifications.
+func SimulateMsgWriteSession(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgWriteSessionRequest
+		scopes := getUnlockedScopes(ctx, k)
+		if len(scopes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlocked scopes available"), nil, nil
+		}
+
+		scope := scopes[r.Intn(len(scopes))]
+		var session types.Session
+		existing := getSessions(ctx, k, scope.ScopeId)
+		if len(existing) > 0 && r.Intn(3) == 0 {
+			session = existing[r.Intn(len(existing))]
+			session.Name = randomName(r)
+			session.Audit = nil
+		} else {
+			scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+			if !found || len(scopeSpec.ContractSpecIds) == 0 {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "scope specification has no contract specifications"), nil, nil
+			}
+			contractSpec, found := k.GetContractSpecification(ctx, scopeSpec.ContractSpecIds[r.Intn(len(scopeSpec.ContractSpecIds))])
+			if !found {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "contract specification not found"), nil, nil
+			}
+			sessionID, err := scope.ScopeId.AsSessionAddress(randomUUID(r))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+			}
+			session = *types.NewSession(randomName(r), sessionID, contractSpec.SpecificationId,
+				randomParties(r, accs, contractSpec.PartiesInvolved), nil)
+		}
+
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(scope.Owners), nil, types.TypeURLMsgWriteSessionRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgWriteSessionRequest(session, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgWriteRecord will write a record that satisfies one of the record specifications of a random session.
+func SimulateMsgWriteRecord(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgWriteRecordRequest
+		var sessions []types.Session
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			sessions = append(sessions, getSessions(ctx, k, scope.ScopeId)...)
+		}
+		if len(sessions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no sessions in unlocked scopes available"), nil, nil
+		}
+
+		session := sessions[r.Intn(len(sessions))]
+		recSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, session.SpecificationId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+		if len(recSpecs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no record specifications for session contract specification"), nil, nil
+		}
+
+		recSpec := recSpecs[r.Intn(len(recSpecs))]
+		record, ok := randomRecordForSpec(r, ctx, k, session.SessionId, *recSpec)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "record specification inputs cannot be satisfied"), nil, nil
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, partyAddresses(session.Parties), nil, types.TypeURLMsgWriteRecordRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgWriteRecordRequest(record, nil, "", accountAddresses(signers), session.Parties)
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteRecord will delete a random record from an unlocked scope.
+func SimulateMsgDeleteRecord(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteRecordRequest
+		var records []types.Record
+		var owners [][]string
+		for _, scope := range getUnlockedScopes(ctx, k) {
+			scopeOwners := partyAddresses(scope.Owners)
+			if err := k.IterateRecords(ctx, scope.ScopeId, func(record types.Record) (stop bool) {
+				records = append(records, record)
+				owners = append(owners, scopeOwners)
+				return false
+			}); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "iterator of existing records failed"), nil, err
+			}
+		}
+		if len(records) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no records in unlocked scopes available to delete"), nil, nil
+		}
+
+		i := r.Intn(len(records))
+		recordID, err := records[i].SessionId.AsRecordAddress(records[i].Name)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+		signers, revoke, err := getSigners(r, app, ctx, ak, bk, accs, chainID, owners[i], nil, types.TypeURLMsgDeleteRecordRequest)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		defer revoke()
+
+		msg := types.NewMsgDeleteRecordRequest(recordID, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// SimulateMsgWriteP8eContractSpec will write a random p8e contract spec with one or two considerations.
+func SimulateMsgWriteP8eContractSpec(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		partyTypes := randomPartyTypes(r)
+		parties := make([]p8e.PartyType, len(partyTypes))
+		for i, pt := range partyTypes {
+			parties[i] = p8e.PartyType(pt)
+		}
+
+		considerations := make([]*p8e.ConsiderationSpec, r.Intn(2)+1)
+		for i := range considerations {
+			considerations[i] = &p8e.ConsiderationSpec{
+				FuncName:         randomName(r),
+				ResponsibleParty: parties[r.Intn(len(parties))],
+				InputSpecs: []*p8e.DefinitionSpec{
+					{
+						Name: randomName(r),
+						ResourceLocation: &p8e.Location{
+							Ref:       &p8e.ProvenanceReference{Hash: randomHash(r)},
+							Classname: randomClassName(r),
+						},
+						Type: p8e.DefinitionSpecType_DEFINITION_SPEC_TYPE_PROPOSED,
+					},
+				},
+				OutputSpec: &p8e.OutputSpec{
+					Spec: &p8e.DefinitionSpec{
+						Name:             fmt.Sprintf("%s-%d", randomName(r), i),
+						ResourceLocation: &p8e.Location{Classname: randomClassName(r)},
+						Type:             p8e.DefinitionSpecType_DEFINITION_SPEC_TYPE_FACT,
+					},
+				},
+			}
+		}
+
+		spec := p8e.ContractSpec{
+			Definition: &p8e.DefinitionSpec{
+				Name:             randomName(r),
+				ResourceLocation: &p8e.Location{Classname: randomClassName(r)},
+			},
+			PartiesInvolved:    parties,
+			ConsiderationSpecs: considerations,
+		}
+
+		owners := randomAccounts(r, accs, r.Intn(2)+1)
+		msg := types.NewMsgWriteP8EContractSpecRequest(spec, accountAddresses(owners))
+		return Dispatch(r, app, ctx, ak, bk, owners, chainID, msg)
+	}
+}
+
+// SimulateMsgP8eMemorializeContract will memorialize a p8e contract into a new scope, creating its session and records.
+func SimulateMsgP8eMemorializeContract(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgP8eMemorializeContractRequest
+		msg, recitalAccs, noOpReason, err := randomP8eMemorializeContract(r, ctx, k, accs)
+		if msg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, noOpReason), nil, err
+		}
+		return Dispatch(r, app, ctx, ak, bk, []simtypes.Account{recitalAccs[0]}, chainID, msg)
+	}
+}
+
+// SimulateMsgExecuteContractSession will write a random contract execution into a new scope, creating its session and
+// records. It is built the same way as a p8e memorialize contract msg, then converted.
+func SimulateMsgExecuteContractSession(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgExecuteContractSessionRequest
+		p8eMsg, recitalAccs, noOpReason, err := randomP8eMemorializeContract(r, ctx, k, accs)
+		if p8eMsg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, noOpReason), nil, err
+		}
+		msg, err := types.ConvertP8eMemorializeContractToExecuteContractSession(p8eMsg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+
+		// The recital accounts can repeat, but each signer only signs once.
+		signers := make([]simtypes.Account, 0, len(msg.Signers))
+		for _, signer := range msg.Signers {
+			for _, acc := range recitalAccs {
+				if acc.Address.String() == signer {
+					signers = append(signers, acc)
+					break
+				}
+			}
+		}
+		return Dispatch(r, app, ctx, ak, bk, signers, chainID, msg)
+	}
+}
+
+// randomP8eMemorializeContract creates a p8e memorialize contract msg for a random scope specification that has contract
+// specifications. Every role needed by either specification gets a recital with a random account, and the first of
+// those accounts is the invoker. If no msg can be made, the reason is returned instead.
+func randomP8eMemorializeContract(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (*types.MsgP8EMemorializeContractRequest, []simtypes.Account, string, error) {
+	var scopeSpecs []types.ScopeSpecification
+	for _, spec := range getAllScopeSpecs(ctx, k) {
+		if len(spec.ContractSpecIds) > 0 {
+			scopeSpecs = append(scopeSpecs, spec)
+		}
+	}
+	if len(scopeSpecs) == 0 {
+		return nil, nil, "no scope specifications with contract specifications", nil
+	}
+
+	scopeSpec := scopeSpecs[r.Intn(len(scopeSpecs))]
+	contractSpec, found := k.GetContractSpecification(ctx, scopeSpec.ContractSpecIds[r.Intn(len(scopeSpec.ContractSpecIds))])
+	if !found {
+		return nil, nil, "contract specification not found", nil
+	}
+	recSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, contractSpec.SpecificationId)
+	if err != nil {
+		return nil, nil, err.Error(), err
+	}
+
+	// Every role needed by either specification gets its own recital, and every recital signs.
+	var roles []types.PartyType
+	for _, role := range append(append([]types.PartyType{}, scopeSpec.PartiesInvolved...), contractSpec.PartiesInvolved...) {
+		if !containsPartyType(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	recitals := make([]*p8e.Recital, len(roles))
+	signatures := make([]*p8e.Signature, len(roles))
+	var recitalAccs []simtypes.Account
+	for i, role := range roles {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		recitalAccs = append(recitalAccs, acc)
+		keys := p8eKeys(acc)
+		recitals[i] = &p8e.Recital{SignerRole: p8e.PartyType(role), Signer: keys}
+		signatures[i] = &p8e.Signature{Signature: randomHash(r), Signer: keys}
+	}
+
+	var considerations []*p8e.Consideration
+	for _, recSpec := range recSpecs {
+		inputs := make([]*p8e.ProposedFact, 0, len(recSpec.Inputs))
+		for _, input := range recSpec.Inputs {
+			if input.GetHash() == "" {
+				break
+			}
+			inputs = append(inputs, &p8e.ProposedFact{Name: input.Name, Hash: randomHash(r), Classname: input.TypeName})
+		}
+		if len(inputs) != len(recSpec.Inputs) {
+			continue
+		}
+		considerations = append(considerations, &p8e.Consideration{
+			ConsiderationName: recSpec.Name,
+			Inputs:            inputs,
+			Result: &p8e.ExecutionResult{
+				Output: &p8e.ProposedFact{Name: recSpec.Name, Hash: randomHash(r), Classname: recSpec.TypeName},
+				Result: p8e.ExecutionResultType_RESULT_TYPE_PASS,
+			},
+		})
+	}
+
+	invoker := recitalAccs[0]
+	msg := types.NewMsgP8EMemorializeContractRequest()
+	msg.ScopeId = randomUUID(r).String()
+	msg.GroupId = randomUUID(r).String()
+	msg.ScopeSpecificationId = scopeSpec.SpecificationId.String()
+	msg.Invoker = invoker.Address.String()
+	msg.Signatures = &p8e.SignatureSet{Signatures: signatures}
+	msg.Contract = &p8e.Contract{
+		Definition: &p8e.DefinitionSpec{
+			Name: randomName(r),
+			ResourceLocation: &p8e.Location{
+				Ref:       &p8e.ProvenanceReference{Hash: randomHash(r)},
+				Classname: contractSpec.ClassName,
+			},
+		},
+		Spec: &p8e.Fact{
+			Name:         contractSpec.ClassName,
+			DataLocation: &p8e.Location{Ref: &p8e.ProvenanceReference{Hash: contractSpec.SpecificationId.String()}},
+		},
+		Invoker:        p8eKeys(invoker),
+		Considerations: considerations,
+		Recitals:       recitals,
+	}
+	return msg, recitalAccs, "", nil
+}
+
+// SimulateMsgBindOSLocator will bind a new named object store locator to a random account.
+func SimulateMsgBindOSLocator(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgBindOSLocatorRequest
+		owner, _ := simtypes.RandomAcc(r, accs)
+		locator := types.NewOSLocatorRecord(owner.Address, nil, randomURI(r, k.GetOSLocatorParams(ctx).MaxUriLength))
+		locator.Name = randomName(r)
+		locator.Priority = uint32(r.Intn(10))
+		if r.Intn(2) == 0 {
+			encryptionKey, _ := simtypes.RandomAcc(r, accs)
+			locator.EncryptionKey = encryptionKey.Address.String()
+		}
+		if specs := getAllScopeSpecs(ctx, k); len(specs) > 0 && r.Intn(2) == 0 {
+			locator.ScopeSpecIds = []types.MetadataAddress{specs[r.Intn(len(specs))].SpecificationId}
+		}
+		if k.OSLocatorExists(ctx, owner.Address, locator.Name) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "object store locator already bound"), nil, nil
+		}
+
+		msg := types.NewMsgBindOSLocatorRequest(locator)
+		return Dispatch(r, app, ctx, ak, bk, []simtypes.Account{owner}, chainID, msg)
+	}
+}
+
+// SimulateMsgModifyOSLocator will change the uri and priority of a random object store locator.
+func SimulateMsgModifyOSLocator(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgModifyOSLocatorRequest
+		locators := getAllOSLocators(ctx, k)
+		if len(locators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no object store locators available to modify"), nil, nil
+		}
+
+		locator := locators[r.Intn(len(locators))]
+		owner, err := findAccounts(accs, []string{locator.Owner})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		locator.LocatorUri = randomURI(r, k.GetOSLocatorParams(ctx).MaxUriLength)
+		locator.Priority = uint32(r.Intn(10))
+
+		msg := types.NewMsgModifyOSLocatorRequest(locator)
+		return Dispatch(r, app, ctx, ak, bk, owner, chainID, msg)
+	}
+}
+
+// SimulateMsgDeleteOSLocator will delete a random object store locator.
+func SimulateMsgDeleteOSLocator(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk bankkeeper.ViewKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.TypeMsgDeleteOSLocatorRequest
+		locators := getAllOSLocators(ctx, k)
+		if len(locators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no object store locators available to delete"), nil, nil
+		}
+
+		locator := locators[r.Intn(len(locators))]
+		owner, err := findAccounts(accs, []string{locator.Owner})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgDeleteOSLocatorRequest(locator)
+		return Dispatch(r, app, ctx, ak, bk, owner, chainID, msg)
+	}
+}
+
+// Dispatch sends an operation to the chain using a given account/funds on account for fees.  The first signer pays
+// the fees, and the signers must be in the same order as the message's signers.
+func Dispatch(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak authkeeper.AccountKeeperI,
+	bk bankkeeper.ViewKeeper,
+	signers []simtypes.Account,
+	chainID string,
+	msg sdk.Msg,
+) (
+	simtypes.OperationMsg,
+	[]simtypes.FutureOperation,
+	error,
+) {
+	if err := deliver(r, app, ctx, ak, bk, signers, chainID, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, fmt.Sprintf("%T", msg), err.Error()), nil, nil
+	}
+	// Amino json can't handle the oneof fields in some of these msgs, so the proto json is used for the operation msg.
+	legacyMsg := msg.(legacytx.LegacyMsg)
+	return simtypes.NewOperationMsgBasic(legacyMsg.Route(), legacyMsg.Type(), "", true, (&codec.ProtoCodec{}).MustMarshalJSON(msg)), nil, nil
+}
+
+// deliver signs the msg with all the given signers and delivers it in its own tx.
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak authkeeper.AccountKeeperI,
+	bk bankkeeper.ViewKeeper,
+	signers []simtypes.Account,
+	chainID string,
+	msg sdk.Msg,
+) error {
+	if len(signers) == 0 {
+		return fmt.Errorf("no signers provided")
+	}
+	accNums := make([]uint64, len(signers))
+	accSeqs := make([]uint64, len(signers))
+	privs := make([]cryptotypes.PrivKey, len(signers))
+	for i, signer := range signers {
+		account := ak.GetAccount(ctx, signer.Address)
+		if account == nil {
+			return fmt.Errorf("account %s not found", signer.Address)
+		}
+		accNums[i] = account.GetAccountNumber()
+		accSeqs[i] = account.GetSequence()
+		privs[i] = signer.PrivKey
+	}
+
+	// A scope's coin is left out so that it isn't paid away as a fee.
+	var feeCoins sdk.Coins
+	for _, coin := range bk.SpendableCoins(ctx, signers[0].Address) {
+		if _, isScopeToken := types.ParseScopeTokenDenom(coin.Denom); !isScopeToken {
+			feeCoins = append(feeCoins, coin)
+		}
+	}
+	fees, err := simtypes.RandomFees(r, ctx, feeCoins)
+	if err != nil {
+		return fmt.Errorf("unable to generate fees: %w", err)
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas*uint64(len(signers)),
+		chainID,
+		accNums,
+		accSeqs,
+		privs...,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to generate mock tx: %w", err)
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	return err
+}
+
+// getSigners looks up the accounts needed to sign for the given addresses. Each of the delegable addresses will
+// occasionally grant another account authz permission for the msg type, and have that account sign instead.
+// The direct addresses must always sign for themselves. The returned accounts are unique, with the direct ones first.
+// The returned revoke func removes any grants that were made and should be called once the msg has been dispatched.
+func getSigners(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak authkeeper.AccountKeeperI,
+	bk bankkeeper.ViewKeeper,
+	accs []simtypes.Account,
+	chainID string,
+	delegable []string,
+	direct []string,
+	msgTypeURL string,
+) ([]simtypes.Account, func(), error) {
+	var grants []*authz.MsgRevoke
+	var granters []simtypes.Account
+	revoke := func() {
+		for i, msg := range grants {
+			// Failures here only leave a grant behind, which doesn't hurt anything.
+			_ = deliver(r, app, ctx, ak, bk, []simtypes.Account{granters[i]}, chainID, msg)
+		}
+	}
+
+	signers, err := findAccounts(accs, direct)
+	if err != nil {
+		return nil, revoke, err
+	}
+	owners, err := findAccounts(accs, delegable)
+	if err != nil {
+		return nil, revoke, err
+	}
+	for _, owner := range owners {
+		signer := owner
+		if len(accs) > 1 && r.Intn(authzChance) == 0 {
+			grantee, _ := simtypes.RandomAcc(r, accs)
+			if !grantee.Equals(owner) && grantAuthz(r, app, ctx, ak, bk, owner, grantee, chainID, msgTypeURL) == nil {
+				signer = grantee
+				msg := authz.NewMsgRevoke(owner.Address, grantee.Address, msgTypeURL)
+				grants = append(grants, &msg)
+				granters = append(granters, owner)
+			}
+		}
+		signers = appendAccountIfNew(signers, signer)
+	}
+	return signers, revoke, nil
+}
+
+// grantAuthz delivers a tx in which the granter gives the grantee permission to sign msgs of the given type for it.
+func grantAuthz(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak authkeeper.AccountKeeperI,
+	bk bankkeeper.ViewKeeper,
+	granter, grantee simtypes.Account,
+	chainID string,
+	msgTypeURL string,
+) error {
+	expiration := ctx.BlockTime().Add(time.Duration(r.Intn(24)+1) * time.Hour)
+	msg, err := authz.NewMsgGrant(granter.Address, grantee.Address, authz.NewGenericAuthorization(msgTypeURL), expiration)
+	if err != nil {
+		return err
+	}
+	return deliver(r, app, ctx, ak, bk, []simtypes.Account{granter}, chainID, msg)
+}
+
+// findAccounts returns the simulation account for each of the given addresses, skipping duplicates.
+func findAccounts(accs []simtypes.Account, addrs []string) ([]simtypes.Account, error) {
+	var rv []simtypes.Account
+	for _, addr := range addrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		acc, found := simtypes.FindAccount(accs, accAddr)
+		if !found {
+			return nil, fmt.Errorf("no simulation account found for %s", addr)
+		}
+		rv = appendAccountIfNew(rv, acc)
+	}
+	return rv, nil
+}
+
+// appendAccountIfNew adds the account to the list unless it's already there.
+func appendAccountIfNew(accs []simtypes.Account, acc simtypes.Account) []simtypes.Account {
+	for _, a := range accs {
+		if a.Equals(acc) {
+			return accs
+		}
+	}
+	return append(accs, acc)
+}
+
+// accountAddresses returns the bech32 address of each account.
+func accountAddresses(accs []simtypes.Account) []string {
+	rv := make([]string, len(accs))
+	for i, acc := range accs {
+		rv[i] = acc.Address.String()
+	}
+	return rv
+}
+
+// partyAddresses returns the address of each party, skipping duplicates.
+func partyAddresses(parties []types.Party) []string {
+	var rv []string
+	for _, p := range parties {
+		found := false
+		for _, addr := range rv {
+			if addr == p.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			rv = append(rv, p.Address)
+		}
+	}
+	return rv
+}
+
+// randomAccounts returns up to count distinct random accounts.
+func randomAccounts(r *rand.Rand, accs []simtypes.Account, count int) []simtypes.Account {
+	var rv []simtypes.Account
+	for _, i := range r.Perm(len(accs)) {
+		if len(rv) >= count {
+			break
+		}
+		rv = append(rv, accs[i])
+	}
+	return rv
+}
+
+// randomParties returns a party with a random account for each of the given roles.
+func randomParties(r *rand.Rand, accs []simtypes.Account, roles []types.PartyType) []types.Party {
+	parties := make([]types.Party, len(roles))
+	for i, role := range roles {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		parties[i] = types.Party{Address: acc.Address.String(), Role: role}
+	}
+	return parties
+}
+
+// randomPartyType returns a random valid party type.
+func randomPartyType(r *rand.Rand) types.PartyType {
+	return types.PartyType(r.Intn(len(types.PartyType_name)-1) + 1)
+}
+
+// randomPartyTypes returns between one and three distinct valid party types.
+func randomPartyTypes(r *rand.Rand) []types.PartyType {
+	perm := r.Perm(len(types.PartyType_name) - 1)
+	rv := make([]types.PartyType, r.Intn(3)+1)
+	for i := range rv {
+		rv[i] = types.PartyType(perm[i] + 1)
+	}
+	return rv
+}
+
+// randomSubset returns a non-empty random subset of the given party types.
+func randomSubset(r *rand.Rand, partyTypes []types.PartyType) []types.PartyType {
+	var rv []types.PartyType
+	for _, pt := range partyTypes {
+		if r.Intn(2) == 0 {
+			rv = append(rv, pt)
+		}
+	}
+	if len(rv) == 0 && len(partyTypes) > 0 {
+		rv = append(rv, partyTypes[r.Intn(len(partyTypes))])
+	}
+	return rv
+}
+
+func containsPartyType(partyTypes []types.PartyType, pt types.PartyType) bool {
+	for _, p := range partyTypes {
+		if p == pt {
+			return true
+		}
+	}
+	return false
+}
+
+// randomContractSpecIDs returns the ids of up to two random contract specifications.
+func randomContractSpecIDs(r *rand.Rand, specs []types.ContractSpecification) []types.MetadataAddress {
+	count := r.Intn(3)
+	var rv []types.MetadataAddress
+	for _, i := range r.Perm(len(specs)) {
+		if len(rv) >= count {
+			break
+		}
+		rv = append(rv, specs[i].SpecificationId)
+	}
+	return rv
+}
+
+// randomRecordForSpec creates a record in the given session that satisfies the record specification.
+// False is returned if one of the spec's record inputs refers to a record that doesn't exist.
+func randomRecordForSpec(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, sessionID types.MetadataAddress, spec types.RecordSpecification) (types.Record, bool) {
+	inputs := make([]types.RecordInput, len(spec.Inputs))
+	for i, inputSpec := range spec.Inputs {
+		input := types.RecordInput{Name: inputSpec.Name, TypeName: inputSpec.TypeName}
+		if source, isRecord := inputSpec.Source.(*types.InputSpecification_RecordId); isRecord {
+			if _, found := k.GetRecord(ctx, source.RecordId); !found {
+				return types.Record{}, false
+			}
+			input.Source = &types.RecordInput_RecordId{RecordId: source.RecordId}
+			input.Status = types.RecordInputStatus_Record
+		} else {
+			input.Source = &types.RecordInput_Hash{Hash: randomHash(r)}
+			input.Status = types.RecordInputStatus_Proposed
+		}
+		inputs[i] = input
+	}
+
+	outputCount := 1
+	if spec.ResultType == types.DefinitionType_DEFINITION_TYPE_RECORD_LIST {
+		outputCount += r.Intn(3)
+	}
+	outputs := make([]types.RecordOutput, outputCount)
+	for i := range outputs {
+		outputs[i] = *types.NewRecordOutput(randomHash(r), types.ResultStatus_RESULT_STATUS_PASS)
+	}
+
+	process := types.NewProcess(randomClassName(r), &types.Process_Hash{Hash: randomHash(r)}, randomName(r))
+	return *types.NewRecord(spec.Name, sessionID, *process, inputs, outputs, spec.SpecificationId), true
+}
+
+// p8eKeys returns the p8e signing keys of the given account.
+func p8eKeys(acc simtypes.Account) *p8e.SigningAndEncryptionPublicKeys {
+	return &p8e.SigningAndEncryptionPublicKeys{
+		SigningPublicKey: &p8e.PublicKey{
+			PublicKeyBytes: acc.PubKey.Bytes(),
+			Type:           p8e.PublicKeyType_ELLIPTIC,
+			Curve:          p8e.PublicKeyCurve_SECP256K1,
+		},
+	}
+}
+
+// randomUUID creates a version 4 uuid from the simulation's source of randomness so that runs are repeatable.
+func randomUUID(r *rand.Rand) uuid.UUID {
+	var rv uuid.UUID
+	r.Read(rv[:])
+	rv[6] = (rv[6] & 0x0f) | 0x40
+	rv[8] = (rv[8] & 0x3f) | 0x80
+	return rv
+}
+
+func randomName(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, r.Intn(10)+3)
+}
+
+func randomClassName(r *rand.Rand) string {
+	return fmt.Sprintf("io.provenance.sim.%s", simtypes.RandStringOfLength(r, r.Intn(10)+3))
+}
+
+func randomHash(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, 44)
+}
+
+// randomJSONSchema returns a json schema for an object with a few random required string properties.
+func randomJSONSchema(r *rand.Rand) []byte {
+	props := make([]string, r.Intn(3)+1)
+	for i := range props {
+		props[i] = fmt.Sprintf("%s%d", simtypes.RandStringOfLength(r, r.Intn(5)+3), i)
+	}
+	var properties, required strings.Builder
+	for i, prop := range props {
+		if i > 0 {
+			properties.WriteString(",")
+			required.WriteString(",")
+		}
+		properties.WriteString(fmt.Sprintf("%q:{\"type\":\"string\"}", prop))
+		required.WriteString(fmt.Sprintf("%q", prop))
+	}
+	return []byte(fmt.Sprintf("{\"type\":\"object\",\"properties\":{%s},\"required\":[%s]}", properties.String(), required.String()))
+}
+
+func randomDescription(r *rand.Rand) *types.Description {
+	return types.NewDescription(randomName(r), simtypes.RandStringOfLength(r, r.Intn(50)+1), "", "")
+}
+
+// randomURI returns a random https uri that is no longer than maxLength.
+func randomURI(r *rand.Rand, maxLength uint32) string {
+	uri := fmt.Sprintf("https://%s.example.com/%s", simtypes.RandStringOfLength(r, 8), simtypes.RandStringOfLength(r, r.Intn(20)))
+	if maxLength > 0 && uint32(len(uri)) > maxLength {
+		uri = uri[:maxLength]
+	}
+	return uri
+}
+
+func getAllScopeSpecs(ctx sdk.Context, k keeper.Keeper) []types.ScopeSpecification {
+	var rv []types.ScopeSpecification
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateScopeSpecs(ctx, func(spec types.ScopeSpecification) (stop bool) {
+		rv = append(rv, spec)
+		return false
+	})
+	return rv
+}
+
+func getAllContractSpecs(ctx sdk.Context, k keeper.Keeper) []types.ContractSpecification {
+	var rv []types.ContractSpecification
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateContractSpecs(ctx, func(spec types.ContractSpecification) (stop bool) {
+		rv = append(rv, spec)
+		return false
+	})
+	return rv
+}
+
+func getAllRecordSpecs(ctx sdk.Context, k keeper.Keeper) []types.RecordSpecification {
+	var rv []types.RecordSpecification
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateRecordSpecs(ctx, func(spec types.RecordSpecification) (stop bool) {
+		rv = append(rv, spec)
+		return false
+	})
+	return rv
+}
+
+func getAllRecordTypes(ctx sdk.Context, k keeper.Keeper) []types.RecordType {
+	var rv []types.RecordType
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateRecordTypes(ctx, func(recordType types.RecordType) (stop bool) {
+		rv = append(rv, recordType)
+		return false
+	})
+	return rv
+}
+
+func getAllOSLocators(ctx sdk.Context, k keeper.Keeper) []types.ObjectStoreLocator {
+	var rv []types.ObjectStoreLocator
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateOSLocators(ctx, func(locator types.ObjectStoreLocator) (stop bool) {
+		rv = append(rv, locator)
+		return false
+	})
+	return rv
+}
+
+// getUnlockedScopes returns all the scopes that are not currently locked.
+func getUnlockedScopes(ctx sdk.Context, k keeper.Keeper) []types.Scope {
+	var rv []types.Scope
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateScopes(ctx, func(scope types.Scope) (stop bool) {
+		if _, locked := k.GetScopeLock(ctx, scope.ScopeId); !locked {
+			rv = append(rv, scope)
+		}
+		return false
+	})
+	return rv
+}
+
+// getOpenValueOwnerTransferOffers returns all the value owner transfer offers that have not expired.
+func getOpenValueOwnerTransferOffers(ctx sdk.Context, k keeper.Keeper) []types.ValueOwnerTransferOffer {
+	var rv []types.ValueOwnerTransferOffer
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateValueOwnerTransferOffers(ctx, types.MetadataAddress{}, func(offer types.ValueOwnerTransferOffer) (stop bool) {
+		if !offer.IsExpired(ctx.BlockTime()) {
+			rv = append(rv, offer)
+		}
+		return false
+	})
+	return rv
+}
+
+func getSessions(ctx sdk.Context, k keeper.Keeper, scopeID types.MetadataAddress) []types.Session {
+	var rv []types.Session
+	//nolint:errcheck // the handler never returns an error.
+	k.IterateSessions(ctx, scopeID, func(session types.Session) (stop bool) {
+		rv = append(rv, session)
+		return false
+	})
+	return rv
+}
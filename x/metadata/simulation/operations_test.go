@@ -0,0 +1,199 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/provenance-io/provenance/app"
+	simappparams "github.com/provenance-io/provenance/app/params"
+
+	"github.com/provenance-io/provenance/x/metadata/simulation"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.App
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := app.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	cdc := suite.app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, suite.app.MetadataKeeper,
+		suite.app.AccountKeeper, suite.app.BankKeeper,
+	)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []int{
+		simappparams.DefaultWeightMsgWriteScopeSpecification,
+		simappparams.DefaultWeightMsgDeleteScopeSpecification,
+		simappparams.DefaultWeightMsgWriteContractSpecification,
+		simappparams.DefaultWeightMsgDeleteContractSpecification,
+		simappparams.DefaultWeightMsgAddContractSpecToScopeSpec,
+		simappparams.DefaultWeightMsgDeleteContractSpecFromScopeSpec,
+		simappparams.DefaultWeightMsgWriteRecordSpecification,
+		simappparams.DefaultWeightMsgDeleteRecordSpecification,
+		simappparams.DefaultWeightMsgWriteRecordType,
+		simappparams.DefaultWeightMsgDeleteRecordType,
+		simappparams.DefaultWeightMsgWriteScope,
+		simappparams.DefaultWeightMsgDeleteScope,
+		simappparams.DefaultWeightMsgAddScopeDataAccess,
+		simappparams.DefaultWeightMsgDeleteScopeDataAccess,
+		simappparams.DefaultWeightMsgAddScopeOwner,
+		simappparams.DefaultWeightMsgDeleteScopeOwner,
+		simappparams.DefaultWeightMsgLockScope,
+		simappparams.DefaultWeightMsgUnlockScope,
+		simappparams.DefaultWeightMsgTokenizeScope,
+		simappparams.DefaultWeightMsgDetokenizeScope,
+		simappparams.DefaultWeightMsgCloneScope,
+		simappparams.DefaultWeightMsgOfferValueOwnerTransfer,
+		simappparams.DefaultWeightMsgAcceptValueOwnerTransfer,
+		simappparams.DefaultWeightMsgCancelValueOwnerTransfer,
+		simappparams.DefaultWeightMsgMigrateScopeSpec,
+		simappparams.DefaultWeightMsgWriteSession,
+		simappparams.DefaultWeightMsgWriteRecord,
+		simappparams.DefaultWeightMsgDeleteRecord,
+		simappparams.DefaultWeightMsgExecuteContractSession,
+		simappparams.DefaultWeightMsgWriteP8eContractSpec,
+		simappparams.DefaultWeightMsgP8eMemorializeContract,
+		simappparams.DefaultWeightMsgBindOSLocator,
+		simappparams.DefaultWeightMsgModifyOSLocator,
+		simappparams.DefaultWeightMsgDeleteOSLocator,
+	}
+
+	suite.Require().Len(weightedOps, len(expected))
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected[i], w.Weight(), "weight should be the same")
+		suite.Require().Equal(types.ModuleName, operationMsg.Route, "route should be the same")
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgWriteScopeSpecification() {
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgWriteScopeSpecification(suite.app.MetadataKeeper, suite.app.AccountKeeper, suite.app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgWriteScopeSpecificationRequest
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg))
+
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgWriteScopeSpecificationRequest, operationMsg.Name)
+	suite.Require().True(msg.Specification.SpecificationId.IsScopeSpecificationAddress())
+	suite.Require().Equal(msg.Signers, msg.Specification.OwnerAddresses)
+	suite.Require().Len(futureOperations, 0)
+
+	_, found := suite.app.MetadataKeeper.GetScopeSpecification(suite.ctx, msg.Specification.SpecificationId)
+	suite.Require().True(found, "scope specification should have been written")
+}
+
+func (suite *SimTestSuite) TestSimulateMsgBindOSLocator() {
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgBindOSLocator(suite.app.MetadataKeeper, suite.app.AccountKeeper, suite.app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgBindOSLocatorRequest
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg))
+
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgBindOSLocatorRequest, operationMsg.Name)
+	suite.Require().NotEmpty(msg.Locator.LocatorUri)
+	suite.Require().Len(futureOperations, 0)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Locator.Owner)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.MetadataKeeper.OSLocatorExists(suite.ctx, owner, msg.Locator.Name), "locator should have been bound")
+}
+
+func (suite *SimTestSuite) TestSimulateMsgWriteRecordType() {
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+	params := types.DefaultParams()
+	params.RecordTypeRegistrars = []string{accounts[0].Address.String()}
+	suite.app.MetadataKeeper.SetParams(suite.ctx, params)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgWriteRecordType(suite.app.MetadataKeeper, suite.app.AccountKeeper, suite.app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgWriteRecordTypeRequest
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg))
+
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.TypeMsgWriteRecordTypeRequest, operationMsg.Name)
+	suite.Require().Equal(accounts[0].Address.String(), msg.Signers[0], "the registrar should be the first signer")
+	suite.Require().Len(futureOperations, 0)
+
+	_, found := suite.app.MetadataKeeper.GetRecordType(suite.ctx, msg.RecordType.Name)
+	suite.Require().True(found, "record type should have been registered")
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		err := app.FundAccount(suite.app, suite.ctx, account.Address, initCoins)
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,66 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyHistoryRetentionBlocks),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenHistoryRetentionBlocks(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxScopeOwners),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCount(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxScopeDataAccess),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCount(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxScopeRecords),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxScopeRecords(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxRecordInputs),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCount(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxRecordOutputs),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCount(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxSessionParties),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCount(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxSessionContextBytes),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxSessionContextBytes(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxValueLength),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxURILength(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,43 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/provenance-io/provenance/x/metadata/simulation"
+)
+
+func TestParamChanges(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	expected := []struct {
+		composedKey string
+		key         string
+		simValue    string
+		subspace    string
+	}{
+		{"metadata/HistoryRetentionBlocks", "HistoryRetentionBlocks", "\"152\"", "metadata"},
+		{"metadata/MaxScopeOwners", "MaxScopeOwners", "67", "metadata"},
+		{"metadata/MaxScopeDataAccess", "MaxScopeDataAccess", "79", "metadata"},
+		{"metadata/MaxScopeRecords", "MaxScopeRecords", "181", "metadata"},
+		{"metadata/MaxRecordInputs", "MaxRecordInputs", "38", "metadata"},
+		{"metadata/MaxRecordOutputs", "MaxRecordOutputs", "45", "metadata"},
+		{"metadata/MaxSessionParties", "MaxSessionParties", "60", "metadata"},
+		{"metadata/MaxSessionContextBytes", "MaxSessionContextBytes", "11080", "metadata"},
+		{"metadata/MaxUriLength", "MaxUriLength", "676", "metadata"},
+	}
+
+	paramChanges := simulation.ParamChanges(r)
+
+	require.Len(t, paramChanges, len(expected))
+
+	for i, p := range paramChanges {
+		require.Equal(t, expected[i].composedKey, p.ComposedKey())
+		require.Equal(t, expected[i].key, p.Key())
+		require.Equal(t, expected[i].simValue, p.SimValue()(r))
+		require.Equal(t, expected[i].subspace, p.Subspace())
+	}
+}
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"gopkg.in/yaml.v2"
+)
+
+// String implements stringer interface
+func (b ScopeBundle) String() string {
+	out, _ := yaml.Marshal(b)
+	return string(out)
+}
+
+// ValidateBasic checks that the bundle has a scope, and that everything in it is valid and goes with that scope.
+func (b ScopeBundle) ValidateBasic() error {
+	if b.Scope == nil {
+		return errors.New("bundle does not have a scope")
+	}
+	if err := b.Scope.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid scope: %w", err)
+	}
+	if b.ScopeSpecification != nil {
+		if err := b.ScopeSpecification.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope specification: %w", err)
+		}
+		if !b.ScopeSpecification.SpecificationId.Equals(b.Scope.SpecificationId) {
+			return fmt.Errorf("scope specification %s is not the scope's specification %s",
+				b.ScopeSpecification.SpecificationId, b.Scope.SpecificationId)
+		}
+	}
+	for _, spec := range b.ContractSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid contract specification %s: %w", spec.SpecificationId, err)
+		}
+	}
+	for _, spec := range b.RecordSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record specification %s: %w", spec.SpecificationId, err)
+		}
+	}
+	for _, recordType := range b.RecordTypes {
+		if err := recordType.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record type %q: %w", recordType.Name, err)
+		}
+	}
+	sessionIDs := make(map[string]bool, len(b.Sessions))
+	for _, session := range b.Sessions {
+		if err := session.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid session %s: %w", session.SessionId, err)
+		}
+		if scopeID, err := session.SessionId.AsScopeAddress(); err != nil || !scopeID.Equals(b.Scope.ScopeId) {
+			return fmt.Errorf("session %s is not part of scope %s", session.SessionId, b.Scope.ScopeId)
+		}
+		sessionIDs[session.SessionId.String()] = true
+	}
+	for _, record := range b.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record %s: %w", record.Name, err)
+		}
+		if !sessionIDs[record.SessionId.String()] {
+			return fmt.Errorf("record %s session %s is not in the bundle", record.Name, record.SessionId)
+		}
+	}
+	for _, locator := range b.OsLocators {
+		if err := locator.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid object store locator for %s: %w", locator.Owner, err)
+		}
+	}
+	return nil
+}
+
+// ConvertAccountAddresses re-encodes all of the account addresses in the bundle using the given bech32 prefix.
+// Bundles exported from one chain can then be imported into a chain that uses a different account address prefix.
+func (b *ScopeBundle) ConvertAccountAddresses(hrp string) error {
+	convert := func(addr *string) error {
+		if len(*addr) == 0 {
+			return nil
+		}
+		_, bz, err := bech32.DecodeAndConvert(*addr)
+		if err != nil {
+			return fmt.Errorf("invalid account address %q: %w", *addr, err)
+		}
+		*addr, err = bech32.ConvertAndEncode(hrp, bz)
+		return err
+	}
+	convertAll := func(addrs []string) error {
+		for i := range addrs {
+			if err := convert(&addrs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	convertParties := func(parties []Party) error {
+		for i := range parties {
+			if err := convert(&parties[i].Address); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if b.ScopeSpecification != nil {
+		if err := convertAll(b.ScopeSpecification.OwnerAddresses); err != nil {
+			return err
+		}
+	}
+	for i := range b.ContractSpecifications {
+		if err := convertAll(b.ContractSpecifications[i].OwnerAddresses); err != nil {
+			return err
+		}
+	}
+	for i := range b.RecordTypes {
+		if err := convertAll(b.RecordTypes[i].OwnerAddresses); err != nil {
+			return err
+		}
+	}
+	if b.Scope != nil {
+		if err := convertParties(b.Scope.Owners); err != nil {
+			return err
+		}
+		if err := convertAll(b.Scope.DataAccess); err != nil {
+			return err
+		}
+		if err := convert(&b.Scope.ValueOwnerAddress); err != nil {
+			return err
+		}
+	}
+	for i := range b.Sessions {
+		if err := convertParties(b.Sessions[i].Parties); err != nil {
+			return err
+		}
+	}
+	for i := range b.OsLocators {
+		if err := convert(&b.OsLocators[i].Owner); err != nil {
+			return err
+		}
+		if err := convert(&b.OsLocators[i].EncryptionKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportMsgs creates the messages needed to write everything in the bundle, in an order that they can be processed.
+// Record types and specifications come first, then the locators, the scope, its sessions, and finally its records.
+// Records with inputs from other records in the bundle are written after those records.
+func (b ScopeBundle) ImportMsgs(signers []string) []sdk.Msg {
+	var msgs []sdk.Msg
+	for _, recordType := range b.RecordTypes {
+		msgs = append(msgs, NewMsgWriteRecordTypeRequest(recordType, signers))
+	}
+	for _, spec := range b.ContractSpecifications {
+		msgs = append(msgs, NewMsgWriteContractSpecificationRequest(spec, signers))
+	}
+	for _, spec := range b.RecordSpecifications {
+		msgs = append(msgs, NewMsgWriteRecordSpecificationRequest(spec, signers))
+	}
+	if b.ScopeSpecification != nil {
+		msgs = append(msgs, NewMsgWriteScopeSpecificationRequest(*b.ScopeSpecification, signers))
+	}
+	for _, locator := range b.OsLocators {
+		msgs = append(msgs, NewMsgBindOSLocatorRequest(locator))
+	}
+	if b.Scope != nil {
+		msgs = append(msgs, NewMsgWriteScopeRequest(*b.Scope, signers))
+	}
+	sessionParties := make(map[string][]Party, len(b.Sessions))
+	for _, session := range b.Sessions {
+		sessionParties[session.SessionId.String()] = session.Parties
+		// The audit fields are set by the chain that the session is written to.
+		session.Audit = nil
+		msgs = append(msgs, NewMsgWriteSessionRequest(session, signers))
+	}
+	for _, record := range b.orderedRecords() {
+		msgs = append(msgs, NewMsgWriteRecordRequest(record, nil, "", signers, sessionParties[record.SessionId.String()]))
+	}
+	return msgs
+}
+
+// orderedRecords returns the bundle's records, with each one after any records in the bundle that it has as inputs.
+func (b ScopeBundle) orderedRecords() []Record {
+	return orderRecordsByInputs(b.Records)
+}
+
+// orderRecordsByInputs returns the given records, with each one after any of the given records that it has as inputs.
+func orderRecordsByInputs(records []Record) []Record {
+	byID := make(map[string]Record, len(records))
+	for _, record := range records {
+		byID[record.GetRecordAddress().String()] = record
+	}
+	added := make(map[string]bool, len(records))
+	rv := make([]Record, 0, len(records))
+	var add func(record Record)
+	add = func(record Record) {
+		key := record.GetRecordAddress().String()
+		if added[key] {
+			return
+		}
+		added[key] = true
+		for _, input := range record.Inputs {
+			if source, ok := input.Source.(*RecordInput_RecordId); ok {
+				if dep, found := byID[source.RecordId.String()]; found {
+					add(dep)
+				}
+			}
+		}
+		rv = append(rv, record)
+	}
+	for _, record := range records {
+		add(record)
+	}
+	return rv
+}
@@ -0,0 +1,1290 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/metadata/v1/bundle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScopeBundle is a scope along with everything it references, so that it can be recreated somewhere else.
+type ScopeBundle struct {
+	// scope_specification is the specification that the scope uses.
+	ScopeSpecification *ScopeSpecification `protobuf:"bytes,1,opt,name=scope_specification,json=scopeSpecification,proto3" json:"scope_specification,omitempty" yaml:"scope_specification"`
+	// contract_specifications are the contract specifications allowed by the scope specification or used by sessions.
+	ContractSpecifications []ContractSpecification `protobuf:"bytes,2,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications" yaml:"contract_specifications"`
+	// record_specifications are the record specifications of the contract specifications.
+	RecordSpecifications []RecordSpecification `protobuf:"bytes,3,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications" yaml:"record_specifications"`
+	// record_types are the registered record types used by the record specifications.
+	RecordTypes []RecordType `protobuf:"bytes,4,rep,name=record_types,json=recordTypes,proto3" json:"record_types" yaml:"record_types"`
+	// scope is the scope being bundled.
+	Scope *Scope `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	// sessions are all of the scope's sessions.
+	Sessions []Session `protobuf:"bytes,6,rep,name=sessions,proto3" json:"sessions"`
+	// records are all of the scope's records.
+	Records []Record `protobuf:"bytes,7,rep,name=records,proto3" json:"records"`
+	// os_locators are the object store locators of the scope's owners.
+	OsLocators []ObjectStoreLocator `protobuf:"bytes,8,rep,name=os_locators,json=osLocators,proto3" json:"os_locators" yaml:"os_locators"`
+}
+
+func (m *ScopeBundle) Reset()      { *m = ScopeBundle{} }
+func (*ScopeBundle) ProtoMessage() {}
+func (*ScopeBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a241f767ee020c1f, []int{0}
+}
+func (m *ScopeBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeBundle.Merge(m, src)
+}
+func (m *ScopeBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeBundle proto.InternalMessageInfo
+
+func (m *ScopeBundle) GetScopeSpecification() *ScopeSpecification {
+	if m != nil {
+		return m.ScopeSpecification
+	}
+	return nil
+}
+
+func (m *ScopeBundle) GetContractSpecifications() []ContractSpecification {
+	if m != nil {
+		return m.ContractSpecifications
+	}
+	return nil
+}
+
+func (m *ScopeBundle) GetRecordSpecifications() []RecordSpecification {
+	if m != nil {
+		return m.RecordSpecifications
+	}
+	return nil
+}
+
+func (m *ScopeBundle) GetRecordTypes() []RecordType {
+	if m != nil {
+		return m.RecordTypes
+	}
+	return nil
+}
+
+func (m *ScopeBundle) GetScope() *Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *ScopeBundle) GetSessions() []Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *ScopeBundle) GetRecords() []Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ScopeBundle) GetOsLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.OsLocators
+	}
+	return nil
+}
+
+// Manifest describes metadata that should exist on chain. The tx metadata apply command compares it to the chain and
+// writes whatever is missing or different.
+type Manifest struct {
+	// scope_specifications are the scope specifications to write.
+	ScopeSpecifications []ScopeSpecification `protobuf:"bytes,1,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications" yaml:"scope_specifications"`
+	// contract_specifications are the contract specifications to write.
+	ContractSpecifications []ContractSpecification `protobuf:"bytes,2,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications" yaml:"contract_specifications"`
+	// record_specifications are the record specifications to write.
+	RecordSpecifications []RecordSpecification `protobuf:"bytes,3,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications" yaml:"record_specifications"`
+	// scopes are the scopes to write.
+	Scopes []Scope `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes"`
+	// sessions are the sessions to write.
+	Sessions []Session `protobuf:"bytes,5,rep,name=sessions,proto3" json:"sessions"`
+	// records are the records to write.
+	Records []Record `protobuf:"bytes,6,rep,name=records,proto3" json:"records"`
+}
+
+func (m *Manifest) Reset()         { *m = Manifest{} }
+func (m *Manifest) String() string { return proto.CompactTextString(m) }
+func (*Manifest) ProtoMessage()    {}
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a241f767ee020c1f, []int{1}
+}
+func (m *Manifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Manifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Manifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Manifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Manifest.Merge(m, src)
+}
+func (m *Manifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *Manifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Manifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Manifest proto.InternalMessageInfo
+
+func (m *Manifest) GetScopeSpecifications() []ScopeSpecification {
+	if m != nil {
+		return m.ScopeSpecifications
+	}
+	return nil
+}
+
+func (m *Manifest) GetContractSpecifications() []ContractSpecification {
+	if m != nil {
+		return m.ContractSpecifications
+	}
+	return nil
+}
+
+func (m *Manifest) GetRecordSpecifications() []RecordSpecification {
+	if m != nil {
+		return m.RecordSpecifications
+	}
+	return nil
+}
+
+func (m *Manifest) GetScopes() []Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *Manifest) GetSessions() []Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *Manifest) GetRecords() []Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ScopeBundle)(nil), "provenance.metadata.v1.ScopeBundle")
+	proto.RegisterType((*Manifest)(nil), "provenance.metadata.v1.Manifest")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/bundle.proto", fileDescriptor_a241f767ee020c1f)
+}
+
+var fileDescriptor_a241f767ee020c1f = []byte{
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0xf2, 0xa7, 0xd1, 0x85, 0xe9, 0x12, 0x8a, 0x49, 0xc1, 0x89, 0xae, 0x08, 0x45,
+	0x45, 0xb5, 0xd5, 0x96, 0xa9, 0x48, 0x48, 0x98, 0x15, 0x04, 0x72, 0x98, 0x58, 0x2a, 0xe7, 0x72,
+	0x0d, 0x86, 0xc4, 0x67, 0xf9, 0xbd, 0x46, 0x44, 0x6c, 0x0c, 0x8c, 0x88, 0x91, 0x91, 0x6f, 0xc0,
+	0xd7, 0xe8, 0xd8, 0x91, 0x29, 0x42, 0xc9, 0xc4, 0xda, 0x4f, 0x80, 0x72, 0xbe, 0x34, 0x31, 0xf1,
+	0x79, 0x80, 0xb5, 0x5b, 0x22, 0x3d, 0xcf, 0xf3, 0xfe, 0x7c, 0xf7, 0x3e, 0x36, 0xda, 0x8d, 0x62,
+	0x3e, 0x66, 0xa1, 0x1f, 0x52, 0xe6, 0x8c, 0x98, 0xf0, 0xfb, 0xbe, 0xf0, 0x9d, 0xf1, 0x81, 0xd3,
+	0x3b, 0x0b, 0xfb, 0x43, 0x66, 0x47, 0x31, 0x17, 0x1c, 0x6f, 0xaf, 0x44, 0xf6, 0x52, 0x64, 0x8f,
+	0x0f, 0x9a, 0x8d, 0x01, 0x1f, 0x70, 0x29, 0x71, 0x16, 0xbf, 0x12, 0x75, 0x93, 0x68, 0x22, 0x81,
+	0xf2, 0x48, 0x25, 0x36, 0xf7, 0x74, 0x9a, 0x88, 0xd1, 0xe0, 0x34, 0xa0, 0xbe, 0x08, 0x78, 0xa8,
+	0xb4, 0x1d, 0x8d, 0x96, 0xf7, 0xde, 0x31, 0x2a, 0x40, 0xf0, 0x58, 0xa5, 0x92, 0x1f, 0x15, 0x54,
+	0xeb, 0x2e, 0xa6, 0xb8, 0x92, 0x1e, 0x7f, 0x44, 0x75, 0x39, 0xf4, 0x24, 0x15, 0x6b, 0x1a, 0x6d,
+	0xa3, 0x53, 0x3b, 0xdc, 0xb3, 0xb3, 0x9f, 0xca, 0x96, 0x09, 0xdd, 0x75, 0x87, 0x6b, 0x5d, 0x4e,
+	0x5b, 0xcd, 0x89, 0x3f, 0x1a, 0x1e, 0x93, 0x8c, 0x40, 0xe2, 0x61, 0xd8, 0xf0, 0xe0, 0x2f, 0x06,
+	0xba, 0x4d, 0x79, 0x28, 0x62, 0x9f, 0x8a, 0xb4, 0x1e, 0xcc, 0x1b, 0xed, 0x62, 0xa7, 0x76, 0xb8,
+	0xaf, 0x23, 0x78, 0xa6, 0x6c, 0x69, 0x88, 0x07, 0xe7, 0xd3, 0x56, 0xe1, 0x72, 0xda, 0xb2, 0x12,
+	0x10, 0x4d, 0x36, 0xf1, 0xb6, 0x69, 0x96, 0x1d, 0xf0, 0x67, 0x03, 0xdd, 0x8a, 0x19, 0xe5, 0x71,
+	0xff, 0x6f, 0x9c, 0xa2, 0xc4, 0x79, 0xa8, 0xc3, 0xf1, 0xa4, 0x29, 0x0d, 0x73, 0x5f, 0xc1, 0xdc,
+	0x4d, 0x60, 0x32, 0x73, 0x89, 0xd7, 0x88, 0x37, 0xad, 0x80, 0x7b, 0xe8, 0xa6, 0xd2, 0x8b, 0x49,
+	0xc4, 0xc0, 0x2c, 0xc9, 0xf1, 0x24, 0x7f, 0xfc, 0xeb, 0x49, 0xc4, 0xdc, 0x1d, 0x35, 0xb5, 0x9e,
+	0x9a, 0x2a, 0x53, 0x88, 0x57, 0x8b, 0xaf, 0x84, 0x80, 0x8f, 0x50, 0x59, 0xde, 0x89, 0x59, 0x96,
+	0x97, 0x7d, 0x2f, 0xf7, 0xb2, 0xbd, 0x44, 0x8b, 0x9f, 0xa2, 0x2a, 0x30, 0x00, 0x79, 0x26, 0x15,
+	0x09, 0xd5, 0xd2, 0xfa, 0x12, 0x9d, 0x5b, 0x5a, 0x10, 0x79, 0x57, 0x36, 0xfc, 0x04, 0x6d, 0x25,
+	0x18, 0x60, 0x6e, 0xc9, 0x04, 0x2b, 0xff, 0xb1, 0x54, 0xc0, 0xd2, 0x84, 0x07, 0xa8, 0xc6, 0xe1,
+	0x64, 0xc8, 0xa9, 0x2f, 0x78, 0x0c, 0x66, 0xb5, 0x5d, 0xcc, 0x5b, 0xd5, 0x97, 0xb2, 0x02, 0xdd,
+	0x45, 0x05, 0x9e, 0x27, 0x16, 0xb7, 0xa9, 0x8e, 0x08, 0x27, 0x47, 0xb4, 0x16, 0x46, 0x3c, 0xc4,
+	0x41, 0xc9, 0xe0, 0xb8, 0xf4, 0xed, 0x7b, 0xab, 0x40, 0x7e, 0x97, 0x50, 0xf5, 0x85, 0x1f, 0x06,
+	0xa7, 0x0c, 0x04, 0xfe, 0x64, 0xa0, 0x46, 0xc6, 0x7a, 0x83, 0x69, 0xe4, 0x53, 0x64, 0x14, 0x66,
+	0x57, 0x51, 0xec, 0x68, 0x4b, 0x03, 0xc4, 0xab, 0x6f, 0xb6, 0x06, 0xae, 0x6b, 0xa3, 0xad, 0xcd,
+	0x63, 0x54, 0x91, 0x07, 0xb6, 0x2c, 0x4c, 0xfe, 0x4e, 0xab, 0xc5, 0x52, 0x96, 0xd4, 0x6a, 0x97,
+	0xff, 0x7b, 0xb5, 0x2b, 0xff, 0xb0, 0xda, 0xee, 0xfb, 0xf3, 0x99, 0x65, 0x5c, 0xcc, 0x2c, 0xe3,
+	0xd7, 0xcc, 0x32, 0xbe, 0xce, 0xad, 0xc2, 0xc5, 0xdc, 0x2a, 0xfc, 0x9c, 0x5b, 0x05, 0x74, 0x27,
+	0xe0, 0x9a, 0xa8, 0x57, 0xc6, 0x9b, 0x47, 0x83, 0x40, 0xbc, 0x3d, 0xeb, 0xd9, 0x94, 0x8f, 0x9c,
+	0x95, 0x68, 0x3f, 0xe0, 0x6b, 0xff, 0x9c, 0x0f, 0xab, 0x2f, 0x83, 0x7c, 0x1b, 0xf4, 0x2a, 0xf2,
+	0x8b, 0x70, 0xf4, 0x67, 0x00, 0xe4, 0xda, 0x20, 0xcc, 0xe0, 0x06, 0x00, 0x00,
+}
+
+func (m *ScopeBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OsLocators) > 0 {
+		for iNdEx := len(m.OsLocators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OsLocators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBundle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RecordTypes) > 0 {
+		for iNdEx := len(m.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractSpecifications) > 0 {
+		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ScopeSpecification != nil {
+		{
+			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBundle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Manifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Manifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Manifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractSpecifications) > 0 {
+		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeSpecifications) > 0 {
+		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBundle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBundle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBundle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScopeBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecification != nil {
+		l = m.ScopeSpecification.Size()
+		n += 1 + l + sovBundle(uint64(l))
+	}
+	if len(m.ContractSpecifications) > 0 {
+		for _, e := range m.ContractSpecifications {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, e := range m.RecordTypes {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovBundle(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.OsLocators) > 0 {
+		for _, e := range m.OsLocators {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Manifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeSpecifications) > 0 {
+		for _, e := range m.ScopeSpecifications {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.ContractSpecifications) > 0 {
+		for _, e := range m.ContractSpecifications {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovBundle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBundle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBundle(x uint64) (n int) {
+	return sovBundle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScopeBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecification == nil {
+				m.ScopeSpecification = &ScopeSpecification{}
+			}
+			if err := m.ScopeSpecification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecifications = append(m.ContractSpecifications, ContractSpecification{})
+			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecifications = append(m.RecordSpecifications, RecordSpecification{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, RecordType{})
+			if err := m.RecordTypes[len(m.RecordTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &Scope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsLocators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsLocators = append(m.OsLocators, ObjectStoreLocator{})
+			if err := m.OsLocators[len(m.OsLocators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Manifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Manifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Manifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSpecifications = append(m.ScopeSpecifications, ScopeSpecification{})
+			if err := m.ScopeSpecifications[len(m.ScopeSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpecifications = append(m.ContractSpecifications, ContractSpecification{})
+			if err := m.ContractSpecifications[len(m.ContractSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSpecifications = append(m.RecordSpecifications, RecordSpecification{})
+			if err := m.RecordSpecifications[len(m.RecordSpecifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, Scope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBundle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBundle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBundle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBundle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBundle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBundle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBundle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBundle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBundleRecord(sessionID MetadataAddress, name string, inputs ...RecordInput) Record {
+	return *NewRecord(name, sessionID, *NewProcess("process", &Process_Hash{Hash: "processhash"}, "method"),
+		inputs, []RecordOutput{*NewRecordOutput(name+"hash", ResultStatus_RESULT_STATUS_PASS)}, nil)
+}
+
+func testScopeBundle() ScopeBundle {
+	scopeUUID := uuid.New()
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	first := testBundleRecord(sessionID, "first")
+	second := testBundleRecord(sessionID, "second", *NewRecordInput("first", &RecordInput_RecordId{RecordId: first.GetRecordAddress()},
+		"io.provenance.Loan", RecordInputStatus_Record))
+	return ScopeBundle{
+		ScopeSpecification: NewScopeSpecification(scopeSpecID, nil, []string{specTestBech32},
+			[]PartyType{PartyType_PARTY_TYPE_OWNER}, []MetadataAddress{contractSpecID}),
+		Scope:    NewScope(ScopeMetadataAddress(scopeUUID), scopeSpecID, ownerPartyList(specTestBech32), []string{specTestBech32}, specTestBech32),
+		Sessions: []Session{*NewSession("session", sessionID, contractSpecID, ownerPartyList(specTestBech32), &AuditFields{CreatedBy: specTestBech32})},
+		// The second record is first so that ImportMsgs has to reorder them.
+		Records:    []Record{second, first},
+		OsLocators: []ObjectStoreLocator{NewOSLocatorRecord(specTestAddr, nil, "https://provenance.io")},
+	}
+}
+
+func TestScopeBundleValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(b *ScopeBundle)
+		err    string
+	}{
+		{"valid", func(b *ScopeBundle) {}, ""},
+		{"no scope", func(b *ScopeBundle) { b.Scope = nil }, "bundle does not have a scope"},
+		{"invalid scope", func(b *ScopeBundle) { b.Scope.Owners = nil }, "invalid scope: "},
+		{
+			"different scope specification",
+			func(b *ScopeBundle) { b.ScopeSpecification.SpecificationId = ScopeSpecMetadataAddress(uuid.New()) },
+			"is not the scope's specification",
+		},
+		{
+			"session of another scope",
+			func(b *ScopeBundle) { b.Sessions[0].SessionId = SessionMetadataAddress(uuid.New(), uuid.New()) },
+			"is not part of scope",
+		},
+		{
+			"record of a session not in the bundle",
+			func(b *ScopeBundle) { b.Records[0].SessionId = SessionMetadataAddress(uuid.New(), uuid.New()) },
+			"is not in the bundle",
+		},
+		{"invalid locator", func(b *ScopeBundle) { b.OsLocators[0].LocatorUri = "" }, "invalid object store locator"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle := testScopeBundle()
+			tc.modify(&bundle)
+			err := bundle.ValidateBasic()
+			if len(tc.err) == 0 {
+				require.NoError(t, err, "ValidateBasic")
+			} else {
+				require.Error(t, err, "ValidateBasic")
+				assert.Contains(t, err.Error(), tc.err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestScopeBundleConvertAccountAddresses(t *testing.T) {
+	bundle := testScopeBundle()
+	require.NoError(t, bundle.ConvertAccountAddresses("pb"), "ConvertAccountAddresses")
+	expected, err := sdk.Bech32ifyAddressBytes("pb", specTestAddr)
+	require.NoError(t, err, "Bech32ifyAddressBytes")
+
+	assert.Equal(t, []string{expected}, bundle.ScopeSpecification.OwnerAddresses, "scope spec owners")
+	assert.Equal(t, expected, bundle.Scope.Owners[0].Address, "scope owner")
+	assert.Equal(t, []string{expected}, bundle.Scope.DataAccess, "scope data access")
+	assert.Equal(t, expected, bundle.Scope.ValueOwnerAddress, "scope value owner")
+	assert.Equal(t, expected, bundle.Sessions[0].Parties[0].Address, "session party")
+	assert.Equal(t, expected, bundle.OsLocators[0].Owner, "locator owner")
+
+	bundle.Scope.ValueOwnerAddress = "not an address"
+	assert.Error(t, bundle.ConvertAccountAddresses("pb"), "ConvertAccountAddresses with invalid address")
+}
+
+func TestScopeBundleImportMsgs(t *testing.T) {
+	bundle := testScopeBundle()
+	signers := []string{specTestBech32}
+	msgs := bundle.ImportMsgs(signers)
+	require.Len(t, msgs, 6, "ImportMsgs")
+
+	assert.IsType(t, &MsgWriteScopeSpecificationRequest{}, msgs[0], "msg 0")
+	assert.IsType(t, &MsgBindOSLocatorRequest{}, msgs[1], "msg 1")
+	assert.IsType(t, &MsgWriteScopeRequest{}, msgs[2], "msg 2")
+	require.IsType(t, &MsgWriteSessionRequest{}, msgs[3], "msg 3")
+	assert.Nil(t, msgs[3].(*MsgWriteSessionRequest).Session.Audit, "session audit")
+	assert.NotNil(t, bundle.Sessions[0].Audit, "bundle session audit")
+	require.IsType(t, &MsgWriteRecordRequest{}, msgs[4], "msg 4")
+	assert.Equal(t, "first", msgs[4].(*MsgWriteRecordRequest).Record.Name, "msg 4 record")
+	require.IsType(t, &MsgWriteRecordRequest{}, msgs[5], "msg 5")
+	assert.Equal(t, "second", msgs[5].(*MsgWriteRecordRequest).Record.Name, "msg 5 record")
+	assert.Equal(t, bundle.Sessions[0].Parties, msgs[5].(*MsgWriteRecordRequest).Parties, "record parties")
+
+	for i, msg := range msgs {
+		assert.NoError(t, msg.ValidateBasic(), "msg %d ValidateBasic", i)
+	}
+}
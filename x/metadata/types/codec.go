@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers concrete types on the Amino codec
@@ -16,11 +17,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeleteScopeDataAccessRequest{}, "provenance/metadata/DeleteScopeDataAccessRequest", nil)
 	cdc.RegisterConcrete(&MsgAddScopeOwnerRequest{}, "provenance/metadata/AddScopeOwnerRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeOwnerRequest{}, "provenance/metadata/DeleteScopeOwnerRequest", nil)
+	cdc.RegisterConcrete(&MsgLockScopeRequest{}, "provenance/metadata/LockScopeRequest", nil)
+	cdc.RegisterConcrete(&MsgUnlockScopeRequest{}, "provenance/metadata/UnlockScopeRequest", nil)
+	cdc.RegisterConcrete(&MsgTokenizeScopeRequest{}, "provenance/metadata/TokenizeScopeRequest", nil)
+	cdc.RegisterConcrete(&MsgDetokenizeScopeRequest{}, "provenance/metadata/DetokenizeScopeRequest", nil)
+	cdc.RegisterConcrete(&MsgCloneScopeRequest{}, "provenance/metadata/CloneScopeRequest", nil)
+	cdc.RegisterConcrete(&MsgOfferValueOwnerTransferRequest{}, "provenance/metadata/OfferValueOwnerTransferRequest", nil)
+	cdc.RegisterConcrete(&MsgAcceptValueOwnerTransferRequest{}, "provenance/metadata/AcceptValueOwnerTransferRequest", nil)
+	cdc.RegisterConcrete(&MsgCancelValueOwnerTransferRequest{}, "provenance/metadata/CancelValueOwnerTransferRequest", nil)
 
 	cdc.RegisterConcrete(&MsgWriteSessionRequest{}, "provenance/metadata/WriteSessionRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteRecordRequest{}, "provenance/metadata/WriteRecordRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteRecordRequest{}, "provenance/metadata/DeleteRecordRequest", nil)
+	cdc.RegisterConcrete(&MsgExecuteContractSessionRequest{}, "provenance/metadata/ExecuteContractSessionRequest", nil)
 
+	cdc.RegisterConcrete(&MsgMigrateScopeSpecRequest{}, "provenance/metadata/MigrateScopeSpecRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteScopeSpecificationRequest{}, "provenance/metadata/WriteScopeSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeSpecificationRequest{}, "provenance/metadata/DeleteScopeSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteContractSpecificationRequest{}, "provenance/metadata/WriteContractSpecificationRequest", nil)
@@ -29,6 +40,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeleteContractSpecFromScopeSpecRequest{}, "provenance/metadata/DeleteContractSpecFromScopeSpecRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteRecordSpecificationRequest{}, "provenance/metadata/WriteRecordSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteRecordSpecificationRequest{}, "provenance/metadata/DeleteRecordSpecificationRequest", nil)
+	cdc.RegisterConcrete(&MsgWriteRecordTypeRequest{}, "provenance/metadata/WriteRecordTypeRequest", nil)
+	cdc.RegisterConcrete(&MsgDeleteRecordTypeRequest{}, "provenance/metadata/DeleteRecordTypeRequest", nil)
 
 	cdc.RegisterConcrete(&MsgWriteP8EContractSpecRequest{}, "provenance/metadata/WriteP8EContractSpecRequest", nil)
 	cdc.RegisterConcrete(&MsgP8EMemorializeContractRequest{}, "provenance/metadata/P8EMemorializeContractRequest", nil)
@@ -47,10 +60,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteScopeDataAccessRequest{},
 		&MsgAddScopeOwnerRequest{},
 		&MsgDeleteScopeOwnerRequest{},
+		&MsgLockScopeRequest{},
+		&MsgUnlockScopeRequest{},
+		&MsgTokenizeScopeRequest{},
+		&MsgDetokenizeScopeRequest{},
+		&MsgCloneScopeRequest{},
+		&MsgOfferValueOwnerTransferRequest{},
+		&MsgAcceptValueOwnerTransferRequest{},
+		&MsgCancelValueOwnerTransferRequest{},
 		&MsgWriteSessionRequest{},
 		&MsgWriteRecordRequest{},
 		&MsgDeleteRecordRequest{},
+		&MsgExecuteContractSessionRequest{},
 
+		&MsgMigrateScopeSpecRequest{},
 		&MsgWriteScopeSpecificationRequest{},
 		&MsgDeleteScopeSpecificationRequest{},
 		&MsgWriteContractSpecificationRequest{},
@@ -59,6 +82,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteContractSpecFromScopeSpecRequest{},
 		&MsgWriteRecordSpecificationRequest{},
 		&MsgDeleteRecordSpecificationRequest{},
+		&MsgWriteRecordTypeRequest{},
+		&MsgDeleteRecordTypeRequest{},
 
 		&MsgWriteP8EContractSpecRequest{},
 		&MsgP8EMemorializeContractRequest{},
@@ -67,6 +92,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgModifyOSLocatorRequest{},
 		&MsgDeleteOSLocatorRequest{},
 	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UnlockScopeProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -14,4 +14,12 @@ var (
 	ErrOSLocatorURIToolong = sdkerrors.Register(ModuleName, 5, "uri length greater than allowed")
 	ErrNoRecordsFound      = sdkerrors.Register(ModuleName, 6, "No records found.")
 	ErrOSLocatorURIInvalid = sdkerrors.Register(ModuleName, 7, "uri is invalid")
+	// ErrScopeLocked occurs when a change is attempted on a scope that is under a lock.
+	ErrScopeLocked = sdkerrors.Register(ModuleName, 8, "scope is locked")
+	// ErrConcurrentUpdate occurs when a write expects a stored value other than the one that currently exists.
+	ErrConcurrentUpdate = sdkerrors.Register(ModuleName, 9, "stored value has changed")
+	// ErrLimitExceeded occurs when a change would put an entry over one of the size limits in the module params.
+	ErrLimitExceeded = sdkerrors.Register(ModuleName, 10, "limit exceeded")
+	// ErrMsgDisabled occurs when a msg is sent that has been turned off by the module params.
+	ErrMsgDisabled = sdkerrors.Register(ModuleName, 11, "msg is disabled")
 )
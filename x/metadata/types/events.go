@@ -1,6 +1,11 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -93,20 +98,31 @@ func GetIncObjFunc(objType TelemetryObjectType, action TelemetryAction) func() {
 type TxEndpoint string
 
 const (
-	TxEndpoint_WriteScope            TxEndpoint = "WriteScope"
-	TxEndpoint_DeleteScope           TxEndpoint = "DeleteScope"
-	TxEndpoint_AddScopeDataAccess    TxEndpoint = "AddScopeDataAccess"
-	TxEndpoint_DeleteScopeDataAccess TxEndpoint = "DeleteScopeDataAccess"
-	TxEndpoint_AddScopeOwner         TxEndpoint = "AddScopeOwner"
-	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
+	TxEndpoint_WriteScope               TxEndpoint = "WriteScope"
+	TxEndpoint_DeleteScope              TxEndpoint = "DeleteScope"
+	TxEndpoint_AddScopeDataAccess       TxEndpoint = "AddScopeDataAccess"
+	TxEndpoint_DeleteScopeDataAccess    TxEndpoint = "DeleteScopeDataAccess"
+	TxEndpoint_AddScopeOwner            TxEndpoint = "AddScopeOwner"
+	TxEndpoint_DeleteScopeOwner         TxEndpoint = "DeleteScopeOwner"
+	TxEndpoint_LockScope                TxEndpoint = "LockScope"
+	TxEndpoint_UnlockScope              TxEndpoint = "UnlockScope"
+	TxEndpoint_TokenizeScope            TxEndpoint = "TokenizeScope"
+	TxEndpoint_DetokenizeScope          TxEndpoint = "DetokenizeScope"
+	TxEndpoint_CloneScope               TxEndpoint = "CloneScope"
+	TxEndpoint_OfferValueOwnerTransfer  TxEndpoint = "OfferValueOwnerTransfer"
+	TxEndpoint_AcceptValueOwnerTransfer TxEndpoint = "AcceptValueOwnerTransfer"
+	TxEndpoint_CancelValueOwnerTransfer TxEndpoint = "CancelValueOwnerTransfer"
 
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
 	TxEndpoint_WriteRecord  TxEndpoint = "WriteRecord"
 	TxEndpoint_DeleteRecord TxEndpoint = "DeleteRecord"
 
+	TxEndpoint_ExecuteContractSession TxEndpoint = "ExecuteContractSession"
+
 	TxEndpoint_WriteScopeSpecification  TxEndpoint = "WriteScopeSpecification"
 	TxEndpoint_DeleteScopeSpecification TxEndpoint = "DeleteScopeSpecification"
+	TxEndpoint_MigrateScopeSpec         TxEndpoint = "MigrateScopeSpec"
 
 	TxEndpoint_WriteContractSpecification  TxEndpoint = "WriteContractSpecification"
 	TxEndpoint_DeleteContractSpecification TxEndpoint = "DeleteContractSpecification"
@@ -117,6 +133,9 @@ const (
 	TxEndpoint_WriteRecordSpecification  TxEndpoint = "WriteRecordSpecification"
 	TxEndpoint_DeleteRecordSpecification TxEndpoint = "DeleteRecordSpecification"
 
+	TxEndpoint_WriteRecordType  TxEndpoint = "WriteRecordType"
+	TxEndpoint_DeleteRecordType TxEndpoint = "DeleteRecordType"
+
 	TxEndpoint_WriteP8eContractSpec   TxEndpoint = "WriteP8eContractSpec"
 	TxEndpoint_P8eMemorializeContract TxEndpoint = "P8eMemorializeContract"
 
@@ -149,12 +168,102 @@ func NewEventScopeUpdated(scopeID MetadataAddress) *EventScopeUpdated {
 	}
 }
 
+// NewEventScopeUpdatedWithDiff creates an EventScopeUpdated with the owner, data access,
+// and value owner changes needed to go from oldScope to newScope.
+func NewEventScopeUpdatedWithDiff(oldScope, newScope Scope) *EventScopeUpdated {
+	rv := NewEventScopeUpdated(newScope.ScopeId)
+	rv.OwnersAdded = partiesNotIn(newScope.Owners, oldScope.Owners)
+	rv.OwnersRemoved = partiesNotIn(oldScope.Owners, newScope.Owners)
+	rv.DataAccessAdded = stringsNotIn(newScope.DataAccess, oldScope.DataAccess)
+	rv.DataAccessRemoved = stringsNotIn(oldScope.DataAccess, newScope.DataAccess)
+	if oldScope.ValueOwnerAddress != newScope.ValueOwnerAddress {
+		rv.ValueOwnerBefore = oldScope.ValueOwnerAddress
+		rv.ValueOwnerAfter = newScope.ValueOwnerAddress
+	}
+	return rv
+}
+
 func NewEventScopeDeleted(scopeID MetadataAddress) *EventScopeDeleted {
 	return &EventScopeDeleted{
 		ScopeAddr: scopeID.String(),
 	}
 }
 
+func NewEventScopeLocked(lock ScopeLock) *EventScopeLocked {
+	return &EventScopeLocked{
+		ScopeAddr: lock.ScopeId.String(),
+		Locker:    lock.Locker,
+		Reason:    lock.Reason,
+	}
+}
+
+func NewEventScopeUnlocked(scopeID MetadataAddress) *EventScopeUnlocked {
+	return &EventScopeUnlocked{
+		ScopeAddr: scopeID.String(),
+	}
+}
+
+func NewEventScopeDataAccessExpired(grant DataAccessGrant) *EventScopeDataAccessExpired {
+	rv := &EventScopeDataAccessExpired{
+		ScopeAddr: grant.ScopeId.String(),
+		Address:   grant.Address,
+	}
+	if grant.Expiration != nil {
+		rv.Expiration = grant.Expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return rv
+}
+
+// The reasons a value owner transfer offer can be removed without being accepted.
+const (
+	ValueOwnerTransferReasonCancelled = "cancelled"
+	ValueOwnerTransferReasonExpired   = "expired"
+)
+
+func NewEventValueOwnerTransferOffered(offer ValueOwnerTransferOffer) *EventValueOwnerTransferOffered {
+	return &EventValueOwnerTransferOffered{
+		ScopeAddr:  offer.ScopeId.String(),
+		Seller:     offer.Seller,
+		Buyer:      offer.Buyer,
+		Price:      offer.Price.String(),
+		Expiration: offer.Expiration.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func NewEventValueOwnerTransferAccepted(offer ValueOwnerTransferOffer) *EventValueOwnerTransferAccepted {
+	return &EventValueOwnerTransferAccepted{
+		ScopeAddr: offer.ScopeId.String(),
+		Seller:    offer.Seller,
+		Buyer:     offer.Buyer,
+		Price:     offer.Price.String(),
+	}
+}
+
+func NewEventValueOwnerTransferCancelled(offer ValueOwnerTransferOffer, reason string) *EventValueOwnerTransferCancelled {
+	return &EventValueOwnerTransferCancelled{
+		ScopeAddr: offer.ScopeId.String(),
+		Seller:    offer.Seller,
+		Buyer:     offer.Buyer,
+		Reason:    reason,
+	}
+}
+
+func NewEventScopeTokenized(scopeID MetadataAddress, denom string, recipient string) *EventScopeTokenized {
+	return &EventScopeTokenized{
+		ScopeAddr: scopeID.String(),
+		Denom:     denom,
+		Recipient: recipient,
+	}
+}
+
+func NewEventScopeDetokenized(scopeID MetadataAddress, denom string, valueOwner string) *EventScopeDetokenized {
+	return &EventScopeDetokenized{
+		ScopeAddr:  scopeID.String(),
+		Denom:      denom,
+		ValueOwner: valueOwner,
+	}
+}
+
 func NewEventSessionCreated(sessionID MetadataAddress) *EventSessionCreated {
 	return &EventSessionCreated{
 		SessionAddr: sessionID.String(),
@@ -169,6 +278,19 @@ func NewEventSessionUpdated(sessionID MetadataAddress) *EventSessionUpdated {
 	}
 }
 
+// NewEventSessionUpdatedWithDiff creates an EventSessionUpdated with the party and context changes
+// needed to go from oldSession to newSession. Contexts are identified by their hash to keep the event small.
+func NewEventSessionUpdatedWithDiff(oldSession, newSession Session) *EventSessionUpdated {
+	rv := NewEventSessionUpdated(newSession.SessionId)
+	rv.PartiesAdded = partiesNotIn(newSession.Parties, oldSession.Parties)
+	rv.PartiesRemoved = partiesNotIn(oldSession.Parties, newSession.Parties)
+	if !bytes.Equal(oldSession.Context, newSession.Context) {
+		rv.ContextHashBefore = sha256Hex(oldSession.Context)
+		rv.ContextHashAfter = sha256Hex(newSession.Context)
+	}
+	return rv
+}
+
 func NewEventSessionDeleted(sessionID MetadataAddress) *EventSessionDeleted {
 	return &EventSessionDeleted{
 		SessionAddr: sessionID.String(),
@@ -192,6 +314,19 @@ func NewEventRecordUpdated(recordID, sessionID MetadataAddress) *EventRecordUpda
 	}
 }
 
+// NewEventRecordUpdatedWithDiff creates an EventRecordUpdated with the output hashes
+// from before and after the update if they changed.
+func NewEventRecordUpdatedWithDiff(oldRecord, newRecord Record) *EventRecordUpdated {
+	rv := NewEventRecordUpdated(newRecord.GetRecordAddress(), newRecord.SessionId)
+	before := eventOutputHashes(oldRecord.Outputs)
+	after := eventOutputHashes(newRecord.Outputs)
+	if !stringsEqual(before, after) {
+		rv.OutputHashesBefore = before
+		rv.OutputHashesAfter = after
+	}
+	return rv
+}
+
 func NewEventRecordDeleted(recordID MetadataAddress) *EventRecordDeleted {
 	return &EventRecordDeleted{
 		RecordAddr: recordID.String(),
@@ -256,6 +391,24 @@ func NewEventRecordSpecificationDeleted(recordSpecificationID MetadataAddress) *
 	}
 }
 
+func NewEventRecordTypeCreated(name string) *EventRecordTypeCreated {
+	return &EventRecordTypeCreated{
+		Name: name,
+	}
+}
+
+func NewEventRecordTypeUpdated(name string) *EventRecordTypeUpdated {
+	return &EventRecordTypeUpdated{
+		Name: name,
+	}
+}
+
+func NewEventRecordTypeDeleted(name string) *EventRecordTypeDeleted {
+	return &EventRecordTypeDeleted{
+		Name: name,
+	}
+}
+
 func NewEventOSLocatorCreated(owner string) *EventOSLocatorCreated {
 	return &EventOSLocatorCreated{
 		Owner: owner,
@@ -273,3 +426,73 @@ func NewEventOSLocatorDeleted(owner string) *EventOSLocatorDeleted {
 		Owner: owner,
 	}
 }
+
+// maxEventHashLength is the longest an output hash can be and still be included as-is in an event.
+const maxEventHashLength = 64
+
+// eventOutputHashes gets the hashes of the provided outputs, replacing long ones with a hash of them.
+func eventOutputHashes(outputs []RecordOutput) []string {
+	if len(outputs) == 0 {
+		return nil
+	}
+	rv := make([]string, len(outputs))
+	for i, output := range outputs {
+		rv[i] = output.Hash
+		if len(output.Hash) > maxEventHashLength {
+			rv[i] = "sha256:" + sha256Hex([]byte(output.Hash))
+		}
+	}
+	return rv
+}
+
+// sha256Hex returns the hex encoded sha256 hash of the provided bytes, or an empty string if there aren't any.
+func sha256Hex(bz []byte) string {
+	if len(bz) == 0 {
+		return ""
+	}
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:])
+}
+
+// partiesNotIn returns the entries in parties that are not in others.
+func partiesNotIn(parties, others []Party) []Party {
+	var rv []Party
+partyLoop:
+	for _, party := range parties {
+		for _, other := range others {
+			if party.Equals(other) {
+				continue partyLoop
+			}
+		}
+		rv = append(rv, party)
+	}
+	return rv
+}
+
+// stringsNotIn returns the entries in vals that are not in others.
+func stringsNotIn(vals, others []string) []string {
+	var rv []string
+valLoop:
+	for _, val := range vals {
+		for _, other := range others {
+			if val == other {
+				continue valLoop
+			}
+		}
+		rv = append(rv, val)
+	}
+	return rv
+}
+
+// stringsEqual returns true if the two slices have the same entries in the same order.
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -133,9 +134,22 @@ func (m *EventScopeCreated) GetScopeAddr() string {
 }
 
 // EventScopeUpdated is an event message indicating a scope has been updated.
+// The other fields describe what changed and are empty for anything that did not change.
 type EventScopeUpdated struct {
 	// scope_addr is the bech32 address string of the scope id that was updated.
 	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// owners_added are the owners that the scope now has that it did not have before.
+	OwnersAdded []Party `protobuf:"bytes,2,rep,name=owners_added,json=ownersAdded,proto3" json:"owners_added"`
+	// owners_removed are the owners that the scope had before that it no longer has.
+	OwnersRemoved []Party `protobuf:"bytes,3,rep,name=owners_removed,json=ownersRemoved,proto3" json:"owners_removed"`
+	// data_access_added are the addresses added to the scope's data access list.
+	DataAccessAdded []string `protobuf:"bytes,4,rep,name=data_access_added,json=dataAccessAdded,proto3" json:"data_access_added,omitempty"`
+	// data_access_removed are the addresses removed from the scope's data access list.
+	DataAccessRemoved []string `protobuf:"bytes,5,rep,name=data_access_removed,json=dataAccessRemoved,proto3" json:"data_access_removed,omitempty"`
+	// value_owner_before is the previous value owner address, only set if the value owner changed.
+	ValueOwnerBefore string `protobuf:"bytes,6,opt,name=value_owner_before,json=valueOwnerBefore,proto3" json:"value_owner_before,omitempty"`
+	// value_owner_after is the new value owner address, only set if the value owner changed.
+	ValueOwnerAfter string `protobuf:"bytes,7,opt,name=value_owner_after,json=valueOwnerAfter,proto3" json:"value_owner_after,omitempty"`
 }
 
 func (m *EventScopeUpdated) Reset()         { *m = EventScopeUpdated{} }
@@ -178,6 +192,48 @@ func (m *EventScopeUpdated) GetScopeAddr() string {
 	return ""
 }
 
+func (m *EventScopeUpdated) GetOwnersAdded() []Party {
+	if m != nil {
+		return m.OwnersAdded
+	}
+	return nil
+}
+
+func (m *EventScopeUpdated) GetOwnersRemoved() []Party {
+	if m != nil {
+		return m.OwnersRemoved
+	}
+	return nil
+}
+
+func (m *EventScopeUpdated) GetDataAccessAdded() []string {
+	if m != nil {
+		return m.DataAccessAdded
+	}
+	return nil
+}
+
+func (m *EventScopeUpdated) GetDataAccessRemoved() []string {
+	if m != nil {
+		return m.DataAccessRemoved
+	}
+	return nil
+}
+
+func (m *EventScopeUpdated) GetValueOwnerBefore() string {
+	if m != nil {
+		return m.ValueOwnerBefore
+	}
+	return ""
+}
+
+func (m *EventScopeUpdated) GetValueOwnerAfter() string {
+	if m != nil {
+		return m.ValueOwnerAfter
+	}
+	return ""
+}
+
 // EventScopeDeleted is an event message indicating a scope has been deleted.
 type EventScopeDeleted struct {
 	// scope_addr is the bech32 address string of the scope id that was deleted.
@@ -224,26 +280,28 @@ func (m *EventScopeDeleted) GetScopeAddr() string {
 	return ""
 }
 
-// EventSessionCreated is an event message indicating a session has been created.
-type EventSessionCreated struct {
-	// session_addr is the bech32 address string of the session id that was created.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+// EventScopeLocked is an event message indicating a scope has been locked.
+type EventScopeLocked struct {
+	// scope_addr is the bech32 address string of the scope id that was locked.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// locker is the bech32 address string of the party that locked the scope.
+	Locker string `protobuf:"bytes,2,opt,name=locker,proto3" json:"locker,omitempty"`
+	// reason is the reason given for locking the scope.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
-func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
-func (*EventSessionCreated) ProtoMessage()    {}
-func (*EventSessionCreated) Descriptor() ([]byte, []int) {
+func (m *EventScopeLocked) Reset()         { *m = EventScopeLocked{} }
+func (m *EventScopeLocked) String() string { return proto.CompactTextString(m) }
+func (*EventScopeLocked) ProtoMessage()    {}
+func (*EventScopeLocked) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{4}
 }
-func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeLocked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeLocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeLocked.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -253,52 +311,57 @@ func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionCreated.Merge(m, src)
+func (m *EventScopeLocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeLocked.Merge(m, src)
 }
-func (m *EventSessionCreated) XXX_Size() int {
+func (m *EventScopeLocked) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionCreated.DiscardUnknown(m)
+func (m *EventScopeLocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeLocked.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeLocked proto.InternalMessageInfo
 
-func (m *EventSessionCreated) GetSessionAddr() string {
+func (m *EventScopeLocked) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventSessionCreated) GetScopeAddr() string {
+func (m *EventScopeLocked) GetLocker() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Locker
 	}
 	return ""
 }
 
-// EventSessionUpdated is an event message indicating a session has been updated.
-type EventSessionUpdated struct {
-	// session_addr is the bech32 address string of the session id that was updated.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeLocked) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
-func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventSessionUpdated) ProtoMessage()    {}
-func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
+// EventScopeUnlocked is an event message indicating a scope has been unlocked.
+type EventScopeUnlocked struct {
+	// scope_addr is the bech32 address string of the scope id that was unlocked.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventScopeUnlocked) Reset()         { *m = EventScopeUnlocked{} }
+func (m *EventScopeUnlocked) String() string { return proto.CompactTextString(m) }
+func (*EventScopeUnlocked) ProtoMessage()    {}
+func (*EventScopeUnlocked) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{5}
 }
-func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeUnlocked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeUnlocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeUnlocked.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -308,52 +371,47 @@ func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionUpdated.Merge(m, src)
+func (m *EventScopeUnlocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeUnlocked.Merge(m, src)
 }
-func (m *EventSessionUpdated) XXX_Size() int {
+func (m *EventScopeUnlocked) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionUpdated.DiscardUnknown(m)
+func (m *EventScopeUnlocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeUnlocked.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionUpdated proto.InternalMessageInfo
-
-func (m *EventSessionUpdated) GetSessionAddr() string {
-	if m != nil {
-		return m.SessionAddr
-	}
-	return ""
-}
+var xxx_messageInfo_EventScopeUnlocked proto.InternalMessageInfo
 
-func (m *EventSessionUpdated) GetScopeAddr() string {
+func (m *EventScopeUnlocked) GetScopeAddr() string {
 	if m != nil {
 		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventSessionDeleted is an event message indicating a session has been deleted.
-type EventSessionDeleted struct {
-	// session_addr is the bech32 address string of the session id that was deleted.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+// EventScopeDataAccessExpired is an event message indicating an address's data access to a scope has expired.
+type EventScopeDataAccessExpired struct {
+	// scope_addr is the bech32 address string of the scope id that the address had data access to.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// address is the bech32 address string that no longer has data access.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// expiration is the time at which the data access expired.
+	Expiration string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
-func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventSessionDeleted) ProtoMessage()    {}
-func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
+func (m *EventScopeDataAccessExpired) Reset()         { *m = EventScopeDataAccessExpired{} }
+func (m *EventScopeDataAccessExpired) String() string { return proto.CompactTextString(m) }
+func (*EventScopeDataAccessExpired) ProtoMessage()    {}
+func (*EventScopeDataAccessExpired) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{6}
 }
-func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeDataAccessExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeDataAccessExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeDataAccessExpired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -363,54 +421,66 @@ func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionDeleted.Merge(m, src)
+func (m *EventScopeDataAccessExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeDataAccessExpired.Merge(m, src)
 }
-func (m *EventSessionDeleted) XXX_Size() int {
+func (m *EventScopeDataAccessExpired) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionDeleted.DiscardUnknown(m)
+func (m *EventScopeDataAccessExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeDataAccessExpired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeDataAccessExpired proto.InternalMessageInfo
 
-func (m *EventSessionDeleted) GetSessionAddr() string {
+func (m *EventScopeDataAccessExpired) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventSessionDeleted) GetScopeAddr() string {
+func (m *EventScopeDataAccessExpired) GetAddress() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Address
 	}
 	return ""
 }
 
-// EventRecordCreated is an event message indicating a record has been created.
-type EventRecordCreated struct {
-	// record_addr is the bech32 address string of the record id that was created.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// session_addr is the bech32 address string of the session id this record belongs to.
-	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belongs to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeDataAccessExpired) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
+	}
+	return ""
 }
 
-func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
-func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordCreated) ProtoMessage()    {}
-func (*EventRecordCreated) Descriptor() ([]byte, []int) {
+// EventValueOwnerTransferOffered is an event message indicating a scope's value owner offered to transfer the value
+// ownership to a buyer.
+type EventValueOwnerTransferOffered struct {
+	// scope_addr is the bech32 address string of the scope id whose value ownership is offered.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// seller is the bech32 address string of the value owner that made the offer.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the party that can accept the offer.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the amount that the buyer must pay to accept the offer.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	// expiration is the time at which the offer expires.
+	Expiration string `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *EventValueOwnerTransferOffered) Reset()         { *m = EventValueOwnerTransferOffered{} }
+func (m *EventValueOwnerTransferOffered) String() string { return proto.CompactTextString(m) }
+func (*EventValueOwnerTransferOffered) ProtoMessage()    {}
+func (*EventValueOwnerTransferOffered) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{7}
 }
-func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventValueOwnerTransferOffered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventValueOwnerTransferOffered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventValueOwnerTransferOffered.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -420,61 +490,78 @@ func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordCreated.Merge(m, src)
+func (m *EventValueOwnerTransferOffered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValueOwnerTransferOffered.Merge(m, src)
 }
-func (m *EventRecordCreated) XXX_Size() int {
+func (m *EventValueOwnerTransferOffered) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordCreated.DiscardUnknown(m)
+func (m *EventValueOwnerTransferOffered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValueOwnerTransferOffered.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventValueOwnerTransferOffered proto.InternalMessageInfo
 
-func (m *EventRecordCreated) GetRecordAddr() string {
+func (m *EventValueOwnerTransferOffered) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordCreated) GetSessionAddr() string {
+func (m *EventValueOwnerTransferOffered) GetSeller() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.Seller
 	}
 	return ""
 }
 
-func (m *EventRecordCreated) GetScopeAddr() string {
+func (m *EventValueOwnerTransferOffered) GetBuyer() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Buyer
 	}
 	return ""
 }
 
-// EventRecordUpdated is an event message indicating a record has been updated.
-type EventRecordUpdated struct {
-	// record_addr is the bech32 address string of the record id that was updated.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// session_addr is the bech32 address string of the session id this record belongs to.
-	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belongs to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventValueOwnerTransferOffered) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
-func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordUpdated) ProtoMessage()    {}
-func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
+func (m *EventValueOwnerTransferOffered) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
+	}
+	return ""
+}
+
+// EventValueOwnerTransferAccepted is an event message indicating a buyer accepted a value owner transfer offer and is
+// now the value owner of the scope.
+type EventValueOwnerTransferAccepted struct {
+	// scope_addr is the bech32 address string of the scope id whose value ownership was transferred.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// seller is the bech32 address string of the previous value owner.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the new value owner.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the amount that the buyer paid the seller.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventValueOwnerTransferAccepted) Reset()         { *m = EventValueOwnerTransferAccepted{} }
+func (m *EventValueOwnerTransferAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventValueOwnerTransferAccepted) ProtoMessage()    {}
+func (*EventValueOwnerTransferAccepted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{8}
 }
-func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventValueOwnerTransferAccepted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventValueOwnerTransferAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventValueOwnerTransferAccepted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -484,59 +571,71 @@ func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordUpdated.Merge(m, src)
+func (m *EventValueOwnerTransferAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValueOwnerTransferAccepted.Merge(m, src)
 }
-func (m *EventRecordUpdated) XXX_Size() int {
+func (m *EventValueOwnerTransferAccepted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordUpdated.DiscardUnknown(m)
+func (m *EventValueOwnerTransferAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValueOwnerTransferAccepted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventValueOwnerTransferAccepted proto.InternalMessageInfo
 
-func (m *EventRecordUpdated) GetRecordAddr() string {
+func (m *EventValueOwnerTransferAccepted) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordUpdated) GetSessionAddr() string {
+func (m *EventValueOwnerTransferAccepted) GetSeller() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.Seller
 	}
 	return ""
 }
 
-func (m *EventRecordUpdated) GetScopeAddr() string {
+func (m *EventValueOwnerTransferAccepted) GetBuyer() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Buyer
 	}
 	return ""
 }
 
-// EventRecordDeleted is an event message indicating a record has been deleted.
-type EventRecordDeleted struct {
-	// record is the bech32 address string of the record id that was deleted.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belonged to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventValueOwnerTransferAccepted) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
-func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventRecordDeleted) ProtoMessage()    {}
-func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
+// EventValueOwnerTransferCancelled is an event message indicating a value owner transfer offer was removed without
+// being accepted.
+type EventValueOwnerTransferCancelled struct {
+	// scope_addr is the bech32 address string of the scope id whose value ownership was offered.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// seller is the bech32 address string of the value owner that made the offer.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the party that could have accepted the offer.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// reason is why the offer was removed, either "cancelled" or "expired".
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventValueOwnerTransferCancelled) Reset()         { *m = EventValueOwnerTransferCancelled{} }
+func (m *EventValueOwnerTransferCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventValueOwnerTransferCancelled) ProtoMessage()    {}
+func (*EventValueOwnerTransferCancelled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{9}
 }
-func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventValueOwnerTransferCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventValueOwnerTransferCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventValueOwnerTransferCancelled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -546,51 +645,68 @@ func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordDeleted.Merge(m, src)
+func (m *EventValueOwnerTransferCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValueOwnerTransferCancelled.Merge(m, src)
 }
-func (m *EventRecordDeleted) XXX_Size() int {
+func (m *EventValueOwnerTransferCancelled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordDeleted.DiscardUnknown(m)
+func (m *EventValueOwnerTransferCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValueOwnerTransferCancelled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventValueOwnerTransferCancelled proto.InternalMessageInfo
 
-func (m *EventRecordDeleted) GetRecordAddr() string {
+func (m *EventValueOwnerTransferCancelled) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordDeleted) GetScopeAddr() string {
+func (m *EventValueOwnerTransferCancelled) GetSeller() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Seller
 	}
 	return ""
 }
 
-// EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
-type EventScopeSpecificationCreated struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// created.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventValueOwnerTransferCancelled) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecificationCreated{} }
-func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationCreated) ProtoMessage()    {}
-func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
+func (m *EventValueOwnerTransferCancelled) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventScopeTokenized is an event message indicating a scope's value owner is now a marker.
+type EventScopeTokenized struct {
+	// scope_addr is the bech32 address string of the scope id that was tokenized.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// denom is the denom of the marker that is now the value owner of the scope.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// recipient is the bech32 address string of the party that received the marker's coin.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventScopeTokenized) Reset()         { *m = EventScopeTokenized{} }
+func (m *EventScopeTokenized) String() string { return proto.CompactTextString(m) }
+func (*EventScopeTokenized) ProtoMessage()    {}
+func (*EventScopeTokenized) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{10}
 }
-func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeTokenized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeTokenized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeTokenized.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,44 +716,61 @@ func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationCreated.Merge(m, src)
+func (m *EventScopeTokenized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeTokenized.Merge(m, src)
 }
-func (m *EventScopeSpecificationCreated) XXX_Size() int {
+func (m *EventScopeTokenized) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationCreated.DiscardUnknown(m)
+func (m *EventScopeTokenized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeTokenized.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeTokenized proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationCreated) GetScopeSpecificationAddr() string {
+func (m *EventScopeTokenized) GetScopeAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventScopeSpecificationUpdated is an event message indicating a scope specification has been updated.
-type EventScopeSpecificationUpdated struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// updated.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventScopeTokenized) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecificationUpdated{} }
-func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
-func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{11}
-}
-func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeTokenized) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// EventScopeDetokenized is an event message indicating a scope's coin has been burned and it has a new value owner.
+type EventScopeDetokenized struct {
+	// scope_addr is the bech32 address string of the scope id that was detokenized.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// denom is the denom of the scope's marker.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// value_owner is the bech32 address string of the new value owner of the scope.
+	ValueOwner string `protobuf:"bytes,3,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty"`
+}
+
+func (m *EventScopeDetokenized) Reset()         { *m = EventScopeDetokenized{} }
+func (m *EventScopeDetokenized) String() string { return proto.CompactTextString(m) }
+func (*EventScopeDetokenized) ProtoMessage()    {}
+func (*EventScopeDetokenized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{11}
+}
+func (m *EventScopeDetokenized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeDetokenized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeDetokenized.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,44 +780,59 @@ func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationUpdated.Merge(m, src)
+func (m *EventScopeDetokenized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeDetokenized.Merge(m, src)
 }
-func (m *EventScopeSpecificationUpdated) XXX_Size() int {
+func (m *EventScopeDetokenized) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationUpdated.DiscardUnknown(m)
+func (m *EventScopeDetokenized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeDetokenized.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeDetokenized proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationUpdated) GetScopeSpecificationAddr() string {
+func (m *EventScopeDetokenized) GetScopeAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventScopeSpecificationDeleted is an event message indicating a scope specification has been deleted.
-type EventScopeSpecificationDeleted struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// deleted.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventScopeDetokenized) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecificationDeleted{} }
-func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
-func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
+func (m *EventScopeDetokenized) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
+// EventSessionCreated is an event message indicating a session has been created.
+type EventSessionCreated struct {
+	// session_addr is the bech32 address string of the session id that was created.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
+func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
+func (*EventSessionCreated) ProtoMessage()    {}
+func (*EventSessionCreated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{12}
 }
-func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -694,44 +842,61 @@ func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationDeleted.Merge(m, src)
+func (m *EventSessionCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionCreated.Merge(m, src)
 }
-func (m *EventScopeSpecificationDeleted) XXX_Size() int {
+func (m *EventSessionCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationDeleted.DiscardUnknown(m)
+func (m *EventSessionCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionCreated proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationDeleted) GetScopeSpecificationAddr() string {
+func (m *EventSessionCreated) GetSessionAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventContractSpecificationCreated is an event message indicating a contract specification has been created.
-type EventContractSpecificationCreated struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was created.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionCreated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventContractSpecificationCreated) Reset()         { *m = EventContractSpecificationCreated{} }
-func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationCreated) ProtoMessage()    {}
-func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
+// EventSessionUpdated is an event message indicating a session has been updated.
+// The other fields describe what changed and are empty for anything that did not change.
+type EventSessionUpdated struct {
+	// session_addr is the bech32 address string of the session id that was updated.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// parties_added are the parties that the session now has that it did not have before.
+	PartiesAdded []Party `protobuf:"bytes,3,rep,name=parties_added,json=partiesAdded,proto3" json:"parties_added"`
+	// parties_removed are the parties that the session had before that it no longer has.
+	PartiesRemoved []Party `protobuf:"bytes,4,rep,name=parties_removed,json=partiesRemoved,proto3" json:"parties_removed"`
+	// context_hash_before is the hex encoded sha256 hash of the previous context, only set if the context changed.
+	ContextHashBefore string `protobuf:"bytes,5,opt,name=context_hash_before,json=contextHashBefore,proto3" json:"context_hash_before,omitempty"`
+	// context_hash_after is the hex encoded sha256 hash of the new context, only set if the context changed.
+	ContextHashAfter string `protobuf:"bytes,6,opt,name=context_hash_after,json=contextHashAfter,proto3" json:"context_hash_after,omitempty"`
+}
+
+func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
+func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventSessionUpdated) ProtoMessage()    {}
+func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{13}
 }
-func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -741,44 +906,80 @@ func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationCreated.Merge(m, src)
+func (m *EventSessionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionUpdated.Merge(m, src)
 }
-func (m *EventContractSpecificationCreated) XXX_Size() int {
+func (m *EventSessionUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationCreated.DiscardUnknown(m)
+func (m *EventSessionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionUpdated proto.InternalMessageInfo
 
-func (m *EventContractSpecificationCreated) GetContractSpecificationAddr() string {
+func (m *EventSessionUpdated) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventContractSpecificationUpdated is an event message indicating a contract specification has been updated.
-type EventContractSpecificationUpdated struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was updated.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionUpdated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContractSpecificationUpdated{} }
-func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationUpdated) ProtoMessage()    {}
-func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
+func (m *EventSessionUpdated) GetPartiesAdded() []Party {
+	if m != nil {
+		return m.PartiesAdded
+	}
+	return nil
+}
+
+func (m *EventSessionUpdated) GetPartiesRemoved() []Party {
+	if m != nil {
+		return m.PartiesRemoved
+	}
+	return nil
+}
+
+func (m *EventSessionUpdated) GetContextHashBefore() string {
+	if m != nil {
+		return m.ContextHashBefore
+	}
+	return ""
+}
+
+func (m *EventSessionUpdated) GetContextHashAfter() string {
+	if m != nil {
+		return m.ContextHashAfter
+	}
+	return ""
+}
+
+// EventSessionDeleted is an event message indicating a session has been deleted.
+type EventSessionDeleted struct {
+	// session_addr is the bech32 address string of the session id that was deleted.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
+func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventSessionDeleted) ProtoMessage()    {}
+func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{14}
 }
-func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -788,44 +989,54 @@ func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationUpdated.Merge(m, src)
+func (m *EventSessionDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionDeleted.Merge(m, src)
 }
-func (m *EventContractSpecificationUpdated) XXX_Size() int {
+func (m *EventSessionDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationUpdated.DiscardUnknown(m)
+func (m *EventSessionDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionDeleted proto.InternalMessageInfo
 
-func (m *EventContractSpecificationUpdated) GetContractSpecificationAddr() string {
+func (m *EventSessionDeleted) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventContractSpecificationDeleted is an event message indicating a contract specification has been deleted.
-type EventContractSpecificationDeleted struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was deleted.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionDeleted) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContractSpecificationDeleted{} }
-func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationDeleted) ProtoMessage()    {}
-func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
+// EventRecordCreated is an event message indicating a record has been created.
+type EventRecordCreated struct {
+	// record_addr is the bech32 address string of the record id that was created.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// session_addr is the bech32 address string of the session id this record belongs to.
+	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
+func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordCreated) ProtoMessage()    {}
+func (*EventRecordCreated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{15}
 }
-func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -835,47 +1046,68 @@ func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationDeleted.Merge(m, src)
+func (m *EventRecordCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordCreated.Merge(m, src)
 }
-func (m *EventContractSpecificationDeleted) XXX_Size() int {
+func (m *EventRecordCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationDeleted.DiscardUnknown(m)
+func (m *EventRecordCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordCreated proto.InternalMessageInfo
 
-func (m *EventContractSpecificationDeleted) GetContractSpecificationAddr() string {
+func (m *EventRecordCreated) GetRecordAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.RecordAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationCreated is an event message indicating a record specification has been created.
-type EventRecordSpecificationCreated struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// created.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventRecordCreated) GetSessionAddr() string {
+	if m != nil {
+		return m.SessionAddr
+	}
+	return ""
 }
 
-func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpecificationCreated{} }
-func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationCreated) ProtoMessage()    {}
-func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
+func (m *EventRecordCreated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+// EventRecordUpdated is an event message indicating a record has been updated.
+// The output hash fields are only set if the record's output hashes changed.
+type EventRecordUpdated struct {
+	// record_addr is the bech32 address string of the record id that was updated.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// session_addr is the bech32 address string of the session id this record belongs to.
+	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// output_hashes_before are the previous output hashes.
+	// Hashes longer than 64 characters are replaced with "sha256:" followed by the hex encoded sha256 hash of them.
+	OutputHashesBefore []string `protobuf:"bytes,4,rep,name=output_hashes_before,json=outputHashesBefore,proto3" json:"output_hashes_before,omitempty"`
+	// output_hashes_after are the new output hashes.
+	// Hashes longer than 64 characters are replaced with "sha256:" followed by the hex encoded sha256 hash of them.
+	OutputHashesAfter []string `protobuf:"bytes,5,rep,name=output_hashes_after,json=outputHashesAfter,proto3" json:"output_hashes_after,omitempty"`
+}
+
+func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
+func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordUpdated) ProtoMessage()    {}
+func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{16}
 }
-func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -885,54 +1117,73 @@ func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationCreated.Merge(m, src)
+func (m *EventRecordUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordUpdated.Merge(m, src)
 }
-func (m *EventRecordSpecificationCreated) XXX_Size() int {
+func (m *EventRecordUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationCreated.DiscardUnknown(m)
+func (m *EventRecordUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordUpdated proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationCreated) GetRecordSpecificationAddr() string {
+func (m *EventRecordUpdated) GetRecordAddr() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationCreated) GetContractSpecificationAddr() string {
+func (m *EventRecordUpdated) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationUpdated is an event message indicating a record specification has been updated.
-type EventRecordSpecificationUpdated struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// updated.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventRecordUpdated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpecificationUpdated{} }
-func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
-func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
+func (m *EventRecordUpdated) GetOutputHashesBefore() []string {
+	if m != nil {
+		return m.OutputHashesBefore
+	}
+	return nil
+}
+
+func (m *EventRecordUpdated) GetOutputHashesAfter() []string {
+	if m != nil {
+		return m.OutputHashesAfter
+	}
+	return nil
+}
+
+// EventRecordDeleted is an event message indicating a record has been deleted.
+type EventRecordDeleted struct {
+	// record is the bech32 address string of the record id that was deleted.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belonged to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
+func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordDeleted) ProtoMessage()    {}
+func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{17}
 }
-func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -942,54 +1193,51 @@ func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationUpdated.Merge(m, src)
+func (m *EventRecordDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordDeleted.Merge(m, src)
 }
-func (m *EventRecordSpecificationUpdated) XXX_Size() int {
+func (m *EventRecordDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationUpdated.DiscardUnknown(m)
+func (m *EventRecordDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordDeleted proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationUpdated) GetRecordSpecificationAddr() string {
+func (m *EventRecordDeleted) GetRecordAddr() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationUpdated) GetContractSpecificationAddr() string {
+func (m *EventRecordDeleted) GetScopeAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationDeleted is an event message indicating a record specification has been deleted.
-type EventRecordSpecificationDeleted struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// deleted.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+// EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
+type EventScopeSpecificationCreated struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// created.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpecificationDeleted{} }
-func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
-func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
+func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecificationCreated{} }
+func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationCreated) ProtoMessage()    {}
+func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{18}
 }
-func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSpecificationCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -999,50 +1247,44 @@ func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationDeleted.Merge(m, src)
+func (m *EventScopeSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationCreated.Merge(m, src)
 }
-func (m *EventRecordSpecificationDeleted) XXX_Size() int {
+func (m *EventScopeSpecificationCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationDeleted.DiscardUnknown(m)
+func (m *EventScopeSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationDeleted proto.InternalMessageInfo
-
-func (m *EventRecordSpecificationDeleted) GetRecordSpecificationAddr() string {
-	if m != nil {
-		return m.RecordSpecificationAddr
-	}
-	return ""
-}
+var xxx_messageInfo_EventScopeSpecificationCreated proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationDeleted) GetContractSpecificationAddr() string {
+func (m *EventScopeSpecificationCreated) GetScopeSpecificationAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.ScopeSpecificationAddr
 	}
 	return ""
 }
 
-// EventOSLocatorCreated is an event message indicating an object store locator has been created.
-type EventOSLocatorCreated struct {
-	// owner is the owner in the object store locator that was created.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// EventScopeSpecificationUpdated is an event message indicating a scope specification has been updated.
+type EventScopeSpecificationUpdated struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// updated.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
-func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorCreated) ProtoMessage()    {}
-func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
+func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecificationUpdated{} }
+func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
+func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
-func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSpecificationUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1052,43 +1294,44 @@ func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorCreated.Merge(m, src)
+func (m *EventScopeSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationUpdated.Merge(m, src)
 }
-func (m *EventOSLocatorCreated) XXX_Size() int {
+func (m *EventScopeSpecificationUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorCreated.DiscardUnknown(m)
+func (m *EventScopeSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSpecificationUpdated proto.InternalMessageInfo
 
-func (m *EventOSLocatorCreated) GetOwner() string {
+func (m *EventScopeSpecificationUpdated) GetScopeSpecificationAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.ScopeSpecificationAddr
 	}
 	return ""
 }
 
-// EventOSLocatorUpdated is an event message indicating an object store locator has been updated.
-type EventOSLocatorUpdated struct {
-	// owner is the owner in the object store locator that was updated.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// EventScopeSpecificationDeleted is an event message indicating a scope specification has been deleted.
+type EventScopeSpecificationDeleted struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// deleted.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
-func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorUpdated) ProtoMessage()    {}
-func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
+func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecificationDeleted{} }
+func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
+func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{20}
 }
-func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSpecificationDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1098,43 +1341,44 @@ func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorUpdated.Merge(m, src)
+func (m *EventScopeSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationDeleted.Merge(m, src)
 }
-func (m *EventOSLocatorUpdated) XXX_Size() int {
+func (m *EventScopeSpecificationDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorUpdated.DiscardUnknown(m)
+func (m *EventScopeSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSpecificationDeleted proto.InternalMessageInfo
 
-func (m *EventOSLocatorUpdated) GetOwner() string {
+func (m *EventScopeSpecificationDeleted) GetScopeSpecificationAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.ScopeSpecificationAddr
 	}
 	return ""
 }
 
-// EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
-type EventOSLocatorDeleted struct {
-	// owner is the owner in the object store locator that was deleted.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// EventContractSpecificationCreated is an event message indicating a contract specification has been created.
+type EventContractSpecificationCreated struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was created.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
-func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorDeleted) ProtoMessage()    {}
-func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
-}
-func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventContractSpecificationCreated) Reset()         { *m = EventContractSpecificationCreated{} }
+func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationCreated) ProtoMessage()    {}
+func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
+}
+func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventContractSpecificationCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1144,643 +1388,676 @@ func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorDeleted.Merge(m, src)
+func (m *EventContractSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationCreated.Merge(m, src)
 }
-func (m *EventOSLocatorDeleted) XXX_Size() int {
+func (m *EventContractSpecificationCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorDeleted.DiscardUnknown(m)
+func (m *EventContractSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventContractSpecificationCreated proto.InternalMessageInfo
 
-func (m *EventOSLocatorDeleted) GetOwner() string {
+func (m *EventContractSpecificationCreated) GetContractSpecificationAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.ContractSpecificationAddr
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
-	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
-	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
-	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
-	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
-	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
-	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
-	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
-	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
-	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
-	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
-	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
-	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
-	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
-	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
-	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
-	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
-	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
-	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
-	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
-	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
-	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+// EventContractSpecificationUpdated is an event message indicating a contract specification has been updated.
+type EventContractSpecificationUpdated struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was updated.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContractSpecificationUpdated{} }
+func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationUpdated) ProtoMessage()    {}
+func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
 }
-
-var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x44, 0x14, 0x32, 0xe5, 0x00, 0x06, 0x82, 0x03, 0xc2, 0xfd, 0xc3, 0xa5, 0x97,
-	0xda, 0x2a, 0x70, 0x40, 0x1c, 0x90, 0x20, 0x70, 0x43, 0x02, 0x25, 0x45, 0x48, 0xbd, 0xc0, 0x76,
-	0x77, 0x28, 0x16, 0xcd, 0xae, 0xb5, 0xbb, 0x4d, 0xcb, 0x5b, 0xf0, 0x02, 0xbc, 0x0f, 0xc7, 0x1e,
-	0x39, 0xa2, 0xe4, 0x45, 0x50, 0xd6, 0x1e, 0xec, 0x36, 0x2e, 0x06, 0x42, 0x80, 0xe3, 0xcc, 0xce,
-	0x7c, 0xbf, 0xd9, 0x6f, 0xe7, 0xb0, 0x70, 0x3b, 0xd5, 0x6a, 0x84, 0x92, 0x49, 0x8e, 0xf1, 0x10,
-	0x2d, 0x13, 0xcc, 0xb2, 0x78, 0xb4, 0x15, 0xe3, 0x08, 0xa5, 0x35, 0x51, 0xaa, 0x95, 0x55, 0x7e,
-	0xa7, 0x28, 0x8a, 0xa8, 0x28, 0x1a, 0x6d, 0xad, 0xbf, 0x81, 0x4b, 0x4f, 0xa7, 0x75, 0xdb, 0x47,
-	0x3d, 0x35, 0x4c, 0xf7, 0xd1, 0xa2, 0xf0, 0x3b, 0xb0, 0x34, 0x54, 0xe2, 0x60, 0x1f, 0x03, 0x6f,
-	0xd5, 0xdb, 0x68, 0xf7, 0xf3, 0xc8, 0xbf, 0x01, 0x17, 0x50, 0x8a, 0x54, 0x25, 0xd2, 0x06, 0x4d,
-	0x77, 0xf2, 0x3d, 0xf6, 0x03, 0x38, 0x6f, 0x92, 0x3d, 0x89, 0xda, 0x04, 0xad, 0xd5, 0xd6, 0x46,
-	0xbb, 0x4f, 0xe1, 0xfa, 0x1d, 0xb8, 0xec, 0x08, 0x03, 0xae, 0x52, 0xec, 0x69, 0x64, 0x53, 0xc4,
-	0x2d, 0x00, 0x33, 0x8d, 0x5f, 0x33, 0x21, 0x74, 0x8e, 0x69, 0xbb, 0xcc, 0x23, 0x21, 0xf4, 0xc9,
-	0x9e, 0x97, 0xa9, 0xf8, 0xe5, 0x9e, 0x27, 0x98, 0x5d, 0xa5, 0xa6, 0xe7, 0x15, 0x5c, 0xc9, 0x7a,
-	0xd0, 0x98, 0x44, 0x49, 0x9a, 0x6e, 0x0d, 0x2e, 0x9a, 0x2c, 0x53, 0xee, 0x5b, 0xce, 0x73, 0xd3,
-	0xce, 0x53, 0xc2, 0xcd, 0x1a, 0x61, 0xba, 0xc2, 0x1f, 0x17, 0xa6, 0x7b, 0xce, 0x2f, 0x7c, 0x08,
-	0xbe, 0x13, 0xee, 0x23, 0x57, 0x5a, 0x90, 0x13, 0x2b, 0xb0, 0xac, 0x5d, 0xa2, 0x2c, 0x0b, 0x59,
-	0xca, 0xa9, 0x9e, 0x06, 0x37, 0xeb, 0xc0, 0xad, 0x1f, 0x83, 0xc9, 0xa9, 0xbf, 0x00, 0xde, 0x3e,
-	0x01, 0x26, 0x27, 0x6b, 0xc1, 0x35, 0xaa, 0x3b, 0x10, 0x16, 0x6b, 0x38, 0x48, 0x91, 0x27, 0x6f,
-	0x13, 0xce, 0x6c, 0x69, 0xbb, 0xee, 0x43, 0x90, 0x09, 0x98, 0xf2, 0x69, 0x19, 0xd7, 0x31, 0x33,
-	0xcd, 0x35, 0xda, 0x64, 0xdb, 0x22, 0xb4, 0xc9, 0x99, 0xdf, 0xd7, 0xe6, 0xb0, 0xe6, 0xb4, 0x7b,
-	0x4a, 0x5a, 0xcd, 0xb8, 0xad, 0xb4, 0xe5, 0x21, 0xdc, 0xe4, 0xf9, 0xf9, 0xd9, 0x84, 0x2e, 0xaf,
-	0x92, 0xa8, 0x87, 0x90, 0x3f, 0x0b, 0x85, 0x90, 0x51, 0xf3, 0x42, 0x3e, 0x79, 0xb0, 0x52, 0xda,
-	0xcc, 0x4a, 0xb7, 0x1e, 0x40, 0x37, 0x5f, 0xd3, 0x33, 0x09, 0xd7, 0xf5, 0x6c, 0xbb, 0xdb, 0xe0,
-	0x9a, 0xf9, 0x9a, 0xf3, 0xcc, 0x47, 0x46, 0xff, 0xaf, 0xf3, 0xd1, 0x1b, 0xfd, 0xcb, 0xf9, 0x36,
-	0xe1, 0x9a, 0x1b, 0xef, 0xf9, 0xe0, 0x99, 0xe2, 0xcc, 0x2a, 0x4d, 0x8f, 0x7a, 0x15, 0xce, 0xa9,
-	0x43, 0x89, 0x34, 0x40, 0x16, 0xcc, 0x96, 0x93, 0xc7, 0x3f, 0x59, 0x4e, 0x57, 0xae, 0x2c, 0x7f,
-	0xfc, 0xfe, 0xf3, 0x38, 0xf4, 0x8e, 0xc7, 0xa1, 0xf7, 0x75, 0x1c, 0x7a, 0x1f, 0x27, 0x61, 0xe3,
-	0x78, 0x12, 0x36, 0xbe, 0x4c, 0xc2, 0x06, 0x74, 0x13, 0x15, 0x55, 0xff, 0x1a, 0x5e, 0x78, 0x3b,
-	0xf7, 0xf6, 0x12, 0xfb, 0xee, 0x60, 0x37, 0xe2, 0x6a, 0x18, 0x17, 0x45, 0x9b, 0x89, 0x2a, 0x45,
-	0xf1, 0x51, 0xf1, 0x1f, 0xb1, 0x1f, 0x52, 0x34, 0xbb, 0x4b, 0xee, 0x33, 0x72, 0xf7, 0x5b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6c, 0x3a, 0xe4, 0x3b, 0xb3, 0x08, 0x00, 0x00,
+func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationUpdated.Merge(m, src)
 }
-
-func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationUpdated) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventContractSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationUpdated.DiscardUnknown(m)
 }
 
-func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_EventContractSpecificationUpdated proto.InternalMessageInfo
 
-func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventContractSpecificationUpdated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventContractSpecificationDeleted is an event message indicating a contract specification has been deleted.
+type EventContractSpecificationDeleted struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was deleted.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContractSpecificationDeleted{} }
+func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationDeleted) ProtoMessage()    {}
+func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
-
-func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationDeleted.Merge(m, src)
 }
-
-func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationDeleted.DiscardUnknown(m)
 }
 
-func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventContractSpecificationDeleted proto.InternalMessageInfo
+
+func (m *EventContractSpecificationDeleted) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordSpecificationCreated is an event message indicating a record specification has been created.
+type EventRecordSpecificationCreated struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// created.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpecificationCreated{} }
+func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationCreated) ProtoMessage()    {}
+func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
 }
-
-func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRecordSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationCreated.Merge(m, src)
+}
+func (m *EventRecordSpecificationCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationCreated.DiscardUnknown(m)
 }
 
-func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventRecordSpecificationCreated proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationCreated) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationCreated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordSpecificationUpdated is an event message indicating a record specification has been updated.
+type EventRecordSpecificationUpdated struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// updated.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpecificationUpdated{} }
+func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
+func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{25}
+}
+func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventRecordSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationUpdated.Merge(m, src)
+}
+func (m *EventRecordSpecificationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordSpecificationUpdated proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationUpdated) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationUpdated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordSpecificationDeleted is an event message indicating a record specification has been deleted.
+type EventRecordSpecificationDeleted struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// deleted.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpecificationDeleted{} }
+func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
+func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{26}
+}
+func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventRecordSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationDeleted.Merge(m, src)
+}
+func (m *EventRecordSpecificationDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordSpecificationDeleted proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationDeleted) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationDeleted) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordTypeCreated is an event message indicating a record type has been registered.
+type EventRecordTypeCreated struct {
+	// name is the name of the record type that was registered.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventRecordTypeCreated) Reset()         { *m = EventRecordTypeCreated{} }
+func (m *EventRecordTypeCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordTypeCreated) ProtoMessage()    {}
+func (*EventRecordTypeCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{27}
 }
-
-func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordTypeCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordTypeCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordTypeCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRecordTypeCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordTypeCreated.Merge(m, src)
 }
-
-func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventRecordTypeCreated) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventRecordTypeCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordTypeCreated.DiscardUnknown(m)
 }
 
-func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_EventRecordTypeCreated proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventRecordTypeCreated) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventRecordTypeUpdated is an event message indicating a record type has been updated.
+type EventRecordTypeUpdated struct {
+	// name is the name of the record type that was updated.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRecordTypeUpdated) Reset()         { *m = EventRecordTypeUpdated{} }
+func (m *EventRecordTypeUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordTypeUpdated) ProtoMessage()    {}
+func (*EventRecordTypeUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{28}
 }
-
-func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventRecordTypeUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordTypeUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordTypeUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventRecordTypeUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordTypeUpdated.Merge(m, src)
+}
+func (m *EventRecordTypeUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordTypeUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordTypeUpdated.DiscardUnknown(m)
 }
 
-func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventRecordTypeUpdated proto.InternalMessageInfo
+
+func (m *EventRecordTypeUpdated) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordTypeDeleted is an event message indicating a record type has been deleted.
+type EventRecordTypeDeleted struct {
+	// name is the name of the record type that was deleted.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventRecordTypeDeleted) Reset()         { *m = EventRecordTypeDeleted{} }
+func (m *EventRecordTypeDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordTypeDeleted) ProtoMessage()    {}
+func (*EventRecordTypeDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{29}
+}
+func (m *EventRecordTypeDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordTypeDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordTypeDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventRecordTypeDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordTypeDeleted.Merge(m, src)
+}
+func (m *EventRecordTypeDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordTypeDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordTypeDeleted.DiscardUnknown(m)
 }
 
-func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventRecordTypeDeleted proto.InternalMessageInfo
+
+func (m *EventRecordTypeDeleted) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOSLocatorCreated is an event message indicating an object store locator has been created.
+type EventOSLocatorCreated struct {
+	// owner is the owner in the object store locator that was created.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
+func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorCreated) ProtoMessage()    {}
+func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{30}
+}
+func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventOSLocatorCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorCreated.Merge(m, src)
+}
+func (m *EventOSLocatorCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorCreated.DiscardUnknown(m)
 }
 
-func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOSLocatorCreated proto.InternalMessageInfo
+
+func (m *EventOSLocatorCreated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOSLocatorUpdated is an event message indicating an object store locator has been updated.
+type EventOSLocatorUpdated struct {
+	// owner is the owner in the object store locator that was updated.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
+func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorUpdated) ProtoMessage()    {}
+func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{31}
+}
+func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventOSLocatorUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorUpdated.Merge(m, src)
+}
+func (m *EventOSLocatorUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorUpdated.DiscardUnknown(m)
 }
 
-func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOSLocatorUpdated proto.InternalMessageInfo
+
+func (m *EventOSLocatorUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
+type EventOSLocatorDeleted struct {
+	// owner is the owner in the object store locator that was deleted.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
+func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorDeleted) ProtoMessage()    {}
+func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{32}
+}
+func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventOSLocatorDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorDeleted.Merge(m, src)
+}
+func (m *EventOSLocatorDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorDeleted.DiscardUnknown(m)
 }
 
-func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_EventOSLocatorDeleted proto.InternalMessageInfo
+
+func (m *EventOSLocatorDeleted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
+	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
+	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
+	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
+	proto.RegisterType((*EventScopeLocked)(nil), "provenance.metadata.v1.EventScopeLocked")
+	proto.RegisterType((*EventScopeUnlocked)(nil), "provenance.metadata.v1.EventScopeUnlocked")
+	proto.RegisterType((*EventScopeDataAccessExpired)(nil), "provenance.metadata.v1.EventScopeDataAccessExpired")
+	proto.RegisterType((*EventValueOwnerTransferOffered)(nil), "provenance.metadata.v1.EventValueOwnerTransferOffered")
+	proto.RegisterType((*EventValueOwnerTransferAccepted)(nil), "provenance.metadata.v1.EventValueOwnerTransferAccepted")
+	proto.RegisterType((*EventValueOwnerTransferCancelled)(nil), "provenance.metadata.v1.EventValueOwnerTransferCancelled")
+	proto.RegisterType((*EventScopeTokenized)(nil), "provenance.metadata.v1.EventScopeTokenized")
+	proto.RegisterType((*EventScopeDetokenized)(nil), "provenance.metadata.v1.EventScopeDetokenized")
+	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
+	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
+	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
+	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
+	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
+	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
+	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
+	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
+	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
+	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
+	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
+	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
+	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
+	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
+	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
+	proto.RegisterType((*EventRecordTypeCreated)(nil), "provenance.metadata.v1.EventRecordTypeCreated")
+	proto.RegisterType((*EventRecordTypeUpdated)(nil), "provenance.metadata.v1.EventRecordTypeUpdated")
+	proto.RegisterType((*EventRecordTypeDeleted)(nil), "provenance.metadata.v1.EventRecordTypeDeleted")
+	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
+	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
+	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+}
+
+var fileDescriptor_476cf6cf9459cf25 = []byte{
+	// 1034 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xe3, 0x54,
+	0x10, 0xaf, 0x93, 0xb4, 0xa5, 0x93, 0xee, 0xb6, 0x75, 0x43, 0x70, 0xbb, 0x6c, 0xda, 0x35, 0x97,
+	0x0a, 0x75, 0x13, 0x76, 0x97, 0x03, 0xe2, 0x80, 0xd4, 0x96, 0x45, 0x15, 0xaa, 0xd4, 0x55, 0x1a,
+	0x40, 0xda, 0x4b, 0x78, 0xb5, 0x27, 0x8d, 0x55, 0xc7, 0xcf, 0x7a, 0x7e, 0xc9, 0xb6, 0x9c, 0x91,
+	0xb8, 0xf2, 0x05, 0x38, 0xf1, 0x65, 0xf6, 0x82, 0xb4, 0x47, 0xb8, 0x20, 0xd4, 0x7e, 0x0d, 0x0e,
+	0xe8, 0xfd, 0xab, 0x9d, 0x34, 0x91, 0x03, 0xa1, 0xb0, 0xb7, 0xcc, 0xbc, 0xdf, 0xcc, 0xef, 0xe7,
+	0x99, 0x79, 0xe3, 0x18, 0x3e, 0x88, 0x19, 0x1d, 0x60, 0x44, 0x22, 0x0f, 0x1b, 0x3d, 0xe4, 0xc4,
+	0x27, 0x9c, 0x34, 0x06, 0x4f, 0x1a, 0x38, 0xc0, 0x88, 0x27, 0xf5, 0x98, 0x51, 0x4e, 0xed, 0x6a,
+	0x0a, 0xaa, 0x1b, 0x50, 0x7d, 0xf0, 0x64, 0xb3, 0x72, 0x46, 0xcf, 0xa8, 0x84, 0x34, 0xc4, 0x2f,
+	0x85, 0xde, 0x74, 0x27, 0xa4, 0x4c, 0x3c, 0x1a, 0xa3, 0xc2, 0xb8, 0xdf, 0xc2, 0xea, 0x73, 0xc1,
+	0xd0, 0xba, 0x38, 0xa0, 0xbd, 0x38, 0x44, 0x8e, 0xbe, 0x5d, 0x85, 0x85, 0x1e, 0xf5, 0xfb, 0x21,
+	0x3a, 0xd6, 0xb6, 0xb5, 0xb3, 0xd4, 0xd4, 0x96, 0xbd, 0x09, 0xef, 0x60, 0xe4, 0xc7, 0x34, 0x88,
+	0xb8, 0x53, 0x90, 0x27, 0x37, 0xb6, 0xed, 0xc0, 0x62, 0x12, 0x9c, 0x45, 0xc8, 0x12, 0xa7, 0xb8,
+	0x5d, 0xdc, 0x59, 0x6a, 0x1a, 0xd3, 0x7d, 0x0a, 0x6b, 0x92, 0xe1, 0x44, 0xb0, 0x1e, 0x30, 0x24,
+	0x82, 0xe2, 0x21, 0x80, 0x54, 0xd1, 0x26, 0xbe, 0xcf, 0x34, 0xcd, 0x92, 0xf4, 0xec, 0xf9, 0x3e,
+	0x73, 0xff, 0x2c, 0x64, 0x83, 0xbe, 0x8a, 0xfd, 0x29, 0x82, 0xec, 0x2f, 0x60, 0x99, 0xbe, 0x12,
+	0x94, 0xe2, 0x1c, 0x7d, 0xa7, 0xb0, 0x5d, 0xdc, 0x29, 0x3f, 0x7d, 0x58, 0x1f, 0x5f, 0xb3, 0xfa,
+	0x0b, 0xc2, 0xf8, 0xe5, 0x7e, 0xe9, 0xf5, 0xef, 0x5b, 0x73, 0xcd, 0xb2, 0x0a, 0xdc, 0x13, 0x71,
+	0xf6, 0x97, 0x70, 0x5f, 0xe7, 0x61, 0xd8, 0xa3, 0x03, 0xf4, 0x9d, 0xe2, 0xf4, 0x99, 0xee, 0xa9,
+	0xd0, 0xa6, 0x8a, 0xb4, 0x3f, 0x84, 0x35, 0x81, 0x6a, 0x13, 0xcf, 0xc3, 0xc4, 0x08, 0x2b, 0xc9,
+	0x02, 0xad, 0x88, 0x83, 0x3d, 0xe9, 0x57, 0xbc, 0x75, 0x58, 0xcf, 0x62, 0x0d, 0xf9, 0xbc, 0x44,
+	0xaf, 0xa5, 0x68, 0x93, 0x7b, 0x17, 0xec, 0x01, 0x09, 0xfb, 0xd8, 0x96, 0x94, 0xed, 0x53, 0xec,
+	0x50, 0x86, 0xce, 0x82, 0x2c, 0xcb, 0xaa, 0x3c, 0x39, 0x16, 0x07, 0xfb, 0xd2, 0x2f, 0x94, 0x64,
+	0xd1, 0xa4, 0xc3, 0x91, 0x39, 0x8b, 0x12, 0xbc, 0x92, 0x82, 0xf7, 0x84, 0x7b, 0xb8, 0x65, 0x9f,
+	0x63, 0x88, 0xf9, 0xd5, 0x77, 0x09, 0xac, 0xa6, 0x31, 0x47, 0xd4, 0x3b, 0xcf, 0x6f, 0x58, 0x15,
+	0x16, 0x42, 0x01, 0x64, 0x7a, 0x9a, 0xb4, 0x25, 0xfc, 0x0c, 0x49, 0x42, 0x23, 0xa7, 0xa8, 0xfc,
+	0xca, 0x72, 0x9f, 0x81, 0x9d, 0x19, 0x8a, 0x28, 0x9c, 0x86, 0xc4, 0x1d, 0xc0, 0x83, 0xcc, 0xb3,
+	0xdc, 0x14, 0xf1, 0xf9, 0x45, 0x1c, 0xb0, 0x7c, 0x89, 0x0e, 0x2c, 0x8a, 0x03, 0x4c, 0x12, 0xad,
+	0xd1, 0x98, 0x76, 0x0d, 0x00, 0x45, 0x0e, 0xc2, 0x83, 0x1b, 0xa1, 0x19, 0x8f, 0xfb, 0xb3, 0x05,
+	0x35, 0x49, 0xfc, 0xf5, 0x4d, 0x71, 0x5b, 0x8c, 0x44, 0x49, 0x07, 0xd9, 0x71, 0xa7, 0x83, 0x6c,
+	0xaa, 0xf2, 0x24, 0x18, 0x86, 0x69, 0x79, 0x94, 0x65, 0x57, 0x60, 0xfe, 0xb4, 0x7f, 0x89, 0x4c,
+	0x93, 0x2a, 0x43, 0x78, 0x63, 0x16, 0x78, 0xe8, 0x94, 0x94, 0x57, 0x1a, 0x23, 0x2a, 0xe7, 0x6f,
+	0xa9, 0xfc, 0xde, 0x82, 0xad, 0x09, 0x2a, 0x45, 0x9d, 0x62, 0xfe, 0x9f, 0xc8, 0x74, 0x7f, 0xb0,
+	0x60, 0x7b, 0x82, 0x8c, 0x03, 0x71, 0xdd, 0xc2, 0xf0, 0xdf, 0xd6, 0x91, 0xce, 0x58, 0x69, 0x68,
+	0xc6, 0xba, 0xb0, 0x9e, 0x8e, 0x4b, 0x8b, 0x9e, 0x63, 0x14, 0x7c, 0x97, 0xcf, 0x5d, 0x81, 0x79,
+	0x1f, 0x23, 0xda, 0xd3, 0xd4, 0xca, 0xb0, 0xdf, 0x87, 0x25, 0x86, 0x5e, 0x10, 0x07, 0x18, 0x71,
+	0xcd, 0x9e, 0x3a, 0xdc, 0x1e, 0xbc, 0x9b, 0xbd, 0x64, 0x7c, 0x36, 0xae, 0x2d, 0x28, 0x67, 0xae,
+	0xb7, 0x99, 0xc7, 0xf4, 0x62, 0xbb, 0xdf, 0x98, 0x07, 0xc3, 0x24, 0x09, 0x68, 0x64, 0x16, 0xf1,
+	0x23, 0x58, 0x4e, 0x94, 0x27, 0x4b, 0x57, 0xd6, 0x3e, 0x49, 0x38, 0xac, 0xa7, 0x30, 0x7a, 0xc1,
+	0x7e, 0x29, 0x0c, 0x67, 0x36, 0xdb, 0x7a, 0xe6, 0xcc, 0xf6, 0x21, 0xdc, 0x8b, 0x09, 0xe3, 0x01,
+	0x9a, 0xc5, 0xf9, 0x37, 0xf6, 0xf0, 0xb2, 0x8e, 0x54, 0xab, 0xf5, 0x08, 0x56, 0x4c, 0x26, 0xb3,
+	0x56, 0x4b, 0xd3, 0xe7, 0xba, 0xaf, 0x63, 0xcd, 0xe2, 0xad, 0xc3, 0xba, 0x47, 0x23, 0x8e, 0x17,
+	0xbc, 0xdd, 0x25, 0x49, 0xd7, 0x6c, 0x5e, 0x75, 0xbb, 0xd6, 0xf4, 0xd1, 0x21, 0x49, 0xba, 0x7a,
+	0xf5, 0xee, 0x82, 0x3d, 0x84, 0x57, 0xbb, 0x57, 0x2f, 0xea, 0x0c, 0x5c, 0x2d, 0xdf, 0x91, 0x46,
+	0x99, 0xf5, 0x3b, 0x7b, 0xa3, 0x5e, 0xe9, 0xf5, 0xd9, 0x44, 0x8f, 0x32, 0xdf, 0x0c, 0xc0, 0x16,
+	0x94, 0x99, 0x74, 0x64, 0xd3, 0x82, 0x72, 0xc9, 0xac, 0xa3, 0xc4, 0x85, 0x3c, 0xe2, 0xe2, 0x28,
+	0xf1, 0x6f, 0xd6, 0x10, 0xb3, 0x19, 0x90, 0xbb, 0x67, 0xb6, 0x3f, 0x82, 0x0a, 0xed, 0xf3, 0xb8,
+	0xaf, 0x0a, 0x8f, 0x89, 0x69, 0x95, 0x7a, 0x03, 0xdb, 0xea, 0xec, 0x50, 0x1e, 0xe9, 0x5e, 0xd5,
+	0x61, 0x7d, 0x38, 0x42, 0x35, 0x4b, 0xbf, 0x84, 0xb3, 0x01, 0xaa, 0x5b, 0xad, 0xa1, 0x47, 0x33,
+	0xcd, 0xca, 0x7d, 0xb4, 0x9c, 0x8a, 0xbd, 0x84, 0x5a, 0xba, 0x1b, 0x4e, 0x62, 0xf4, 0x82, 0x4e,
+	0xe0, 0xc9, 0x8d, 0x6d, 0xda, 0xf6, 0x09, 0x38, 0x2a, 0x41, 0x92, 0x3d, 0xcd, 0xd2, 0x55, 0x93,
+	0x5b, 0xc1, 0x39, 0xb9, 0x4d, 0x63, 0xee, 0x22, 0xb7, 0xa9, 0xcc, 0x3f, 0xcf, 0xed, 0xc1, 0x23,
+	0x99, 0xfb, 0x80, 0x46, 0x9c, 0x11, 0x8f, 0x8f, 0x2d, 0xcb, 0x67, 0xf0, 0xc0, 0xd3, 0xe7, 0x93,
+	0x19, 0x36, 0xbc, 0x71, 0x29, 0xf2, 0x49, 0x4c, 0x7d, 0xee, 0x94, 0xc4, 0x14, 0x6a, 0x56, 0x92,
+	0x9f, 0xcc, 0x9b, 0x5d, 0x4d, 0xe6, 0xd8, 0x6a, 0x7d, 0x0a, 0x1b, 0x7a, 0x4c, 0x27, 0x32, 0xbc,
+	0xc7, 0x6e, 0x87, 0xcb, 0x09, 0xce, 0xd1, 0x57, 0x98, 0x45, 0x9f, 0x29, 0xf4, 0xdb, 0xaa, 0xcf,
+	0xf4, 0xe8, 0xff, 0xd4, 0xb7, 0x0b, 0xd5, 0x8c, 0xbc, 0xd6, 0x65, 0xfa, 0x6d, 0x65, 0x43, 0x29,
+	0x22, 0x3d, 0xf3, 0xf1, 0x26, 0x7f, 0x8f, 0x41, 0x9b, 0x1a, 0x4f, 0x87, 0x36, 0x4f, 0x3c, 0x0e,
+	0xfd, 0x58, 0xff, 0x91, 0x39, 0x3e, 0x39, 0xa2, 0x1e, 0xe1, 0x94, 0x19, 0x21, 0x15, 0x98, 0x57,
+	0xff, 0x46, 0x14, 0x5a, 0x19, 0xb7, 0xe1, 0x46, 0xc9, 0x94, 0x70, 0x23, 0x65, 0x2c, 0x7c, 0xff,
+	0xfc, 0xf5, 0x55, 0xcd, 0x7a, 0x73, 0x55, 0xb3, 0xfe, 0xb8, 0xaa, 0x59, 0x3f, 0x5e, 0xd7, 0xe6,
+	0xde, 0x5c, 0xd7, 0xe6, 0x7e, 0xbd, 0xae, 0xcd, 0xc1, 0x46, 0x40, 0x27, 0xbc, 0xec, 0x5f, 0x58,
+	0x2f, 0x3f, 0x3e, 0x0b, 0x78, 0xb7, 0x7f, 0x5a, 0xf7, 0x68, 0xaf, 0x91, 0x82, 0x1e, 0x07, 0x34,
+	0x63, 0x35, 0x2e, 0xd2, 0xaf, 0x68, 0x7e, 0x19, 0x63, 0x72, 0xba, 0x20, 0xbf, 0xa1, 0x9f, 0xfd,
+	0x35, 0x00, 0x9f, 0x68, 0x9b, 0xfd, 0xbc, 0x0f, 0x00, 0x00,
+}
+
+func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1790,34 +2067,43 @@ func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1827,34 +2113,27 @@ func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1864,34 +2143,87 @@ func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+	if len(m.ValueOwnerAfter) > 0 {
+		i -= len(m.ValueOwnerAfter)
+		copy(dAtA[i:], m.ValueOwnerAfter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueOwnerAfter)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+	if len(m.ValueOwnerBefore) > 0 {
+		i -= len(m.ValueOwnerBefore)
+		copy(dAtA[i:], m.ValueOwnerBefore)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueOwnerBefore)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DataAccessRemoved) > 0 {
+		for iNdEx := len(m.DataAccessRemoved) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccessRemoved[iNdEx])
+			copy(dAtA[i:], m.DataAccessRemoved[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.DataAccessRemoved[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DataAccessAdded) > 0 {
+		for iNdEx := len(m.DataAccessAdded) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccessAdded[iNdEx])
+			copy(dAtA[i:], m.DataAccessAdded[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.DataAccessAdded[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OwnersRemoved) > 0 {
+		for iNdEx := len(m.OwnersRemoved) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnersRemoved[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OwnersAdded) > 0 {
+		for iNdEx := len(m.OwnersAdded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnersAdded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1901,27 +2233,27 @@ func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeLocked) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1931,27 +2263,41 @@ func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeLocked) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeLocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Locker) > 0 {
+		i -= len(m.Locker)
+		copy(dAtA[i:], m.Locker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Locker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventScopeUnlocked) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1961,384 +2307,3072 @@ func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScopeUnlocked) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScopeUnlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventScopeDataAccessExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventTxCompleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventScopeDataAccessExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeDataAccessExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventValueOwnerTransferOffered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventScopeUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventValueOwnerTransferOffered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventValueOwnerTransferOffered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *EventSessionCreated) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventValueOwnerTransferAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSessionDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventValueOwnerTransferAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValueOwnerTransferAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventValueOwnerTransferCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValueOwnerTransferCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValueOwnerTransferCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeTokenized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeTokenized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeTokenized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventScopeDetokenized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventScopeDetokenized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeDetokenized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *EventScopeSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContextHashAfter) > 0 {
+		i -= len(m.ContextHashAfter)
+		copy(dAtA[i:], m.ContextHashAfter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContextHashAfter)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContextHashBefore) > 0 {
+		i -= len(m.ContextHashBefore)
+		copy(dAtA[i:], m.ContextHashBefore)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContextHashBefore)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PartiesRemoved) > 0 {
+		for iNdEx := len(m.PartiesRemoved) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartiesRemoved[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
-}
-
-func (m *EventRecordSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PartiesAdded) > 0 {
+		for iNdEx := len(m.PartiesAdded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartiesAdded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventOSLocatorUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutputHashesAfter) > 0 {
+		for iNdEx := len(m.OutputHashesAfter) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutputHashesAfter[iNdEx])
+			copy(dAtA[i:], m.OutputHashesAfter[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.OutputHashesAfter[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OutputHashesBefore) > 0 {
+		for iNdEx := len(m.OutputHashesBefore) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutputHashesBefore[iNdEx])
+			copy(dAtA[i:], m.OutputHashesBefore[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.OutputHashesBefore[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordTypeCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordTypeCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordTypeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordTypeUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordTypeUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordTypeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordTypeDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordTypeDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordTypeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTxCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.OwnersAdded) > 0 {
+		for _, e := range m.OwnersAdded {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.OwnersRemoved) > 0 {
+		for _, e := range m.OwnersRemoved {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.DataAccessAdded) > 0 {
+		for _, s := range m.DataAccessAdded {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.DataAccessRemoved) > 0 {
+		for _, s := range m.DataAccessRemoved {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerBefore)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueOwnerAfter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeLocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Locker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeUnlocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDataAccessExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValueOwnerTransferOffered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValueOwnerTransferAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValueOwnerTransferCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeTokenized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDetokenized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PartiesAdded) > 0 {
+		for _, e := range m.PartiesAdded {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.PartiesRemoved) > 0 {
+		for _, e := range m.PartiesRemoved {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.ContextHashBefore)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContextHashAfter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.OutputHashesBefore) > 0 {
+		for _, s := range m.OutputHashesBefore {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.OutputHashesAfter) > 0 {
+		for _, s := range m.OutputHashesAfter {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRecordDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordTypeCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordTypeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordTypeDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnersAdded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnersAdded = append(m.OwnersAdded, Party{})
+			if err := m.OwnersAdded[len(m.OwnersAdded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnersRemoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnersRemoved = append(m.OwnersRemoved, Party{})
+			if err := m.OwnersRemoved[len(m.OwnersRemoved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccessAdded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccessAdded = append(m.DataAccessAdded, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccessRemoved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccessRemoved = append(m.DataAccessRemoved, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeLocked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeLocked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeLocked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeUnlocked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeUnlocked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeUnlocked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDataAccessExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDataAccessExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDataAccessExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValueOwnerTransferOffered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValueOwnerTransferOffered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValueOwnerTransferOffered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValueOwnerTransferAccepted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValueOwnerTransferAccepted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValueOwnerTransferAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventOSLocatorDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+func (m *EventValueOwnerTransferCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2361,15 +5395,15 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValueOwnerTransferCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValueOwnerTransferCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2397,11 +5431,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2429,11 +5463,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2461,7 +5495,39 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2484,7 +5550,7 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeTokenized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2503,19 +5569,83 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeTokenized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeTokenized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2543,7 +5673,7 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2566,7 +5696,7 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventScopeDetokenized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2589,10 +5719,10 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScopeDetokenized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScopeDetokenized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2627,59 +5757,41 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2707,7 +5819,7 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2937,6 +6049,138 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartiesAdded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartiesAdded = append(m.PartiesAdded, Party{})
+			if err := m.PartiesAdded[len(m.PartiesAdded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartiesRemoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartiesRemoved = append(m.PartiesRemoved, Party{})
+			if err := m.PartiesRemoved[len(m.PartiesRemoved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextHashBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContextHashBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextHashAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContextHashAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3249,7 +6493,71 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3277,11 +6585,11 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputHashesBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3309,11 +6617,11 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.OutputHashesBefore = append(m.OutputHashesBefore, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputHashesAfter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3341,7 +6649,7 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.OutputHashesAfter = append(m.OutputHashesAfter, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4312,6 +7620,252 @@ func (m *EventRecordSpecificationDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRecordTypeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordTypeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordTypeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordTypeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordTypeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordTypeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordTypeDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordTypeDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordTypeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEventScopeUpdatedWithDiff(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	owner1 := Party{Address: "owner1", Role: PartyType_PARTY_TYPE_OWNER}
+	owner2 := Party{Address: "owner2", Role: PartyType_PARTY_TYPE_OWNER}
+	owner1Affiliate := Party{Address: "owner1", Role: PartyType_PARTY_TYPE_AFFILIATE}
+
+	tests := []struct {
+		name     string
+		oldScope *Scope
+		newScope *Scope
+		expected *EventScopeUpdated
+	}{
+		{
+			name:     "nothing changed",
+			oldScope: NewScope(scopeID, specID, []Party{owner1}, []string{"da1"}, "vo1"),
+			newScope: NewScope(scopeID, specID, []Party{owner1}, []string{"da1"}, "vo1"),
+			expected: &EventScopeUpdated{ScopeAddr: scopeID.String()},
+		},
+		{
+			name:     "owner added and role changed",
+			oldScope: NewScope(scopeID, specID, []Party{owner1}, nil, ""),
+			newScope: NewScope(scopeID, specID, []Party{owner1Affiliate, owner2}, nil, ""),
+			expected: &EventScopeUpdated{
+				ScopeAddr:     scopeID.String(),
+				OwnersAdded:   []Party{owner1Affiliate, owner2},
+				OwnersRemoved: []Party{owner1},
+			},
+		},
+		{
+			name:     "data access changed",
+			oldScope: NewScope(scopeID, specID, []Party{owner1}, []string{"da1", "da2"}, ""),
+			newScope: NewScope(scopeID, specID, []Party{owner1}, []string{"da2", "da3"}, ""),
+			expected: &EventScopeUpdated{
+				ScopeAddr:         scopeID.String(),
+				DataAccessAdded:   []string{"da3"},
+				DataAccessRemoved: []string{"da1"},
+			},
+		},
+		{
+			name:     "value owner changed",
+			oldScope: NewScope(scopeID, specID, []Party{owner1}, nil, "vo1"),
+			newScope: NewScope(scopeID, specID, []Party{owner1}, nil, "vo2"),
+			expected: &EventScopeUpdated{
+				ScopeAddr:        scopeID.String(),
+				ValueOwnerBefore: "vo1",
+				ValueOwnerAfter:  "vo2",
+			},
+		},
+		{
+			name:     "value owner cleared",
+			oldScope: NewScope(scopeID, specID, []Party{owner1}, nil, "vo1"),
+			newScope: NewScope(scopeID, specID, []Party{owner1}, nil, ""),
+			expected: &EventScopeUpdated{
+				ScopeAddr:        scopeID.String(),
+				ValueOwnerBefore: "vo1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := NewEventScopeUpdatedWithDiff(*tc.oldScope, *tc.newScope)
+			assert.Equal(t, tc.expected, actual, "NewEventScopeUpdatedWithDiff")
+		})
+	}
+}
+
+func TestNewEventSessionUpdatedWithDiff(t *testing.T) {
+	sessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	party1 := Party{Address: "party1", Role: PartyType_PARTY_TYPE_ORIGINATOR}
+	party2 := Party{Address: "party2", Role: PartyType_PARTY_TYPE_SERVICER}
+	newSession := func(context []byte, parties ...Party) Session {
+		session := NewSession("name", sessionID, contractSpecID, parties, nil)
+		session.Context = context
+		return *session
+	}
+
+	t.Run("nothing changed", func(t *testing.T) {
+		actual := NewEventSessionUpdatedWithDiff(newSession([]byte("context"), party1), newSession([]byte("context"), party1))
+		expected := &EventSessionUpdated{
+			SessionAddr: sessionID.String(),
+			ScopeAddr:   sessionID.MustGetAsScopeAddress().String(),
+		}
+		assert.Equal(t, expected, actual, "NewEventSessionUpdatedWithDiff")
+	})
+
+	t.Run("parties and context changed", func(t *testing.T) {
+		context := []byte(strings.Repeat("a large context ", 1000))
+		actual := NewEventSessionUpdatedWithDiff(newSession(nil, party1), newSession(context, party2))
+		assert.Equal(t, []Party{party2}, actual.PartiesAdded, "PartiesAdded")
+		assert.Equal(t, []Party{party1}, actual.PartiesRemoved, "PartiesRemoved")
+		assert.Equal(t, "", actual.ContextHashBefore, "ContextHashBefore")
+		assert.Equal(t, sha256Hex(context), actual.ContextHashAfter, "ContextHashAfter")
+		assert.Len(t, actual.ContextHashAfter, 64, "ContextHashAfter length")
+	})
+}
+
+func TestNewEventRecordUpdatedWithDiff(t *testing.T) {
+	sessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+	recordID := sessionID.MustGetAsRecordAddress("record")
+	newRecord := func(hashes ...string) Record {
+		outputs := make([]RecordOutput, len(hashes))
+		for i, hash := range hashes {
+			outputs[i] = *NewRecordOutput(hash, ResultStatus_RESULT_STATUS_PASS)
+		}
+		return *NewRecord("record", sessionID, *NewProcess("process", &Process_Hash{Hash: "processhash"}, "method"), nil, outputs, nil)
+	}
+	longHash := strings.Repeat("h", maxEventHashLength+1)
+
+	tests := []struct {
+		name      string
+		oldRecord Record
+		newRecord Record
+		before    []string
+		after     []string
+	}{
+		{"same outputs", newRecord("hash1"), newRecord("hash1"), nil, nil},
+		{"output changed", newRecord("hash1"), newRecord("hash2"), []string{"hash1"}, []string{"hash2"}},
+		{"output added", newRecord("hash1"), newRecord("hash1", "hash2"), []string{"hash1"}, []string{"hash1", "hash2"}},
+		{"outputs reordered", newRecord("hash1", "hash2"), newRecord("hash2", "hash1"), []string{"hash1", "hash2"}, []string{"hash2", "hash1"}},
+		{"outputs removed", newRecord("hash1"), newRecord(), []string{"hash1"}, nil},
+		{
+			name:      "long hash is hashed",
+			oldRecord: newRecord("hash1"),
+			newRecord: newRecord(longHash),
+			before:    []string{"hash1"},
+			after:     []string{"sha256:" + sha256Hex([]byte(longHash))},
+		},
+		{
+			name:      "hash at max length is not hashed",
+			oldRecord: newRecord("hash1"),
+			newRecord: newRecord(longHash[1:]),
+			before:    []string{"hash1"},
+			after:     []string{longHash[1:]},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := NewEventRecordUpdatedWithDiff(tc.oldRecord, tc.newRecord)
+			assert.Equal(t, recordID.String(), actual.RecordAddr, "RecordAddr")
+			assert.Equal(t, sessionID.String(), actual.SessionAddr, "SessionAddr")
+			assert.Equal(t, recordID.MustGetAsScopeAddress().String(), actual.ScopeAddr, "ScopeAddr")
+			assert.Equal(t, tc.before, actual.OutputHashesBefore, "OutputHashesBefore")
+			assert.Equal(t, tc.after, actual.OutputHashesAfter, "OutputHashesAfter")
+		})
+	}
+}
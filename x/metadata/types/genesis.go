@@ -1,7 +1,15 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	for i, entry := range state.HistoryEntries {
+		if entry.Sequence == 0 || entry.Sequence > state.HistorySequence {
+			return fmt.Errorf("history entry %d sequence %d is not between 1 and the history sequence %d",
+				i, entry.Sequence, state.HistorySequence)
+		}
+	}
 	return nil
 }
 
@@ -16,6 +24,14 @@ func NewGenesisState(
 	contracSpecs []ContractSpecification,
 	recordSpecs []RecordSpecification,
 	objectStoreLocators []ObjectStoreLocator,
+	scopeLocks []ScopeLock,
+	recordTypes []RecordType,
+	missingResponsibleParties []MissingResponsibleParties,
+	dataAccessGrants []DataAccessGrant,
+	valueOwnerTransferOffers []ValueOwnerTransferOffer,
+	historyEntries []HistoryEntry,
+	historySequence uint64,
+	historyFloor *HistoryFloor,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                 params,
@@ -27,6 +43,16 @@ func NewGenesisState(
 		ContractSpecifications: contracSpecs,
 		RecordSpecifications:   recordSpecs,
 		ObjectStoreLocators:    objectStoreLocators,
+		ScopeLocks:             scopeLocks,
+		RecordTypes:            recordTypes,
+
+		MissingResponsibleParties: missingResponsibleParties,
+		DataAccessGrants:          dataAccessGrants,
+		ValueOwnerTransferOffers:  valueOwnerTransferOffers,
+
+		HistoryEntries:  historyEntries,
+		HistorySequence: historySequence,
+		HistoryFloor:    historyFloor,
 	}
 }
 
@@ -28,14 +28,26 @@ type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A collection of metadata scopes and specs to create on start
-	Scopes                 []Scope                 `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes"`
-	Sessions               []Session               `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions"`
-	Records                []Record                `protobuf:"bytes,4,rep,name=records,proto3" json:"records"`
-	ScopeSpecifications    []ScopeSpecification    `protobuf:"bytes,5,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications"`
-	ContractSpecifications []ContractSpecification `protobuf:"bytes,6,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications"`
-	RecordSpecifications   []RecordSpecification   `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
-	OSLocatorParams        OSLocatorParams         `protobuf:"bytes,8,opt,name=o_s_locator_params,json=oSLocatorParams,proto3" json:"o_s_locator_params"`
-	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
+	Scopes                    []Scope                     `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes"`
+	Sessions                  []Session                   `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions"`
+	Records                   []Record                    `protobuf:"bytes,4,rep,name=records,proto3" json:"records"`
+	ScopeSpecifications       []ScopeSpecification        `protobuf:"bytes,5,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications"`
+	ContractSpecifications    []ContractSpecification     `protobuf:"bytes,6,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications"`
+	RecordSpecifications      []RecordSpecification       `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
+	OSLocatorParams           OSLocatorParams             `protobuf:"bytes,8,opt,name=o_s_locator_params,json=oSLocatorParams,proto3" json:"o_s_locator_params"`
+	ObjectStoreLocators       []ObjectStoreLocator        `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
+	ScopeLocks                []ScopeLock                 `protobuf:"bytes,10,rep,name=scope_locks,json=scopeLocks,proto3" json:"scope_locks"`
+	RecordTypes               []RecordType                `protobuf:"bytes,11,rep,name=record_types,json=recordTypes,proto3" json:"record_types"`
+	MissingResponsibleParties []MissingResponsibleParties `protobuf:"bytes,12,rep,name=missing_responsible_parties,json=missingResponsibleParties,proto3" json:"missing_responsible_parties"`
+	DataAccessGrants          []DataAccessGrant           `protobuf:"bytes,13,rep,name=data_access_grants,json=dataAccessGrants,proto3" json:"data_access_grants"`
+	ValueOwnerTransferOffers  []ValueOwnerTransferOffer   `protobuf:"bytes,14,rep,name=value_owner_transfer_offers,json=valueOwnerTransferOffers,proto3" json:"value_owner_transfer_offers"`
+	// history_entries are the recorded changes to scopes, sessions, and records.
+	HistoryEntries []HistoryEntry `protobuf:"bytes,15,rep,name=history_entries,json=historyEntries,proto3" json:"history_entries"`
+	// history_sequence is the last history entry sequence number used.
+	HistorySequence uint64 `protobuf:"varint,16,opt,name=history_sequence,json=historySequence,proto3" json:"history_sequence,omitempty"`
+	// history_floor is the lowest block height that a scope's records can be reconstructed at.
+	// It is not set if history is not being recorded.
+	HistoryFloor *HistoryFloor `protobuf:"bytes,17,opt,name=history_floor,json=historyFloor,proto3" json:"history_floor,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,37 +92,53 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0x77, 0x69, 0xd9, 0x06, 0x17, 0x09, 0xc9, 0xa4, 0x65, 0xa9, 0xc4, 0xa6, 0xaa, 0x40,
-	0x44, 0x45, 0xdd, 0x55, 0x0b, 0x27, 0x40, 0x48, 0x94, 0x03, 0x17, 0xa4, 0x56, 0xdd, 0x5b, 0x2f,
-	0x2b, 0xc7, 0x71, 0x83, 0xa1, 0xd9, 0x59, 0x79, 0x4c, 0x04, 0x6f, 0xc0, 0x11, 0x89, 0x17, 0xe8,
-	0xe3, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x0b, 0x8f, 0x51, 0xc5, 0xf6, 0x36, 0xcd, 0x1f, 0xe7,
-	0x96, 0xec, 0xfc, 0xbe, 0xef, 0x9b, 0xb1, 0xc7, 0xe4, 0x69, 0xa5, 0x60, 0x20, 0x4a, 0x56, 0x72,
-	0x91, 0xf5, 0x85, 0x66, 0x5d, 0xa6, 0x59, 0x36, 0xd8, 0xcf, 0x7a, 0xa2, 0x14, 0x28, 0x31, 0xad,
-	0x14, 0x68, 0xa0, 0x9b, 0x13, 0x2a, 0xad, 0xa9, 0x74, 0xb0, 0xbf, 0xd5, 0xec, 0x41, 0x0f, 0x0c,
-	0x92, 0x8d, 0x7f, 0x59, 0x7a, 0xeb, 0x99, 0xc7, 0xf3, 0x46, 0x69, 0xb1, 0x1d, 0x0f, 0x86, 0x1c,
-	0x2a, 0xe1, 0x98, 0x5d, 0x1f, 0x53, 0x09, 0x2e, 0xcf, 0x24, 0x67, 0x5a, 0x42, 0xe9, 0xd8, 0xb6,
-	0x87, 0x85, 0xce, 0x17, 0xc1, 0x35, 0x6a, 0x50, 0xce, 0x75, 0xe7, 0x77, 0x44, 0xee, 0x7f, 0xb4,
-	0x03, 0xe6, 0x9a, 0x69, 0x41, 0xdf, 0x92, 0xa8, 0x62, 0x8a, 0xf5, 0x31, 0x0e, 0xb7, 0xc3, 0xf6,
-	0xfa, 0x41, 0x92, 0x2e, 0x1e, 0x38, 0x3d, 0x36, 0xd4, 0xe1, 0xea, 0xe5, 0xdf, 0x56, 0x70, 0xe2,
-	0x34, 0xf4, 0x0d, 0x89, 0x4c, 0xcf, 0x18, 0xdf, 0xd9, 0x5e, 0x69, 0xaf, 0x1f, 0x3c, 0xf1, 0xa9,
-	0xf3, 0x31, 0x55, 0x8b, 0xad, 0x84, 0xbe, 0x27, 0x0d, 0x14, 0x88, 0x12, 0x4a, 0x8c, 0x57, 0x8c,
-	0xbc, 0xe5, 0x95, 0x5b, 0xce, 0x19, 0xdc, 0xc8, 0xe8, 0x3b, 0xb2, 0xa6, 0x04, 0x07, 0xd5, 0xc5,
-	0x78, 0xd5, 0x38, 0x78, 0xdb, 0x3f, 0x31, 0x98, 0x33, 0xa8, 0x45, 0x94, 0x93, 0xa6, 0x69, 0xa6,
-	0x98, 0x3a, 0x55, 0x8c, 0xef, 0x1a, 0xb3, 0xdd, 0xa5, 0xd3, 0xe4, 0xb7, 0x25, 0xce, 0xf8, 0x21,
-	0xce, 0x55, 0x90, 0x9e, 0x93, 0x47, 0x1c, 0x4a, 0xad, 0x18, 0xd7, 0xb3, 0x39, 0x91, 0xc9, 0xd9,
-	0xf3, 0xe5, 0x7c, 0x70, 0xb2, 0x45, 0x51, 0x9b, 0x7c, 0x51, 0x11, 0xe9, 0x19, 0xd9, 0xb0, 0xd3,
-	0xcd, 0x66, 0xad, 0x99, 0xac, 0x17, 0xcb, 0x0f, 0x68, 0x51, 0x52, 0x53, 0xcd, 0x97, 0x90, 0x9e,
-	0x12, 0x0a, 0x05, 0x16, 0xe7, 0xc0, 0x99, 0x06, 0x55, 0xb8, 0x25, 0x6a, 0x98, 0x25, 0x7a, 0xee,
-	0x0b, 0x39, 0xca, 0x3f, 0x59, 0x7e, 0x6a, 0x9b, 0x1e, 0xc0, 0xf4, 0x67, 0xda, 0x25, 0x1b, 0x76,
-	0x75, 0x0b, 0xb3, 0xbb, 0x75, 0x08, 0xc6, 0xf7, 0x96, 0xdf, 0xcb, 0x91, 0x11, 0xe5, 0x63, 0x8d,
-	0x33, 0xac, 0xef, 0x05, 0xe6, 0x2a, 0xf8, 0xba, 0xf1, 0xf3, 0xa2, 0x15, 0xfc, 0xbf, 0x68, 0x05,
-	0x87, 0x5f, 0x2f, 0x87, 0x49, 0x78, 0x35, 0x4c, 0xc2, 0x7f, 0xc3, 0x24, 0xfc, 0x35, 0x4a, 0x82,
-	0xab, 0x51, 0x12, 0xfc, 0x19, 0x25, 0x01, 0x79, 0x2c, 0xc1, 0x13, 0x76, 0x1c, 0x9e, 0xbe, 0xea,
-	0x49, 0xfd, 0xf9, 0x5b, 0x27, 0xe5, 0xd0, 0xcf, 0x26, 0xd0, 0x9e, 0x84, 0x5b, 0xff, 0xb2, 0xef,
-	0x93, 0x17, 0xa9, 0x7f, 0x54, 0x02, 0x3b, 0x91, 0x79, 0x89, 0x2f, 0xaf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x3a, 0xcc, 0x7d, 0x59, 0x80, 0x04, 0x00, 0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x4e, 0x1b, 0x3b,
+	0x14, 0xc6, 0x33, 0x37, 0xdc, 0xc0, 0x75, 0xc2, 0x9f, 0xeb, 0x0b, 0xdc, 0x01, 0xd4, 0x24, 0x45,
+	0x54, 0x4d, 0xa9, 0x48, 0x04, 0xed, 0xaa, 0xad, 0x2a, 0x41, 0xff, 0x40, 0x55, 0xaa, 0xa0, 0x04,
+	0x75, 0x41, 0x17, 0x23, 0xc7, 0x71, 0x92, 0x29, 0xc9, 0x38, 0xf5, 0x31, 0xa1, 0x79, 0x83, 0x2e,
+	0xfb, 0x08, 0xbc, 0x4a, 0x77, 0x2c, 0x59, 0x76, 0x55, 0x55, 0xb0, 0xe9, 0x63, 0x54, 0xf6, 0x78,
+	0x12, 0x02, 0xf1, 0xa8, 0xbb, 0xcc, 0xf1, 0xef, 0xfb, 0x3e, 0xcf, 0xf1, 0x99, 0x18, 0xad, 0x75,
+	0x05, 0xef, 0xb1, 0x80, 0x04, 0x94, 0x95, 0x3a, 0x4c, 0x92, 0x3a, 0x91, 0xa4, 0xd4, 0xdb, 0x2c,
+	0x35, 0x59, 0xc0, 0xc0, 0x87, 0x62, 0x57, 0x70, 0xc9, 0xf1, 0xe2, 0x90, 0x2a, 0x46, 0x54, 0xb1,
+	0xb7, 0xb9, 0x3c, 0xdf, 0xe4, 0x4d, 0xae, 0x91, 0x92, 0xfa, 0x15, 0xd2, 0xcb, 0x36, 0xcf, 0x96,
+	0x0f, 0x92, 0x8b, 0xbe, 0xa1, 0xee, 0x59, 0xa8, 0x81, 0x7f, 0x88, 0xad, 0x5a, 0x30, 0xa0, 0xbc,
+	0xcb, 0x0c, 0xb3, 0x6e, 0x63, 0xba, 0x8c, 0xfa, 0x0d, 0x9f, 0x12, 0xe9, 0xf3, 0xc0, 0xb0, 0x05,
+	0x0b, 0xcb, 0x6b, 0x1f, 0x19, 0x95, 0x6a, 0x83, 0xc6, 0x75, 0xf5, 0x5b, 0x1a, 0x65, 0x76, 0xc3,
+	0x36, 0x54, 0x25, 0x91, 0x0c, 0x3f, 0x43, 0xa9, 0x2e, 0x11, 0xa4, 0x03, 0xae, 0x93, 0x77, 0x0a,
+	0xe9, 0xad, 0x6c, 0x71, 0x7c, 0x5b, 0x8a, 0x07, 0x9a, 0xda, 0x99, 0x38, 0xff, 0x91, 0x4b, 0x54,
+	0x8c, 0x06, 0x3f, 0x45, 0x29, 0xbd, 0x67, 0x70, 0xff, 0xca, 0x27, 0x0b, 0xe9, 0xad, 0x3b, 0x36,
+	0x75, 0x55, 0x51, 0x91, 0x38, 0x94, 0xe0, 0x6d, 0x34, 0x05, 0x0c, 0xc0, 0xe7, 0x01, 0xb8, 0x49,
+	0x2d, 0xcf, 0x59, 0xe5, 0x21, 0x67, 0x0c, 0x06, 0x32, 0xfc, 0x1c, 0x4d, 0x0a, 0x46, 0xb9, 0xa8,
+	0x83, 0x3b, 0x91, 0x4f, 0xc6, 0x6d, 0xbf, 0xa2, 0x31, 0x63, 0x10, 0x89, 0x30, 0x45, 0xf3, 0x7a,
+	0x33, 0xde, 0x48, 0x57, 0xc1, 0xfd, 0x5b, 0x9b, 0xad, 0xc7, 0xbe, 0x4d, 0xf5, 0xba, 0xc4, 0x18,
+	0xff, 0x07, 0xb7, 0x56, 0x00, 0xb7, 0xd1, 0xff, 0x94, 0x07, 0x52, 0x10, 0x2a, 0x6f, 0xe6, 0xa4,
+	0x74, 0xce, 0x86, 0x2d, 0xe7, 0x85, 0x91, 0x8d, 0x8b, 0x5a, 0xa4, 0xe3, 0x16, 0x01, 0x37, 0xd0,
+	0x42, 0xf8, 0x76, 0x37, 0xb3, 0x26, 0x75, 0xd6, 0xc3, 0xf8, 0x06, 0x8d, 0x4b, 0x9a, 0x17, 0xb7,
+	0x97, 0x00, 0x1f, 0x21, 0xcc, 0x3d, 0xf0, 0xda, 0x9c, 0x12, 0xc9, 0x85, 0x67, 0x86, 0x68, 0x4a,
+	0x0f, 0xd1, 0x7d, 0x5b, 0x48, 0xb9, 0xba, 0x1f, 0xf2, 0x23, 0xd3, 0x34, 0xcb, 0x47, 0xcb, 0xb8,
+	0x8e, 0x16, 0xc2, 0xd1, 0xf5, 0xf4, 0xec, 0x46, 0x21, 0xe0, 0xfe, 0x13, 0x7f, 0x2e, 0x65, 0x2d,
+	0xaa, 0x2a, 0x8d, 0x31, 0x8c, 0xce, 0x85, 0xdf, 0x5a, 0x01, 0xbc, 0x87, 0xd2, 0xe1, 0xe1, 0xb7,
+	0x39, 0x3d, 0x06, 0x17, 0x69, 0xef, 0xbb, 0xb1, 0x67, 0xbe, 0xcf, 0xe9, 0xb1, 0xb1, 0x44, 0x10,
+	0x15, 0x00, 0xbf, 0x45, 0x19, 0xd3, 0x73, 0xd9, 0x57, 0x1f, 0x43, 0x5a, 0x5b, 0xad, 0xc6, 0xb7,
+	0xfa, 0xb0, 0x3f, 0xf8, 0x22, 0xd2, 0x62, 0x50, 0x01, 0x7c, 0x8a, 0x56, 0x3a, 0x3e, 0x80, 0x1f,
+	0x34, 0x3d, 0xc1, 0xa0, 0xcb, 0x03, 0xf0, 0x6b, 0x6d, 0xa6, 0x1a, 0x2c, 0x7d, 0x06, 0x6e, 0x46,
+	0x7b, 0x6f, 0xda, 0xbc, 0xdf, 0x85, 0xd2, 0xca, 0x50, 0x79, 0x10, 0x0a, 0x4d, 0xd4, 0x52, 0xc7,
+	0x06, 0xe0, 0x0f, 0x08, 0x2b, 0x17, 0x8f, 0x50, 0xca, 0x00, 0xbc, 0xa6, 0x20, 0x81, 0x04, 0x77,
+	0x3a, 0x9f, 0x8c, 0x3b, 0xd1, 0x97, 0x44, 0x92, 0x6d, 0x2d, 0xd8, 0x55, 0xbc, 0x49, 0x99, 0xab,
+	0x8f, 0x96, 0x01, 0x4b, 0xb4, 0xd2, 0x23, 0xed, 0x13, 0xe6, 0xf1, 0xd3, 0x80, 0x09, 0x4f, 0x0a,
+	0x12, 0x40, 0x83, 0x09, 0x8f, 0x37, 0x1a, 0x4c, 0x80, 0x3b, 0xa3, 0x53, 0x4a, 0xb6, 0x94, 0xf7,
+	0x4a, 0x5a, 0x56, 0xca, 0x43, 0x23, 0x2c, 0x2b, 0x9d, 0x49, 0x73, 0x7b, 0xe3, 0x97, 0x01, 0x57,
+	0xd1, 0xac, 0xf9, 0x83, 0xf6, 0x58, 0x20, 0x85, 0xea, 0xdf, 0xac, 0x4e, 0x5a, 0xb3, 0x25, 0xed,
+	0x85, 0xf8, 0xab, 0x40, 0x8a, 0xbe, 0xb1, 0x9f, 0x69, 0x0d, 0x6b, 0xaa, 0x4f, 0x0f, 0xd0, 0x5c,
+	0x64, 0x0a, 0xec, 0xd3, 0x09, 0x0b, 0x28, 0x73, 0xe7, 0xf2, 0x4e, 0x61, 0xa2, 0x12, 0x85, 0x55,
+	0x4d, 0x19, 0xbf, 0x41, 0xd3, 0x11, 0xda, 0x68, 0x73, 0x2e, 0xdc, 0x7f, 0xf3, 0xce, 0x1f, 0xa4,
+	0xbf, 0x56, 0x6c, 0x25, 0xd3, 0xba, 0xf6, 0xf4, 0x64, 0xea, 0xcb, 0x59, 0x2e, 0xf1, 0xeb, 0x2c,
+	0x97, 0xd8, 0x39, 0x3e, 0xbf, 0xcc, 0x3a, 0x17, 0x97, 0x59, 0xe7, 0xe7, 0x65, 0xd6, 0xf9, 0x7a,
+	0x95, 0x4d, 0x5c, 0x5c, 0x65, 0x13, 0xdf, 0xaf, 0xb2, 0x09, 0xb4, 0xe4, 0x73, 0x8b, 0xf3, 0x81,
+	0x73, 0xf4, 0xb8, 0xe9, 0xcb, 0xd6, 0x49, 0xad, 0x48, 0x79, 0xa7, 0x34, 0x84, 0x36, 0x7c, 0x7e,
+	0xed, 0xa9, 0xf4, 0x79, 0x78, 0x7f, 0xe8, 0x51, 0xae, 0xa5, 0xf4, 0xbd, 0xf1, 0xe8, 0xf7, 0x00,
+	0x96, 0x14, 0x63, 0x86, 0x54, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +161,111 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryFloor != nil {
+		{
+			size, err := m.HistoryFloor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.HistorySequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistorySequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.HistoryEntries) > 0 {
+		for iNdEx := len(m.HistoryEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ValueOwnerTransferOffers) > 0 {
+		for iNdEx := len(m.ValueOwnerTransferOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValueOwnerTransferOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DataAccessGrants) > 0 {
+		for iNdEx := len(m.DataAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.MissingResponsibleParties) > 0 {
+		for iNdEx := len(m.MissingResponsibleParties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissingResponsibleParties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for iNdEx := len(m.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ScopeLocks) > 0 {
+		for iNdEx := len(m.ScopeLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ObjectStoreLocators) > 0 {
 		for iNdEx := len(m.ObjectStoreLocators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -317,6 +450,49 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeLocks) > 0 {
+		for _, e := range m.ScopeLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, e := range m.RecordTypes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissingResponsibleParties) > 0 {
+		for _, e := range m.MissingResponsibleParties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataAccessGrants) > 0 {
+		for _, e := range m.DataAccessGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValueOwnerTransferOffers) > 0 {
+		for _, e := range m.ValueOwnerTransferOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HistoryEntries) > 0 {
+		for _, e := range m.HistoryEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HistorySequence != 0 {
+		n += 2 + sovGenesis(uint64(m.HistorySequence))
+	}
+	if m.HistoryFloor != nil {
+		l = m.HistoryFloor.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -659,6 +835,265 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeLocks = append(m.ScopeLocks, ScopeLock{})
+			if err := m.ScopeLocks[len(m.ScopeLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, RecordType{})
+			if err := m.RecordTypes[len(m.RecordTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingResponsibleParties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingResponsibleParties = append(m.MissingResponsibleParties, MissingResponsibleParties{})
+			if err := m.MissingResponsibleParties[len(m.MissingResponsibleParties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccessGrants = append(m.DataAccessGrants, DataAccessGrant{})
+			if err := m.DataAccessGrants[len(m.DataAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerTransferOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerTransferOffers = append(m.ValueOwnerTransferOffers, ValueOwnerTransferOffer{})
+			if err := m.ValueOwnerTransferOffers[len(m.ValueOwnerTransferOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryEntries = append(m.HistoryEntries, HistoryEntry{})
+			if err := m.HistoryEntries[len(m.HistoryEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySequence", wireType)
+			}
+			m.HistorySequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryFloor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistoryFloor == nil {
+				m.HistoryFloor = &HistoryFloor{}
+			}
+			if err := m.HistoryFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,920 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/metadata/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HistoryAction is the type of change that caused a history entry to be recorded.
+type HistoryAction int32
+
+const (
+	// HISTORY_ACTION_UNSPECIFIED indicates an unknown/invalid action.
+	HistoryAction_Unspecified HistoryAction = 0
+	// HISTORY_ACTION_CREATED indicates the object did not previously exist.
+	HistoryAction_Created HistoryAction = 1
+	// HISTORY_ACTION_UPDATED indicates an existing object was overwritten.
+	HistoryAction_Updated HistoryAction = 2
+	// HISTORY_ACTION_DELETED indicates an existing object was removed.
+	HistoryAction_Deleted HistoryAction = 3
+)
+
+var HistoryAction_name = map[int32]string{
+	0: "HISTORY_ACTION_UNSPECIFIED",
+	1: "HISTORY_ACTION_CREATED",
+	2: "HISTORY_ACTION_UPDATED",
+	3: "HISTORY_ACTION_DELETED",
+}
+
+var HistoryAction_value = map[string]int32{
+	"HISTORY_ACTION_UNSPECIFIED": 0,
+	"HISTORY_ACTION_CREATED":     1,
+	"HISTORY_ACTION_UPDATED":     2,
+	"HISTORY_ACTION_DELETED":     3,
+}
+
+func (x HistoryAction) String() string {
+	return proto.EnumName(HistoryAction_name, int32(x))
+}
+
+func (HistoryAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4f84675d79b956fd, []int{0}
+}
+
+// HistoryEntry is a compact record of a single change made to a scope, or to a session or record in a scope.
+type HistoryEntry struct {
+	// scope_id is the id of the scope that the changed object belongs to.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// object_id is the id of the scope, session, or record that was changed.
+	ObjectId MetadataAddress `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3,customtype=MetadataAddress" json:"object_id" yaml:"object_id"`
+	// action is the type of change that was made.
+	Action HistoryAction `protobuf:"varint,3,opt,name=action,proto3,enum=provenance.metadata.v1.HistoryAction" json:"action,omitempty"`
+	// sequence is a chain-wide, strictly increasing number identifying this entry.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// previous_value_hash is the sha256 hash of the encoded value that was replaced or removed.
+	// It is empty when the action is HISTORY_ACTION_CREATED.
+	PreviousValueHash []byte `protobuf:"bytes,5,opt,name=previous_value_hash,json=previousValueHash,proto3" json:"previous_value_hash,omitempty" yaml:"previous_value_hash"`
+	// signers are the bech32 address strings of the signers of the message that made this change (if known).
+	Signers []string `protobuf:"bytes,6,rep,name=signers,proto3" json:"signers,omitempty"`
+	// height is the block height at which this change was made.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which this change was made.
+	Time time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+	// previous_record is the record value that was replaced or removed.
+	// It is only populated for record updates and deletions, and allows a scope's records to be reconstructed.
+	PreviousRecord *Record `protobuf:"bytes,9,opt,name=previous_record,json=previousRecord,proto3" json:"previous_record,omitempty" yaml:"previous_record,omitempty"`
+}
+
+func (m *HistoryEntry) Reset()         { *m = HistoryEntry{} }
+func (m *HistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*HistoryEntry) ProtoMessage()    {}
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f84675d79b956fd, []int{0}
+}
+func (m *HistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryEntry.Merge(m, src)
+}
+func (m *HistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryEntry proto.InternalMessageInfo
+
+func (m *HistoryEntry) GetAction() HistoryAction {
+	if m != nil {
+		return m.Action
+	}
+	return HistoryAction_Unspecified
+}
+
+func (m *HistoryEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetPreviousValueHash() []byte {
+	if m != nil {
+		return m.PreviousValueHash
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *HistoryEntry) GetPreviousRecord() *Record {
+	if m != nil {
+		return m.PreviousRecord
+	}
+	return nil
+}
+
+// HistoryFloor is the lowest block height that a scope's records can be reconstructed at.
+type HistoryFloor struct {
+	// height is the block height of the floor.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *HistoryFloor) Reset()         { *m = HistoryFloor{} }
+func (m *HistoryFloor) String() string { return proto.CompactTextString(m) }
+func (*HistoryFloor) ProtoMessage()    {}
+func (*HistoryFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f84675d79b956fd, []int{1}
+}
+func (m *HistoryFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryFloor.Merge(m, src)
+}
+func (m *HistoryFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryFloor proto.InternalMessageInfo
+
+func (m *HistoryFloor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("provenance.metadata.v1.HistoryAction", HistoryAction_name, HistoryAction_value)
+	proto.RegisterType((*HistoryEntry)(nil), "provenance.metadata.v1.HistoryEntry")
+	proto.RegisterType((*HistoryFloor)(nil), "provenance.metadata.v1.HistoryFloor")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/history.proto", fileDescriptor_4f84675d79b956fd)
+}
+
+var fileDescriptor_4f84675d79b956fd = []byte{
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0x4d, 0x48, 0xd2, 0x69, 0x69, 0x8a, 0x41, 0x95, 0xf1, 0xc2, 0xb6, 0xac, 0x02,
+	0x51, 0x01, 0x5b, 0x2d, 0x2c, 0x10, 0x12, 0x8b, 0xfc, 0x71, 0x95, 0x48, 0xd0, 0x56, 0x6e, 0x82,
+	0x04, 0x9b, 0xc8, 0xb1, 0x5f, 0xed, 0x81, 0xd8, 0x63, 0x3c, 0x93, 0x88, 0x5c, 0xa1, 0xab, 0x5e,
+	0xa0, 0x4b, 0x4e, 0xc1, 0x05, 0xba, 0xec, 0x12, 0xb1, 0x08, 0xa8, 0xbd, 0x41, 0x4f, 0x80, 0x62,
+	0xc7, 0x69, 0xa9, 0x9a, 0xdd, 0xbc, 0x99, 0xdf, 0xf7, 0xe5, 0xbd, 0xf7, 0xc5, 0x78, 0x33, 0x8a,
+	0xe9, 0x08, 0x42, 0x3b, 0x74, 0xc0, 0x08, 0x80, 0xdb, 0xae, 0xcd, 0x6d, 0x63, 0xb4, 0x6d, 0xf8,
+	0x84, 0x71, 0x1a, 0x8f, 0xf5, 0x28, 0xa6, 0x9c, 0x0a, 0x1b, 0xd7, 0x94, 0x9e, 0x51, 0xfa, 0x68,
+	0x5b, 0x7a, 0xe4, 0x51, 0x8f, 0x26, 0x88, 0x31, 0x3d, 0xa5, 0xb4, 0xa4, 0x78, 0x94, 0x7a, 0x03,
+	0x30, 0x92, 0xaa, 0x3f, 0x3c, 0x32, 0x38, 0x09, 0x80, 0x71, 0x3b, 0x88, 0x66, 0x80, 0xb6, 0xe0,
+	0x47, 0x99, 0x43, 0x23, 0x48, 0x19, 0xed, 0x47, 0x01, 0xaf, 0xb6, 0xd2, 0x26, 0xcc, 0x90, 0xc7,
+	0x63, 0xc1, 0xc4, 0xe5, 0xe4, 0xbd, 0x47, 0x5c, 0x11, 0xa9, 0xa8, 0xba, 0x5a, 0xdf, 0x3a, 0x9b,
+	0x28, 0xb9, 0xdf, 0x13, 0xa5, 0xf2, 0x61, 0xe6, 0x51, 0x73, 0xdd, 0x18, 0x18, 0xbb, 0x9a, 0x28,
+	0x95, 0xb1, 0x1d, 0x0c, 0xde, 0x6a, 0x99, 0x40, 0xb3, 0x4a, 0xc9, 0xb1, 0xed, 0x0a, 0x2d, 0xbc,
+	0x4c, 0xfb, 0x5f, 0xc0, 0xe1, 0x53, 0x9f, 0xa5, 0xc4, 0xe7, 0xf9, 0x62, 0x9f, 0xf5, 0xd4, 0x67,
+	0xae, 0xd0, 0xac, 0x72, 0x7a, 0x6e, 0xbb, 0xc2, 0x3b, 0x5c, 0xb4, 0x1d, 0x4e, 0x68, 0x28, 0xe6,
+	0x55, 0x54, 0x5d, 0xdb, 0x79, 0xa2, 0xdf, 0xbd, 0x25, 0x7d, 0x36, 0x46, 0x2d, 0x81, 0xad, 0x99,
+	0x48, 0x90, 0x70, 0x99, 0xc1, 0xb7, 0x21, 0x84, 0x0e, 0x88, 0x05, 0x15, 0x55, 0x0b, 0xd6, 0xbc,
+	0x16, 0xf6, 0xf0, 0xc3, 0x28, 0x86, 0x11, 0xa1, 0x43, 0xd6, 0x1b, 0xd9, 0x83, 0x21, 0xf4, 0x7c,
+	0x9b, 0xf9, 0xe2, 0xbd, 0xa4, 0x5d, 0xf9, 0x6a, 0xa2, 0x48, 0x69, 0x5f, 0x77, 0x40, 0x9a, 0xf5,
+	0x20, 0xbb, 0xfd, 0x38, 0xbd, 0x6c, 0xd9, 0xcc, 0x17, 0x44, 0x5c, 0x62, 0xc4, 0x0b, 0x21, 0x66,
+	0x62, 0x51, 0xcd, 0x57, 0x97, 0xad, 0xac, 0x14, 0x36, 0x70, 0xd1, 0x07, 0xe2, 0xf9, 0x5c, 0x2c,
+	0xa9, 0xa8, 0x9a, 0xb7, 0x66, 0x95, 0xf0, 0x06, 0x17, 0xa6, 0xa9, 0x89, 0x65, 0x15, 0x55, 0x57,
+	0x76, 0x24, 0x3d, 0x8d, 0x54, 0xcf, 0x22, 0xd5, 0x3b, 0x59, 0xa4, 0xf5, 0xf2, 0x74, 0x7b, 0x27,
+	0x7f, 0x14, 0x64, 0x25, 0x0a, 0x21, 0xc0, 0x95, 0x79, 0x5b, 0x31, 0x38, 0x34, 0x76, 0xc5, 0xe5,
+	0xc4, 0x44, 0x5e, 0xb4, 0x1f, 0x2b, 0xa1, 0xea, 0x9b, 0x57, 0x13, 0x45, 0xbd, 0x35, 0x57, 0x6a,
+	0xf0, 0x82, 0x06, 0x84, 0x43, 0x10, 0xf1, 0xb1, 0x66, 0xad, 0x65, 0x6f, 0xa9, 0x4a, 0x7b, 0x3a,
+	0xff, 0x9b, 0xec, 0x0e, 0x28, 0x8d, 0x6f, 0x0c, 0x84, 0x6e, 0x0e, 0xb4, 0xf5, 0x13, 0xe1, 0xfb,
+	0xff, 0x05, 0x21, 0x18, 0x58, 0x6a, 0xb5, 0x0f, 0x3b, 0xfb, 0xd6, 0xa7, 0x5e, 0xad, 0xd1, 0x69,
+	0xef, 0xef, 0xf5, 0xba, 0x7b, 0x87, 0x07, 0x66, 0xa3, 0xbd, 0xdb, 0x36, 0x9b, 0xeb, 0x39, 0xa9,
+	0x72, 0x7c, 0xaa, 0xae, 0x74, 0x43, 0x16, 0x81, 0x43, 0x8e, 0x08, 0xb8, 0xc2, 0x33, 0xbc, 0x71,
+	0x4b, 0xd0, 0xb0, 0xcc, 0x5a, 0xc7, 0x6c, 0xae, 0x23, 0x69, 0xe5, 0xf8, 0x54, 0x2d, 0x35, 0x62,
+	0xb0, 0xf9, 0x9d, 0x60, 0xf7, 0xa0, 0x99, 0x80, 0x4b, 0x29, 0xd8, 0x8d, 0xdc, 0x05, 0x60, 0xd3,
+	0x7c, 0x6f, 0x4e, 0xc1, 0x7c, 0x0a, 0x36, 0x61, 0x00, 0x1c, 0xdc, 0xfa, 0xd7, 0xb3, 0x0b, 0x19,
+	0x9d, 0x5f, 0xc8, 0xe8, 0xef, 0x85, 0x8c, 0x4e, 0x2e, 0xe5, 0xdc, 0xf9, 0xa5, 0x9c, 0xfb, 0x75,
+	0x29, 0xe7, 0xf0, 0x63, 0x42, 0x17, 0xec, 0xf5, 0x00, 0x7d, 0x7e, 0xed, 0x11, 0xee, 0x0f, 0xfb,
+	0xba, 0x43, 0x03, 0xe3, 0x1a, 0x7a, 0x49, 0xe8, 0x8d, 0xca, 0xf8, 0x7e, 0xfd, 0x0d, 0xf2, 0x71,
+	0x04, 0xac, 0x5f, 0x4c, 0x52, 0x7e, 0xf5, 0x6f, 0x00, 0x69, 0x5b, 0x9e, 0xeb, 0x1c, 0x04, 0x00,
+	0x00,
+}
+
+func (m *HistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousRecord != nil {
+		{
+			size, err := m.PreviousRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHistory(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintHistory(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PreviousValueHash) > 0 {
+		i -= len(m.PreviousValueHash)
+		copy(dAtA[i:], m.PreviousValueHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.PreviousValueHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Action != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	l = m.ObjectId.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.Action != 0 {
+		n += 1 + sovHistory(uint64(m.Action))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovHistory(uint64(m.Sequence))
+	}
+	l = len(m.PreviousValueHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHistory(uint64(l))
+	if m.PreviousRecord != nil {
+		l = m.PreviousRecord.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= HistoryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValueHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValueHash = append(m.PreviousValueHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousValueHash == nil {
+				m.PreviousValueHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousRecord == nil {
+				m.PreviousRecord = &Record{}
+			}
+			if err := m.PreviousRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -40,7 +43,7 @@ const (
 //
 // - 0x05<session_specification_key_bytes><record_spec_name_hash>: RecordSpecification
 //
-// - 0x21<owner_address>: ObjectStoreLocator
+// - 0x21<owner_address><locator_name>: ObjectStoreLocator
 //
 // These keys are used for indexing and more specific iteration.
 // These keys are handled using the stuff in this file.
@@ -58,6 +61,45 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x27<data_access_address><scope_id>: 0x01
+//
+// - 0x28<contract_spec_id><session_id>: 0x01
+//
+// - 0x29<record_spec_id><record_id>: 0x01
+//
+// - 0x2A<spec_id>: <number of sessions or records using the spec as an 8 byte big-endian number>
+//
+// - 0x2B<previous_version_spec_id><spec_id>: 0x01
+//
+// - 0x2C<locator_uri_hash><owner_address><locator_name>: 0x01
+//
+// These keys are used to store the history of changes to scopes, sessions, and records.
+// The "..._sequence" and "..._height" parts are 8 byte big-endian numbers.
+//
+// - 0x22<scope_id><history_sequence>: HistoryEntry
+//
+// - 0x23<history_height><history_sequence>: <scope_id>
+//
+// - 0x24: <last history_sequence>
+//
+// - 0x25: <lowest height that records can be reconstructed at>
+//
+// - 0x26<scope_id>: ScopeLock
+//
+// - 0x2D<record_type_name>: RecordType
+//
+// - 0x2E<record_id>: MissingResponsibleParties
+//
+// - 0x2F<scope_id><address>: DataAccessGrant
+//
+// - 0x30<expiration_time><scope_id><address>: <data access grant key>
+//
+// - 0x31<scope_id><buyer_address>: ValueOwnerTransferOffer
+//
+// - 0x32<seller_or_buyer_address><scope_id><buyer_address>: 0x01
+//
+// - 0x33<expiration_time><scope_id><buyer_address>: <value owner transfer offer key>
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -88,6 +130,51 @@ var (
 
 	// OSLocatorAddressKeyPrefix is the key for OSLocator Record by address
 	OSLocatorAddressKeyPrefix = []byte{0x21}
+
+	// HistoryEntryKeyPrefix is the key for history entries by scope
+	HistoryEntryKeyPrefix = []byte{0x22}
+	// HistoryHeightCacheKeyPrefix for history entry lookup by block height
+	HistoryHeightCacheKeyPrefix = []byte{0x23}
+	// HistorySequenceKey is the key for the last history entry sequence number used
+	HistorySequenceKey = []byte{0x24}
+	// HistoryFloorKey is the key for the lowest block height that a scope's records can be reconstructed at
+	HistoryFloorKey = []byte{0x25}
+
+	// ScopeLockKeyPrefix is the key for scope locks by scope
+	ScopeLockKeyPrefix = []byte{0x26}
+
+	// DataAccessScopeCacheKeyPrefix for scope lookup by data access address
+	DataAccessScopeCacheKeyPrefix = []byte{0x27}
+
+	// ContractSpecSessionCacheKeyPrefix for session lookup by contract specification
+	ContractSpecSessionCacheKeyPrefix = []byte{0x28}
+	// RecordSpecRecordCacheKeyPrefix for record lookup by record specification
+	RecordSpecRecordCacheKeyPrefix = []byte{0x29}
+	// SpecUsageCountKeyPrefix is the key for the number of sessions or records using a specification
+	SpecUsageCountKeyPrefix = []byte{0x2A}
+	// SpecVersionCacheKeyPrefix for newer specification version lookup by previous version
+	SpecVersionCacheKeyPrefix = []byte{0x2B}
+
+	// OSLocatorURICacheKeyPrefix for OSLocator lookup by uri
+	OSLocatorURICacheKeyPrefix = []byte{0x2C}
+
+	// RecordTypeKeyPrefix is the key for registered record types by name
+	RecordTypeKeyPrefix = []byte{0x2D}
+
+	// MissingResponsiblePartiesKeyPrefix is the key for records written without their responsible parties
+	MissingResponsiblePartiesKeyPrefix = []byte{0x2E}
+
+	// DataAccessGrantKeyPrefix is the key for data access grants by scope and address
+	DataAccessGrantKeyPrefix = []byte{0x2F}
+	// DataAccessExpirationCacheKeyPrefix for data access grant lookup by expiration time
+	DataAccessExpirationCacheKeyPrefix = []byte{0x30}
+
+	// ValueOwnerTransferOfferKeyPrefix is the key for value owner transfer offers by scope and buyer
+	ValueOwnerTransferOfferKeyPrefix = []byte{0x31}
+	// AddressValueOwnerTransferOfferCacheKeyPrefix for value owner transfer offer lookup by seller or buyer address
+	AddressValueOwnerTransferOfferCacheKeyPrefix = []byte{0x32}
+	// ValueOwnerTransferExpirationCacheKeyPrefix for value owner transfer offer lookup by expiration time
+	ValueOwnerTransferExpirationCacheKeyPrefix = []byte{0x33}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -120,6 +207,51 @@ func GetValueOwnerScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []
 	return append(GetValueOwnerScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
 }
 
+// GetDataAccessScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries granting data access to a given address
+func GetDataAccessScopeCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(DataAccessScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetDataAccessScopeCacheKey returns the store key for a data access address + scope cache entry
+func GetDataAccessScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []byte {
+	return append(GetDataAccessScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
+}
+
+// GetContractSpecSessionCacheIteratorPrefix returns an iterator prefix for all session cache entries assigned to a given contract spec
+func GetContractSpecSessionCacheIteratorPrefix(contractSpecID MetadataAddress) []byte {
+	return append(ContractSpecSessionCacheKeyPrefix, contractSpecID.Bytes()...)
+}
+
+// GetContractSpecSessionCacheKey returns the store key for a contract spec + session cache entry
+func GetContractSpecSessionCacheKey(contractSpecID MetadataAddress, sessionID MetadataAddress) []byte {
+	return append(GetContractSpecSessionCacheIteratorPrefix(contractSpecID), sessionID.Bytes()...)
+}
+
+// GetRecordSpecRecordCacheIteratorPrefix returns an iterator prefix for all record cache entries assigned to a given record spec
+func GetRecordSpecRecordCacheIteratorPrefix(recordSpecID MetadataAddress) []byte {
+	return append(RecordSpecRecordCacheKeyPrefix, recordSpecID.Bytes()...)
+}
+
+// GetRecordSpecRecordCacheKey returns the store key for a record spec + record cache entry
+func GetRecordSpecRecordCacheKey(recordSpecID MetadataAddress, recordID MetadataAddress) []byte {
+	return append(GetRecordSpecRecordCacheIteratorPrefix(recordSpecID), recordID.Bytes()...)
+}
+
+// GetSpecUsageCountKey returns the store key for the usage count of a contract or record spec
+func GetSpecUsageCountKey(specID MetadataAddress) []byte {
+	return append(SpecUsageCountKeyPrefix, specID.Bytes()...)
+}
+
+// GetSpecVersionCacheIteratorPrefix returns an iterator prefix for the cache entries of the newer versions of a given spec
+func GetSpecVersionCacheIteratorPrefix(previousSpecID MetadataAddress) []byte {
+	return append(SpecVersionCacheKeyPrefix, previousSpecID.Bytes()...)
+}
+
+// GetSpecVersionCacheKey returns the store key for a previous spec version + spec cache entry
+func GetSpecVersionCacheKey(previousSpecID MetadataAddress, specID MetadataAddress) []byte {
+	return append(GetSpecVersionCacheIteratorPrefix(previousSpecID), specID.Bytes()...)
+}
+
 // GetAddressScopeSpecCacheIteratorPrefix returns an iterator prefix for all scope spec cache entries assigned to a given address
 func GetAddressScopeSpecCacheIteratorPrefix(addr sdk.AccAddress) []byte {
 	return append(AddressScopeSpecCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
@@ -150,7 +282,122 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 	return append(GetAddressContractSpecCacheIteratorPrefix(addr), contractSpecID.Bytes()...)
 }
 
-// GetOSLocatorKey returns a store key for an object store locator entry
-func GetOSLocatorKey(addr sdk.AccAddress) []byte {
+// GetOSLocatorIteratorPrefix returns an iterator prefix for all object store locator entries of a given owner
+func GetOSLocatorIteratorPrefix(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
+
+// GetOSLocatorKey returns a store key for an object store locator entry
+// An owner's unnamed locator is stored using only the owner address.
+func GetOSLocatorKey(addr sdk.AccAddress, name string) []byte {
+	return append(GetOSLocatorIteratorPrefix(addr), name...)
+}
+
+// GetOSLocatorURICacheIteratorPrefix returns an iterator prefix for all object store locator cache entries with a given uri
+func GetOSLocatorURICacheIteratorPrefix(uri string) []byte {
+	uriHash := sha256.Sum256([]byte(uri))
+	return append(OSLocatorURICacheKeyPrefix, uriHash[:]...)
+}
+
+// GetOSLocatorURICacheKey returns the store key for a uri + object store locator cache entry
+func GetOSLocatorURICacheKey(uri string, addr sdk.AccAddress, name string) []byte {
+	return append(GetOSLocatorURICacheIteratorPrefix(uri), GetOSLocatorKey(addr, name)[1:]...)
+}
+
+// GetHistoryEntryIteratorPrefix returns an iterator prefix for all history entries of a given scope
+func GetHistoryEntryIteratorPrefix(scopeID MetadataAddress) []byte {
+	return append(HistoryEntryKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetHistoryEntryKey returns the store key for a history entry
+func GetHistoryEntryKey(scopeID MetadataAddress, sequence uint64) []byte {
+	return append(GetHistoryEntryIteratorPrefix(scopeID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetHistoryHeightCacheIteratorPrefix returns an iterator prefix for all history height cache entries at a given height
+func GetHistoryHeightCacheIteratorPrefix(height int64) []byte {
+	return append(HistoryHeightCacheKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetHistoryHeightCacheKey returns the store key for a history height cache entry
+func GetHistoryHeightCacheKey(height int64, sequence uint64) []byte {
+	return append(GetHistoryHeightCacheIteratorPrefix(height), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetScopeLockKey returns the store key for the lock on a scope
+func GetScopeLockKey(scopeID MetadataAddress) []byte {
+	return append(ScopeLockKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetMissingResponsiblePartiesKey returns the store key for the missing responsible parties of a record
+func GetMissingResponsiblePartiesKey(recordID MetadataAddress) []byte {
+	return append(MissingResponsiblePartiesKeyPrefix, recordID.Bytes()...)
+}
+
+// GetMissingResponsiblePartiesIteratorPrefix returns an iterator prefix for the missing responsible parties
+// of all records in a scope
+func GetMissingResponsiblePartiesIteratorPrefix(scopeID MetadataAddress) ([]byte, error) {
+	recordPrefix, err := scopeID.ScopeRecordIteratorPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return append(MissingResponsiblePartiesKeyPrefix, recordPrefix...), nil
+}
+
+// GetDataAccessGrantIteratorPrefix returns an iterator prefix for all data access grants of a given scope
+func GetDataAccessGrantIteratorPrefix(scopeID MetadataAddress) []byte {
+	return append(DataAccessGrantKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetDataAccessGrantKey returns the store key for a scope + address data access grant
+func GetDataAccessGrantKey(scopeID MetadataAddress, addr sdk.AccAddress) []byte {
+	return append(GetDataAccessGrantIteratorPrefix(scopeID), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetDataAccessExpirationCacheIteratorPrefix returns an iterator prefix for all data access expiration cache entries
+// at a given time
+func GetDataAccessExpirationCacheIteratorPrefix(expiration time.Time) []byte {
+	return append(DataAccessExpirationCacheKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetDataAccessExpirationCacheKey returns the store key for an expiration time + data access grant cache entry
+func GetDataAccessExpirationCacheKey(expiration time.Time, scopeID MetadataAddress, addr sdk.AccAddress) []byte {
+	return append(GetDataAccessExpirationCacheIteratorPrefix(expiration), GetDataAccessGrantKey(scopeID, addr)[1:]...)
+}
+
+// GetRecordTypeKey returns the store key for a registered record type
+func GetRecordTypeKey(name string) []byte {
+	return append(RecordTypeKeyPrefix, []byte(name)...)
+}
+
+// GetValueOwnerTransferOfferIteratorPrefix returns an iterator prefix for all value owner transfer offers of a given scope
+func GetValueOwnerTransferOfferIteratorPrefix(scopeID MetadataAddress) []byte {
+	return append(ValueOwnerTransferOfferKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetValueOwnerTransferOfferKey returns the store key for a scope + buyer value owner transfer offer
+func GetValueOwnerTransferOfferKey(scopeID MetadataAddress, buyer sdk.AccAddress) []byte {
+	return append(GetValueOwnerTransferOfferIteratorPrefix(scopeID), address.MustLengthPrefix(buyer.Bytes())...)
+}
+
+// GetAddressValueOwnerTransferOfferCacheIteratorPrefix returns an iterator prefix for all value owner transfer offer
+// cache entries of a given seller or buyer
+func GetAddressValueOwnerTransferOfferCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(AddressValueOwnerTransferOfferCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetAddressValueOwnerTransferOfferCacheKey returns the store key for a seller or buyer address + offer cache entry
+func GetAddressValueOwnerTransferOfferCacheKey(addr sdk.AccAddress, scopeID MetadataAddress, buyer sdk.AccAddress) []byte {
+	return append(GetAddressValueOwnerTransferOfferCacheIteratorPrefix(addr), GetValueOwnerTransferOfferKey(scopeID, buyer)[1:]...)
+}
+
+// GetValueOwnerTransferExpirationCacheIteratorPrefix returns an iterator prefix for all value owner transfer offer
+// expiration cache entries at a given time
+func GetValueOwnerTransferExpirationCacheIteratorPrefix(expiration time.Time) []byte {
+	return append(ValueOwnerTransferExpirationCacheKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetValueOwnerTransferExpirationCacheKey returns the store key for an expiration time + offer cache entry
+func GetValueOwnerTransferExpirationCacheKey(expiration time.Time, scopeID MetadataAddress, buyer sdk.AccAddress) []byte {
+	return append(GetValueOwnerTransferExpirationCacheIteratorPrefix(expiration), GetValueOwnerTransferOfferKey(scopeID, buyer)[1:]...)
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ValidateBasic checks that everything in the manifest is valid and that nothing is in it more than once.
+func (m Manifest) ValidateBasic() error {
+	seen := make(map[string]bool)
+	checkDup := func(kind string, id MetadataAddress) error {
+		key := id.String()
+		if seen[key] {
+			return fmt.Errorf("duplicate %s %s", kind, key)
+		}
+		seen[key] = true
+		return nil
+	}
+
+	for _, spec := range m.ScopeSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope specification %s: %w", spec.SpecificationId, err)
+		}
+		if err := checkDup("scope specification", spec.SpecificationId); err != nil {
+			return err
+		}
+	}
+	for _, spec := range m.ContractSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid contract specification %s: %w", spec.SpecificationId, err)
+		}
+		if err := checkDup("contract specification", spec.SpecificationId); err != nil {
+			return err
+		}
+	}
+	for _, spec := range m.RecordSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record specification %s: %w", spec.SpecificationId, err)
+		}
+		if err := checkDup("record specification", spec.SpecificationId); err != nil {
+			return err
+		}
+	}
+	for _, scope := range m.Scopes {
+		if err := scope.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope %s: %w", scope.ScopeId, err)
+		}
+		if err := checkDup("scope", scope.ScopeId); err != nil {
+			return err
+		}
+	}
+	for _, session := range m.Sessions {
+		if err := session.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid session %s: %w", session.SessionId, err)
+		}
+		if err := checkDup("session", session.SessionId); err != nil {
+			return err
+		}
+	}
+	for _, record := range m.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record %s: %w", record.Name, err)
+		}
+		if err := checkDup("record", record.GetRecordAddress()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OrderedRecords returns the manifest's records, with each one after any records in the manifest that it has as inputs.
+func (m Manifest) OrderedRecords() []Record {
+	return orderRecordsByInputs(m.Records)
+}
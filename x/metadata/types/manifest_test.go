@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testManifest() Manifest {
+	bundle := testScopeBundle()
+	contractSpecID := bundle.Sessions[0].SpecificationId
+	contractSpec := NewContractSpecification(contractSpecID, nil, []string{specTestBech32},
+		[]PartyType{PartyType_PARTY_TYPE_OWNER}, NewContractSpecificationSourceHash("sourcehash"), "io.provenance.Contract")
+	return Manifest{
+		ScopeSpecifications:    []ScopeSpecification{*bundle.ScopeSpecification},
+		ContractSpecifications: []ContractSpecification{*contractSpec},
+		Scopes:                 []Scope{*bundle.Scope},
+		Sessions:               bundle.Sessions,
+		Records:                bundle.Records,
+	}
+}
+
+func TestManifestValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *Manifest)
+		err    string
+	}{
+		{"valid", func(m *Manifest) {}, ""},
+		{"empty", func(m *Manifest) { *m = Manifest{} }, ""},
+		{
+			"invalid scope specification",
+			func(m *Manifest) { m.ScopeSpecifications[0].OwnerAddresses = nil },
+			"invalid scope specification",
+		},
+		{
+			"invalid contract specification",
+			func(m *Manifest) { m.ContractSpecifications[0].Source = nil },
+			"invalid contract specification",
+		},
+		{"invalid scope", func(m *Manifest) { m.Scopes[0].Owners = nil }, "invalid scope"},
+		{"invalid session", func(m *Manifest) { m.Sessions[0].Parties = nil }, "invalid session"},
+		{"invalid record", func(m *Manifest) { m.Records[0].Process.Name = "" }, "invalid record second"},
+		{"duplicate scope", func(m *Manifest) { m.Scopes = append(m.Scopes, m.Scopes[0]) }, "duplicate scope scope1"},
+		{
+			"duplicate record",
+			func(m *Manifest) { m.Records = append(m.Records, m.Records[1]) },
+			"duplicate record record1",
+		},
+		{
+			"records in different scopes with the same name",
+			func(m *Manifest) {
+				other := m.Records[1]
+				other.SessionId = SessionMetadataAddress(uuid.New(), uuid.New())
+				m.Records = append(m.Records, other)
+			},
+			"",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest := testManifest()
+			tc.modify(&manifest)
+			err := manifest.ValidateBasic()
+			if len(tc.err) == 0 {
+				require.NoError(t, err, "ValidateBasic")
+			} else {
+				require.Error(t, err, "ValidateBasic")
+				assert.Contains(t, err.Error(), tc.err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestManifestOrderedRecords(t *testing.T) {
+	manifest := testManifest()
+	records := manifest.OrderedRecords()
+	require.Len(t, records, 2, "OrderedRecords")
+	assert.Equal(t, "first", records[0].Name, "record 0")
+	assert.Equal(t, "second", records[1].Name, "record 1")
+	assert.Equal(t, "second", manifest.Records[0].Name, "manifest record 0")
+}
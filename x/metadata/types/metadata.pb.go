@@ -25,6 +25,36 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// history_retention_blocks is the number of blocks that scope, session, and record history entries are kept for.
+	// A value of zero disables the recording of history.
+	HistoryRetentionBlocks uint64 `protobuf:"varint,1,opt,name=history_retention_blocks,json=historyRetentionBlocks,proto3" json:"history_retention_blocks,omitempty" yaml:"history_retention_blocks"`
+	// record_type_registrars are the accounts that can register new record types.
+	RecordTypeRegistrars []string `protobuf:"bytes,2,rep,name=record_type_registrars,json=recordTypeRegistrars,proto3" json:"record_type_registrars,omitempty" yaml:"record_type_registrars"`
+	// require_registered_record_types indicates whether the type names used in record and input specifications
+	// must be registered record types. Existing specifications are not affected unless they are changed.
+	RequireRegisteredRecordTypes bool `protobuf:"varint,3,opt,name=require_registered_record_types,json=requireRegisteredRecordTypes,proto3" json:"require_registered_record_types,omitempty" yaml:"require_registered_record_types"`
+	// max_scope_owners is the maximum number of owners a scope can have. Zero means no limit.
+	MaxScopeOwners uint32 `protobuf:"varint,4,opt,name=max_scope_owners,json=maxScopeOwners,proto3" json:"max_scope_owners,omitempty" yaml:"max_scope_owners"`
+	// max_scope_data_access is the maximum number of data access addresses a scope can have. Zero means no limit.
+	MaxScopeDataAccess uint32 `protobuf:"varint,5,opt,name=max_scope_data_access,json=maxScopeDataAccess,proto3" json:"max_scope_data_access,omitempty" yaml:"max_scope_data_access"`
+	// max_scope_records is the maximum number of records a scope can have. Zero means no limit.
+	MaxScopeRecords uint32 `protobuf:"varint,6,opt,name=max_scope_records,json=maxScopeRecords,proto3" json:"max_scope_records,omitempty" yaml:"max_scope_records"`
+	// max_record_inputs is the maximum number of inputs a record can have. Zero means no limit.
+	MaxRecordInputs uint32 `protobuf:"varint,7,opt,name=max_record_inputs,json=maxRecordInputs,proto3" json:"max_record_inputs,omitempty" yaml:"max_record_inputs"`
+	// max_record_outputs is the maximum number of outputs a record can have. Zero means no limit.
+	MaxRecordOutputs uint32 `protobuf:"varint,8,opt,name=max_record_outputs,json=maxRecordOutputs,proto3" json:"max_record_outputs,omitempty" yaml:"max_record_outputs"`
+	// max_session_parties is the maximum number of parties a session can have. Zero means no limit.
+	MaxSessionParties uint32 `protobuf:"varint,9,opt,name=max_session_parties,json=maxSessionParties,proto3" json:"max_session_parties,omitempty" yaml:"max_session_parties"`
+	// max_session_context_bytes is the maximum length of a session's context. Zero means no limit.
+	MaxSessionContextBytes uint32 `protobuf:"varint,10,opt,name=max_session_context_bytes,json=maxSessionContextBytes,proto3" json:"max_session_context_bytes,omitempty" yaml:"max_session_context_bytes"`
+	// enforce_responsible_parties indicates whether records can only be written when the signers include a party of
+	// each of the responsible party types listed in the record's specification.
+	// When false, records written without them are still allowed, but are tracked so they can be audited.
+	EnforceResponsibleParties bool `protobuf:"varint,11,opt,name=enforce_responsible_parties,json=enforceResponsibleParties,proto3" json:"enforce_responsible_parties,omitempty" yaml:"enforce_responsible_parties"`
+	// disable_p8e_messages indicates whether the deprecated MsgWriteP8eContractSpecRequest and
+	// MsgP8eMemorializeContractRequest are rejected. Once clients have moved to the native messages (e.g.
+	// MsgExecuteContractSessionRequest), this can be turned on to retire them.
+	DisableP8EMessages bool `protobuf:"varint,12,opt,name=disable_p8e_messages,json=disableP8eMessages,proto3" json:"disable_p8e_messages,omitempty" yaml:"disable_p8e_messages"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +89,90 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetHistoryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.HistoryRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRecordTypeRegistrars() []string {
+	if m != nil {
+		return m.RecordTypeRegistrars
+	}
+	return nil
+}
+
+func (m *Params) GetRequireRegisteredRecordTypes() bool {
+	if m != nil {
+		return m.RequireRegisteredRecordTypes
+	}
+	return false
+}
+
+func (m *Params) GetMaxScopeOwners() uint32 {
+	if m != nil {
+		return m.MaxScopeOwners
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScopeDataAccess() uint32 {
+	if m != nil {
+		return m.MaxScopeDataAccess
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScopeRecords() uint32 {
+	if m != nil {
+		return m.MaxScopeRecords
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordInputs() uint32 {
+	if m != nil {
+		return m.MaxRecordInputs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordOutputs() uint32 {
+	if m != nil {
+		return m.MaxRecordOutputs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSessionParties() uint32 {
+	if m != nil {
+		return m.MaxSessionParties
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSessionContextBytes() uint32 {
+	if m != nil {
+		return m.MaxSessionContextBytes
+	}
+	return 0
+}
+
+func (m *Params) GetEnforceResponsibleParties() bool {
+	if m != nil {
+		return m.EnforceResponsibleParties
+	}
+	return false
+}
+
+func (m *Params) GetDisableP8EMessages() bool {
+	if m != nil {
+		return m.DisableP8EMessages
+	}
+	return false
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -560,70 +674,94 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x37, 0x21, 0x6d, 0x5e, 0x7e, 0xec, 0xc6, 0xdd, 0x4d, 0xb6, 0x69, 0xea, 0x49, 0xa7,
-	0x54, 0x5a, 0x85, 0xb2, 0x4b, 0x4b, 0x25, 0xa4, 0xdc, 0x08, 0xaa, 0x94, 0xaa, 0x2a, 0x8a, 0xbc,
-	0x02, 0x09, 0x84, 0x14, 0xb9, 0xb6, 0x93, 0x58, 0xb0, 0xeb, 0x95, 0xbd, 0x1b, 0xb5, 0xe2, 0xc0,
-	0xbf, 0xc0, 0x91, 0x63, 0xef, 0x9c, 0xf8, 0x2f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0x18, 0x41, 0xc2,
-	0x81, 0xf3, 0xfc, 0x05, 0xc8, 0x33, 0x63, 0xcf, 0x1b, 0xff, 0xb8, 0x71, 0xf3, 0x8c, 0xbf, 0xf7,
-	0x7d, 0xf6, 0xfb, 0x3e, 0xbf, 0xd9, 0x85, 0x87, 0xb3, 0x24, 0xbe, 0x0c, 0xa7, 0xde, 0xd4, 0x0f,
-	0x47, 0x93, 0x70, 0xee, 0x05, 0xde, 0xdc, 0x1b, 0x5d, 0x3e, 0x2e, 0xae, 0x87, 0xb3, 0x24, 0x9e,
-	0xc7, 0xf6, 0xb6, 0x86, 0x0d, 0x8b, 0x5b, 0x97, 0x8f, 0x77, 0xbb, 0xe7, 0xf1, 0x79, 0x2c, 0x20,
-	0xa3, 0xec, 0x4a, 0xa2, 0xa9, 0x0d, 0x2b, 0x27, 0x5e, 0xe2, 0x4d, 0xd2, 0xc3, 0x5b, 0xbf, 0xbc,
-	0x25, 0xad, 0x7f, 0xdf, 0x12, 0x8b, 0xfe, 0x7e, 0x03, 0xd6, 0xc6, 0x7e, 0x3c, 0x0b, 0x9f, 0x07,
-	0xcf, 0xa7, 0x67, 0xb1, 0xfd, 0x0c, 0x6e, 0xa5, 0xd9, 0xf2, 0x34, 0x0a, 0xfa, 0xd6, 0xbe, 0x35,
-	0x58, 0x3f, 0x3a, 0x78, 0xc7, 0x48, 0xeb, 0x4f, 0x46, 0xda, 0x2f, 0x95, 0xc0, 0xe7, 0x41, 0x90,
-	0x84, 0x69, 0xca, 0x19, 0x69, 0xbf, 0xf1, 0x26, 0x3f, 0x1c, 0xd2, 0xbc, 0x80, 0xba, 0x37, 0x53,
-	0x49, 0x65, 0x1f, 0x41, 0x3b, 0xdf, 0x3d, 0x9d, 0x25, 0xe1, 0x59, 0xf4, 0xba, 0x7f, 0x43, 0xb0,
-	0xed, 0x72, 0x46, 0xb6, 0xcd, 0x32, 0x05, 0xa0, 0xee, 0x86, 0xaa, 0x3e, 0x11, 0x6b, 0xfb, 0x25,
-	0xdc, 0x2e, 0x20, 0xf2, 0x62, 0xb1, 0x88, 0x82, 0xfe, 0x92, 0xe0, 0x71, 0x38, 0x23, 0xbb, 0x25,
-	0x1e, 0x0d, 0xa2, 0x6e, 0x47, 0x71, 0x89, 0x77, 0xfb, 0x6a, 0x11, 0x05, 0xf6, 0x53, 0x00, 0x09,
-	0xf0, 0x82, 0x20, 0xe9, 0x2f, 0xef, 0x5b, 0x83, 0xd5, 0xa3, 0x1e, 0x67, 0x64, 0x0b, 0xb3, 0x64,
-	0xf7, 0xa8, 0xbb, 0x2a, 0x16, 0xd9, 0x7b, 0xea, 0x2a, 0xa1, 0xfd, 0x41, 0x7d, 0x95, 0x94, 0x94,
-	0x55, 0x99, 0x16, 0xfd, 0x6d, 0x19, 0x36, 0xc6, 0x61, 0x9a, 0x46, 0xf1, 0x54, 0xf5, 0xf5, 0x05,
-	0x40, 0x2a, 0x37, 0x74, 0x67, 0x1f, 0x35, 0x77, 0x36, 0xa7, 0x2f, 0x4a, 0x32, 0xfa, 0x9c, 0xd0,
-	0x3e, 0x86, 0x2d, 0x7d, 0xc7, 0xec, 0xef, 0x1e, 0x67, 0xa4, 0x5f, 0x2e, 0x2e, 0x3a, 0xdc, 0x2e,
-	0x38, 0x54, 0x8f, 0xc7, 0xd0, 0x43, 0xb0, 0x4a, 0x97, 0xf7, 0x39, 0x23, 0x7b, 0x15, 0x36, 0xfc,
-	0xd2, 0x76, 0xc1, 0xa8, 0x3b, 0xfd, 0x0d, 0xec, 0x60, 0xb4, 0xba, 0x14, 0xb4, 0xcb, 0x82, 0x96,
-	0x72, 0x46, 0x9c, 0x2a, 0x2d, 0x02, 0x52, 0xb7, 0xab, 0x89, 0xe5, 0x85, 0xa0, 0x3e, 0x84, 0xf5,
-	0x1c, 0x26, 0x6c, 0x94, 0x86, 0xec, 0x70, 0x46, 0x6e, 0x9b, 0x7c, 0xd2, 0xc8, 0x35, 0xb5, 0x14,
-	0x56, 0xa2, 0x5a, 0xf1, 0x2c, 0x2b, 0x4d, 0xb5, 0xf2, 0x01, 0xf2, 0x5a, 0xa1, 0xeb, 0xc1, 0x46,
-	0x11, 0xb3, 0x68, 0x7a, 0x16, 0xf7, 0x6f, 0xee, 0x5b, 0x83, 0xb5, 0x27, 0x0f, 0x86, 0xf5, 0x1f,
-	0xe0, 0x10, 0x7d, 0x52, 0x47, 0x7d, 0xce, 0x48, 0xb7, 0x14, 0xd5, 0x8c, 0x23, 0x93, 0xd0, 0x30,
-	0x7a, 0xb5, 0x04, 0xeb, 0x6e, 0xe8, 0xc7, 0x49, 0xa0, 0x22, 0x73, 0x0c, 0xab, 0x89, 0x58, 0xeb,
-	0xc4, 0x7c, 0xd4, 0x9c, 0x98, 0x8e, 0x54, 0x28, 0x2a, 0xa8, 0x7b, 0x2b, 0x51, 0x6c, 0xf6, 0x33,
-	0xe8, 0x14, 0xfb, 0x66, 0x5c, 0xee, 0x72, 0x46, 0x76, 0x4a, 0x95, 0x45, 0x5a, 0x36, 0x73, 0x02,
-	0x15, 0x96, 0x13, 0xe8, 0x6a, 0x50, 0x25, 0x2b, 0x84, 0x33, 0x72, 0xb7, 0x4c, 0x85, 0xa3, 0xb2,
-	0x95, 0xd3, 0xe9, 0xa4, 0x8c, 0xa1, 0xa7, 0xb1, 0x17, 0x5e, 0x7a, 0x11, 0x06, 0xa7, 0x53, 0x6f,
-	0x12, 0xaa, 0x9c, 0xa0, 0xf8, 0xd5, 0xc2, 0xa8, 0x6b, 0xe7, 0x9c, 0xc7, 0x62, 0xf7, 0x4b, 0x6f,
-	0x12, 0xda, 0x9f, 0xc1, 0x9a, 0x42, 0xa3, 0x88, 0x6c, 0x73, 0x46, 0x6c, 0x83, 0x4a, 0x26, 0x04,
-	0xe4, 0x4a, 0x04, 0xa4, 0x62, 0xf2, 0xca, 0xff, 0x6e, 0xf2, 0xaf, 0x4b, 0xd0, 0x16, 0x65, 0xe3,
-	0x59, 0xe8, 0x2b, 0x9f, 0xc7, 0xb9, 0x6c, 0x3a, 0x0b, 0x7d, 0xed, 0xf5, 0xa8, 0xd9, 0x6b, 0x43,
-	0x48, 0x55, 0xe5, 0x42, 0x92, 0x38, 0xf3, 0xca, 0xb8, 0x6d, 0xda, 0x8e, 0xbc, 0xaa, 0x43, 0x51,
-	0x77, 0x0b, 0x71, 0x29, 0xf7, 0x23, 0xb8, 0x67, 0x62, 0xd1, 0x0a, 0xc5, 0x60, 0xc0, 0x19, 0xf9,
-	0xb0, 0x8e, 0xba, 0x04, 0xa7, 0x6e, 0x1f, 0x69, 0x14, 0x3d, 0x11, 0xb1, 0x28, 0x4e, 0x0f, 0x81,
-	0x46, 0xf3, 0xba, 0x72, 0x7a, 0x14, 0x80, 0xfc, 0xf4, 0xc8, 0x38, 0x84, 0x99, 0x26, 0x07, 0x9a,
-	0xde, 0xf5, 0x1c, 0xf2, 0x91, 0x34, 0x87, 0x18, 0xe3, 0xff, 0x2c, 0x81, 0xfd, 0x45, 0x3c, 0x9d,
-	0x27, 0x9e, 0x3f, 0x47, 0x86, 0x7d, 0x07, 0x1d, 0x5f, 0xed, 0x96, 0x3c, 0x7b, 0xd2, 0xec, 0x99,
-	0xfa, 0xca, 0xca, 0x85, 0xd4, 0xdd, 0xf4, 0x0d, 0x85, 0x6c, 0x7a, 0x96, 0x41, 0xa6, 0x79, 0x68,
-	0x7a, 0x36, 0x00, 0xa9, 0xdb, 0x35, 0x49, 0x95, 0x85, 0x3f, 0xc2, 0x83, 0x4a, 0x85, 0xb9, 0x81,
-	0x8c, 0x1c, 0x72, 0x46, 0x0e, 0x1a, 0x64, 0xaa, 0x45, 0xd4, 0x75, 0x4c, 0x49, 0xdc, 0x37, 0x61,
-	0xea, 0x0b, 0xb0, 0xcd, 0x32, 0xe4, 0xeb, 0x3d, 0xce, 0xc8, 0x9d, 0x3a, 0x2d, 0x69, 0x6d, 0x07,
-	0x53, 0x0b, 0x77, 0x2b, 0x64, 0xc8, 0xe0, 0x46, 0x32, 0xf5, 0xcb, 0xc0, 0x2f, 0x3d, 0x19, 0xfd,
-	0x7b, 0x19, 0x3a, 0x72, 0xf2, 0x22, 0x93, 0xbf, 0x06, 0x35, 0xfe, 0x4a, 0x16, 0x7f, 0xd2, 0x6c,
-	0x71, 0xcf, 0x98, 0x2f, 0x85, 0xc1, 0xeb, 0x09, 0xe2, 0x46, 0x23, 0xaf, 0xd6, 0xdc, 0xea, 0xc8,
-	0x2b, 0x5b, 0x6b, 0x63, 0x3a, 0x65, 0xec, 0x02, 0xee, 0x97, 0xd0, 0x8d, 0xb6, 0x3e, 0xe2, 0x8c,
-	0x0c, 0x6a, 0x05, 0xea, 0x9a, 0xb5, 0x87, 0xc5, 0x2a, 0x96, 0x7a, 0xb0, 0x5b, 0xe2, 0xa8, 0xce,
-	0xf0, 0x87, 0x9c, 0x91, 0xfb, 0xb5, 0x7a, 0xc6, 0x20, 0xdf, 0xc6, 0x42, 0x68, 0x98, 0xeb, 0xa3,
-	0x4b, 0x67, 0x46, 0xda, 0x5c, 0x3d, 0xba, 0x50, 0x62, 0x36, 0x35, 0x9d, 0xc8, 0xcb, 0x4f, 0xd0,
-	0xab, 0x84, 0x18, 0x8d, 0xf8, 0x83, 0xa6, 0x11, 0x5f, 0xfd, 0xfa, 0xb1, 0x43, 0xb5, 0x94, 0xd4,
-	0xb5, 0xfd, 0x6a, 0xd5, 0xf7, 0xef, 0xae, 0x1c, 0xeb, 0xfd, 0x95, 0x63, 0xfd, 0x75, 0xe5, 0x58,
-	0x3f, 0x5f, 0x3b, 0xad, 0xf7, 0xd7, 0x4e, 0xeb, 0x8f, 0x6b, 0xa7, 0x05, 0x77, 0xa2, 0xb8, 0x41,
-	0xfd, 0xc4, 0xfa, 0xf6, 0xe9, 0x79, 0x34, 0xbf, 0x58, 0xbc, 0x1a, 0xfa, 0xf1, 0x64, 0xa4, 0x41,
-	0x1f, 0x47, 0x31, 0x5a, 0x8d, 0x5e, 0xeb, 0xbf, 0x08, 0xf3, 0x37, 0xb3, 0x30, 0x7d, 0xb5, 0x22,
-	0x7e, 0xef, 0x7f, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x3b, 0xb8, 0x76, 0x46, 0x0c,
-	0x00, 0x00,
+	// 1382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xc7, 0xad, 0xd8, 0x71, 0xec, 0xf1, 0x4d, 0x9e, 0xc8, 0x0a, 0xed, 0x38, 0x1a, 0x65, 0x72,
+	0x81, 0xe0, 0x2f, 0x9f, 0xd4, 0xa4, 0x01, 0x1a, 0x64, 0x17, 0xb5, 0x01, 0x12, 0x04, 0x49, 0xd4,
+	0x51, 0x2f, 0x68, 0xd1, 0x82, 0xa0, 0xc9, 0xb1, 0x4d, 0x24, 0x22, 0x15, 0x0e, 0x95, 0xda, 0xe8,
+	0xa2, 0xaf, 0xd0, 0x65, 0x97, 0x59, 0x75, 0xd3, 0x55, 0xdf, 0x22, 0xcb, 0x00, 0xdd, 0x14, 0x5d,
+	0x0c, 0x5a, 0xbb, 0x8b, 0xae, 0xf9, 0x04, 0x05, 0x67, 0x86, 0xe4, 0xf0, 0x96, 0x55, 0x77, 0xe4,
+	0x99, 0xff, 0xf9, 0x1d, 0x72, 0xfe, 0x87, 0x67, 0x6c, 0x81, 0x1b, 0xd3, 0xc0, 0x7f, 0x4d, 0x3d,
+	0xcb, 0xb3, 0xe9, 0x60, 0x42, 0x43, 0xcb, 0xb1, 0x42, 0x6b, 0xf0, 0xfa, 0x76, 0x7a, 0xdd, 0x9f,
+	0x06, 0x7e, 0xe8, 0xc3, 0x76, 0x26, 0xeb, 0xa7, 0x4b, 0xaf, 0x6f, 0xef, 0xb4, 0x0e, 0xfd, 0x43,
+	0x5f, 0x48, 0x06, 0xf1, 0x95, 0x54, 0xe3, 0x9f, 0x97, 0xc0, 0xe2, 0xc8, 0x0a, 0xac, 0x09, 0x83,
+	0xdf, 0x02, 0xe3, 0xc8, 0x65, 0xa1, 0x1f, 0x9c, 0x98, 0x01, 0x0d, 0xa9, 0x17, 0xba, 0xbe, 0x67,
+	0xee, 0xbf, 0xf4, 0xed, 0x17, 0xcc, 0x68, 0x74, 0x1b, 0xbd, 0x85, 0xe1, 0xb5, 0x88, 0x23, 0x74,
+	0x62, 0x4d, 0x5e, 0xde, 0xc7, 0x75, 0x4a, 0x4c, 0xda, 0x6a, 0x89, 0x24, 0x2b, 0x43, 0xb1, 0x00,
+	0xbf, 0x04, 0xed, 0x80, 0xda, 0x7e, 0xe0, 0x98, 0xe1, 0xc9, 0x94, 0x9a, 0x01, 0x3d, 0x74, 0x59,
+	0x18, 0x58, 0x01, 0x33, 0xce, 0x75, 0xe7, 0x7b, 0xcb, 0xc3, 0xab, 0x11, 0x47, 0x57, 0x24, 0xbc,
+	0x5a, 0x87, 0x49, 0x4b, 0x2e, 0x7c, 0x76, 0x32, 0xa5, 0x24, 0x0d, 0xc3, 0x57, 0x00, 0x05, 0xf4,
+	0xd5, 0xcc, 0x0d, 0x12, 0x31, 0x0d, 0xa8, 0x63, 0x6a, 0x0c, 0x66, 0xcc, 0x77, 0x1b, 0xbd, 0xa5,
+	0xe1, 0x5e, 0xc4, 0xd1, 0xcd, 0xa4, 0xc2, 0x7b, 0x13, 0x30, 0xd9, 0x55, 0x0a, 0x92, 0x0a, 0x48,
+	0x5a, 0x9b, 0xc1, 0x87, 0xa0, 0x39, 0xb1, 0x8e, 0x4d, 0x66, 0xfb, 0x53, 0x6a, 0xfa, 0xdf, 0x79,
+	0x34, 0x60, 0xc6, 0x42, 0xb7, 0xd1, 0x5b, 0x1b, 0x5e, 0x8e, 0x38, 0xba, 0x24, 0x6b, 0x14, 0x15,
+	0x98, 0xac, 0x4f, 0xac, 0xe3, 0x71, 0x1c, 0x79, 0x2e, 0x02, 0x70, 0x0c, 0xb6, 0x32, 0x51, 0xec,
+	0x93, 0x69, 0xd9, 0x36, 0x65, 0xcc, 0x38, 0x2f, 0x58, 0xdd, 0x88, 0xa3, 0xdd, 0x22, 0x4b, 0x93,
+	0x61, 0x02, 0x13, 0xe0, 0x27, 0x56, 0x68, 0x3d, 0x10, 0x41, 0xf8, 0x08, 0x6c, 0x66, 0x6a, 0xf9,
+	0x52, 0xcc, 0x58, 0x14, 0xc0, 0xdd, 0x88, 0x23, 0xa3, 0x08, 0x54, 0x12, 0x4c, 0x36, 0x12, 0x98,
+	0x7c, 0xd3, 0x94, 0xa4, 0x36, 0xc6, 0xf5, 0xa6, 0xb3, 0x90, 0x19, 0x17, 0xaa, 0x48, 0x39, 0x89,
+	0x24, 0x49, 0xc8, 0x63, 0x11, 0x81, 0x4f, 0x00, 0xd4, 0x64, 0xfe, 0x2c, 0x14, 0xa8, 0x25, 0x81,
+	0xba, 0x12, 0x71, 0xb4, 0x5d, 0x42, 0x29, 0x0d, 0x26, 0xcd, 0x94, 0xf5, 0x5c, 0x86, 0xe0, 0x33,
+	0x70, 0x51, 0x3c, 0x3d, 0x65, 0x2c, 0xee, 0xbb, 0xa9, 0x15, 0x84, 0x2e, 0x65, 0xc6, 0xb2, 0xa0,
+	0x75, 0x22, 0x8e, 0x76, 0xb4, 0x57, 0xcc, 0x8b, 0x30, 0x89, 0xdf, 0x68, 0x2c, 0x83, 0x23, 0x19,
+	0x83, 0x26, 0xd8, 0xd6, 0xa5, 0xb6, 0xef, 0x85, 0xf4, 0x38, 0x34, 0xf7, 0x4f, 0x42, 0xca, 0x0c,
+	0x20, 0xa8, 0xd7, 0x23, 0x8e, 0xba, 0x65, 0x6a, 0x4e, 0x8a, 0x49, 0x3b, 0x63, 0x7f, 0x2c, 0x57,
+	0x86, 0xf1, 0x02, 0x3c, 0x00, 0x97, 0xa9, 0x77, 0xe0, 0x07, 0x76, 0xbc, 0xd9, 0x6c, 0xea, 0x7b,
+	0xcc, 0xdd, 0x7f, 0x49, 0xd3, 0x07, 0x5f, 0x11, 0xcd, 0x79, 0x33, 0xe2, 0x08, 0xcb, 0x12, 0xef,
+	0x11, 0x63, 0xb2, 0xad, 0x56, 0x49, 0xb6, 0x98, 0xbc, 0xc8, 0xa7, 0xa0, 0xe5, 0xb8, 0xcc, 0x12,
+	0xf2, 0x7b, 0xd4, 0x9c, 0x50, 0xc6, 0xac, 0x43, 0xca, 0x8c, 0x55, 0x51, 0x00, 0x45, 0x1c, 0x5d,
+	0x96, 0x05, 0xaa, 0x54, 0x98, 0x40, 0x15, 0x1e, 0xdd, 0xa3, 0x4f, 0x55, 0xf0, 0xfe, 0xd2, 0x4f,
+	0x6f, 0xd0, 0xdc, 0x3f, 0x6f, 0x50, 0x03, 0xff, 0x76, 0x0e, 0xac, 0x88, 0xee, 0x78, 0xec, 0x3c,
+	0xf6, 0x0e, 0x7c, 0xf8, 0x10, 0x2c, 0xc9, 0xfe, 0x71, 0x1d, 0x31, 0x1d, 0x56, 0x87, 0x7b, 0x6f,
+	0x39, 0x9a, 0xfb, 0x83, 0xa3, 0x8d, 0xa7, 0x6a, 0xea, 0x3c, 0x70, 0x9c, 0x80, 0x32, 0x16, 0x71,
+	0xb4, 0x21, 0xeb, 0x26, 0x09, 0x98, 0x5c, 0x60, 0x12, 0x05, 0x87, 0x60, 0x23, 0x89, 0x9a, 0xd3,
+	0x80, 0x1e, 0xb8, 0xc7, 0xc6, 0x39, 0x41, 0xdb, 0x89, 0x38, 0x6a, 0xe7, 0xd3, 0x94, 0x00, 0x93,
+	0x35, 0x95, 0x3d, 0x12, 0xf7, 0xf0, 0x29, 0xb8, 0x98, 0x4a, 0xe4, 0xc5, 0x6c, 0xe6, 0x3a, 0xe2,
+	0xa3, 0x5f, 0xd5, 0x1b, 0xa2, 0x42, 0x84, 0x49, 0x53, 0xb1, 0xc4, 0xbb, 0x7d, 0x3e, 0x73, 0x1d,
+	0x78, 0x17, 0x00, 0x29, 0xb0, 0x1c, 0x27, 0x10, 0x9f, 0xf5, 0xf2, 0x70, 0x2b, 0xe2, 0x68, 0x53,
+	0xa7, 0xc4, 0x6b, 0x98, 0x2c, 0x8b, 0x9b, 0xf8, 0x3d, 0xb3, 0x2c, 0x51, 0xfb, 0x7c, 0x75, 0x96,
+	0x2c, 0xb9, 0xcc, 0x92, 0x5a, 0xf8, 0xd7, 0x05, 0xb0, 0xa6, 0x5a, 0x46, 0xed, 0xeb, 0x13, 0x00,
+	0x92, 0xf6, 0x4a, 0x77, 0xf6, 0x56, 0xfd, 0xce, 0x26, 0xf8, 0x34, 0x25, 0xc6, 0x27, 0xc0, 0xf8,
+	0x0b, 0xce, 0x56, 0xf2, 0xfb, 0xab, 0x7d, 0xc1, 0x25, 0x09, 0x26, 0x1b, 0x29, 0x43, 0xed, 0xf1,
+	0x18, 0x6c, 0x69, 0xb2, 0xd2, 0x2e, 0x6b, 0xa3, 0xaa, 0x52, 0x86, 0x09, 0x4c, 0x89, 0xd9, 0x4e,
+	0x7f, 0x05, 0x2e, 0xe9, 0x6a, 0x75, 0x29, 0xb0, 0x0b, 0x02, 0x8b, 0x23, 0x8e, 0x3a, 0x65, 0xac,
+	0x26, 0xc4, 0xa4, 0x95, 0x81, 0xe5, 0x85, 0x40, 0xdf, 0x07, 0xab, 0x89, 0x4c, 0xd8, 0x28, 0x0d,
+	0xb9, 0x14, 0x71, 0x74, 0x31, 0xcf, 0x93, 0x46, 0xae, 0xa8, 0x5b, 0x61, 0xa5, 0x96, 0x2b, 0x9e,
+	0x65, 0xb1, 0x2e, 0x57, 0x3e, 0xc0, 0x0a, 0xd3, 0xea, 0x5a, 0x60, 0x2d, 0x6d, 0x33, 0xd7, 0x3b,
+	0xf0, 0xc5, 0xbc, 0x5c, 0xb9, 0x73, 0xad, 0x5f, 0x7d, 0x2a, 0xf7, 0xb5, 0x4f, 0x6a, 0x68, 0x44,
+	0x1c, 0xb5, 0x0a, 0xad, 0x1a, 0x33, 0xe2, 0x12, 0x99, 0x0c, 0x9f, 0xce, 0x83, 0x55, 0x35, 0x5d,
+	0x65, 0xcb, 0x3c, 0x02, 0xcb, 0xc9, 0x00, 0x4e, 0x3a, 0xe6, 0x7f, 0xf5, 0x1d, 0xd3, 0xcc, 0x9d,
+	0xb1, 0xf1, 0x0b, 0x2c, 0x05, 0x8a, 0x16, 0x9f, 0x6b, 0x69, 0x3c, 0xdf, 0x2e, 0xda, 0xb9, 0x56,
+	0x54, 0x60, 0xb2, 0x9e, 0x00, 0x54, 0xb3, 0x8c, 0x40, 0x2b, 0x13, 0x95, 0x7a, 0x45, 0x1b, 0x44,
+	0x55, 0x2a, 0x4c, 0x36, 0x13, 0x5c, 0xd6, 0x29, 0x63, 0xb0, 0x95, 0x69, 0x8f, 0x2c, 0x76, 0x44,
+	0x1d, 0xd3, 0xb3, 0x26, 0xd4, 0x58, 0x28, 0xb6, 0x5f, 0xa5, 0x0c, 0x13, 0x98, 0x30, 0x1f, 0x89,
+	0xe8, 0x33, 0x6b, 0x42, 0xe1, 0x47, 0x60, 0x45, 0xa9, 0xb5, 0x16, 0x69, 0x47, 0x1c, 0xc1, 0x1c,
+	0x4a, 0x76, 0x08, 0x90, 0x77, 0xa2, 0x41, 0x4a, 0x26, 0x2f, 0xfe, 0xe7, 0x26, 0xff, 0x32, 0x0f,
+	0x36, 0x44, 0xda, 0x78, 0x4a, 0x6d, 0xe5, 0xf3, 0x38, 0x29, 0xcb, 0xa6, 0xd4, 0xce, 0xbc, 0x1e,
+	0xd4, 0x7b, 0x9d, 0x2b, 0xa4, 0xb2, 0x92, 0x42, 0x12, 0x1c, 0x7b, 0x95, 0x5b, 0xce, 0xdb, 0xae,
+	0x79, 0x55, 0xa5, 0xc2, 0x64, 0x53, 0x63, 0x29, 0xf7, 0x5d, 0x70, 0x25, 0xaf, 0xd5, 0xee, 0xb4,
+	0x36, 0xe8, 0x45, 0x1c, 0x5d, 0xaf, 0x42, 0x17, 0xe4, 0x98, 0x18, 0x5a, 0x8d, 0x74, 0x4f, 0x44,
+	0x5b, 0xa4, 0xa7, 0x87, 0x50, 0x6b, 0xf3, 0xba, 0x74, 0x7a, 0xa4, 0x82, 0xe4, 0xf4, 0x88, 0x19,
+	0xc2, 0xcc, 0x3c, 0x43, 0x9b, 0xde, 0xd5, 0x0c, 0xf9, 0x48, 0x6b, 0x4c, 0x7f, 0x0e, 0xfc, 0xf7,
+	0x3c, 0x80, 0xf1, 0x91, 0x1f, 0x58, 0x76, 0xa8, 0x19, 0xf6, 0x0d, 0x68, 0xda, 0x2a, 0x5a, 0xf0,
+	0xec, 0x4e, 0xbd, 0x67, 0xea, 0x2b, 0x2b, 0x26, 0x62, 0xb2, 0x6e, 0xe7, 0x2a, 0xc4, 0xd3, 0xb3,
+	0x28, 0xca, 0x9b, 0xa7, 0x4d, 0xcf, 0x1a, 0x21, 0x26, 0xad, 0x3c, 0x54, 0x59, 0xf8, 0x3d, 0xb8,
+	0x56, 0xca, 0xc8, 0x07, 0x34, 0x23, 0xfb, 0x11, 0x47, 0x7b, 0x35, 0x65, 0xca, 0x49, 0x98, 0x74,
+	0xf2, 0x25, 0xf5, 0x7d, 0x13, 0xa6, 0x3e, 0x01, 0x30, 0x9f, 0xa6, 0xf9, 0xaa, 0xfd, 0xb1, 0x58,
+	0xd6, 0x60, 0xd2, 0xd4, 0xd1, 0xc2, 0xdd, 0x12, 0x4c, 0x33, 0xb8, 0x16, 0xa6, 0xfe, 0x32, 0xb0,
+	0x0b, 0x4f, 0x86, 0xff, 0x5a, 0x00, 0x4d, 0x39, 0x79, 0x35, 0x93, 0xbf, 0x00, 0x6a, 0xfc, 0x15,
+	0x2c, 0xfe, 0xa0, 0xde, 0xe2, 0xad, 0xdc, 0x7c, 0x49, 0x0d, 0x5e, 0x0d, 0x34, 0xb6, 0x36, 0xf2,
+	0x2a, 0xcd, 0x2d, 0x8f, 0xbc, 0xa2, 0xb5, 0x50, 0xc7, 0x29, 0x63, 0x67, 0xe0, 0x6a, 0x41, 0x5d,
+	0x6b, 0xeb, 0xad, 0x88, 0xa3, 0x5e, 0x65, 0x81, 0xaa, 0xcd, 0xda, 0xd5, 0x8b, 0x95, 0x2c, 0xb5,
+	0xc0, 0x4e, 0x81, 0x51, 0x9e, 0xe1, 0x37, 0x22, 0x8e, 0xae, 0x56, 0xd6, 0xcb, 0x0d, 0xf2, 0xb6,
+	0x5e, 0x48, 0x1b, 0xe6, 0xd9, 0xd1, 0x95, 0xf5, 0x8c, 0xb4, 0xb9, 0x7c, 0x74, 0x69, 0x1d, 0xb3,
+	0x9e, 0xe1, 0x44, 0xbf, 0xfc, 0x00, 0xb6, 0x4a, 0x4d, 0xac, 0x8d, 0xf8, 0xbd, 0xba, 0x11, 0x5f,
+	0xfe, 0xfa, 0x75, 0x87, 0x2a, 0x91, 0x98, 0x40, 0xbb, 0x9c, 0xf5, 0xe2, 0xed, 0x69, 0xa7, 0xf1,
+	0xee, 0xb4, 0xd3, 0xf8, 0xf3, 0xb4, 0xd3, 0xf8, 0xf1, 0xac, 0x33, 0xf7, 0xee, 0xac, 0x33, 0xf7,
+	0xfb, 0x59, 0x67, 0x0e, 0x6c, 0xbb, 0x7e, 0x4d, 0xf5, 0x51, 0xe3, 0xeb, 0xbb, 0x87, 0x6e, 0x78,
+	0x34, 0xdb, 0xef, 0xdb, 0xfe, 0x64, 0x90, 0x89, 0xfe, 0xef, 0xfa, 0xda, 0xdd, 0xe0, 0x38, 0xfb,
+	0xdd, 0x40, 0xfc, 0x9b, 0xbb, 0xbf, 0x28, 0x7e, 0x04, 0xf8, 0xf0, 0xdf, 0x01, 0x00, 0x11, 0xef,
+	0x42, 0xd9, 0x5b, 0x10, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -645,6 +783,47 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.HistoryRetentionBlocks != that1.HistoryRetentionBlocks {
+		return false
+	}
+	if len(this.RecordTypeRegistrars) != len(that1.RecordTypeRegistrars) {
+		return false
+	}
+	for i := range this.RecordTypeRegistrars {
+		if this.RecordTypeRegistrars[i] != that1.RecordTypeRegistrars[i] {
+			return false
+		}
+	}
+	if this.RequireRegisteredRecordTypes != that1.RequireRegisteredRecordTypes {
+		return false
+	}
+	if this.MaxScopeOwners != that1.MaxScopeOwners {
+		return false
+	}
+	if this.MaxScopeDataAccess != that1.MaxScopeDataAccess {
+		return false
+	}
+	if this.MaxScopeRecords != that1.MaxScopeRecords {
+		return false
+	}
+	if this.MaxRecordInputs != that1.MaxRecordInputs {
+		return false
+	}
+	if this.MaxRecordOutputs != that1.MaxRecordOutputs {
+		return false
+	}
+	if this.MaxSessionParties != that1.MaxSessionParties {
+		return false
+	}
+	if this.MaxSessionContextBytes != that1.MaxSessionContextBytes {
+		return false
+	}
+	if this.EnforceResponsibleParties != that1.EnforceResponsibleParties {
+		return false
+	}
+	if this.DisableP8EMessages != that1.DisableP8EMessages {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -667,6 +846,85 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DisableP8EMessages {
+		i--
+		if m.DisableP8EMessages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EnforceResponsibleParties {
+		i--
+		if m.EnforceResponsibleParties {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxSessionContextBytes != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxSessionContextBytes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxSessionParties != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxSessionParties))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxRecordOutputs != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxRecordOutputs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRecordInputs != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxRecordInputs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxScopeRecords != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxScopeRecords))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxScopeDataAccess != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxScopeDataAccess))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxScopeOwners != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxScopeOwners))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequireRegisteredRecordTypes {
+		i--
+		if m.RequireRegisteredRecordTypes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecordTypeRegistrars) > 0 {
+		for iNdEx := len(m.RecordTypeRegistrars) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordTypeRegistrars[iNdEx])
+			copy(dAtA[i:], m.RecordTypeRegistrars[iNdEx])
+			i = encodeVarintMetadata(dAtA, i, uint64(len(m.RecordTypeRegistrars[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HistoryRetentionBlocks != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.HistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1096,6 +1354,45 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.HistoryRetentionBlocks != 0 {
+		n += 1 + sovMetadata(uint64(m.HistoryRetentionBlocks))
+	}
+	if len(m.RecordTypeRegistrars) > 0 {
+		for _, s := range m.RecordTypeRegistrars {
+			l = len(s)
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	if m.RequireRegisteredRecordTypes {
+		n += 2
+	}
+	if m.MaxScopeOwners != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxScopeOwners))
+	}
+	if m.MaxScopeDataAccess != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxScopeDataAccess))
+	}
+	if m.MaxScopeRecords != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxScopeRecords))
+	}
+	if m.MaxRecordInputs != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxRecordInputs))
+	}
+	if m.MaxRecordOutputs != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxRecordOutputs))
+	}
+	if m.MaxSessionParties != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxSessionParties))
+	}
+	if m.MaxSessionContextBytes != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxSessionContextBytes))
+	}
+	if m.EnforceResponsibleParties {
+		n += 2
+	}
+	if m.DisableP8EMessages {
+		n += 2
+	}
 	return n
 }
 
@@ -1312,6 +1609,250 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionBlocks", wireType)
+			}
+			m.HistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypeRegistrars", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypeRegistrars = append(m.RecordTypeRegistrars, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireRegisteredRecordTypes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireRegisteredRecordTypes = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScopeOwners", wireType)
+			}
+			m.MaxScopeOwners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScopeOwners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScopeDataAccess", wireType)
+			}
+			m.MaxScopeDataAccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScopeDataAccess |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScopeRecords", wireType)
+			}
+			m.MaxScopeRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScopeRecords |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordInputs", wireType)
+			}
+			m.MaxRecordInputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordInputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordOutputs", wireType)
+			}
+			m.MaxRecordOutputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordOutputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSessionParties", wireType)
+			}
+			m.MaxSessionParties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSessionParties |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSessionContextBytes", wireType)
+			}
+			m.MaxSessionContextBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSessionContextBytes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceResponsibleParties", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceResponsibleParties = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableP8EMessages", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableP8EMessages = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -1,10 +1,12 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
@@ -20,9 +22,19 @@ const (
 	TypeMsgDeleteScopeDataAccessRequest           = "delete_scope_data_access_request"
 	TypeMsgAddScopeOwnerRequest                   = "add_scope_owner_request"
 	TypeMsgDeleteScopeOwnerRequest                = "delete_scope_owner_request"
+	TypeMsgLockScopeRequest                       = "lock_scope_request"
+	TypeMsgUnlockScopeRequest                     = "unlock_scope_request"
+	TypeMsgTokenizeScopeRequest                   = "tokenize_scope_request"
+	TypeMsgDetokenizeScopeRequest                 = "detokenize_scope_request"
+	TypeMsgCloneScopeRequest                      = "clone_scope_request"
+	TypeMsgOfferValueOwnerTransferRequest         = "offer_value_owner_transfer_request"
+	TypeMsgAcceptValueOwnerTransferRequest        = "accept_value_owner_transfer_request"
+	TypeMsgCancelValueOwnerTransferRequest        = "cancel_value_owner_transfer_request"
 	TypeMsgWriteSessionRequest                    = "write_session_request"
 	TypeMsgWriteRecordRequest                     = "write_record_request"
 	TypeMsgDeleteRecordRequest                    = "delete_record_request"
+	TypeMsgExecuteContractSessionRequest          = "execute_contract_session_request"
+	TypeMsgMigrateScopeSpecRequest                = "migrate_scope_spec_request"
 	TypeMsgWriteScopeSpecificationRequest         = "write_scope_specification_request"
 	TypeMsgDeleteScopeSpecificationRequest        = "delete_scope_specification_request"
 	TypeMsgWriteContractSpecificationRequest      = "write_contract_specification_request"
@@ -31,6 +43,8 @@ const (
 	TypeMsgDeleteContractSpecFromScopeSpecRequest = "delete_contract_spec_from_scope_spec_request"
 	TypeMsgWriteRecordSpecificationRequest        = "write_record_specification_request"
 	TypeMsgDeleteRecordSpecificationRequest       = "delete_record_specification_request"
+	TypeMsgWriteRecordTypeRequest                 = "write_record_type_request"
+	TypeMsgDeleteRecordTypeRequest                = "delete_record_type_request"
 	TypeMsgWriteP8EContractSpecRequest            = "write_p8e_contract_spec_request"
 	TypeMsgP8eMemorializeContractRequest          = "p8e_memorialize_contract_request"
 	TypeMsgBindOSLocatorRequest                   = "write_os_locator_request"
@@ -46,9 +60,19 @@ const (
 	TypeURLMsgDeleteScopeDataAccessRequest           = "/provenance.metadata.v1.MsgDeleteScopeDataAccessRequest"
 	TypeURLMsgAddScopeOwnerRequest                   = "/provenance.metadata.v1.MsgAddScopeOwnerRequest"
 	TypeURLMsgDeleteScopeOwnerRequest                = "/provenance.metadata.v1.MsgDeleteScopeOwnerRequest"
+	TypeURLMsgLockScopeRequest                       = "/provenance.metadata.v1.MsgLockScopeRequest"
+	TypeURLMsgUnlockScopeRequest                     = "/provenance.metadata.v1.MsgUnlockScopeRequest"
+	TypeURLMsgTokenizeScopeRequest                   = "/provenance.metadata.v1.MsgTokenizeScopeRequest"
+	TypeURLMsgDetokenizeScopeRequest                 = "/provenance.metadata.v1.MsgDetokenizeScopeRequest"
+	TypeURLMsgCloneScopeRequest                      = "/provenance.metadata.v1.MsgCloneScopeRequest"
+	TypeURLMsgOfferValueOwnerTransferRequest         = "/provenance.metadata.v1.MsgOfferValueOwnerTransferRequest"
+	TypeURLMsgAcceptValueOwnerTransferRequest        = "/provenance.metadata.v1.MsgAcceptValueOwnerTransferRequest"
+	TypeURLMsgCancelValueOwnerTransferRequest        = "/provenance.metadata.v1.MsgCancelValueOwnerTransferRequest"
 	TypeURLMsgWriteSessionRequest                    = "/provenance.metadata.v1.MsgWriteSessionRequest"
 	TypeURLMsgWriteRecordRequest                     = "/provenance.metadata.v1.MsgWriteRecordRequest"
 	TypeURLMsgDeleteRecordRequest                    = "/provenance.metadata.v1.MsgDeleteRecordRequest"
+	TypeURLMsgExecuteContractSessionRequest          = "/provenance.metadata.v1.MsgExecuteContractSessionRequest"
+	TypeURLMsgMigrateScopeSpecRequest                = "/provenance.metadata.v1.MsgMigrateScopeSpecRequest"
 	TypeURLMsgWriteScopeSpecificationRequest         = "/provenance.metadata.v1.MsgWriteScopeSpecificationRequest"
 	TypeURLMsgDeleteScopeSpecificationRequest        = "/provenance.metadata.v1.MsgDeleteScopeSpecificationRequest"
 	TypeURLMsgWriteContractSpecificationRequest      = "/provenance.metadata.v1.MsgWriteContractSpecificationRequest"
@@ -57,6 +81,8 @@ const (
 	TypeURLMsgDeleteContractSpecFromScopeSpecRequest = "/provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest"
 	TypeURLMsgWriteRecordSpecificationRequest        = "/provenance.metadata.v1.MsgWriteRecordSpecificationRequest"
 	TypeURLMsgDeleteRecordSpecificationRequest       = "/provenance.metadata.v1.MsgDeleteRecordSpecificationRequest"
+	TypeURLMsgWriteRecordTypeRequest                 = "/provenance.metadata.v1.MsgWriteRecordTypeRequest"
+	TypeURLMsgDeleteRecordTypeRequest                = "/provenance.metadata.v1.MsgDeleteRecordTypeRequest"
 	TypeURLMsgBindOSLocatorRequest                   = "/provenance.metadata.v1.MsgBindOSLocatorRequest"
 	TypeURLMsgDeleteOSLocatorRequest                 = "/provenance.metadata.v1.MsgDeleteOSLocatorRequest"
 	TypeURLMsgModifyOSLocatorRequest                 = "/provenance.metadata.v1.MsgModifyOSLocatorRequest"
@@ -72,9 +98,19 @@ var (
 	_ sdk.Msg = &MsgDeleteScopeDataAccessRequest{}
 	_ sdk.Msg = &MsgAddScopeOwnerRequest{}
 	_ sdk.Msg = &MsgDeleteScopeOwnerRequest{}
+	_ sdk.Msg = &MsgLockScopeRequest{}
+	_ sdk.Msg = &MsgUnlockScopeRequest{}
+	_ sdk.Msg = &MsgTokenizeScopeRequest{}
+	_ sdk.Msg = &MsgDetokenizeScopeRequest{}
+	_ sdk.Msg = &MsgCloneScopeRequest{}
+	_ sdk.Msg = &MsgOfferValueOwnerTransferRequest{}
+	_ sdk.Msg = &MsgAcceptValueOwnerTransferRequest{}
+	_ sdk.Msg = &MsgCancelValueOwnerTransferRequest{}
 	_ sdk.Msg = &MsgWriteSessionRequest{}
 	_ sdk.Msg = &MsgWriteRecordRequest{}
 	_ sdk.Msg = &MsgDeleteRecordRequest{}
+	_ sdk.Msg = &MsgExecuteContractSessionRequest{}
+	_ sdk.Msg = &MsgMigrateScopeSpecRequest{}
 	_ sdk.Msg = &MsgWriteScopeSpecificationRequest{}
 	_ sdk.Msg = &MsgDeleteScopeSpecificationRequest{}
 	_ sdk.Msg = &MsgWriteContractSpecificationRequest{}
@@ -83,6 +119,8 @@ var (
 	_ sdk.Msg = &MsgDeleteContractSpecFromScopeSpecRequest{}
 	_ sdk.Msg = &MsgWriteRecordSpecificationRequest{}
 	_ sdk.Msg = &MsgDeleteRecordSpecificationRequest{}
+	_ sdk.Msg = &MsgWriteRecordTypeRequest{}
+	_ sdk.Msg = &MsgDeleteRecordTypeRequest{}
 	_ sdk.Msg = &MsgBindOSLocatorRequest{}
 	_ sdk.Msg = &MsgDeleteOSLocatorRequest{}
 	_ sdk.Msg = &MsgModifyOSLocatorRequest{}
@@ -155,6 +193,9 @@ func (msg MsgWriteScopeRequest) ValidateBasic() error {
 	if len(msg.Signers) < 1 {
 		return fmt.Errorf("at least one signer is required")
 	}
+	if len(msg.ExpectedValueHash) > 0 && len(msg.ExpectedValueHash) != sha256.Size {
+		return fmt.Errorf("invalid expected value hash: expected %d bytes, got %d", sha256.Size, len(msg.ExpectedValueHash))
+	}
 	if err := msg.ConvertOptionalFields(); err != nil {
 		return err
 	}
@@ -197,10 +238,11 @@ func (msg *MsgWriteScopeRequest) ConvertOptionalFields() error {
 // ------------------  NewMsgDeleteScopeRequest  ------------------
 
 // NewMsgDeleteScopeRequest creates a new msg instance
-func NewMsgDeleteScopeRequest(scopeID MetadataAddress, signers []string) *MsgDeleteScopeRequest {
+func NewMsgDeleteScopeRequest(scopeID MetadataAddress, cascade bool, signers []string) *MsgDeleteScopeRequest {
 	return &MsgDeleteScopeRequest{
 		ScopeId: scopeID,
 		Signers: signers,
+		Cascade: cascade,
 	}
 }
 
@@ -298,6 +340,12 @@ func (msg MsgAddScopeDataAccessRequest) ValidateBasic() error {
 			return fmt.Errorf("data access address is invalid: %s", da)
 		}
 	}
+	if msg.Expiration != nil && msg.Expiration.IsZero() {
+		return fmt.Errorf("data access expiration cannot be zero")
+	}
+	if err := ValidateDataAccessRecordNames(msg.RecordNames); err != nil {
+		return err
+	}
 	if len(msg.Signers) < 1 {
 		return fmt.Errorf("at least one signer is required")
 	}
@@ -478,6 +526,496 @@ func (msg MsgDeleteScopeOwnerRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgLockScopeRequest  ------------------
+
+// NewMsgLockScopeRequest creates a new msg instance
+func NewMsgLockScopeRequest(scopeID MetadataAddress, locker string, reason string, signers []string) *MsgLockScopeRequest {
+	return &MsgLockScopeRequest{
+		ScopeId: scopeID,
+		Locker:  locker,
+		Reason:  reason,
+		Signers: signers,
+	}
+}
+
+func (msg MsgLockScopeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgLockScopeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgLockScopeRequest) Type() string {
+	return TypeMsgLockScopeRequest
+}
+
+func (msg MsgLockScopeRequest) MsgTypeURL() string {
+	return TypeURLMsgLockScopeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgLockScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgLockScopeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgLockScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Locker); err != nil {
+		return fmt.Errorf("locker address is invalid: %s", msg.Locker)
+	}
+	if len(strings.TrimSpace(msg.Reason)) == 0 {
+		return errors.New("a reason for locking the scope is required")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	found := false
+	for _, signer := range msg.Signers {
+		if signer == msg.Locker {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("locker %s must be one of the signers", msg.Locker)
+	}
+	return nil
+}
+
+// ------------------  MsgUnlockScopeRequest  ------------------
+
+// NewMsgUnlockScopeRequest creates a new msg instance
+func NewMsgUnlockScopeRequest(scopeID MetadataAddress, signers []string) *MsgUnlockScopeRequest {
+	return &MsgUnlockScopeRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+func (msg MsgUnlockScopeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgUnlockScopeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgUnlockScopeRequest) Type() string {
+	return TypeMsgUnlockScopeRequest
+}
+
+func (msg MsgUnlockScopeRequest) MsgTypeURL() string {
+	return TypeURLMsgUnlockScopeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgUnlockScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgUnlockScopeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgUnlockScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgTokenizeScopeRequest  ------------------
+
+// NewMsgTokenizeScopeRequest creates a new msg instance
+func NewMsgTokenizeScopeRequest(scopeID MetadataAddress, recipient string, transferAgents []string, signers []string) *MsgTokenizeScopeRequest {
+	return &MsgTokenizeScopeRequest{
+		ScopeId:        scopeID,
+		Recipient:      recipient,
+		TransferAgents: transferAgents,
+		Signers:        signers,
+	}
+}
+
+func (msg MsgTokenizeScopeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgTokenizeScopeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgTokenizeScopeRequest) Type() string {
+	return TypeMsgTokenizeScopeRequest
+}
+
+func (msg MsgTokenizeScopeRequest) MsgTypeURL() string {
+	return TypeURLMsgTokenizeScopeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgTokenizeScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgTokenizeScopeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgTokenizeScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient: %w", err)
+		}
+	}
+	for i, agent := range msg.TransferAgents {
+		if _, err := sdk.AccAddressFromBech32(agent); err != nil {
+			return fmt.Errorf("invalid transfer agent at index %d: %w", i, err)
+		}
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgDetokenizeScopeRequest  ------------------
+
+// NewMsgDetokenizeScopeRequest creates a new msg instance
+func NewMsgDetokenizeScopeRequest(scopeID MetadataAddress, valueOwnerAddress string, signers []string) *MsgDetokenizeScopeRequest {
+	return &MsgDetokenizeScopeRequest{
+		ScopeId:           scopeID,
+		ValueOwnerAddress: valueOwnerAddress,
+		Signers:           signers,
+	}
+}
+
+func (msg MsgDetokenizeScopeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgDetokenizeScopeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgDetokenizeScopeRequest) Type() string {
+	return TypeMsgDetokenizeScopeRequest
+}
+
+func (msg MsgDetokenizeScopeRequest) MsgTypeURL() string {
+	return TypeURLMsgDetokenizeScopeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDetokenizeScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDetokenizeScopeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgDetokenizeScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.ValueOwnerAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.ValueOwnerAddress); err != nil {
+			return fmt.Errorf("invalid value owner address: %w", err)
+		}
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgCloneScopeRequest  ------------------
+
+// NewMsgCloneScopeRequest creates a new msg instance
+func NewMsgCloneScopeRequest(sourceScopeID, scopeID MetadataAddress, includeRecords bool, sessionID MetadataAddress, signers []string) *MsgCloneScopeRequest {
+	return &MsgCloneScopeRequest{
+		SourceScopeId:  sourceScopeID,
+		ScopeId:        scopeID,
+		IncludeRecords: includeRecords,
+		SessionId:      sessionID,
+		Signers:        signers,
+	}
+}
+
+func (msg MsgCloneScopeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgCloneScopeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgCloneScopeRequest) Type() string {
+	return TypeMsgCloneScopeRequest
+}
+
+func (msg MsgCloneScopeRequest) MsgTypeURL() string {
+	return TypeURLMsgCloneScopeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCloneScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgCloneScopeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgCloneScopeRequest) ValidateBasic() error {
+	if !msg.SourceScopeId.IsScopeAddress() {
+		return fmt.Errorf("source address is not a scope id: %v", msg.SourceScopeId.String())
+	}
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if msg.ScopeId.Equals(msg.SourceScopeId) {
+		return fmt.Errorf("scope id %s cannot be the same as the source scope id", msg.ScopeId)
+	}
+	if msg.IncludeRecords {
+		if !msg.SessionId.IsSessionAddress() {
+			return fmt.Errorf("address is not a session id: %v", msg.SessionId.String())
+		}
+		if scopeID := msg.SessionId.MustGetAsScopeAddress(); !scopeID.Equals(msg.ScopeId) {
+			return fmt.Errorf("session id %s is not part of scope %s", msg.SessionId, msg.ScopeId)
+		}
+	} else if len(msg.SessionId) > 0 {
+		return fmt.Errorf("session id %s is only used when records are included", msg.SessionId)
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgOfferValueOwnerTransferRequest  ------------------
+
+// NewMsgOfferValueOwnerTransferRequest creates a new msg instance
+func NewMsgOfferValueOwnerTransferRequest(scopeID MetadataAddress, buyer string, price sdk.Coins, expiration time.Time, signers []string) *MsgOfferValueOwnerTransferRequest {
+	return &MsgOfferValueOwnerTransferRequest{
+		ScopeId:    scopeID,
+		Buyer:      buyer,
+		Price:      price,
+		Expiration: expiration,
+		Signers:    signers,
+	}
+}
+
+func (msg MsgOfferValueOwnerTransferRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgOfferValueOwnerTransferRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgOfferValueOwnerTransferRequest) Type() string {
+	return TypeMsgOfferValueOwnerTransferRequest
+}
+
+func (msg MsgOfferValueOwnerTransferRequest) MsgTypeURL() string {
+	return TypeURLMsgOfferValueOwnerTransferRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgOfferValueOwnerTransferRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgOfferValueOwnerTransferRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgOfferValueOwnerTransferRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address %s: %w", msg.Buyer, err)
+	}
+	if err := validateTransferPrice(msg.Price); err != nil {
+		return err
+	}
+	if msg.Expiration.IsZero() {
+		return fmt.Errorf("offer expiration is required")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgAcceptValueOwnerTransferRequest  ------------------
+
+// NewMsgAcceptValueOwnerTransferRequest creates a new msg instance
+func NewMsgAcceptValueOwnerTransferRequest(scopeID MetadataAddress, buyer string, price sdk.Coins, signers []string) *MsgAcceptValueOwnerTransferRequest {
+	return &MsgAcceptValueOwnerTransferRequest{
+		ScopeId: scopeID,
+		Buyer:   buyer,
+		Price:   price,
+		Signers: signers,
+	}
+}
+
+func (msg MsgAcceptValueOwnerTransferRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgAcceptValueOwnerTransferRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgAcceptValueOwnerTransferRequest) Type() string {
+	return TypeMsgAcceptValueOwnerTransferRequest
+}
+
+func (msg MsgAcceptValueOwnerTransferRequest) MsgTypeURL() string {
+	return TypeURLMsgAcceptValueOwnerTransferRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAcceptValueOwnerTransferRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAcceptValueOwnerTransferRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgAcceptValueOwnerTransferRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address %s: %w", msg.Buyer, err)
+	}
+	if err := validateTransferPrice(msg.Price); err != nil {
+		return err
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgCancelValueOwnerTransferRequest  ------------------
+
+// NewMsgCancelValueOwnerTransferRequest creates a new msg instance
+func NewMsgCancelValueOwnerTransferRequest(scopeID MetadataAddress, buyer string, signers []string) *MsgCancelValueOwnerTransferRequest {
+	return &MsgCancelValueOwnerTransferRequest{
+		ScopeId: scopeID,
+		Buyer:   buyer,
+		Signers: signers,
+	}
+}
+
+func (msg MsgCancelValueOwnerTransferRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgCancelValueOwnerTransferRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgCancelValueOwnerTransferRequest) Type() string {
+	return TypeMsgCancelValueOwnerTransferRequest
+}
+
+func (msg MsgCancelValueOwnerTransferRequest) MsgTypeURL() string {
+	return TypeURLMsgCancelValueOwnerTransferRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelValueOwnerTransferRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgCancelValueOwnerTransferRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgCancelValueOwnerTransferRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address %s: %w", msg.Buyer, err)
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// validateTransferPrice checks that the price of a value owner transfer is a valid, non-empty amount.
+func validateTransferPrice(price sdk.Coins) error {
+	if price.Empty() {
+		return fmt.Errorf("price cannot be empty")
+	}
+	if err := price.Validate(); err != nil {
+		return fmt.Errorf("invalid price %s: %w", price, err)
+	}
+	return nil
+}
+
 // ------------------  MsgWriteSessionRequest  ------------------
 
 // NewMsgWriteSessionRequest creates a new msg instance
@@ -600,6 +1138,11 @@ func (msg MsgWriteRecordRequest) ValidateBasic() error {
 	if len(msg.Signers) < 1 {
 		return fmt.Errorf("at least one signer is required")
 	}
+	for i, hash := range msg.ExpectedOutputHashes {
+		if len(hash) == 0 {
+			return fmt.Errorf("invalid expected output hash %d: cannot be empty", i)
+		}
+	}
 	if err := msg.ConvertOptionalFields(); err != nil {
 		return err
 	}
@@ -687,6 +1230,140 @@ func (msg MsgDeleteRecordRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgExecuteContractSessionRequest  ------------------
+
+// NewMsgExecuteContractSessionRequest creates a new msg instance
+func NewMsgExecuteContractSessionRequest(scope Scope, session Session, records []ContractSessionRecord, signers []string) *MsgExecuteContractSessionRequest {
+	return &MsgExecuteContractSessionRequest{
+		Scope:   scope,
+		Session: session,
+		Records: records,
+		Signers: signers,
+	}
+}
+
+func (msg MsgExecuteContractSessionRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgExecuteContractSessionRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgExecuteContractSessionRequest) Type() string {
+	return TypeMsgExecuteContractSessionRequest
+}
+
+func (msg MsgExecuteContractSessionRequest) MsgTypeURL() string {
+	return TypeURLMsgExecuteContractSessionRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgExecuteContractSessionRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgExecuteContractSessionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgExecuteContractSessionRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	for i, signer := range msg.Signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid signer %d: %w", i, err)
+		}
+	}
+	if err := msg.Scope.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := msg.Session.ValidateBasic(); err != nil {
+		return err
+	}
+	sessionScopeID, err := msg.Session.SessionId.AsScopeAddress()
+	if err != nil {
+		return err
+	}
+	if !msg.Scope.ScopeId.Equals(sessionScopeID) {
+		return fmt.Errorf("session %s is not part of scope %s", msg.Session.SessionId, msg.Scope.ScopeId)
+	}
+	for i, rec := range msg.Records {
+		if err := rec.Record.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record %d: %w", i, err)
+		}
+		if !msg.Session.SessionId.Equals(rec.Record.SessionId) {
+			return fmt.Errorf("record %d %q is not part of session %s", i, rec.Record.Name, msg.Session.SessionId)
+		}
+		for j, hash := range rec.OriginalOutputHashes {
+			if len(hash) == 0 {
+				return fmt.Errorf("invalid record %d original output hash %d: cannot be empty", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+// ------------------  MsgMigrateScopeSpecRequest  ------------------
+
+// NewMsgMigrateScopeSpecRequest creates a new msg instance
+func NewMsgMigrateScopeSpecRequest(scopeID, specificationID MetadataAddress, signers []string) *MsgMigrateScopeSpecRequest {
+	return &MsgMigrateScopeSpecRequest{
+		ScopeId:         scopeID,
+		SpecificationId: specificationID,
+		Signers:         signers,
+	}
+}
+
+func (msg MsgMigrateScopeSpecRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgMigrateScopeSpecRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgMigrateScopeSpecRequest) Type() string {
+	return TypeMsgMigrateScopeSpecRequest
+}
+
+func (msg MsgMigrateScopeSpecRequest) MsgTypeURL() string {
+	return TypeURLMsgMigrateScopeSpecRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgMigrateScopeSpecRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgMigrateScopeSpecRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgMigrateScopeSpecRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if !msg.SpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("address is not a scope specification id: %v", msg.SpecificationId.String())
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  MsgWriteScopeSpecificationRequest  ------------------
 
 // NewMsgAddScopeSpecificationRequest creates a new msg instance
@@ -1174,6 +1851,97 @@ func (msg MsgDeleteRecordSpecificationRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgWriteRecordTypeRequest  ------------------
+
+// NewMsgWriteRecordTypeRequest creates a new msg instance
+func NewMsgWriteRecordTypeRequest(recordType RecordType, signers []string) *MsgWriteRecordTypeRequest {
+	return &MsgWriteRecordTypeRequest{RecordType: recordType, Signers: signers}
+}
+
+func (msg MsgWriteRecordTypeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgWriteRecordTypeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgWriteRecordTypeRequest) Type() string {
+	return TypeMsgWriteRecordTypeRequest
+}
+
+func (msg MsgWriteRecordTypeRequest) MsgTypeURL() string {
+	return TypeURLMsgWriteRecordTypeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgWriteRecordTypeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgWriteRecordTypeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgWriteRecordTypeRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return msg.RecordType.ValidateBasic()
+}
+
+// ------------------  MsgDeleteRecordTypeRequest  ------------------
+
+// NewMsgDeleteRecordTypeRequest creates a new msg instance
+func NewMsgDeleteRecordTypeRequest(name string, signers []string) *MsgDeleteRecordTypeRequest {
+	return &MsgDeleteRecordTypeRequest{Name: name, Signers: signers}
+}
+
+func (msg MsgDeleteRecordTypeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgDeleteRecordTypeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgDeleteRecordTypeRequest) Type() string {
+	return TypeMsgDeleteRecordTypeRequest
+}
+
+func (msg MsgDeleteRecordTypeRequest) MsgTypeURL() string {
+	return TypeURLMsgDeleteRecordTypeRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDeleteRecordTypeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDeleteRecordTypeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgDeleteRecordTypeRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if len(msg.Name) == 0 {
+		return errors.New("record type name cannot be empty")
+	}
+	return nil
+}
+
 // ------------------  MsgP8EMemorializeContractRequest  ------------------
 
 // NewMsgP8EMemorializeContractRequest creates a new msg instance
@@ -1238,7 +2006,7 @@ func (msg MsgBindOSLocatorRequest) MsgTypeURL() string {
 }
 
 func (msg MsgBindOSLocatorRequest) ValidateBasic() error {
-	err := ValidateOSLocatorObj(msg.Locator.Owner, msg.Locator.EncryptionKey, msg.Locator.LocatorUri)
+	err := msg.Locator.ValidateBasic()
 	if err != nil {
 		return err
 	}
@@ -1344,7 +2112,7 @@ func (msg MsgModifyOSLocatorRequest) MsgTypeURL() string {
 }
 
 func (msg MsgModifyOSLocatorRequest) ValidateBasic() error {
-	err := ValidateOSLocatorObj(msg.Locator.Owner, msg.Locator.EncryptionKey, msg.Locator.LocatorUri)
+	err := msg.Locator.ValidateBasic()
 	if err != nil {
 		return err
 	}
@@ -1430,6 +2198,46 @@ func NewMsgDeleteScopeOwnerResponse() *MsgDeleteScopeOwnerResponse {
 	return &MsgDeleteScopeOwnerResponse{}
 }
 
+func NewMsgLockScopeResponse() *MsgLockScopeResponse {
+	return &MsgLockScopeResponse{}
+}
+
+func NewMsgUnlockScopeResponse() *MsgUnlockScopeResponse {
+	return &MsgUnlockScopeResponse{}
+}
+
+func NewMsgTokenizeScopeResponse(denom string) *MsgTokenizeScopeResponse {
+	return &MsgTokenizeScopeResponse{Denom: denom}
+}
+
+func NewMsgDetokenizeScopeResponse() *MsgDetokenizeScopeResponse {
+	return &MsgDetokenizeScopeResponse{}
+}
+
+func NewMsgCloneScopeResponse(scopeID MetadataAddress, recordIDs []MetadataAddress) *MsgCloneScopeResponse {
+	rv := &MsgCloneScopeResponse{ScopeIdInfo: GetScopeIDInfo(scopeID)}
+	for _, recordID := range recordIDs {
+		rv.RecordIdInfos = append(rv.RecordIdInfos, GetRecordIDInfo(recordID))
+	}
+	return rv
+}
+
+func NewMsgOfferValueOwnerTransferResponse() *MsgOfferValueOwnerTransferResponse {
+	return &MsgOfferValueOwnerTransferResponse{}
+}
+
+func NewMsgAcceptValueOwnerTransferResponse() *MsgAcceptValueOwnerTransferResponse {
+	return &MsgAcceptValueOwnerTransferResponse{}
+}
+
+func NewMsgCancelValueOwnerTransferResponse() *MsgCancelValueOwnerTransferResponse {
+	return &MsgCancelValueOwnerTransferResponse{}
+}
+
+func NewMsgMigrateScopeSpecResponse() *MsgMigrateScopeSpecResponse {
+	return &MsgMigrateScopeSpecResponse{}
+}
+
 func NewMsgWriteSessionResponse(sessionID MetadataAddress) *MsgWriteSessionResponse {
 	return &MsgWriteSessionResponse{
 		SessionIdInfo: GetSessionIDInfo(sessionID),
@@ -1446,6 +2254,18 @@ func NewMsgDeleteRecordResponse() *MsgDeleteRecordResponse {
 	return &MsgDeleteRecordResponse{}
 }
 
+func NewMsgExecuteContractSessionResponse(
+	scopeIDInfo *ScopeIdInfo,
+	sessionIDInfo *SessionIdInfo,
+	recordIDInfos []*RecordIdInfo,
+) *MsgExecuteContractSessionResponse {
+	return &MsgExecuteContractSessionResponse{
+		ScopeIdInfo:   scopeIDInfo,
+		SessionIdInfo: sessionIDInfo,
+		RecordIdInfos: recordIDInfos,
+	}
+}
+
 func NewMsgWriteScopeSpecificationResponse(scopeSpecID MetadataAddress) *MsgWriteScopeSpecificationResponse {
 	return &MsgWriteScopeSpecificationResponse{
 		ScopeSpecIdInfo: GetScopeSpecIDInfo(scopeSpecID),
@@ -1484,6 +2304,14 @@ func NewMsgDeleteRecordSpecificationResponse() *MsgDeleteRecordSpecificationResp
 	return &MsgDeleteRecordSpecificationResponse{}
 }
 
+func NewMsgWriteRecordTypeResponse() *MsgWriteRecordTypeResponse {
+	return &MsgWriteRecordTypeResponse{}
+}
+
+func NewMsgDeleteRecordTypeResponse() *MsgDeleteRecordTypeResponse {
+	return &MsgDeleteRecordTypeResponse{}
+}
+
 func NewMsgWriteP8EContractSpecResponse(
 	contractSpecID MetadataAddress,
 	recordSpecIDs ...MetadataAddress,
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -94,6 +95,26 @@ func TestWriteScopeValidation(t *testing.T) {
 	x, err := hex.DecodeString("85EA54E8598B27EC37EAEEEEA44F1E78A9B5E671")
 	require.NoError(t, err)
 	require.Equal(t, sdk.AccAddress(x), requiredSigners[0])
+
+	msg.ExpectedValueHash = []byte("not a hash")
+	err = msg.ValidateBasic()
+	require.EqualError(t, err, "invalid expected value hash: expected 32 bytes, got 10")
+	msg.ExpectedValueHash = make([]byte, 32)
+	require.NoError(t, msg.ValidateBasic(), "valid expected value hash")
+}
+
+func TestWriteRecordExpectedOutputHashesValidation(t *testing.T) {
+	scopeUUID := uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	process := NewProcess("processname", &Process_Hash{Hash: "HASH"}, "process_method")
+	record := NewRecord("recordname", SessionMetadataAddress(scopeUUID, uuid.MustParse("22fc17a6-40dd-4d68-a95b-ec94e7572a09")), *process,
+		[]RecordInput{}, []RecordOutput{{Hash: "out2", Status: ResultStatus_RESULT_STATUS_PASS}},
+		RecordSpecMetadataAddress(uuid.MustParse("c2074a03-43f6-4e35-8f5c-2d2d4de9b9f4"), "recordname"))
+	msg := NewMsgWriteRecordRequest(*record, nil, "", []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}, []Party{})
+
+	msg.ExpectedOutputHashes = []string{"out1", ""}
+	require.EqualError(t, msg.ValidateBasic(), "invalid expected output hash 1: cannot be empty")
+	msg.ExpectedOutputHashes = []string{"out1"}
+	require.NoError(t, msg.ValidateBasic(), "valid expected output hashes")
 }
 
 func TestAddScopeDataAccessValidateBasic(t *testing.T) {
@@ -325,6 +346,369 @@ func TestDeleteScopeOwnerValidateBasic(t *testing.T) {
 	}
 }
 
+func TestLockScopeValidateBasic(t *testing.T) {
+	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
+	actualScopeId := ScopeMetadataAddress(uuid.New())
+	locker := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	other := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	cases := map[string]struct {
+		msg      *MsgLockScopeRequest
+		wantErr  bool
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgLockScopeRequest(notAScopeId, locker, "reason", []string{locker}),
+			true,
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"should fail to validate basic, incorrect locker address format": {
+			NewMsgLockScopeRequest(actualScopeId, "notabech32address", "reason", []string{locker}),
+			true,
+			"locker address is invalid: notabech32address",
+		},
+		"should fail to validate basic, requires a reason": {
+			NewMsgLockScopeRequest(actualScopeId, locker, "  ", []string{locker}),
+			true,
+			"a reason for locking the scope is required",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgLockScopeRequest(actualScopeId, locker, "reason", []string{}),
+			true,
+			"at least one signer is required",
+		},
+		"should fail to validate basic, locker must be a signer": {
+			NewMsgLockScopeRequest(actualScopeId, locker, "reason", []string{other}),
+			true,
+			fmt.Sprintf("locker %s must be one of the signers", locker),
+		},
+		"should successfully validate basic": {
+			NewMsgLockScopeRequest(actualScopeId, locker, "reason", []string{other, locker}),
+			false,
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenizeScopeValidateBasic(t *testing.T) {
+	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
+	actualScopeId := ScopeMetadataAddress(uuid.New())
+	signer := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	agent := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	cases := map[string]struct {
+		msg      *MsgTokenizeScopeRequest
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgTokenizeScopeRequest(notAScopeId, "", nil, []string{signer}),
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"should fail to validate basic, incorrect recipient address format": {
+			NewMsgTokenizeScopeRequest(actualScopeId, "notabech32address", nil, []string{signer}),
+			"invalid recipient: decoding bech32 failed: invalid separator index -1",
+		},
+		"should fail to validate basic, incorrect transfer agent address format": {
+			NewMsgTokenizeScopeRequest(actualScopeId, "", []string{agent, "notabech32address"}, []string{signer}),
+			"invalid transfer agent at index 1: decoding bech32 failed: invalid separator index -1",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgTokenizeScopeRequest(actualScopeId, "", nil, []string{}),
+			"at least one signer is required",
+		},
+		"should successfully validate basic without recipient or transfer agents": {
+			NewMsgTokenizeScopeRequest(actualScopeId, "", nil, []string{signer}),
+			"",
+		},
+		"should successfully validate basic with recipient and transfer agents": {
+			NewMsgTokenizeScopeRequest(actualScopeId, signer, []string{agent}, []string{signer}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDetokenizeScopeValidateBasic(t *testing.T) {
+	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
+	actualScopeId := ScopeMetadataAddress(uuid.New())
+	signer := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	cases := map[string]struct {
+		msg      *MsgDetokenizeScopeRequest
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgDetokenizeScopeRequest(notAScopeId, "", []string{signer}),
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"should fail to validate basic, incorrect value owner address format": {
+			NewMsgDetokenizeScopeRequest(actualScopeId, "notabech32address", []string{signer}),
+			"invalid value owner address: decoding bech32 failed: invalid separator index -1",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgDetokenizeScopeRequest(actualScopeId, "", []string{}),
+			"at least one signer is required",
+		},
+		"should successfully validate basic": {
+			NewMsgDetokenizeScopeRequest(actualScopeId, signer, []string{signer}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloneScopeValidateBasic(t *testing.T) {
+	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
+	sourceScopeId := ScopeMetadataAddress(uuid.New())
+	scopeUUID := uuid.New()
+	scopeId := ScopeMetadataAddress(scopeUUID)
+	sessionId := SessionMetadataAddress(scopeUUID, uuid.New())
+	otherSessionId := SessionMetadataAddress(uuid.New(), uuid.New())
+	signer := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	cases := map[string]struct {
+		msg      *MsgCloneScopeRequest
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect source scope id type": {
+			NewMsgCloneScopeRequest(notAScopeId, scopeId, false, nil, []string{signer}),
+			fmt.Sprintf("source address is not a scope id: %v", notAScopeId.String()),
+		},
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgCloneScopeRequest(sourceScopeId, notAScopeId, false, nil, []string{signer}),
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"should fail to validate basic, scope id same as source": {
+			NewMsgCloneScopeRequest(sourceScopeId, sourceScopeId, false, nil, []string{signer}),
+			fmt.Sprintf("scope id %s cannot be the same as the source scope id", sourceScopeId),
+		},
+		"should fail to validate basic, records without a session id": {
+			NewMsgCloneScopeRequest(sourceScopeId, scopeId, true, nil, []string{signer}),
+			"address is not a session id: ",
+		},
+		"should fail to validate basic, session id in another scope": {
+			NewMsgCloneScopeRequest(sourceScopeId, scopeId, true, otherSessionId, []string{signer}),
+			fmt.Sprintf("session id %s is not part of scope %s", otherSessionId, scopeId),
+		},
+		"should fail to validate basic, session id without records": {
+			NewMsgCloneScopeRequest(sourceScopeId, scopeId, false, sessionId, []string{signer}),
+			fmt.Sprintf("session id %s is only used when records are included", sessionId),
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgCloneScopeRequest(sourceScopeId, scopeId, false, nil, []string{}),
+			"at least one signer is required",
+		},
+		"should successfully validate basic without records": {
+			NewMsgCloneScopeRequest(sourceScopeId, scopeId, false, nil, []string{signer}),
+			"",
+		},
+		"should successfully validate basic with records": {
+			NewMsgCloneScopeRequest(sourceScopeId, scopeId, true, sessionId, []string{signer}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValueOwnerTransferValidateBasic(t *testing.T) {
+	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
+	scopeId := ScopeMetadataAddress(uuid.New())
+	buyer := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	signer := "cosmos1tj3mhmnc8yzt4xzgskuhrzc3ggjvknnfjm6f9n"
+	price := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+	expiration := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		"offer, incorrect scope id type": {
+			NewMsgOfferValueOwnerTransferRequest(notAScopeId, buyer, price, expiration, []string{signer}),
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"offer, invalid buyer": {
+			NewMsgOfferValueOwnerTransferRequest(scopeId, "notabuyer", price, expiration, []string{signer}),
+			"invalid buyer address notabuyer: decoding bech32 failed: invalid separator index -1",
+		},
+		"offer, empty price": {
+			NewMsgOfferValueOwnerTransferRequest(scopeId, buyer, sdk.Coins{}, expiration, []string{signer}),
+			"price cannot be empty",
+		},
+		"offer, zero price": {
+			NewMsgOfferValueOwnerTransferRequest(scopeId, buyer, sdk.Coins{sdk.NewInt64Coin("nhash", 0)}, expiration, []string{signer}),
+			"invalid price 0nhash: coin 0nhash amount is not positive",
+		},
+		"offer, no expiration": {
+			NewMsgOfferValueOwnerTransferRequest(scopeId, buyer, price, time.Time{}, []string{signer}),
+			"offer expiration is required",
+		},
+		"offer, no signers": {
+			NewMsgOfferValueOwnerTransferRequest(scopeId, buyer, price, expiration, []string{}),
+			"at least one signer is required",
+		},
+		"offer, valid": {
+			NewMsgOfferValueOwnerTransferRequest(scopeId, buyer, price, expiration, []string{signer}),
+			"",
+		},
+		"accept, incorrect scope id type": {
+			NewMsgAcceptValueOwnerTransferRequest(notAScopeId, buyer, price, []string{buyer}),
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"accept, empty price": {
+			NewMsgAcceptValueOwnerTransferRequest(scopeId, buyer, nil, []string{buyer}),
+			"price cannot be empty",
+		},
+		"accept, no signers": {
+			NewMsgAcceptValueOwnerTransferRequest(scopeId, buyer, price, nil),
+			"at least one signer is required",
+		},
+		"accept, valid": {
+			NewMsgAcceptValueOwnerTransferRequest(scopeId, buyer, price, []string{buyer}),
+			"",
+		},
+		"cancel, invalid buyer": {
+			NewMsgCancelValueOwnerTransferRequest(scopeId, "", []string{signer}),
+			"invalid buyer address : empty address string is not allowed",
+		},
+		"cancel, valid": {
+			NewMsgCancelValueOwnerTransferRequest(scopeId, buyer, []string{signer}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExecuteContractSessionValidateBasic(t *testing.T) {
+	signer := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	otherSessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+	scope := *NewScope(scopeID, ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(signer), []string{signer}, signer)
+	session := *NewSession("name", sessionID, ContractSpecMetadataAddress(uuid.New()), ownerPartyList(signer), nil)
+	newRecord := func(sessionID MetadataAddress, originalOutputHashes ...string) ContractSessionRecord {
+		return ContractSessionRecord{
+			Record: *NewRecord("record", sessionID, *NewProcess("process", &Process_Hash{Hash: "processhash"}, "method"),
+				[]RecordInput{}, []RecordOutput{*NewRecordOutput("outputhash", ResultStatus_RESULT_STATUS_PASS)}, nil),
+			OriginalOutputHashes: originalOutputHashes,
+		}
+	}
+
+	cases := map[string]struct {
+		msg      *MsgExecuteContractSessionRequest
+		errorMsg string
+	}{
+		"no signers": {
+			NewMsgExecuteContractSessionRequest(scope, session, []ContractSessionRecord{newRecord(sessionID)}, nil),
+			"at least one signer is required",
+		},
+		"invalid signer": {
+			NewMsgExecuteContractSessionRequest(scope, session, []ContractSessionRecord{newRecord(sessionID)}, []string{"notasigner"}),
+			"invalid signer 0: decoding bech32 failed: invalid separator index -1",
+		},
+		"invalid scope": {
+			NewMsgExecuteContractSessionRequest(Scope{}, session, []ContractSessionRecord{newRecord(sessionID)}, []string{signer}),
+			"address is empty",
+		},
+		"session not in scope": {
+			NewMsgExecuteContractSessionRequest(scope, *NewSession("name", otherSessionID, session.SpecificationId, session.Parties, nil),
+				[]ContractSessionRecord{newRecord(otherSessionID)}, []string{signer}),
+			fmt.Sprintf("session %s is not part of scope %s", otherSessionID, scopeID),
+		},
+		"record not in session": {
+			NewMsgExecuteContractSessionRequest(scope, session, []ContractSessionRecord{newRecord(SessionMetadataAddress(scopeUUID, uuid.New()))}, []string{signer}),
+			fmt.Sprintf("record 0 \"record\" is not part of session %s", sessionID),
+		},
+		"empty original output hash": {
+			NewMsgExecuteContractSessionRequest(scope, session, []ContractSessionRecord{newRecord(sessionID, "")}, []string{signer}),
+			"invalid record 0 original output hash 0: cannot be empty",
+		},
+		"valid": {
+			NewMsgExecuteContractSessionRequest(scope, session, []ContractSessionRecord{newRecord(sessionID, "ancestorhash")}, []string{signer}),
+			"",
+		},
+		"valid without records": {
+			NewMsgExecuteContractSessionRequest(scope, session, nil, []string{signer}),
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgAddContractSpecToScopeSpecRequestValidateBasic(t *testing.T) {
 	contractSpecID := ContractSpecMetadataAddress(uuid.New())
 	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
@@ -371,6 +755,53 @@ func TestMsgAddContractSpecToScopeSpecRequestValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgMigrateScopeSpecRequestValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	signers := []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}
+
+	cases := map[string]struct {
+		msg      *MsgMigrateScopeSpecRequest
+		wantErr  bool
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgMigrateScopeSpecRequest(scopeSpecID, scopeSpecID, signers),
+			true,
+			fmt.Sprintf("address is not a scope id: %v", scopeSpecID.String()),
+		},
+		"should fail to validate basic, incorrect scope spec id type": {
+			NewMsgMigrateScopeSpecRequest(scopeID, scopeID, signers),
+			true,
+			fmt.Sprintf("address is not a scope specification id: %v", scopeID.String()),
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgMigrateScopeSpecRequest(scopeID, scopeSpecID, []string{}),
+			true,
+			"at least one signer is required",
+		},
+		"should successfully validate basic": {
+			NewMsgMigrateScopeSpecRequest(scopeID, scopeSpecID, signers),
+			false,
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgDeleteContractSpecFromScopeSpecRequestValidateBasic(t *testing.T) {
 	contractSpecID := ContractSpecMetadataAddress(uuid.New())
 	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
@@ -543,9 +974,19 @@ func TestPrintMessageTypeStrings(t *testing.T) {
 		&MsgDeleteScopeDataAccessRequest{},
 		&MsgAddScopeOwnerRequest{},
 		&MsgDeleteScopeOwnerRequest{},
+		&MsgLockScopeRequest{},
+		&MsgUnlockScopeRequest{},
+		&MsgTokenizeScopeRequest{},
+		&MsgDetokenizeScopeRequest{},
+		&MsgCloneScopeRequest{},
+		&MsgOfferValueOwnerTransferRequest{},
+		&MsgAcceptValueOwnerTransferRequest{},
+		&MsgCancelValueOwnerTransferRequest{},
 		&MsgWriteSessionRequest{},
 		&MsgWriteRecordRequest{},
 		&MsgDeleteRecordRequest{},
+		&MsgExecuteContractSessionRequest{},
+		&MsgMigrateScopeSpecRequest{},
 		&MsgWriteScopeSpecificationRequest{},
 		&MsgDeleteScopeSpecificationRequest{},
 		&MsgWriteContractSpecificationRequest{},
@@ -554,6 +995,8 @@ func TestPrintMessageTypeStrings(t *testing.T) {
 		&MsgDeleteContractSpecFromScopeSpecRequest{},
 		&MsgWriteRecordSpecificationRequest{},
 		&MsgDeleteRecordSpecificationRequest{},
+		&MsgWriteRecordTypeRequest{},
+		&MsgDeleteRecordTypeRequest{},
 		&MsgBindOSLocatorRequest{},
 		&MsgDeleteOSLocatorRequest{},
 		&MsgModifyOSLocatorRequest{},
@@ -1,9 +1,15 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxOSLocatorNameLength is the maximum length of an object store locator's name.
+const MaxOSLocatorNameLength = 64
+
 // NewOSLocatorRecord creates a oslocator for a given address.
 func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) ObjectStoreLocator { //nolint:interfacer
 	return ObjectStoreLocator{
@@ -12,3 +18,40 @@ func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) Obj
 		EncryptionKey: encryptionKey.String(),
 	}
 }
+
+// ValidateBasic performs a static check on the locator's fields.
+func (l ObjectStoreLocator) ValidateBasic() error {
+	if err := ValidateOSLocatorObj(l.Owner, l.EncryptionKey, l.LocatorUri); err != nil {
+		return err
+	}
+	if len(l.Name) > MaxOSLocatorNameLength {
+		return fmt.Errorf("locator name length %d exceeds maximum length of %d", len(l.Name), MaxOSLocatorNameLength)
+	}
+	if strings.TrimSpace(l.Name) != l.Name {
+		return fmt.Errorf("locator name [%s] cannot have leading or trailing whitespace", l.Name)
+	}
+	seen := make(map[string]bool, len(l.ScopeSpecIds))
+	for _, specID := range l.ScopeSpecIds {
+		if !specID.IsScopeSpecificationAddress() {
+			return fmt.Errorf("invalid scope specification id: %s", specID)
+		}
+		if seen[string(specID)] {
+			return fmt.Errorf("duplicate scope specification id: %s", specID)
+		}
+		seen[string(specID)] = true
+	}
+	return nil
+}
+
+// AppliesToScopeSpec returns true if this locator should be used for scopes with the given scope specification.
+func (l ObjectStoreLocator) AppliesToScopeSpec(scopeSpecID MetadataAddress) bool {
+	if len(l.ScopeSpecIds) == 0 {
+		return true
+	}
+	for _, specID := range l.ScopeSpecIds {
+		if specID.Equals(scopeSpecID) {
+			return true
+		}
+	}
+	return false
+}
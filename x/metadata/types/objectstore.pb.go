@@ -32,6 +32,14 @@ type ObjectStoreLocator struct {
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// name distinguishes this locator from the owner's other locators.
+	// An owner can have one locator without a name.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// priority is used to order an owner's locators, lowest first.
+	Priority uint32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// scope_spec_ids limits this locator to scopes using one of these scope specifications.
+	// If empty, this locator applies to all scopes.
+	ScopeSpecIds []MetadataAddress `protobuf:"bytes,6,rep,name=scope_spec_ids,json=scopeSpecIds,proto3,customtype=MetadataAddress" json:"scope_spec_ids,omitempty" yaml:"scope_spec_ids"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -88,6 +96,20 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ObjectStoreLocator) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length" yaml:"max_uri_length"`
@@ -136,27 +158,33 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4e, 0x32, 0x31,
-	0x1c, 0xc4, 0xb7, 0xdf, 0x17, 0x49, 0xac, 0x82, 0x49, 0x83, 0x06, 0x3d, 0x74, 0xcd, 0x26, 0x46,
-	0x2e, 0x6e, 0x83, 0x78, 0xf2, 0xc8, 0x55, 0x0c, 0x04, 0xc2, 0xc5, 0x0b, 0x96, 0xb5, 0x59, 0x2a,
-	0xb4, 0xff, 0x4d, 0x29, 0xc8, 0xbe, 0x85, 0x8f, 0xc5, 0x91, 0xa3, 0xf1, 0x40, 0x0c, 0xbc, 0x81,
-	0x4f, 0x60, 0xe8, 0x62, 0x56, 0x13, 0x6f, 0x9d, 0xe9, 0x2f, 0x33, 0xf9, 0x0f, 0xae, 0x26, 0x06,
-	0x66, 0x42, 0x73, 0x1d, 0x09, 0xa6, 0x84, 0xe5, 0x4f, 0xdc, 0x72, 0x36, 0xab, 0x31, 0x18, 0x3c,
-	0x8b, 0xc8, 0x4e, 0x2c, 0x18, 0x11, 0x26, 0x06, 0x2c, 0x90, 0x93, 0x9c, 0x0c, 0xbf, 0xc9, 0x70,
-	0x56, 0x3b, 0x2b, 0xc7, 0x10, 0x83, 0x43, 0xd8, 0xf6, 0x95, 0xd1, 0x81, 0xc1, 0xa4, 0xe5, 0x22,
-	0xba, 0xdb, 0x88, 0x26, 0x44, 0xdc, 0x82, 0x21, 0x65, 0xbc, 0x07, 0x2f, 0x5a, 0x98, 0x0a, 0x3a,
-	0x47, 0xd5, 0xfd, 0x4e, 0x26, 0x88, 0x8f, 0x0f, 0xc6, 0x19, 0xd0, 0x9f, 0x1a, 0x59, 0xf9, 0xe7,
-	0xfe, 0xf0, 0xce, 0xea, 0x19, 0x49, 0x2e, 0x70, 0x49, 0xe8, 0xc8, 0xa4, 0x89, 0x95, 0xa0, 0xfb,
-	0x23, 0x91, 0x56, 0xfe, 0x3b, 0xa6, 0x98, 0xbb, 0x77, 0x22, 0x0d, 0x1e, 0xf1, 0x51, 0xab, 0xbb,
-	0xab, 0x6a, 0x73, 0xc3, 0xd5, 0x84, 0xdc, 0xe3, 0x92, 0xe2, 0xf3, 0x6d, 0x6c, 0x7f, 0x2c, 0x74,
-	0x6c, 0x87, 0xae, 0xb9, 0xd8, 0xb8, 0x5c, 0xac, 0x7c, 0xef, 0x7d, 0xe5, 0x17, 0xa6, 0x52, 0xdb,
-	0xfa, 0xf5, 0xe7, 0xca, 0x3f, 0x4e, 0xb9, 0x1a, 0xdf, 0x06, 0xbf, 0xe9, 0xa0, 0x73, 0xa8, 0xf8,
-	0xbc, 0x67, 0x64, 0xd3, 0xc9, 0xc6, 0x68, 0xb1, 0xa6, 0x68, 0xb9, 0xa6, 0xe8, 0x63, 0x4d, 0xd1,
-	0xeb, 0x86, 0x7a, 0xcb, 0x0d, 0xf5, 0xde, 0x36, 0xd4, 0xc3, 0xa7, 0xd2, 0x5d, 0xfe, 0xc7, 0x40,
-	0x6d, 0xf4, 0x70, 0x13, 0x4b, 0x3b, 0x9c, 0x0e, 0xc2, 0x08, 0x14, 0xcb, 0xa1, 0x2b, 0x09, 0x3f,
-	0x14, 0x9b, 0xe7, 0xfb, 0xdb, 0x34, 0x11, 0x93, 0x41, 0xc1, 0x2d, 0x59, 0xff, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x0b, 0x65, 0x9a, 0xf7, 0xa3, 0x01, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x8e, 0x13, 0x31,
+	0x10, 0xc6, 0xb3, 0xf7, 0x27, 0x02, 0x93, 0xe4, 0x24, 0xeb, 0x40, 0xcb, 0x15, 0xbb, 0xd1, 0x4a,
+	0x88, 0x14, 0xb0, 0xab, 0xe3, 0xa8, 0xe8, 0x48, 0x87, 0xc8, 0xe9, 0x4e, 0x89, 0xae, 0xa1, 0x59,
+	0x1c, 0xef, 0x28, 0x67, 0x2e, 0xf6, 0x58, 0xb6, 0x13, 0xb2, 0x6f, 0x41, 0xc5, 0x33, 0x5d, 0x99,
+	0x12, 0x51, 0x44, 0x28, 0xe9, 0x28, 0x79, 0x02, 0x14, 0x67, 0x61, 0x89, 0x44, 0x37, 0xdf, 0x37,
+	0x3f, 0x8f, 0x3d, 0x33, 0x26, 0x3d, 0x6d, 0x70, 0x0e, 0x8a, 0x29, 0x0e, 0x99, 0x04, 0xc7, 0x0a,
+	0xe6, 0x58, 0x36, 0x3f, 0xcf, 0x70, 0xfc, 0x09, 0xb8, 0xb3, 0x0e, 0x0d, 0xa4, 0xda, 0xa0, 0x43,
+	0xfa, 0xa4, 0x26, 0xd3, 0x3f, 0x64, 0x3a, 0x3f, 0x3f, 0x3b, 0x9d, 0xe0, 0x04, 0x3d, 0x92, 0x6d,
+	0xa3, 0x1d, 0x9d, 0x7c, 0x3d, 0x20, 0xf4, 0xca, 0xd7, 0x18, 0x6d, 0x6b, 0x0c, 0x90, 0x33, 0x87,
+	0x86, 0x9e, 0x92, 0x63, 0xfc, 0xac, 0xc0, 0x84, 0x41, 0x37, 0xe8, 0x3d, 0x1c, 0xee, 0x04, 0x8d,
+	0xc9, 0xa3, 0xe9, 0x0e, 0xc8, 0x67, 0x46, 0x84, 0x07, 0x3e, 0x47, 0x2a, 0xeb, 0xc6, 0x08, 0xfa,
+	0x8c, 0x74, 0x40, 0x71, 0x53, 0x6a, 0x27, 0x50, 0xe5, 0x77, 0x50, 0x86, 0x87, 0x9e, 0x69, 0xd7,
+	0xee, 0x7b, 0x28, 0x29, 0x25, 0x47, 0x8a, 0x49, 0x08, 0x8f, 0x7c, 0xd2, 0xc7, 0xf4, 0x8c, 0x3c,
+	0xd0, 0x46, 0xa0, 0x11, 0xae, 0x0c, 0x8f, 0xbb, 0x41, 0xaf, 0x3d, 0xfc, 0xab, 0xa9, 0x21, 0x1d,
+	0xcb, 0x51, 0x43, 0x6e, 0x35, 0xf0, 0x5c, 0x14, 0x36, 0x6c, 0x76, 0x0f, 0x7b, 0xad, 0xfe, 0xe0,
+	0x7e, 0x15, 0x37, 0xbe, 0xaf, 0xe2, 0x93, 0xcb, 0xaa, 0xcf, 0xb7, 0x45, 0x61, 0xc0, 0xda, 0x9f,
+	0xab, 0x38, 0xdc, 0x3f, 0xf0, 0x02, 0xa5, 0x70, 0x20, 0xb5, 0x2b, 0x7f, 0xad, 0xe2, 0xc7, 0x25,
+	0x93, 0xd3, 0x37, 0xc9, 0x3e, 0x91, 0x0c, 0x5b, 0xde, 0x18, 0x69, 0xe0, 0xef, 0x0a, 0x9b, 0x7c,
+	0x24, 0x27, 0x57, 0xa3, 0x6a, 0x1c, 0xd7, 0xcc, 0x30, 0x69, 0xe9, 0x25, 0xe9, 0x48, 0xb6, 0xd8,
+	0xb6, 0x9e, 0x4f, 0x41, 0x4d, 0xdc, 0xad, 0x9f, 0x4e, 0xbb, 0xff, 0xbc, 0x7a, 0x46, 0x73, 0x26,
+	0x94, 0xbb, 0x78, 0x55, 0xdf, 0xb0, 0x4f, 0x27, 0xc3, 0x96, 0x64, 0x8b, 0x1b, 0x23, 0x06, 0x5e,
+	0xf6, 0xef, 0xee, 0xd7, 0x51, 0xb0, 0x5c, 0x47, 0xc1, 0x8f, 0x75, 0x14, 0x7c, 0xd9, 0x44, 0x8d,
+	0xe5, 0x26, 0x6a, 0x7c, 0xdb, 0x44, 0x0d, 0xf2, 0x54, 0x60, 0xfa, 0xff, 0x2d, 0x5e, 0x07, 0x1f,
+	0x5e, 0x4f, 0x84, 0xbb, 0x9d, 0x8d, 0x53, 0x8e, 0x32, 0xab, 0xa1, 0x97, 0x02, 0xff, 0x51, 0xd9,
+	0xa2, 0xfe, 0x24, 0xae, 0xd4, 0x60, 0xc7, 0x4d, 0xbf, 0xee, 0x8b, 0xdf, 0x03, 0x00, 0xe0, 0x95,
+	0x35, 0xa7, 0x48, 0x02, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -179,6 +207,32 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeSpecIds) > 0 {
+		for iNdEx := len(m.ScopeSpecIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeSpecIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeSpecIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintObjectstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -260,6 +314,19 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovObjectstore(uint64(m.Priority))
+	}
+	if len(m.ScopeSpecIds) > 0 {
+		for _, e := range m.ScopeSpecIds {
+			l = e.Size()
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -406,6 +473,92 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeSpecIds = append(m.ScopeSpecIds, v)
+			if err := m.ScopeSpecIds[len(m.ScopeSpecIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
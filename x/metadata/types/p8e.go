@@ -145,6 +145,9 @@ func ConvertP8eMemorializeContractRequest(msg *MsgP8EMemorializeContractRequest)
 	}
 	var err error
 
+	if msg.Contract == nil {
+		return p8EData, fmt.Errorf("missing contract value")
+	}
 	contractRecitalParties, err := convertParties(msg.Contract.Recitals)
 	if err != nil {
 		return p8EData, err
@@ -277,6 +280,33 @@ func ConvertP8eMemorializeContractRequest(msg *MsgP8EMemorializeContractRequest)
 	return p8EData, err
 }
 
+// ConvertP8eMemorializeContractToExecuteContractSession converts a MsgP8EMemorializeContractRequest into the
+// MsgExecuteContractSessionRequest that writes the same scope, session, and records.
+// The signers are the addresses of the contract signatures followed by the invoker (if it isn't already one of them).
+// Unlike the p8e msg, all of them must sign the transaction.
+func ConvertP8eMemorializeContractToExecuteContractSession(msg *MsgP8EMemorializeContractRequest) (*MsgExecuteContractSessionRequest, error) {
+	p8EData, err := ConvertP8eMemorializeContractRequest(msg)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]ContractSessionRecord, len(p8EData.RecordReqs))
+	for i, recordReq := range p8EData.RecordReqs {
+		records[i] = ContractSessionRecord{
+			Record:               *recordReq.Record,
+			OriginalOutputHashes: recordReq.OriginalOutputHashes,
+		}
+	}
+	signers := make([]string, 0, len(p8EData.Signers)+1)
+	seen := make(map[string]bool)
+	for _, signer := range append(p8EData.Signers, msg.Invoker) {
+		if len(signer) > 0 && !seen[signer] {
+			seen[signer] = true
+			signers = append(signers, signer)
+		}
+	}
+	return NewMsgExecuteContractSessionRequest(*p8EData.Scope, *p8EData.Session, records, signers), nil
+}
+
 // emptyScope creates a new empty Scope.
 func emptyScope() *Scope {
 	return &Scope{
@@ -658,6 +658,100 @@ func (s *P8eTestSuite) TestConvertP8eMemorializeContractRequest() {
 	}
 }
 
+func (s *P8eTestSuite) TestConvertP8eMemorializeContractToExecuteContractSession() {
+	scopeUUID := uuid.New()
+	groupUUID := uuid.New()
+	scopeSpecUUID := uuid.New()
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	keys := &p8e.SigningAndEncryptionPublicKeys{
+		SigningPublicKey: &p8e.PublicKey{
+			PublicKeyBytes: s.pubkey1.Bytes(),
+			Type:           p8e.PublicKeyType_ELLIPTIC,
+			Curve:          p8e.PublicKeyCurve_SECP256K1,
+		},
+	}
+	invoker := sdk.AccAddress("invoker_____________").String()
+
+	newMsg := func() *MsgP8EMemorializeContractRequest {
+		return &MsgP8EMemorializeContractRequest{
+			ScopeId:              scopeUUID.String(),
+			GroupId:              groupUUID.String(),
+			ScopeSpecificationId: scopeSpecUUID.String(),
+			Contract: &p8e.Contract{
+				Definition: &p8e.DefinitionSpec{
+					Name:             "loan",
+					ResourceLocation: &p8e.Location{Ref: &p8e.ProvenanceReference{Hash: "contracthash"}},
+				},
+				Spec: &p8e.Fact{
+					Name:         "spec",
+					DataLocation: &p8e.Location{Ref: &p8e.ProvenanceReference{Hash: contractSpecID.String()}},
+				},
+				Invoker: keys,
+				Considerations: []*p8e.Consideration{
+					{
+						ConsiderationName: "payment",
+						Inputs:            []*p8e.ProposedFact{{Name: "amount", Hash: "inputhash", Classname: "Amount"}},
+						Result: &p8e.ExecutionResult{
+							Output: &p8e.ProposedFact{
+								Name:      "payment",
+								Hash:      "outputhash",
+								Classname: "Payment",
+								Ancestor:  &p8e.ProvenanceReference{Hash: "ancestorhash"},
+							},
+							Result: p8e.ExecutionResultType_RESULT_TYPE_PASS,
+						},
+					},
+				},
+				Recitals: []*p8e.Recital{{SignerRole: p8e.PartyType_PARTY_TYPE_OWNER, Signer: keys}},
+			},
+			Signatures: &p8e.SignatureSet{Signatures: []*p8e.Signature{{Signer: keys}, {Signer: keys}}},
+			Invoker:    invoker,
+		}
+	}
+
+	s.T().Run("same scope, session, and records as the p8e conversion", func(t *testing.T) {
+		p8eData, err := ConvertP8eMemorializeContractRequest(newMsg())
+		require.NoError(t, err, "ConvertP8eMemorializeContractRequest")
+		msg, err := ConvertP8eMemorializeContractToExecuteContractSession(newMsg())
+		require.NoError(t, err, "ConvertP8eMemorializeContractToExecuteContractSession")
+		require.NoError(t, msg.ValidateBasic(), "ValidateBasic")
+
+		assert.Equal(t, *p8eData.Scope, msg.Scope, "scope")
+		assert.Equal(t, *p8eData.Session, msg.Session, "session")
+		require.Len(t, msg.Records, 1, "records")
+		assert.Equal(t, *p8eData.RecordReqs[0].Record, msg.Records[0].Record, "record")
+		assert.Equal(t, []string{"ancestorhash"}, msg.Records[0].OriginalOutputHashes, "original output hashes")
+	})
+
+	s.T().Run("signers are deduplicated and include the invoker", func(t *testing.T) {
+		msg, err := ConvertP8eMemorializeContractToExecuteContractSession(newMsg())
+		require.NoError(t, err, "ConvertP8eMemorializeContractToExecuteContractSession")
+		assert.Equal(t, []string{s.user1, invoker}, msg.Signers, "signers")
+	})
+
+	s.T().Run("invoker that also signed is only listed once", func(t *testing.T) {
+		p8eMsg := newMsg()
+		p8eMsg.Invoker = s.user1
+		msg, err := ConvertP8eMemorializeContractToExecuteContractSession(p8eMsg)
+		require.NoError(t, err, "ConvertP8eMemorializeContractToExecuteContractSession")
+		assert.Equal(t, []string{s.user1}, msg.Signers, "signers")
+	})
+
+	s.T().Run("p8e conversion error is returned", func(t *testing.T) {
+		p8eMsg := newMsg()
+		p8eMsg.Contract.Spec = nil
+		_, err := ConvertP8eMemorializeContractToExecuteContractSession(p8eMsg)
+		assert.EqualError(t, err, "no contract.spec.datalocation.ref.hash value")
+	})
+
+	s.T().Run("missing contract is an error", func(t *testing.T) {
+		p8eMsg := newMsg()
+		p8eMsg.Contract = nil
+		_, err := ConvertP8eMemorializeContractToExecuteContractSession(p8eMsg)
+		assert.EqualError(t, err, "missing contract value")
+	})
+}
+
 func (s *P8eTestSuite) TestParsePublicKey() {
 	tests := []struct {
 		name      string
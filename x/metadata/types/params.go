@@ -1,11 +1,59 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Default parameter values
+const (
+	// DefaultHistoryRetentionBlocks is the default number of blocks to retain history for (history is disabled).
+	DefaultHistoryRetentionBlocks = uint64(0)
+	// DefaultRequireRegisteredRecordTypes is the default for whether specifications can only use registered record types.
+	DefaultRequireRegisteredRecordTypes = false
+	// DefaultMaxScopeOwners is the default maximum number of owners a scope can have.
+	DefaultMaxScopeOwners = uint32(100)
+	// DefaultMaxScopeDataAccess is the default maximum number of data access addresses a scope can have.
+	DefaultMaxScopeDataAccess = uint32(100)
+	// DefaultMaxScopeRecords is the default maximum number of records a scope can have.
+	DefaultMaxScopeRecords = uint32(1000)
+	// DefaultMaxRecordInputs is the default maximum number of inputs a record can have.
+	DefaultMaxRecordInputs = uint32(100)
+	// DefaultMaxRecordOutputs is the default maximum number of outputs a record can have.
+	DefaultMaxRecordOutputs = uint32(100)
+	// DefaultMaxSessionParties is the default maximum number of parties a session can have.
+	DefaultMaxSessionParties = uint32(100)
+	// DefaultMaxSessionContextBytes is the default maximum length of a session's context.
+	DefaultMaxSessionContextBytes = uint32(10240)
+	// DefaultEnforceResponsibleParties is the default for whether records must be signed by their responsible parties.
+	DefaultEnforceResponsibleParties = false
+	// DefaultDisableP8eMessages is the default for whether the deprecated p8e msgs are rejected.
+	DefaultDisableP8eMessages = false
+)
+
+// DefaultRecordTypeRegistrars is the default list of accounts that can register record types (none).
+var DefaultRecordTypeRegistrars = []string{}
+
+// Parameter store keys
+var (
+	ParamStoreKeyHistoryRetentionBlocks       = []byte("HistoryRetentionBlocks")
+	ParamStoreKeyRecordTypeRegistrars         = []byte("RecordTypeRegistrars")
+	ParamStoreKeyRequireRegisteredRecordTypes = []byte("RequireRegisteredRecordTypes")
+	ParamStoreKeyMaxScopeOwners               = []byte("MaxScopeOwners")
+	ParamStoreKeyMaxScopeDataAccess           = []byte("MaxScopeDataAccess")
+	ParamStoreKeyMaxScopeRecords              = []byte("MaxScopeRecords")
+	ParamStoreKeyMaxRecordInputs              = []byte("MaxRecordInputs")
+	ParamStoreKeyMaxRecordOutputs             = []byte("MaxRecordOutputs")
+	ParamStoreKeyMaxSessionParties            = []byte("MaxSessionParties")
+	ParamStoreKeyMaxSessionContextBytes       = []byte("MaxSessionContextBytes")
+	ParamStoreKeyEnforceResponsibleParties    = []byte("EnforceResponsibleParties")
+	ParamStoreKeyDisableP8eMessages           = []byte("DisableP8eMessages")
+)
+
 var _ paramtypes.ParamSet = &Params{}
 
 // ParamKeyTable for metadata module
@@ -14,19 +62,71 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	historyRetentionBlocks uint64,
+	recordTypeRegistrars []string,
+	requireRegisteredRecordTypes bool,
+	maxScopeOwners uint32,
+	maxScopeDataAccess uint32,
+	maxScopeRecords uint32,
+	maxRecordInputs uint32,
+	maxRecordOutputs uint32,
+	maxSessionParties uint32,
+	maxSessionContextBytes uint32,
+	enforceResponsibleParties bool,
+	disableP8eMessages bool,
+) Params {
+	return Params{
+		HistoryRetentionBlocks:       historyRetentionBlocks,
+		RecordTypeRegistrars:         recordTypeRegistrars,
+		RequireRegisteredRecordTypes: requireRegisteredRecordTypes,
+		MaxScopeOwners:               maxScopeOwners,
+		MaxScopeDataAccess:           maxScopeDataAccess,
+		MaxScopeRecords:              maxScopeRecords,
+		MaxRecordInputs:              maxRecordInputs,
+		MaxRecordOutputs:             maxRecordOutputs,
+		MaxSessionParties:            maxSessionParties,
+		MaxSessionContextBytes:       maxSessionContextBytes,
+		EnforceResponsibleParties:    enforceResponsibleParties,
+		DisableP8EMessages:           disableP8eMessages,
+	}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyHistoryRetentionBlocks, &p.HistoryRetentionBlocks, validateHistoryRetentionBlocks),
+		paramtypes.NewParamSetPair(ParamStoreKeyRecordTypeRegistrars, &p.RecordTypeRegistrars, validateRecordTypeRegistrars),
+		paramtypes.NewParamSetPair(ParamStoreKeyRequireRegisteredRecordTypes, &p.RequireRegisteredRecordTypes, validateRequireRegisteredRecordTypes),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxScopeOwners, &p.MaxScopeOwners, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxScopeDataAccess, &p.MaxScopeDataAccess, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxScopeRecords, &p.MaxScopeRecords, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRecordInputs, &p.MaxRecordInputs, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRecordOutputs, &p.MaxRecordOutputs, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSessionParties, &p.MaxSessionParties, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSessionContextBytes, &p.MaxSessionContextBytes, validateLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnforceResponsibleParties, &p.EnforceResponsibleParties, validateEnforceResponsibleParties),
+		paramtypes.NewParamSetPair(ParamStoreKeyDisableP8eMessages, &p.DisableP8EMessages, validateDisableP8eMessages),
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultHistoryRetentionBlocks,
+		DefaultRecordTypeRegistrars,
+		DefaultRequireRegisteredRecordTypes,
+		DefaultMaxScopeOwners,
+		DefaultMaxScopeDataAccess,
+		DefaultMaxScopeRecords,
+		DefaultMaxRecordInputs,
+		DefaultMaxRecordOutputs,
+		DefaultMaxSessionParties,
+		DefaultMaxSessionContextBytes,
+		DefaultEnforceResponsibleParties,
+		DefaultDisableP8eMessages,
+	)
 }
 
 // String implements stringer interface
@@ -34,3 +134,62 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateHistoryRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRecordTypeRegistrars(i interface{}) error {
+	registrars, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for j, registrar := range registrars {
+		if _, err := sdk.AccAddressFromBech32(registrar); err != nil {
+			return fmt.Errorf("invalid record type registrar at index %d: %w", j, err)
+		}
+	}
+
+	return nil
+}
+
+func validateRequireRegisteredRecordTypes(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEnforceResponsibleParties(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDisableP8eMessages(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateLimit(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUnlockScope is a proposal to remove the lock from a scope.
+	ProposalTypeUnlockScope string = "UnlockScope"
+)
+
+var _ govtypes.Content = &UnlockScopeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUnlockScope)
+	govtypes.RegisterProposalTypeCodec(UnlockScopeProposal{}, "provenance/metadata/UnlockScopeProposal")
+}
+
+// NewUnlockScopeProposal creates a new proposal
+func NewUnlockScopeProposal(title, description string, scopeID MetadataAddress) *UnlockScopeProposal {
+	return &UnlockScopeProposal{
+		Title:       title,
+		Description: description,
+		ScopeId:     scopeID.String(),
+	}
+}
+
+// Implements Proposal Interface
+
+func (p UnlockScopeProposal) ProposalRoute() string { return RouterKey }
+func (p UnlockScopeProposal) ProposalType() string  { return ProposalTypeUnlockScope }
+func (p UnlockScopeProposal) ValidateBasic() error {
+	scopeID, err := MetadataAddressFromBech32(p.ScopeId)
+	if err != nil {
+		return fmt.Errorf("invalid scope id: %w", err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %s", p.ScopeId)
+	}
+	return govtypes.ValidateAbstract(&p)
+}
+
+func (p UnlockScopeProposal) String() string {
+	return fmt.Sprintf(`Unlock Scope Proposal:
+  Title:       %s
+  Description: %s
+  Scope:       %s
+`, p.Title, p.Description, p.ScopeId)
+}
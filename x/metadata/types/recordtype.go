@@ -0,0 +1,210 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// Default max length for RecordType.Name
+	maxRecordTypeNameLength = 1000
+	// Max length for RecordType.Schema, which is parsed or compiled on chain whenever a record type is written
+	maxRecordTypeSchemaLength = 65536
+	// jsonSchemaResourceURL is the url that a JSON Schema is compiled under.
+	jsonSchemaResourceURL = "recordtype.json"
+)
+
+// NewRecordType creates a new RecordType instance
+func NewRecordType(
+	name string,
+	schemaType SchemaType,
+	schema []byte,
+	messageName string,
+	ownerAddresses []string,
+) *RecordType {
+	return &RecordType{
+		Name:           name,
+		SchemaType:     schemaType,
+		Schema:         schema,
+		MessageName:    messageName,
+		OwnerAddresses: ownerAddresses,
+	}
+}
+
+// ValidateBasic performs basic format checking of data in a RecordType, including making sure the schema is usable.
+func (t RecordType) ValidateBasic() error {
+	if len(t.Name) == 0 {
+		return errors.New("record type name cannot be empty")
+	}
+	if len(t.Name) > maxRecordTypeNameLength {
+		return fmt.Errorf("record type name exceeds maximum length (expected <= %d got: %d)",
+			maxRecordTypeNameLength, len(t.Name))
+	}
+	if len(t.OwnerAddresses) < 1 {
+		return errors.New("the RecordType must have at least one owner")
+	}
+	for i, owner := range t.OwnerAddresses {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return fmt.Errorf("invalid owner address at index %d on RecordType: %w", i, err)
+		}
+	}
+	if len(t.Schema) == 0 {
+		return errors.New("record type schema cannot be empty")
+	}
+	if len(t.Schema) > maxRecordTypeSchemaLength {
+		return fmt.Errorf("record type schema exceeds maximum length (expected <= %d got: %d)",
+			maxRecordTypeSchemaLength, len(t.Schema))
+	}
+	switch t.SchemaType {
+	case SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR:
+		if len(t.MessageName) == 0 {
+			return errors.New("record type message name cannot be empty for a proto descriptor schema")
+		}
+		if _, err := t.protoMessageDescriptor(); err != nil {
+			return err
+		}
+	case SchemaType_SCHEMA_TYPE_JSON_SCHEMA:
+		if len(t.MessageName) > 0 {
+			return errors.New("record type message name must be empty for a json schema")
+		}
+		if _, err := t.compileJSONSchema(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid record type schema type: %s", t.SchemaType)
+	}
+	return nil
+}
+
+// ValidatePayload checks that the provided payload satisfies this RecordType's schema.
+//
+// For a proto descriptor schema, the payload can either be the binary encoding of the message, or its JSON encoding.
+// Unknown fields are not allowed in either. For a JSON Schema, the payload must be a JSON document.
+func (t RecordType) ValidatePayload(payload []byte) error {
+	switch t.SchemaType {
+	case SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR:
+		md, err := t.protoMessageDescriptor()
+		if err != nil {
+			return err
+		}
+		msg := dynamicpb.NewMessage(md)
+		if json.Valid(payload) {
+			err = protojson.Unmarshal(payload, msg)
+		} else {
+			err = proto.Unmarshal(payload, msg)
+		}
+		if err != nil {
+			return fmt.Errorf("payload is not a valid %s: %w", t.MessageName, err)
+		}
+		if hasUnknownFields(msg) {
+			return fmt.Errorf("payload is not a valid %s: it has unknown fields", t.MessageName)
+		}
+		if err = proto.CheckInitialized(msg); err != nil {
+			return fmt.Errorf("payload is not a valid %s: %w", t.MessageName, err)
+		}
+	case SchemaType_SCHEMA_TYPE_JSON_SCHEMA:
+		schema, err := t.compileJSONSchema()
+		if err != nil {
+			return err
+		}
+		dec := json.NewDecoder(bytes.NewReader(payload))
+		dec.UseNumber()
+		var doc interface{}
+		if err = dec.Decode(&doc); err != nil {
+			return fmt.Errorf("payload is not valid json: %w", err)
+		}
+		if dec.More() {
+			return errors.New("payload is not valid json: unexpected data after the top-level value")
+		}
+		if err = schema.Validate(doc); err != nil {
+			return fmt.Errorf("payload does not satisfy the json schema of %s: %w", t.Name, err)
+		}
+	default:
+		return fmt.Errorf("invalid record type schema type: %s", t.SchemaType)
+	}
+	return nil
+}
+
+// String implements stringer interface
+func (t RecordType) String() string {
+	out, _ := yaml.Marshal(t)
+	return string(out)
+}
+
+// protoMessageDescriptor gets the descriptor of this RecordType's message from its FileDescriptorSet.
+func (t RecordType) protoMessageDescriptor() (protoreflect.MessageDescriptor, error) {
+	var fds descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(t.Schema, &fds); err != nil {
+		return nil, fmt.Errorf("record type schema is not a valid FileDescriptorSet: %w", err)
+	}
+	files, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return nil, fmt.Errorf("record type schema is not a valid FileDescriptorSet: %w", err)
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(t.MessageName))
+	if err != nil {
+		return nil, fmt.Errorf("record type message name %q not found in schema: %w", t.MessageName, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("record type message name %q is not a message in the schema", t.MessageName)
+	}
+	return md, nil
+}
+
+// compileJSONSchema compiles this RecordType's JSON Schema.
+// Remote references are not allowed since the schema must be self-contained.
+func (t RecordType) compileJSONSchema() (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("loading %s is not allowed", url)
+	}
+	if err := compiler.AddResource(jsonSchemaResourceURL, bytes.NewReader(t.Schema)); err != nil {
+		return nil, fmt.Errorf("record type schema is not a valid json schema: %w", err)
+	}
+	schema, err := compiler.Compile(jsonSchemaResourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("record type schema is not a valid json schema: %w", err)
+	}
+	return schema, nil
+}
+
+// hasUnknownFields returns true if the provided message, or any message in it, has unknown fields.
+func hasUnknownFields(msg protoreflect.Message) bool {
+	if len(msg.GetUnknown()) > 0 {
+		return true
+	}
+	rv := false
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len() && !rv; i++ {
+				rv = hasUnknownFields(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				rv = hasUnknownFields(mv.Message())
+				return !rv
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			rv = hasUnknownFields(v.Message())
+		}
+		return !rv
+	})
+	return rv
+}
@@ -0,0 +1,192 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const testLoanJSONSchema = `{
+  "type": "object",
+  "properties": {
+    "id": {"type": "string"},
+    "amount": {"type": "integer", "minimum": 0}
+  },
+  "required": ["id", "amount"],
+  "additionalProperties": false
+}`
+
+// testLoanDescriptorSet gets a FileDescriptorSet with a Loan message that has a nested Amount message.
+func testLoanDescriptorSet(t *testing.T) []byte {
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("loan.proto"),
+			Package: proto.String("test.loan"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Loan"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: proto.String("id"), JsonName: proto.String("id"), Number: proto.Int32(1), Label: optional,
+							Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+						{Name: proto.String("amount"), JsonName: proto.String("amount"), Number: proto.Int32(2), Label: optional,
+							Type: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(), TypeName: proto.String(".test.loan.Amount")},
+					},
+				},
+				{
+					Name: proto.String("Amount"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: proto.String("value"), JsonName: proto.String("value"), Number: proto.Int32(1), Label: optional,
+							Type: descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()},
+					},
+				},
+			},
+		}},
+	}
+	bz, err := proto.Marshal(fds)
+	require.NoError(t, err, "Marshal FileDescriptorSet")
+	return bz
+}
+
+func TestRecordTypeValidateBasic(t *testing.T) {
+	owners := []string{specTestBech32}
+	loanFDS := testLoanDescriptorSet(t)
+	tests := []struct {
+		name       string
+		recordType *RecordType
+		want       string
+	}{
+		{
+			"valid json schema",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(testLoanJSONSchema), "", owners),
+			"",
+		},
+		{
+			"valid proto descriptor",
+			NewRecordType("test.loan.Loan", SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR, loanFDS, "test.loan.Loan", owners),
+			"",
+		},
+		{
+			"empty name",
+			NewRecordType("", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(testLoanJSONSchema), "", owners),
+			"record type name cannot be empty",
+		},
+		{
+			"no owners",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(testLoanJSONSchema), "", []string{}),
+			"the RecordType must have at least one owner",
+		},
+		{
+			"invalid owner",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(testLoanJSONSchema), "", []string{"bad"}),
+			"invalid owner address at index 0 on RecordType: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			"empty schema",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, nil, "", owners),
+			"record type schema cannot be empty",
+		},
+		{
+			"schema too long",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(`{"description": "`+strings.Repeat("x", 65536)+`"}`), "", owners),
+			"record type schema exceeds maximum length (expected <= 65536 got: 65555)",
+		},
+		{
+			"unspecified schema type",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_UNSPECIFIED, []byte(testLoanJSONSchema), "", owners),
+			"invalid record type schema type: SCHEMA_TYPE_UNSPECIFIED",
+		},
+		{
+			"json schema with message name",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(testLoanJSONSchema), "test.loan.Loan", owners),
+			"record type message name must be empty for a json schema",
+		},
+		{
+			"json schema is not json",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte("not json"), "", owners),
+			"record type schema is not a valid json schema",
+		},
+		{
+			"json schema does not satisfy the meta schema",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(`{"type": 5}`), "", owners),
+			"record type schema is not a valid json schema",
+		},
+		{
+			"json schema with a remote reference",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(`{"$ref": "https://example.com/loan.json"}`), "", owners),
+			"loading https://example.com/loan.json is not allowed",
+		},
+		{
+			"proto descriptor without message name",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR, loanFDS, "", owners),
+			"record type message name cannot be empty for a proto descriptor schema",
+		},
+		{
+			"proto descriptor is not a FileDescriptorSet",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR, []byte("not a descriptor"), "test.loan.Loan", owners),
+			"record type schema is not a valid FileDescriptorSet",
+		},
+		{
+			"proto descriptor without the message",
+			NewRecordType("loan", SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR, loanFDS, "test.loan.Lease", owners),
+			`record type message name "test.loan.Lease" not found in schema`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recordType.ValidateBasic()
+			if len(tc.want) == 0 {
+				assert.NoError(t, err, "ValidateBasic")
+			} else {
+				assert.ErrorContains(t, err, tc.want, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestRecordTypeValidatePayload(t *testing.T) {
+	owners := []string{specTestBech32}
+	jsonType := NewRecordType("loan", SchemaType_SCHEMA_TYPE_JSON_SCHEMA, []byte(testLoanJSONSchema), "", owners)
+	protoType := NewRecordType("test.loan.Loan", SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR, testLoanDescriptorSet(t), "test.loan.Loan", owners)
+
+	// Binary encoding of Loan{id: "L1", amount: {value: 5}}.
+	loanBinary := []byte{0x0a, 0x02, 'L', '1', 0x12, 0x02, 0x08, 0x05}
+	// Same as loanBinary, but the Amount has an extra field 9 with a varint value of 1.
+	loanBinaryNestedUnknown := []byte{0x0a, 0x02, 'L', '1', 0x12, 0x04, 0x08, 0x05, 0x48, 0x01}
+
+	tests := []struct {
+		name       string
+		recordType *RecordType
+		payload    string
+		want       string
+	}{
+		{"json valid", jsonType, `{"id": "L1", "amount": 5}`, ""},
+		{"json missing required property", jsonType, `{"id": "L1"}`, "payload does not satisfy the json schema of loan"},
+		{"json extra property", jsonType, `{"id": "L1", "amount": 5, "rate": 3}`, "payload does not satisfy the json schema of loan"},
+		{"json wrong type", jsonType, `{"id": "L1", "amount": "five"}`, "payload does not satisfy the json schema of loan"},
+		{"json not json", jsonType, `{"id": `, "payload is not valid json"},
+		{"json trailing data", jsonType, `{"id": "L1", "amount": 5} {}`, "payload is not valid json: unexpected data after the top-level value"},
+		{"proto binary valid", protoType, string(loanBinary), ""},
+		{"proto json valid", protoType, `{"id": "L1", "amount": {"value": "5"}}`, ""},
+		{"proto json unknown field", protoType, `{"id": "L1", "rate": 3}`, "payload is not a valid test.loan.Loan"},
+		{"proto binary nested unknown field", protoType, string(loanBinaryNestedUnknown), "payload is not a valid test.loan.Loan: it has unknown fields"},
+		{"proto binary invalid", protoType, "\x0a\x09L1", "payload is not a valid test.loan.Loan"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recordType.ValidatePayload([]byte(tc.payload))
+			if len(tc.want) == 0 {
+				assert.NoError(t, err, "ValidatePayload")
+			} else {
+				assert.ErrorContains(t, err, tc.want, "ValidatePayload")
+			}
+		})
+	}
+}
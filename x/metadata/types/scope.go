@@ -184,6 +184,74 @@ func (s *Scope) RemoveOwners(addressesToRemove []string) error {
 	return nil
 }
 
+// ValidateBasic performs basic format checking of data within a data access grant
+func (g DataAccessGrant) ValidateBasic() error {
+	if !g.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("invalid data access grant scope id: %s", g.ScopeId)
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return fmt.Errorf("invalid data access grant address %s: %w", g.Address, err)
+	}
+	if g.Expiration != nil && g.Expiration.IsZero() {
+		return fmt.Errorf("data access grant expiration cannot be zero")
+	}
+	return ValidateDataAccessRecordNames(g.RecordNames)
+}
+
+// IsLimited returns true if the grant has an expiration or is limited to specific records.
+func (g DataAccessGrant) IsLimited() bool {
+	return g.Expiration != nil || len(g.RecordNames) > 0
+}
+
+// IsExpired returns true if the grant has an expiration that is not after the provided time.
+func (g DataAccessGrant) IsExpired(blockTime time.Time) bool {
+	return g.Expiration != nil && !g.Expiration.After(blockTime)
+}
+
+// ValidateBasic performs static checks on a value owner transfer offer.
+func (o ValueOwnerTransferOffer) ValidateBasic() error {
+	if !o.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("invalid value owner transfer offer scope id: %s", o.ScopeId)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Seller); err != nil {
+		return fmt.Errorf("invalid value owner transfer offer seller %s: %w", o.Seller, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Buyer); err != nil {
+		return fmt.Errorf("invalid value owner transfer offer buyer %s: %w", o.Buyer, err)
+	}
+	if o.Seller == o.Buyer {
+		return fmt.Errorf("value owner transfer offer seller and buyer cannot both be %s", o.Buyer)
+	}
+	if err := validateTransferPrice(o.Price); err != nil {
+		return err
+	}
+	if o.Expiration.IsZero() {
+		return fmt.Errorf("value owner transfer offer expiration cannot be zero")
+	}
+	return nil
+}
+
+// IsExpired returns true if the offer's expiration is not after the provided time.
+func (o ValueOwnerTransferOffer) IsExpired(blockTime time.Time) bool {
+	return !o.Expiration.After(blockTime)
+}
+
+// ValidateDataAccessRecordNames checks that the record names a data access grant is limited to are not empty or
+// duplicated.
+func ValidateDataAccessRecordNames(recordNames []string) error {
+	for i, name := range recordNames {
+		if len(strings.TrimSpace(name)) == 0 {
+			return fmt.Errorf("data access record names cannot be empty")
+		}
+		for _, other := range recordNames[:i] {
+			if name == other {
+				return fmt.Errorf("duplicate data access record name: %s", name)
+			}
+		}
+	}
+	return nil
+}
+
 // UpdateAudit computes a set of changes to the audit fields based on the existing message.
 func (a *AuditFields) UpdateAudit(blocktime time.Time, signers, message string) *AuditFields {
 	if a == nil {
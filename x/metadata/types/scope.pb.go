@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -165,12 +167,231 @@ func (m *Scope) GetValueOwnerAddress() string {
 	return ""
 }
 
+// ScopeLock is a hold placed on a scope that prevents the scope, its sessions, and its records from being changed.
+type ScopeLock struct {
+	// scope_id is the id of the locked scope.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// locker is the bech32 address of the party that placed the lock.
+	// Only the locker (or a governance proposal) can remove the lock.
+	Locker string `protobuf:"bytes,2,opt,name=locker,proto3" json:"locker,omitempty"`
+	// reason is a description of why the scope was locked.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height is the block height at which the lock was placed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// locked_date is the block time at which the lock was placed.
+	LockedDate time.Time `protobuf:"bytes,5,opt,name=locked_date,json=lockedDate,proto3,stdtime" json:"locked_date" yaml:"locked_date,omitempty"`
+}
+
+func (m *ScopeLock) Reset()         { *m = ScopeLock{} }
+func (m *ScopeLock) String() string { return proto.CompactTextString(m) }
+func (*ScopeLock) ProtoMessage()    {}
+func (*ScopeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{1}
+}
+func (m *ScopeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLock.Merge(m, src)
+}
+func (m *ScopeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeLock proto.InternalMessageInfo
+
+func (m *ScopeLock) GetLocker() string {
+	if m != nil {
+		return m.Locker
+	}
+	return ""
+}
+
+func (m *ScopeLock) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ScopeLock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScopeLock) GetLockedDate() time.Time {
+	if m != nil {
+		return m.LockedDate
+	}
+	return time.Time{}
+}
+
+// DataAccessGrant holds the limits placed on an address's access to a scope's data.
+// An address in a scope's data access list without a grant has unlimited access.
+type DataAccessGrant struct {
+	// scope_id is the id of the scope the address has access to.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// address is the bech32 address that has data access.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// expiration is the time at which the address is removed from the scope's data access list (optional).
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" yaml:"expiration,omitempty"`
+	// record_names are the names of the scope's records the address has access to (optional).
+	// When empty, the address has access to all of the scope's records.
+	RecordNames []string `protobuf:"bytes,4,rep,name=record_names,json=recordNames,proto3" json:"record_names,omitempty" yaml:"record_names,omitempty"`
+}
+
+func (m *DataAccessGrant) Reset()         { *m = DataAccessGrant{} }
+func (m *DataAccessGrant) String() string { return proto.CompactTextString(m) }
+func (*DataAccessGrant) ProtoMessage()    {}
+func (*DataAccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{2}
+}
+func (m *DataAccessGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataAccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataAccessGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataAccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataAccessGrant.Merge(m, src)
+}
+func (m *DataAccessGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataAccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataAccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataAccessGrant proto.InternalMessageInfo
+
+func (m *DataAccessGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DataAccessGrant) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *DataAccessGrant) GetRecordNames() []string {
+	if m != nil {
+		return m.RecordNames
+	}
+	return nil
+}
+
+// ValueOwnerTransferOffer is an open offer from a scope's value owner to transfer the value ownership to a buyer.
+// While a scope has open offers, its value owner cannot be changed other than by a buyer accepting one of them.
+type ValueOwnerTransferOffer struct {
+	// scope_id is the id of the scope whose value ownership is offered.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// seller is the bech32 address of the value owner that made the offer.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address of the only party that can accept the offer.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the amount that the buyer pays the seller when accepting the offer.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// expiration is the time at which the offer is removed if it has not been accepted.
+	Expiration time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration" yaml:"expiration"`
+}
+
+func (m *ValueOwnerTransferOffer) Reset()         { *m = ValueOwnerTransferOffer{} }
+func (m *ValueOwnerTransferOffer) String() string { return proto.CompactTextString(m) }
+func (*ValueOwnerTransferOffer) ProtoMessage()    {}
+func (*ValueOwnerTransferOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{3}
+}
+func (m *ValueOwnerTransferOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValueOwnerTransferOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValueOwnerTransferOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValueOwnerTransferOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueOwnerTransferOffer.Merge(m, src)
+}
+func (m *ValueOwnerTransferOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValueOwnerTransferOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueOwnerTransferOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueOwnerTransferOffer proto.InternalMessageInfo
+
+func (m *ValueOwnerTransferOffer) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ValueOwnerTransferOffer) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ValueOwnerTransferOffer) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ValueOwnerTransferOffer) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// A Session is created for an execution context against a specific specification instance
 //
-//A Session is created for an execution context against a specific specification instance
-//
-//The context will have a specification and set of parties involved.  The Session may be updated several
-//times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
-//that reference a Session it is removed.
+// The context will have a specification and set of parties involved.  The Session may be updated several
+// times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
+// that reference a Session it is removed.
 type Session struct {
 	SessionId MetadataAddress `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,customtype=MetadataAddress" json:"session_id" yaml:"session_id"`
 	// unique id of the contract specification that was used to create this session.
@@ -188,7 +409,7 @@ type Session struct {
 func (m *Session) Reset()      { *m = Session{} }
 func (*Session) ProtoMessage() {}
 func (*Session) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{1}
+	return fileDescriptor_edeea634bfb18aba, []int{4}
 }
 func (m *Session) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +485,7 @@ type Record struct {
 func (m *Record) Reset()      { *m = Record{} }
 func (*Record) ProtoMessage() {}
 func (*Record) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{2}
+	return fileDescriptor_edeea634bfb18aba, []int{5}
 }
 func (m *Record) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +559,7 @@ type Process struct {
 func (m *Process) Reset()      { *m = Process{} }
 func (*Process) ProtoMessage() {}
 func (*Process) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{3}
+	return fileDescriptor_edeea634bfb18aba, []int{6}
 }
 func (m *Process) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +666,7 @@ type RecordInput struct {
 func (m *RecordInput) Reset()      { *m = RecordInput{} }
 func (*RecordInput) ProtoMessage() {}
 func (*RecordInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{4}
+	return fileDescriptor_edeea634bfb18aba, []int{7}
 }
 func (m *RecordInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +765,7 @@ type RecordOutput struct {
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
 func (*RecordOutput) ProtoMessage() {}
 func (*RecordOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{5}
+	return fileDescriptor_edeea634bfb18aba, []int{8}
 }
 func (m *RecordOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,6 +808,64 @@ func (m *RecordOutput) GetStatus() ResultStatus {
 	return ResultStatus_RESULT_STATUS_UNSPECIFIED
 }
 
+// MissingResponsibleParties identifies a record that was last written without a signature from a party of each of
+// the responsible party types required by its record specification.
+type MissingResponsibleParties struct {
+	// record_id is the id of the record.
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	// party_types are the responsible party types that did not have a party sign.
+	PartyTypes []PartyType `protobuf:"varint,2,rep,packed,name=party_types,json=partyTypes,proto3,enum=provenance.metadata.v1.PartyType" json:"party_types,omitempty" yaml:"party_types"`
+	// height is the block height at which the record was written.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MissingResponsibleParties) Reset()         { *m = MissingResponsibleParties{} }
+func (m *MissingResponsibleParties) String() string { return proto.CompactTextString(m) }
+func (*MissingResponsibleParties) ProtoMessage()    {}
+func (*MissingResponsibleParties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{9}
+}
+func (m *MissingResponsibleParties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissingResponsibleParties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissingResponsibleParties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissingResponsibleParties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingResponsibleParties.Merge(m, src)
+}
+func (m *MissingResponsibleParties) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissingResponsibleParties) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingResponsibleParties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissingResponsibleParties proto.InternalMessageInfo
+
+func (m *MissingResponsibleParties) GetPartyTypes() []PartyType {
+	if m != nil {
+		return m.PartyTypes
+	}
+	return nil
+}
+
+func (m *MissingResponsibleParties) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // A Party is an address with/in a given role associated with a contract
 type Party struct {
 	// address of the account (on chain)
@@ -598,7 +877,7 @@ type Party struct {
 func (m *Party) Reset()      { *m = Party{} }
 func (*Party) ProtoMessage() {}
 func (*Party) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{6}
+	return fileDescriptor_edeea634bfb18aba, []int{10}
 }
 func (m *Party) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,7 +940,7 @@ func (m *AuditFields) Reset()         { *m = AuditFields{} }
 func (m *AuditFields) String() string { return proto.CompactTextString(m) }
 func (*AuditFields) ProtoMessage()    {}
 func (*AuditFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{7}
+	return fileDescriptor_edeea634bfb18aba, []int{11}
 }
 func (m *AuditFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,11 +1015,15 @@ func init() {
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
+	proto.RegisterType((*ScopeLock)(nil), "provenance.metadata.v1.ScopeLock")
+	proto.RegisterType((*DataAccessGrant)(nil), "provenance.metadata.v1.DataAccessGrant")
+	proto.RegisterType((*ValueOwnerTransferOffer)(nil), "provenance.metadata.v1.ValueOwnerTransferOffer")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
 	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
 	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
+	proto.RegisterType((*MissingResponsibleParties)(nil), "provenance.metadata.v1.MissingResponsibleParties")
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
 }
@@ -750,81 +1033,102 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1a, 0x57,
-	0x10, 0x67, 0x01, 0x83, 0x19, 0x68, 0x8d, 0x5f, 0x2c, 0x42, 0x68, 0xcc, 0xd2, 0x6d, 0xa5, 0xb8,
-	0x6e, 0x0a, 0xb5, 0xfb, 0x4f, 0x4a, 0xff, 0x89, 0xb5, 0xb1, 0x82, 0x92, 0xda, 0x68, 0x31, 0x97,
-	0x4a, 0x2d, 0x5a, 0x76, 0x9f, 0xf1, 0x2a, 0xc0, 0x5b, 0xed, 0x3e, 0x9c, 0xa0, 0xde, 0x2a, 0x55,
-	0x95, 0x7c, 0xca, 0x31, 0x17, 0x4b, 0xed, 0x07, 0xe8, 0xf7, 0xc8, 0x31, 0xc7, 0xaa, 0x87, 0x6d,
-	0x65, 0xdf, 0x7c, 0xe4, 0xd6, 0x5b, 0xb5, 0xef, 0xbd, 0x85, 0x25, 0x06, 0x2b, 0x55, 0x9b, 0xdb,
-	0xce, 0xcc, 0x6f, 0xe6, 0xcd, 0xfc, 0x66, 0xde, 0xbc, 0x05, 0xc5, 0x76, 0xc8, 0x09, 0x1e, 0xe8,
-	0x03, 0x03, 0x57, 0xfa, 0x98, 0xea, 0xa6, 0x4e, 0xf5, 0xca, 0xc9, 0x56, 0xc5, 0x35, 0x88, 0x8d,
-	0xcb, 0xb6, 0x43, 0x28, 0x41, 0xb9, 0x29, 0xa6, 0x1c, 0x60, 0xca, 0x27, 0x5b, 0x85, 0xb5, 0x2e,
-	0xe9, 0x12, 0x06, 0xa9, 0xf8, 0x5f, 0x1c, 0x5d, 0x90, 0xbb, 0x84, 0x74, 0x7b, 0xb8, 0xc2, 0xa4,
-	0xce, 0xf0, 0xa8, 0x42, 0xad, 0x3e, 0x76, 0xa9, 0xde, 0xb7, 0x05, 0xa0, 0xf4, 0x32, 0xc0, 0xc4,
-	0xae, 0xe1, 0x58, 0x36, 0x25, 0x8e, 0x40, 0x6c, 0x2e, 0x4a, 0xca, 0xc6, 0x86, 0x75, 0x64, 0x19,
-	0x3a, 0xb5, 0xc8, 0x80, 0x63, 0x95, 0xbf, 0xa3, 0xb0, 0xd4, 0xf4, 0x93, 0x45, 0x35, 0x58, 0x66,
-	0x59, 0xb7, 0x2d, 0x33, 0x2f, 0x95, 0xa4, 0x8d, 0x8c, 0xba, 0xf9, 0xdc, 0x93, 0x23, 0x7f, 0x78,
-	0xf2, 0xca, 0x37, 0x22, 0x48, 0xd5, 0x34, 0x1d, 0xec, 0xba, 0x63, 0x4f, 0x5e, 0x19, 0xe9, 0xfd,
-	0xde, 0x3d, 0x25, 0x70, 0x50, 0xb4, 0x24, 0xfb, 0xac, 0x9b, 0xe8, 0x3b, 0xc8, 0xce, 0x9c, 0xe3,
-	0x87, 0x8b, 0xb2, 0x70, 0xdb, 0x8b, 0xc3, 0xdd, 0x14, 0xe1, 0x5e, 0x72, 0x54, 0xb4, 0x95, 0x19,
-	0x55, 0xdd, 0x44, 0x9f, 0x43, 0x82, 0x3c, 0x1e, 0x60, 0xc7, 0xcd, 0xc7, 0x4a, 0xb1, 0x8d, 0xf4,
-	0xf6, 0x7a, 0x79, 0x3e, 0xbb, 0xe5, 0x86, 0xee, 0xd0, 0x91, 0x1a, 0xf7, 0xcf, 0xd4, 0x84, 0x0b,
-	0xfa, 0x0c, 0xd2, 0xbe, 0xb9, 0xad, 0x1b, 0x06, 0x76, 0xdd, 0x7c, 0xbc, 0x14, 0xdb, 0x48, 0xa9,
-	0xb9, 0xb1, 0x27, 0x23, 0x7e, 0x7e, 0xc8, 0xa8, 0x68, 0xc0, 0x52, 0x64, 0x02, 0xda, 0x87, 0x1b,
-	0x27, 0x7a, 0x6f, 0x88, 0xdb, 0x2c, 0x50, 0x5b, 0xe7, 0x89, 0xe7, 0x97, 0x4a, 0xd2, 0x46, 0x4a,
-	0x2d, 0x8e, 0x3d, 0xb9, 0xc0, 0x03, 0xcc, 0x01, 0x29, 0xda, 0x2a, 0xd3, 0x1e, 0xf8, 0x4a, 0x51,
-	0xf1, 0xbd, 0xf8, 0xb3, 0x5f, 0xe4, 0x88, 0xf2, 0x2c, 0x06, 0xc9, 0x26, 0x76, 0x5d, 0x8b, 0x0c,
-	0xd0, 0x03, 0x00, 0x97, 0x7f, 0x4e, 0xf9, 0xbf, 0xbb, 0x98, 0xb0, 0x55, 0x41, 0xd8, 0xc4, 0x45,
-	0xd1, 0x52, 0x42, 0x78, 0xfd, 0x3d, 0xf8, 0x12, 0x92, 0xb6, 0xee, 0x50, 0x0b, 0xff, 0xab, 0x26,
-	0x04, 0x3e, 0xe8, 0x7d, 0x88, 0x0f, 0xf4, 0x3e, 0xce, 0xc7, 0x19, 0x7b, 0x37, 0x2f, 0x3d, 0x39,
-	0x4e, 0x47, 0x36, 0x1e, 0x7b, 0x72, 0x9a, 0xa7, 0xe0, 0x4b, 0x8a, 0xc6, 0x40, 0x28, 0x0f, 0x49,
-	0x83, 0x0c, 0x28, 0x7e, 0x42, 0x19, 0xdb, 0x19, 0x2d, 0x10, 0x51, 0x0b, 0x96, 0xf4, 0xa1, 0x69,
-	0xd1, 0xbc, 0x51, 0x92, 0x36, 0xd2, 0xdb, 0xef, 0x2c, 0xca, 0xa1, 0xea, 0x83, 0xf6, 0x2c, 0xdc,
-	0x33, 0x5d, 0xb5, 0x30, 0xf6, 0xe4, 0x1c, 0x3f, 0x84, 0xf9, 0xde, 0x25, 0x7d, 0x8b, 0xe2, 0xbe,
-	0x4d, 0x47, 0x8a, 0xc6, 0xa3, 0x89, 0xd6, 0xfc, 0x16, 0x83, 0x84, 0x86, 0x0d, 0xe2, 0x98, 0xe8,
-	0x8e, 0x48, 0x57, 0x62, 0xe9, 0xde, 0xb8, 0xf4, 0xe4, 0xa8, 0x65, 0x8e, 0x3d, 0x39, 0xc5, 0xe3,
-	0xf8, 0x0c, 0xf1, 0x54, 0x67, 0x5b, 0x18, 0xfd, 0x6f, 0x2d, 0xfc, 0x1a, 0x92, 0xb6, 0x43, 0xd8,
-	0x98, 0xc6, 0x58, 0x7d, 0xf2, 0x42, 0x8e, 0x39, 0x6c, 0xc2, 0x32, 0x17, 0x51, 0x15, 0x12, 0xd6,
-	0xc0, 0x1e, 0x52, 0x3e, 0xe6, 0xd7, 0xf0, 0xc3, 0xcb, 0xac, 0xfb, 0xd8, 0xe0, 0xba, 0x70, 0x47,
-	0xb4, 0x0b, 0x49, 0x32, 0xa4, 0x2c, 0xc6, 0x12, 0x8b, 0xf1, 0xee, 0xf5, 0x31, 0x0e, 0x18, 0x38,
-	0x48, 0x44, 0xb8, 0xce, 0x1d, 0xc6, 0xc4, 0xff, 0x36, 0x8c, 0xa2, 0x5f, 0x3f, 0x40, 0x52, 0xf0,
-	0x80, 0x0a, 0x90, 0x0c, 0xee, 0x27, 0x6b, 0xd9, 0xfd, 0x88, 0x16, 0x28, 0xd0, 0x1a, 0xc4, 0x8f,
-	0x75, 0xf7, 0x98, 0x35, 0xc7, 0x37, 0x30, 0x09, 0x21, 0xd1, 0x61, 0x9f, 0xe8, 0x94, 0x68, 0x66,
-	0x0e, 0x12, 0x7d, 0x4c, 0x8f, 0x89, 0xc9, 0xc7, 0x54, 0x13, 0x12, 0x3f, 0x4e, 0xcd, 0x00, 0x08,
-	0x9e, 0xfd, 0xa4, 0x7e, 0x8a, 0x42, 0x3a, 0xc4, 0xe2, 0x24, 0x9e, 0x14, 0x8a, 0xb7, 0x07, 0x29,
-	0x87, 0x41, 0xa6, 0xb3, 0x71, 0x67, 0x7e, 0xe9, 0x59, 0x5e, 0xfa, 0x04, 0xad, 0xdc, 0x8f, 0x68,
-	0xcb, 0x5c, 0xaa, 0x9b, 0x93, 0x0a, 0x62, 0x33, 0x15, 0x6c, 0x41, 0xca, 0xbf, 0x34, 0xed, 0xd0,
-	0xbd, 0x5a, 0x9b, 0x86, 0x9a, 0x98, 0x14, 0x6d, 0xd9, 0xff, 0xde, 0xf7, 0x13, 0xaa, 0x42, 0xc2,
-	0xa5, 0x3a, 0x1d, 0xf2, 0x2d, 0xf6, 0xe6, 0xf6, 0x7b, 0xaf, 0x30, 0x1f, 0x4d, 0xe6, 0xa0, 0x09,
-	0x47, 0xc1, 0xc5, 0x32, 0x24, 0x5c, 0x32, 0x74, 0x0c, 0xac, 0x1c, 0x41, 0x26, 0x3c, 0x08, 0x3e,
-	0x0f, 0x2c, 0x57, 0xc1, 0x03, 0xcb, 0xf4, 0x8b, 0xc9, 0xb1, 0x51, 0x76, 0xec, 0x35, 0x23, 0xe5,
-	0x0e, 0x7b, 0x73, 0x4f, 0x54, 0xbe, 0x87, 0x25, 0xb6, 0x58, 0xfc, 0xe5, 0x30, 0xd3, 0xea, 0x69,
-	0xa3, 0x3f, 0x81, 0xb8, 0x43, 0x7a, 0x58, 0x1c, 0xf2, 0xf6, 0xb5, 0xfb, 0xe9, 0x70, 0x64, 0x63,
-	0x8d, 0xc1, 0x45, 0xfc, 0x9f, 0xe3, 0x90, 0x0e, 0x6d, 0x0d, 0xf4, 0xa3, 0x04, 0x19, 0xc3, 0xc1,
-	0x3a, 0xc5, 0x66, 0xdb, 0xd4, 0x29, 0x6f, 0x6c, 0x7a, 0xbb, 0x50, 0xe6, 0x2f, 0x71, 0x39, 0x78,
-	0x89, 0xcb, 0x87, 0xc1, 0x53, 0xad, 0xee, 0xf8, 0xa3, 0x7d, 0xe9, 0xc9, 0xb9, 0xb0, 0xdf, 0x74,
-	0xdb, 0x8c, 0x3d, 0x79, 0x9d, 0xf7, 0x66, 0xbe, 0x5d, 0x79, 0xfa, 0xa7, 0x2c, 0x69, 0x69, 0x61,
-	0xdc, 0xd5, 0x29, 0x46, 0x5f, 0x01, 0x04, 0xd8, 0xce, 0x88, 0x0f, 0xb0, 0x2a, 0x8f, 0x3d, 0xf9,
-	0xad, 0xd9, 0x38, 0x9d, 0x51, 0x78, 0xa7, 0xa5, 0x84, 0x5a, 0x1d, 0xb1, 0x22, 0x86, 0xb6, 0x39,
-	0x2d, 0x22, 0xf6, 0xea, 0x45, 0x84, 0xfd, 0xe6, 0x15, 0x31, 0xdf, 0x2e, 0x8a, 0x10, 0xc6, 0xa0,
-	0x88, 0x00, 0xdb, 0x19, 0x89, 0x41, 0x0d, 0x15, 0x31, 0xb5, 0xcd, 0x14, 0x21, 0xd4, 0xea, 0x08,
-	0x7d, 0x0a, 0xc9, 0x13, 0xec, 0xf8, 0x2b, 0x92, 0x4d, 0xed, 0x1b, 0xea, 0xed, 0xb1, 0x27, 0xe7,
-	0xc5, 0xdb, 0xcb, 0x0d, 0x61, 0xcf, 0x00, 0xec, 0xfb, 0xf5, 0xb1, 0xeb, 0xea, 0x5d, 0xcc, 0x56,
-	0x4f, 0x2a, 0xec, 0x27, 0x0c, 0x33, 0x7e, 0x42, 0xb7, 0xf9, 0xab, 0x04, 0xab, 0x57, 0xe6, 0x1f,
-	0x7d, 0x08, 0xb2, 0x56, 0xdb, 0x39, 0xd0, 0x76, 0xdb, 0xf5, 0xfd, 0x46, 0xeb, 0xb0, 0xdd, 0x3c,
-	0xac, 0x1e, 0xb6, 0x9a, 0xed, 0xd6, 0x7e, 0xb3, 0x51, 0xdb, 0xa9, 0xef, 0xd5, 0x6b, 0xbb, 0xd9,
-	0x48, 0x21, 0x7d, 0x7a, 0x56, 0x4a, 0xb6, 0x06, 0x8f, 0x06, 0xe4, 0xf1, 0x00, 0x95, 0xe1, 0xf6,
-	0x3c, 0x8f, 0x86, 0x76, 0xd0, 0x38, 0x68, 0xd6, 0x76, 0xb3, 0x52, 0x21, 0x73, 0x7a, 0x56, 0x5a,
-	0x6e, 0x38, 0xc4, 0x26, 0x2e, 0x36, 0xd1, 0x26, 0x14, 0xe6, 0xe1, 0xb9, 0x2e, 0x1b, 0x2d, 0xc0,
-	0xe9, 0x59, 0x49, 0xbc, 0x4f, 0x9b, 0x43, 0xff, 0xd6, 0x4d, 0xef, 0x0a, 0x5a, 0x87, 0x5b, 0x5a,
-	0xad, 0xd9, 0x7a, 0x38, 0x3f, 0x2f, 0x94, 0x03, 0x34, 0x6b, 0x6e, 0x54, 0x9b, 0xcd, 0xac, 0x74,
-	0x55, 0xdf, 0x7c, 0x50, 0x6f, 0x64, 0xa3, 0x57, 0xf5, 0x7b, 0xd5, 0xfa, 0xc3, 0x6c, 0x4c, 0x7d,
-	0xf4, 0xfc, 0xbc, 0x28, 0xbd, 0x38, 0x2f, 0x4a, 0x7f, 0x9d, 0x17, 0xa5, 0xa7, 0x17, 0xc5, 0xc8,
-	0x8b, 0x8b, 0x62, 0xe4, 0xf7, 0x8b, 0x62, 0x04, 0x6e, 0x59, 0x64, 0xc1, 0x7d, 0x6b, 0x48, 0xdf,
-	0x7e, 0xdc, 0xb5, 0xe8, 0xf1, 0xb0, 0x53, 0x36, 0x48, 0xbf, 0x32, 0x05, 0x7d, 0x60, 0x91, 0x90,
-	0x54, 0x79, 0x32, 0xfd, 0x6d, 0xf5, 0xf7, 0x95, 0xdb, 0x49, 0xb0, 0xe9, 0xfc, 0xe8, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x99, 0x3c, 0x21, 0x36, 0x6f, 0x0b, 0x00, 0x00,
+	// 1519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1a, 0x47,
+	0x1b, 0x67, 0x01, 0x83, 0x19, 0x78, 0x63, 0x32, 0xb1, 0x1c, 0xcc, 0x1b, 0xb3, 0x64, 0x5b, 0x29,
+	0xd4, 0x49, 0x20, 0x76, 0xbf, 0xa4, 0xf4, 0x4b, 0xc6, 0xd8, 0x35, 0x4a, 0x62, 0xa3, 0xc5, 0xae,
+	0xd4, 0x48, 0x2d, 0x5a, 0x76, 0xc7, 0x78, 0x65, 0xd8, 0x59, 0xed, 0x2c, 0x4e, 0x50, 0x4f, 0xad,
+	0x54, 0x55, 0xca, 0x29, 0xc7, 0x5c, 0x2c, 0xb5, 0xd7, 0x4a, 0xfd, 0x3f, 0x72, 0xe8, 0x21, 0xc7,
+	0xb6, 0x87, 0x4d, 0x95, 0xdc, 0x72, 0xe4, 0xd6, 0x5b, 0x35, 0x1f, 0xcb, 0x2e, 0x36, 0xc4, 0xa9,
+	0xea, 0x9e, 0xcc, 0xf3, 0x39, 0xcf, 0xf3, 0x9b, 0x67, 0x7e, 0xfb, 0x18, 0x28, 0xb6, 0x83, 0x8f,
+	0x90, 0xa5, 0x59, 0x3a, 0xaa, 0xf4, 0x90, 0xab, 0x19, 0x9a, 0xab, 0x55, 0x8e, 0x56, 0x2a, 0x44,
+	0xc7, 0x36, 0x2a, 0xdb, 0x0e, 0x76, 0x31, 0x5c, 0x08, 0x7c, 0xca, 0xbe, 0x4f, 0xf9, 0x68, 0x25,
+	0x3f, 0xdf, 0xc1, 0x1d, 0xcc, 0x5c, 0x2a, 0xf4, 0x17, 0xf7, 0xce, 0xcb, 0x1d, 0x8c, 0x3b, 0x5d,
+	0x54, 0x61, 0x52, 0xbb, 0xbf, 0x5f, 0x71, 0xcd, 0x1e, 0x22, 0xae, 0xd6, 0xb3, 0x85, 0x43, 0xf1,
+	0xa4, 0x83, 0x81, 0x88, 0xee, 0x98, 0xb6, 0x8b, 0x1d, 0xe1, 0x51, 0xd0, 0x31, 0xe9, 0x61, 0x52,
+	0x69, 0x6b, 0x04, 0x55, 0x8e, 0x56, 0xda, 0xc8, 0xd5, 0x56, 0x2a, 0x3a, 0x36, 0x2d, 0x61, 0x5f,
+	0x9e, 0x56, 0xb4, 0x8d, 0x74, 0x73, 0xdf, 0xd4, 0x35, 0xd7, 0xc4, 0xc2, 0x57, 0xf9, 0x2b, 0x0a,
+	0x66, 0x9a, 0xb4, 0x19, 0xb8, 0x01, 0x66, 0x59, 0x57, 0x2d, 0xd3, 0xc8, 0x49, 0x45, 0xa9, 0x94,
+	0xa9, 0x2e, 0x3f, 0xf5, 0xe4, 0xc8, 0x1f, 0x9e, 0x3c, 0x77, 0x4f, 0x24, 0x59, 0x33, 0x0c, 0x07,
+	0x11, 0x32, 0xf4, 0xe4, 0xb9, 0x81, 0xd6, 0xeb, 0xde, 0x56, 0xfc, 0x00, 0x45, 0x4d, 0xb2, 0x9f,
+	0x75, 0x03, 0x7e, 0x05, 0xb2, 0x63, 0xe7, 0xd0, 0x74, 0x51, 0x96, 0x6e, 0x75, 0x7a, 0xba, 0xcb,
+	0x22, 0xdd, 0x89, 0x40, 0x45, 0x9d, 0x1b, 0x53, 0xd5, 0x0d, 0xf8, 0x11, 0x48, 0xe0, 0x07, 0x16,
+	0x72, 0x48, 0x2e, 0x56, 0x8c, 0x95, 0xd2, 0xab, 0x4b, 0xe5, 0xc9, 0xe8, 0x97, 0x1b, 0x9a, 0xe3,
+	0x0e, 0xaa, 0x71, 0x7a, 0xa6, 0x2a, 0x42, 0xe0, 0x87, 0x20, 0x4d, 0xcd, 0x2d, 0x4d, 0xd7, 0x11,
+	0x21, 0xb9, 0x78, 0x31, 0x56, 0x4a, 0x55, 0x17, 0x86, 0x9e, 0x0c, 0xf9, 0xf9, 0x21, 0xa3, 0xa2,
+	0x02, 0x56, 0x22, 0x13, 0xe0, 0x36, 0xb8, 0x74, 0xa4, 0x75, 0xfb, 0xa8, 0xc5, 0x12, 0xb5, 0x34,
+	0x5e, 0x78, 0x6e, 0xa6, 0x28, 0x95, 0x52, 0xd5, 0xc2, 0xd0, 0x93, 0xf3, 0x3c, 0xc1, 0x04, 0x27,
+	0x45, 0xbd, 0xc8, 0xb4, 0x3b, 0x54, 0x29, 0x3a, 0xbe, 0x1d, 0x7f, 0xf2, 0xa3, 0x1c, 0x51, 0xbe,
+	0x8d, 0x82, 0x14, 0xc3, 0xfe, 0x2e, 0xd6, 0x0f, 0xcf, 0x0b, 0xff, 0x05, 0x90, 0xe8, 0x62, 0xfd,
+	0x10, 0x39, 0x0c, 0xf5, 0x94, 0x2a, 0x24, 0xaa, 0x77, 0x90, 0x46, 0xb0, 0x95, 0x8b, 0x71, 0x3d,
+	0x97, 0xa8, 0xfe, 0x00, 0x99, 0x9d, 0x03, 0x37, 0x17, 0x2f, 0x4a, 0xa5, 0x98, 0x2a, 0x24, 0x88,
+	0x40, 0x9a, 0x45, 0x1a, 0x2d, 0x43, 0x73, 0x11, 0x6b, 0x35, 0xbd, 0x9a, 0x2f, 0xf3, 0xe1, 0x2c,
+	0xfb, 0xc3, 0x59, 0xde, 0xf5, 0xa7, 0xb7, 0x5a, 0xa2, 0xd5, 0x0e, 0x3d, 0xf9, 0x0a, 0x2f, 0x2d,
+	0x14, 0x7c, 0x03, 0xf7, 0x4c, 0x17, 0xf5, 0x6c, 0x77, 0xa0, 0x3c, 0x7e, 0x2e, 0x4b, 0x2a, 0xe0,
+	0xb6, 0x9a, 0xe6, 0x22, 0xe5, 0x38, 0x0a, 0xe6, 0x6a, 0x23, 0xa0, 0x3f, 0x77, 0x34, 0xcb, 0x3d,
+	0x2f, 0x24, 0x72, 0x20, 0xe9, 0x5f, 0x14, 0x87, 0xc2, 0x17, 0xa1, 0x0e, 0x00, 0x7a, 0x68, 0x9b,
+	0x0e, 0x1b, 0xaa, 0x5c, 0xec, 0xcc, 0xd6, 0xae, 0x3d, 0xf5, 0x64, 0x69, 0xe8, 0xc9, 0xff, 0xe7,
+	0x67, 0x05, 0xb1, 0xa7, 0x3a, 0x0b, 0x4c, 0xb0, 0x06, 0x32, 0x0e, 0xd2, 0xb1, 0x63, 0xb4, 0x2c,
+	0xad, 0x87, 0xfc, 0x69, 0xbb, 0x3a, 0xf4, 0xe4, 0x25, 0x9e, 0x26, 0x6c, 0x0d, 0x25, 0x52, 0xd3,
+	0xdc, 0xb0, 0x4d, 0xf5, 0xca, 0xaf, 0x51, 0x70, 0xf9, 0x8b, 0xd1, 0xfc, 0xec, 0x3a, 0x9a, 0x45,
+	0xf6, 0x91, 0xb3, 0xb3, 0xbf, 0x8f, 0x9c, 0x73, 0x9c, 0x18, 0x82, 0xba, 0xdd, 0x60, 0x62, 0xb8,
+	0x04, 0xe7, 0xc1, 0x4c, 0xbb, 0x3f, 0x40, 0x8e, 0x18, 0x18, 0x2e, 0x40, 0x0d, 0xcc, 0xd8, 0x8e,
+	0xa9, 0x23, 0xd6, 0x4f, 0x7a, 0x75, 0xb1, 0xcc, 0xc9, 0xa8, 0x4c, 0xc9, 0xa8, 0x2c, 0xc8, 0xa8,
+	0xbc, 0x8e, 0x4d, 0xab, 0x7a, 0x8b, 0x16, 0xf3, 0xf3, 0x73, 0xb9, 0xd4, 0x31, 0xdd, 0x83, 0x7e,
+	0xbb, 0xac, 0xe3, 0x5e, 0x45, 0x30, 0x17, 0xff, 0x73, 0x93, 0x18, 0x87, 0x15, 0x77, 0x60, 0x23,
+	0xc2, 0x02, 0x88, 0xca, 0x33, 0xc3, 0x2f, 0xc7, 0xae, 0xe7, 0xec, 0xc9, 0x5b, 0x12, 0x93, 0x77,
+	0xf1, 0xe4, 0xf5, 0x9c, 0xba, 0x14, 0xe5, 0x49, 0x0c, 0x24, 0x9b, 0x88, 0x10, 0x7a, 0x41, 0x77,
+	0x00, 0x20, 0xfc, 0x67, 0x00, 0xe0, 0x8d, 0xe9, 0x00, 0x8a, 0xec, 0x41, 0x88, 0xa2, 0xa6, 0x84,
+	0xf0, 0xdf, 0xd3, 0xde, 0x27, 0x20, 0x69, 0x6b, 0x8e, 0x6b, 0xa2, 0x7f, 0xc4, 0x7b, 0x7e, 0x0c,
+	0xbc, 0x0e, 0xe2, 0x74, 0xcc, 0xd8, 0x13, 0x4f, 0x55, 0x2f, 0xbf, 0xf2, 0xe4, 0x38, 0xc5, 0x7d,
+	0xe8, 0xc9, 0x69, 0x5e, 0x02, 0x95, 0x14, 0x95, 0x39, 0xd1, 0x77, 0xa3, 0x63, 0xcb, 0x45, 0x0f,
+	0x5d, 0x86, 0x7d, 0x46, 0xf5, 0x45, 0xb8, 0x07, 0x66, 0xb4, 0xbe, 0x61, 0xba, 0x39, 0x9d, 0xdd,
+	0xc9, 0x5b, 0xd3, 0x6a, 0x58, 0xa3, 0x4e, 0x9b, 0x26, 0xea, 0x1a, 0xa4, 0x9a, 0x1f, 0x7a, 0xf2,
+	0x02, 0x3f, 0x84, 0xc5, 0x86, 0x27, 0x9d, 0x67, 0x13, 0x6c, 0xf8, 0x4b, 0x0c, 0x24, 0x54, 0x36,
+	0xf9, 0xf0, 0x9a, 0x28, 0x57, 0x62, 0xe5, 0x5e, 0x7a, 0xe5, 0xc9, 0x51, 0xd3, 0x18, 0x7a, 0x72,
+	0x8a, 0xe7, 0xa1, 0x08, 0xf1, 0x52, 0xc7, 0xaf, 0x30, 0xfa, 0xef, 0xae, 0xf0, 0x33, 0x90, 0xb4,
+	0x1d, 0xcc, 0xbe, 0x0c, 0x9c, 0x12, 0xe4, 0xa9, 0x18, 0x73, 0xb7, 0x11, 0xca, 0x5c, 0x84, 0x6b,
+	0x20, 0x61, 0x5a, 0x76, 0xdf, 0x25, 0xe2, 0x6d, 0x4c, 0xc5, 0x87, 0xb7, 0x59, 0xa7, 0xbe, 0xfe,
+	0x17, 0x8a, 0x07, 0xc2, 0x1a, 0x48, 0xe2, 0xbe, 0xcb, 0x72, 0xcc, 0xb0, 0x1c, 0x6f, 0xbf, 0x3e,
+	0xc7, 0x4e, 0xdf, 0x0d, 0x92, 0xf8, 0xa1, 0x13, 0x87, 0x31, 0x71, 0x6e, 0xc3, 0x28, 0xee, 0xeb,
+	0x1b, 0x90, 0x14, 0x38, 0xc0, 0x7c, 0xc0, 0xb4, 0xec, 0xca, 0xb6, 0x22, 0x01, 0xd7, 0xce, 0x83,
+	0xf8, 0x81, 0x46, 0x0e, 0x38, 0xb7, 0x6c, 0x45, 0x54, 0x26, 0x41, 0x28, 0x6e, 0x98, 0x53, 0x0b,
+	0xbf, 0xcc, 0x05, 0x90, 0xe8, 0x21, 0xf7, 0x00, 0x1b, 0x7c, 0x4c, 0x55, 0x21, 0xf1, 0xe3, 0xaa,
+	0x19, 0x00, 0x04, 0xce, 0xb4, 0xa8, 0xef, 0xa3, 0x20, 0x1d, 0x42, 0x71, 0x94, 0x4f, 0x0a, 0xe5,
+	0xdb, 0x04, 0x29, 0x41, 0xb1, 0xa3, 0xd9, 0xb8, 0x36, 0xb9, 0xf5, 0xec, 0x18, 0x21, 0x9b, 0x86,
+	0xb2, 0x15, 0x51, 0x67, 0xb9, 0x54, 0x37, 0x46, 0x1d, 0xc4, 0xc6, 0x3a, 0x58, 0x01, 0x29, 0xfa,
+	0x68, 0x5a, 0xa1, 0x77, 0x35, 0x1f, 0xa4, 0x1a, 0x99, 0x14, 0x75, 0x96, 0xfe, 0xa6, 0x64, 0x4e,
+	0xe7, 0x83, 0xb8, 0x9a, 0xdb, 0xe7, 0x8b, 0xc3, 0x85, 0xd5, 0x77, 0xde, 0x60, 0x3e, 0x9a, 0x2c,
+	0x40, 0x15, 0x81, 0x02, 0x8b, 0x59, 0x90, 0x20, 0xb8, 0xef, 0xe8, 0x48, 0xd9, 0x07, 0x99, 0xf0,
+	0x20, 0x50, 0x1c, 0x58, 0xad, 0x02, 0x07, 0x56, 0xe9, 0xc7, 0xa3, 0x63, 0xa3, 0xec, 0xd8, 0xd7,
+	0x8c, 0x14, 0xe9, 0x77, 0x27, 0x9e, 0xa8, 0xfc, 0x2e, 0x81, 0xc5, 0x7b, 0x26, 0x21, 0xa6, 0xd5,
+	0x51, 0x11, 0xb1, 0xb1, 0x45, 0xcc, 0x76, 0x17, 0x35, 0x04, 0xbd, 0x6c, 0x85, 0x91, 0xe6, 0x44,
+	0x7a, 0x7d, 0xfa, 0xa0, 0x9d, 0x42, 0x3b, 0x84, 0xf5, 0x7d, 0x90, 0xa6, 0x9c, 0x35, 0x68, 0xb1,
+	0xcf, 0x42, 0x2e, 0x5a, 0x8c, 0x95, 0x2e, 0xac, 0x5e, 0x7d, 0x2d, 0xd7, 0xed, 0x0e, 0x6c, 0x14,
+	0x5e, 0xe2, 0x42, 0xf1, 0x8a, 0x0a, 0x6c, 0xdf, 0x85, 0x84, 0x36, 0x9d, 0x58, 0x78, 0xd3, 0x51,
+	0xbe, 0x06, 0x33, 0x2c, 0x51, 0x78, 0x61, 0x90, 0xc6, 0x17, 0x86, 0xf7, 0x41, 0xdc, 0xc1, 0x5d,
+	0x24, 0x00, 0x3c, 0xbb, 0x1e, 0x95, 0xb9, 0x0b, 0xec, 0x7e, 0x88, 0x83, 0x74, 0x88, 0x11, 0xe1,
+	0x77, 0x12, 0xc8, 0xe8, 0x0e, 0xd2, 0x5c, 0x7f, 0xb7, 0x92, 0xce, 0xfc, 0xc2, 0xad, 0x53, 0x34,
+	0x5f, 0x79, 0xf2, 0x42, 0x38, 0x2e, 0x60, 0xd2, 0x60, 0xa7, 0x98, 0x6c, 0xe7, 0xdf, 0xc1, 0xb4,
+	0x30, 0xd2, 0xbd, 0x0b, 0x7e, 0x0a, 0x80, 0xef, 0xdb, 0x1e, 0xf0, 0xc7, 0x59, 0x95, 0x83, 0x15,
+	0x27, 0xb0, 0x85, 0xf9, 0x3a, 0x25, 0xd4, 0xd5, 0x01, 0x6b, 0xa2, 0x6f, 0x1b, 0x41, 0x13, 0xb1,
+	0x37, 0x6f, 0x22, 0x1c, 0x37, 0xa9, 0x89, 0xc9, 0x76, 0xd1, 0x84, 0x30, 0xfa, 0x4d, 0xf8, 0xbe,
+	0xed, 0x41, 0x2e, 0x7e, 0xb2, 0x89, 0xc0, 0x36, 0xd6, 0x84, 0x50, 0x57, 0x07, 0xf0, 0x03, 0x90,
+	0x3c, 0x42, 0x0e, 0xf1, 0xb7, 0x8c, 0xff, 0x55, 0xaf, 0x0c, 0x3d, 0x39, 0x27, 0x56, 0x79, 0x6e,
+	0x08, 0x47, 0xfa, 0xce, 0x34, 0xae, 0x87, 0x08, 0xd1, 0x3a, 0x88, 0xd1, 0x6a, 0x2a, 0x1c, 0x27,
+	0x0c, 0x63, 0x71, 0x42, 0xb7, 0xfc, 0x93, 0x04, 0x2e, 0x9e, 0x7a, 0xdb, 0xf0, 0x16, 0x90, 0xd5,
+	0x8d, 0xf5, 0x1d, 0xb5, 0xd6, 0xaa, 0x6f, 0x37, 0xf6, 0x76, 0x5b, 0xcd, 0xdd, 0xb5, 0xdd, 0xbd,
+	0x66, 0x6b, 0x6f, 0xbb, 0xd9, 0xd8, 0x58, 0xaf, 0x6f, 0xd6, 0x37, 0x6a, 0xd9, 0x48, 0x3e, 0xfd,
+	0xe8, 0xb8, 0x98, 0xdc, 0xb3, 0x0e, 0x2d, 0xfc, 0xc0, 0x82, 0x65, 0x70, 0x65, 0x52, 0x44, 0x43,
+	0xdd, 0x69, 0xec, 0x34, 0x37, 0x6a, 0x59, 0x29, 0x9f, 0x79, 0x74, 0x5c, 0x9c, 0x6d, 0x38, 0xd8,
+	0xc6, 0x04, 0x19, 0x70, 0x19, 0xe4, 0x27, 0xf9, 0x73, 0x5d, 0x36, 0x9a, 0x07, 0x8f, 0x8e, 0x8b,
+	0xe2, 0xdb, 0xbb, 0xdc, 0x07, 0x99, 0x30, 0x0f, 0xc0, 0x25, 0xb0, 0xa8, 0x6e, 0x34, 0xf7, 0xee,
+	0x4e, 0xae, 0x0b, 0x2e, 0x00, 0x38, 0x6e, 0x6e, 0xac, 0x35, 0x9b, 0x59, 0xe9, 0xb4, 0xbe, 0x79,
+	0xa7, 0xde, 0xc8, 0x46, 0x4f, 0xeb, 0x37, 0xd7, 0xea, 0x77, 0xb3, 0xb1, 0xea, 0xe1, 0xd3, 0x17,
+	0x05, 0xe9, 0xd9, 0x8b, 0x82, 0xf4, 0xe7, 0x8b, 0x82, 0xf4, 0xf8, 0x65, 0x21, 0xf2, 0xec, 0x65,
+	0x21, 0xf2, 0xdb, 0xcb, 0x42, 0x04, 0x2c, 0x9a, 0x78, 0xca, 0x7b, 0x6b, 0x48, 0xf7, 0xdf, 0x0b,
+	0xed, 0x96, 0x81, 0xd3, 0x4d, 0x13, 0x87, 0xa4, 0xca, 0xc3, 0xe0, 0xbf, 0x60, 0x46, 0x0b, 0xed,
+	0x04, 0x9b, 0xce, 0x77, 0xff, 0x1e, 0x00, 0xaa, 0x7f, 0xf2, 0x7a, 0xde, 0x0f, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -900,7 +1204,7 @@ func (m *Scope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Session) Marshal() (dAtA []byte, err error) {
+func (m *ScopeLock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -910,72 +1214,47 @@ func (m *Session) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeLock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Audit != nil {
-		{
-			size, err := m.Audit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintScope(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LockedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedDate):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if len(m.Context) > 0 {
-		i -= len(m.Context)
-		copy(dAtA[i:], m.Context)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Context)))
+	i -= n1
+	i = encodeVarintScope(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x20
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Parties) > 0 {
-		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintScope(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x1a
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintScope(dAtA, i, uint64(size))
+	if len(m.Locker) > 0 {
+		i -= len(m.Locker)
+		copy(dAtA[i:], m.Locker)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Locker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.SessionId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SessionId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintScope(dAtA, i, uint64(size))
@@ -985,7 +1264,7 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Record) Marshal() (dAtA []byte, err error) {
+func (m *DataAccessGrant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -995,40 +1274,253 @@ func (m *Record) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Record) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataAccessGrant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataAccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecordNames) > 0 {
+		for iNdEx := len(m.RecordNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordNames[iNdEx])
+			copy(dAtA[i:], m.RecordNames[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.RecordNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Expiration != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintScope(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintScope(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintScope(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValueOwnerTransferOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueOwnerTransferOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueOwnerTransferOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Audit != nil {
+		{
+			size, err := m.Audit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScope(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SessionId.Size()
+		i -= size
+		if _, err := m.SessionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Record) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Inputs) > 0 {
 		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1266,6 +1758,62 @@ func (m *RecordOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MissingResponsibleParties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissingResponsibleParties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissingResponsibleParties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PartyTypes) > 0 {
+		dAtA7 := make([]byte, len(m.PartyTypes)*10)
+		var j6 int
+		for _, num := range m.PartyTypes {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintScope(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Party) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1340,12 +1888,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i -= n8
+	i = encodeVarintScope(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.CreatedBy) > 0 {
@@ -1355,12 +1903,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i -= n9
+	i = encodeVarintScope(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1406,56 +1954,132 @@ func (m *Scope) Size() (n int) {
 	return n
 }
 
-func (m *Session) Size() (n int) {
+func (m *ScopeLock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SessionId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovScope(uint64(l))
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
-		}
-	}
-	l = len(m.Name)
+	l = len(m.Locker)
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
-	l = len(m.Context)
+	l = len(m.Reason)
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
-	if m.Audit != nil {
-		l = m.Audit.Size()
-		n += 2 + l + sovScope(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedDate)
+	n += 1 + l + sovScope(uint64(l))
 	return n
 }
 
-func (m *Record) Size() (n int) {
+func (m *DataAccessGrant) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
-	l = m.SessionId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.Process.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
-		}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.RecordNames) > 0 {
+		for _, s := range m.RecordNames {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValueOwnerTransferOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SessionId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Audit != nil {
+		l = m.Audit.Size()
+		n += 2 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Record) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = m.SessionId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.Process.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
 	}
 	if len(m.Outputs) > 0 {
 		for _, e := range m.Outputs {
@@ -1567,6 +2191,27 @@ func (m *RecordOutput) Size() (n int) {
 	return n
 }
 
+func (m *MissingResponsibleParties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.PartyTypes) > 0 {
+		l = 0
+		for _, e := range m.PartyTypes {
+			l += sovScope(uint64(e))
+		}
+		n += 1 + sovScope(uint64(l)) + l
+	}
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *Party) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1580,44 +2225,640 @@ func (m *Party) Size() (n int) {
 	if m.Role != 0 {
 		n += 1 + sovScope(uint64(m.Role))
 	}
-	return n
-}
+	return n
+}
+
+func (m *AuditFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func sovScope(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScope(x uint64) (n int) {
+	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Scope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LockedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataAccessGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataAccessGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataAccessGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordNames = append(m.RecordNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AuditFields) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate)
-	n += 1 + l + sovScope(uint64(l))
-	l = len(m.CreatedBy)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate)
-	n += 1 + l + sovScope(uint64(l))
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovScope(uint64(m.Version))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovScope(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozScope(x uint64) (n int) {
-	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Scope) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnerTransferOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1640,10 +2881,10 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnerTransferOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnerTransferOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1681,9 +2922,9 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1693,30 +2934,29 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1726,31 +2966,29 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1760,29 +2998,31 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1792,23 +3032,24 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2812,6 +4053,177 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MissingResponsibleParties) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissingResponsibleParties: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissingResponsibleParties: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v PartyType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScope
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PartyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PartyTypes = append(m.PartyTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScope
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthScope
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthScope
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PartyTypes) == 0 {
+					m.PartyTypes = make([]PartyType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PartyType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowScope
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PartyType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PartyTypes = append(m.PartyTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyTypes", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Party) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
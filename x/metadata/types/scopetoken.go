@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ScopeTokenDenomPrefix is the prefix of all scope token denoms (and every other bech32 scope id).
+const ScopeTokenDenomPrefix = PrefixScope + "1"
+
+// ScopeTokenDenom returns the denom of the marker that a scope is tokenized with.
+func ScopeTokenDenom(scopeID MetadataAddress) string {
+	return scopeID.String()
+}
+
+// ScopeTokenMarkerAddress returns the address of the marker that a scope is tokenized with.
+func ScopeTokenMarkerAddress(scopeID MetadataAddress) sdk.AccAddress {
+	return markertypes.MustGetMarkerAddress(ScopeTokenDenom(scopeID))
+}
+
+// ParseScopeTokenDenom returns the scope id that the given denom would tokenize.
+// The second return value is false if the denom is not a scope token denom.
+func ParseScopeTokenDenom(denom string) (MetadataAddress, bool) {
+	scopeID, err := MetadataAddressFromBech32(denom)
+	if err != nil || !scopeID.IsScopeAddress() {
+		return nil, false
+	}
+	return scopeID, true
+}
+
+// IsTokenized returns true if this scope's value owner is the marker that it is tokenized with.
+func (s Scope) IsTokenized() bool {
+	return len(s.ValueOwnerAddress) > 0 && s.ValueOwnerAddress == ScopeTokenMarkerAddress(s.ScopeId).String()
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestScopeTokenDenom(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	denom := ScopeTokenDenom(scopeID)
+	assert.Equal(t, scopeID.String(), denom, "denom")
+	require.NoError(t, sdk.ValidateDenom(denom), "ValidateDenom")
+	assert.Equal(t, markertypes.MustGetMarkerAddress(denom), ScopeTokenMarkerAddress(scopeID), "marker address")
+
+	parsed, ok := ParseScopeTokenDenom(denom)
+	assert.True(t, ok, "ParseScopeTokenDenom ok")
+	assert.Equal(t, scopeID, parsed, "ParseScopeTokenDenom scope id")
+}
+
+func TestParseScopeTokenDenomNotScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		denom string
+	}{
+		{"empty", ""},
+		{"not a metadata address", "nhash"},
+		{"session address", SessionMetadataAddress(uuid.New(), uuid.New()).String()},
+		{"scope spec address", ScopeSpecMetadataAddress(uuid.New()).String()},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scopeID, ok := ParseScopeTokenDenom(tc.denom)
+			assert.False(t, ok, "ParseScopeTokenDenom ok")
+			assert.Nil(t, scopeID, "ParseScopeTokenDenom scope id")
+		})
+	}
+}
+
+func TestScopeIsTokenized(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	otherScopeID := ScopeMetadataAddress(uuid.New())
+	tests := []struct {
+		name       string
+		valueOwner string
+		expected   bool
+	}{
+		{"no value owner", "", false},
+		{"account value owner", specTestBech32, false},
+		{"marker of another scope", ScopeTokenMarkerAddress(otherScopeID).String(), false},
+		{"marker of this scope", ScopeTokenMarkerAddress(scopeID).String(), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scope := Scope{ScopeId: scopeID, ValueOwnerAddress: tc.valueOwner}
+			assert.Equal(t, tc.expected, scope.IsTokenized())
+		})
+	}
+}
@@ -91,6 +91,14 @@ func (s ScopeSpecification) ValidateBasic() error {
 				i, PrefixContractSpecification, prefix)
 		}
 	}
+	if !s.PreviousVersionId.Empty() {
+		if !s.PreviousVersionId.IsScopeSpecificationAddress() {
+			return fmt.Errorf("invalid previous version id: %s is not a scope specification id", s.PreviousVersionId)
+		}
+		if s.PreviousVersionId.Equals(s.SpecificationId) {
+			return errors.New("a scope specification cannot be its own previous version")
+		}
+	}
 	return nil
 }
 
@@ -178,6 +186,14 @@ func (s ContractSpecification) ValidateBasic() error {
 		return fmt.Errorf("class name exceeds maximum length (expected <= %d got: %d)",
 			maxContractSpecificationClassNameLength, len(s.ClassName))
 	}
+	if !s.PreviousVersionId.Empty() {
+		if !s.PreviousVersionId.IsContractSpecificationAddress() {
+			return fmt.Errorf("invalid previous version id: %s is not a contract specification id", s.PreviousVersionId)
+		}
+		if s.PreviousVersionId.Equals(s.SpecificationId) {
+			return errors.New("a contract specification cannot be its own previous version")
+		}
+	}
 	return nil
 }
 
@@ -116,6 +116,38 @@ func (PartyType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1e2d1042057ea889, []int{1}
 }
 
+// SchemaType indicates the kind of schema used by a RecordType
+type SchemaType int32
+
+const (
+	// SCHEMA_TYPE_UNSPECIFIED is an error condition
+	SchemaType_SCHEMA_TYPE_UNSPECIFIED SchemaType = 0
+	// SCHEMA_TYPE_PROTO_DESCRIPTOR indicates the schema is a binary encoded google.protobuf.FileDescriptorSet
+	SchemaType_SCHEMA_TYPE_PROTO_DESCRIPTOR SchemaType = 1
+	// SCHEMA_TYPE_JSON_SCHEMA indicates the schema is a JSON Schema document
+	SchemaType_SCHEMA_TYPE_JSON_SCHEMA SchemaType = 2
+)
+
+var SchemaType_name = map[int32]string{
+	0: "SCHEMA_TYPE_UNSPECIFIED",
+	1: "SCHEMA_TYPE_PROTO_DESCRIPTOR",
+	2: "SCHEMA_TYPE_JSON_SCHEMA",
+}
+
+var SchemaType_value = map[string]int32{
+	"SCHEMA_TYPE_UNSPECIFIED":      0,
+	"SCHEMA_TYPE_PROTO_DESCRIPTOR": 1,
+	"SCHEMA_TYPE_JSON_SCHEMA":      2,
+}
+
+func (x SchemaType) String() string {
+	return proto.EnumName(SchemaType_name, int32(x))
+}
+
+func (SchemaType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{2}
+}
+
 // ScopeSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
 type ScopeSpecification struct {
 	// unique identifier for this specification on chain
@@ -128,6 +160,8 @@ type ScopeSpecification struct {
 	PartiesInvolved []PartyType `protobuf:"varint,4,rep,packed,name=parties_involved,json=partiesInvolved,proto3,enum=provenance.metadata.v1.PartyType" json:"parties_involved,omitempty" yaml:"parties_involved"`
 	// A list of contract specification ids allowed for a scope based on this specification.
 	ContractSpecIds []MetadataAddress `protobuf:"bytes,5,rep,name=contract_spec_ids,json=contractSpecIds,proto3,customtype=MetadataAddress" json:"contract_spec_ids" yaml:"contract_spec_ids"`
+	// The id of the scope specification that this specification is a newer version of (if any).
+	PreviousVersionId MetadataAddress `protobuf:"bytes,6,opt,name=previous_version_id,json=previousVersionId,proto3,customtype=MetadataAddress" json:"previous_version_id" yaml:"previous_version_id,omitempty"`
 }
 
 func (m *ScopeSpecification) Reset()      { *m = ScopeSpecification{} }
@@ -202,6 +236,8 @@ type ContractSpecification struct {
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
 	// name of the class/type of this contract executable
 	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty" yaml:"class_name"`
+	// The id of the contract specification that this specification is a newer version of (if any).
+	PreviousVersionId MetadataAddress `protobuf:"bytes,8,opt,name=previous_version_id,json=previousVersionId,proto3,customtype=MetadataAddress" json:"previous_version_id" yaml:"previous_version_id,omitempty"`
 }
 
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
@@ -316,6 +352,8 @@ type RecordSpecification struct {
 	ResultType DefinitionType `protobuf:"varint,5,opt,name=result_type,json=resultType,proto3,enum=provenance.metadata.v1.DefinitionType" json:"result_type,omitempty" yaml:"result_type"`
 	// Type of party responsible for this record
 	ResponsibleParties []PartyType `protobuf:"varint,6,rep,packed,name=responsible_parties,json=responsibleParties,proto3,enum=provenance.metadata.v1.PartyType" json:"responsible_parties,omitempty" yaml:"responsible_parties"`
+	// clonable indicates that records of this specification are copied to new scopes made with CloneScope.
+	Clonable bool `protobuf:"varint,7,opt,name=clonable,proto3" json:"clonable,omitempty"`
 }
 
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
@@ -385,6 +423,13 @@ func (m *RecordSpecification) GetResponsibleParties() []PartyType {
 	return nil
 }
 
+func (m *RecordSpecification) GetClonable() bool {
+	if m != nil {
+		return m.Clonable
+	}
+	return false
+}
+
 // InputSpecification defines a name, type_name, and source reference (either on or off chain) to define an input
 // parameter
 type InputSpecification struct {
@@ -484,6 +529,91 @@ func (*InputSpecification) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// RecordType is a registered type name that record and input specifications can use as their type_name.
+// Payloads of this type can be validated against its schema before being hashed and recorded.
+type RecordType struct {
+	// name is the unique name of this type, e.g. a proto message name or class name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// schema_type indicates what kind of schema is in the schema field.
+	SchemaType SchemaType `protobuf:"varint,2,opt,name=schema_type,json=schemaType,proto3,enum=provenance.metadata.v1.SchemaType" json:"schema_type,omitempty" yaml:"schema_type"`
+	// schema is the schema payloads of this type must satisfy.
+	// For SCHEMA_TYPE_PROTO_DESCRIPTOR, it is a binary encoded google.protobuf.FileDescriptorSet.
+	// For SCHEMA_TYPE_JSON_SCHEMA, it is a JSON Schema document.
+	Schema []byte `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	// message_name is the fully qualified name of the message in the FileDescriptorSet that payloads must be.
+	// It is only used with SCHEMA_TYPE_PROTO_DESCRIPTOR.
+	MessageName string `protobuf:"bytes,4,opt,name=message_name,json=messageName,proto3" json:"message_name,omitempty" yaml:"message_name,omitempty"`
+	// owner_addresses are the accounts that can update or delete this type.
+	OwnerAddresses []string `protobuf:"bytes,5,rep,name=owner_addresses,json=ownerAddresses,proto3" json:"owner_addresses,omitempty" yaml:"owner_addresses"`
+}
+
+func (m *RecordType) Reset()      { *m = RecordType{} }
+func (*RecordType) ProtoMessage() {}
+func (*RecordType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{4}
+}
+func (m *RecordType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordType.Merge(m, src)
+}
+func (m *RecordType) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordType) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordType proto.InternalMessageInfo
+
+func (m *RecordType) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RecordType) GetSchemaType() SchemaType {
+	if m != nil {
+		return m.SchemaType
+	}
+	return SchemaType_SCHEMA_TYPE_UNSPECIFIED
+}
+
+func (m *RecordType) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *RecordType) GetMessageName() string {
+	if m != nil {
+		return m.MessageName
+	}
+	return ""
+}
+
+func (m *RecordType) GetOwnerAddresses() []string {
+	if m != nil {
+		return m.OwnerAddresses
+	}
+	return nil
+}
+
 // Description holds general information that is handy to associate with a structure.
 type Description struct {
 	// A Name for this thing.
@@ -499,7 +629,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{4}
+	return fileDescriptor_1e2d1042057ea889, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,10 +689,12 @@ func (m *Description) GetIconUrl() string {
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.DefinitionType", DefinitionType_name, DefinitionType_value)
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
+	proto.RegisterEnum("provenance.metadata.v1.SchemaType", SchemaType_name, SchemaType_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
 	proto.RegisterType((*RecordSpecification)(nil), "provenance.metadata.v1.RecordSpecification")
 	proto.RegisterType((*InputSpecification)(nil), "provenance.metadata.v1.InputSpecification")
+	proto.RegisterType((*RecordType)(nil), "provenance.metadata.v1.RecordType")
 	proto.RegisterType((*Description)(nil), "provenance.metadata.v1.Description")
 }
 
@@ -571,67 +703,79 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x8e, 0xda, 0x56,
-	0x14, 0xc6, 0x63, 0x86, 0x81, 0x4b, 0x34, 0x38, 0x77, 0x18, 0xe2, 0x4c, 0x2a, 0x4c, 0x5d, 0xa9,
-	0xa5, 0x23, 0x15, 0x34, 0x24, 0x52, 0xa5, 0xec, 0xf8, 0x31, 0xcd, 0x95, 0x26, 0x06, 0x5d, 0x60,
-	0xaa, 0x54, 0xaa, 0x2c, 0x8f, 0x7d, 0x33, 0x63, 0x15, 0x6c, 0xcb, 0x36, 0xa4, 0xf3, 0x0e, 0x5d,
-	0x74, 0xd7, 0x2e, 0xfb, 0x0c, 0x7d, 0x8a, 0x74, 0x97, 0xee, 0xaa, 0x2c, 0x50, 0x35, 0xf3, 0x04,
-	0xe5, 0x09, 0xaa, 0x7b, 0x6d, 0xc0, 0x78, 0x40, 0xca, 0xa6, 0x5d, 0x65, 0x77, 0xcf, 0xf9, 0xbe,
-	0x73, 0x7c, 0xfc, 0x9d, 0xcf, 0x57, 0x06, 0xa7, 0xae, 0xe7, 0xcc, 0x88, 0xad, 0xdb, 0x06, 0xa9,
-	0x4f, 0x48, 0xa0, 0x9b, 0x7a, 0xa0, 0xd7, 0x67, 0x67, 0x75, 0xdf, 0x25, 0x86, 0xf5, 0xda, 0x32,
-	0xf4, 0xc0, 0x72, 0xec, 0x9a, 0xeb, 0x39, 0x81, 0x03, 0x4b, 0x6b, 0x6e, 0x6d, 0xc9, 0xad, 0xcd,
-	0xce, 0x4e, 0x8a, 0x57, 0xce, 0x95, 0xc3, 0x28, 0x75, 0x7a, 0x0a, 0xd9, 0xf2, 0x9f, 0x3c, 0x80,
-	0x03, 0xc3, 0x71, 0xc9, 0x20, 0xde, 0x0a, 0x7e, 0x0f, 0x84, 0x8d, 0xde, 0x9a, 0x65, 0x8a, 0x5c,
-	0x85, 0xab, 0x3e, 0x68, 0x35, 0xde, 0xce, 0xa5, 0xd4, 0xfb, 0xb9, 0x54, 0x78, 0x19, 0xf5, 0x6e,
-	0x9a, 0xa6, 0x47, 0x7c, 0x7f, 0x31, 0x97, 0x1e, 0xdd, 0xe8, 0x93, 0xf1, 0x73, 0x39, 0x59, 0x28,
-	0xe3, 0xc2, 0x46, 0x0a, 0x99, 0x50, 0x01, 0x79, 0x93, 0xf8, 0x86, 0x67, 0xb9, 0x34, 0x21, 0xee,
-	0x55, 0xb8, 0x6a, 0xbe, 0xf1, 0x59, 0x6d, 0xfb, 0xe4, 0xb5, 0xce, 0x9a, 0x8a, 0xe3, 0x75, 0xb0,
-	0x0d, 0x0a, 0xce, 0x1b, 0x9b, 0x78, 0x9a, 0x1e, 0xce, 0x40, 0x7c, 0x91, 0xaf, 0xf0, 0xd5, 0x5c,
-	0xeb, 0x64, 0x31, 0x97, 0x4a, 0xe1, 0x34, 0x09, 0x82, 0x8c, 0x0f, 0x59, 0xa6, 0xb9, 0x4c, 0x40,
-	0x0b, 0x08, 0xae, 0xee, 0x05, 0x16, 0xf1, 0x35, 0xcb, 0x9e, 0x39, 0xe3, 0x19, 0x31, 0xc5, 0x74,
-	0x85, 0xaf, 0x1e, 0x36, 0x3e, 0xdd, 0x35, 0x50, 0x5f, 0xf7, 0x82, 0x9b, 0xe1, 0x8d, 0x4b, 0x5a,
-	0x4f, 0xd6, 0xaf, 0x9d, 0x6c, 0x22, 0xe3, 0x42, 0x94, 0x42, 0x51, 0x06, 0x6a, 0xe0, 0xa1, 0xe1,
-	0xd8, 0x81, 0xa7, 0x1b, 0x81, 0x46, 0x25, 0xd1, 0x2c, 0xd3, 0x17, 0xf7, 0x2b, 0x7c, 0xf5, 0x41,
-	0xeb, 0xe9, 0x6e, 0x59, 0xc5, 0xb0, 0xff, 0xbd, 0x4a, 0x19, 0x17, 0x96, 0x39, 0xba, 0x3c, 0x64,
-	0xfa, 0xcf, 0xd3, 0xbf, 0xfe, 0x26, 0xa5, 0xe4, 0x5f, 0xd2, 0xe0, 0xb8, 0x1d, 0x43, 0x3e, 0xae,
-	0xf5, 0xbf, 0x5d, 0xeb, 0x39, 0xc8, 0x7b, 0xc4, 0x77, 0xa6, 0x9e, 0x41, 0xa8, 0xa0, 0xfb, 0x4c,
-	0xd0, 0x2f, 0xb7, 0x8b, 0x09, 0xc3, 0xae, 0x31, 0xbe, 0xfc, 0x22, 0x85, 0xc1, 0x32, 0x46, 0x26,
-	0x2c, 0x82, 0xf4, 0xb5, 0xee, 0x5f, 0x8b, 0x99, 0x0a, 0x57, 0xcd, 0xbd, 0x48, 0x61, 0x16, 0xc1,
-	0x67, 0x00, 0x18, 0x63, 0xdd, 0xf7, 0x35, 0x5b, 0x9f, 0x10, 0xf1, 0x80, 0x62, 0xad, 0xe3, 0xc5,
-	0x5c, 0x7a, 0x18, 0x99, 0x63, 0x85, 0xc9, 0x38, 0xc7, 0x02, 0x55, 0x9f, 0x90, 0xd0, 0x0f, 0xad,
-	0x2c, 0xc8, 0x84, 0xdd, 0xe5, 0xf7, 0x3c, 0x38, 0xc2, 0xc4, 0x70, 0x3c, 0xf3, 0x7f, 0xf5, 0x05,
-	0x04, 0x69, 0x36, 0x36, 0x35, 0x44, 0x0e, 0xb3, 0x33, 0x6c, 0x81, 0x8c, 0x65, 0xbb, 0xd3, 0x20,
-	0xdc, 0x6d, 0xbe, 0x71, 0xba, 0x6b, 0x2b, 0x88, 0xb2, 0x36, 0xc6, 0xc5, 0x51, 0x25, 0x3c, 0x03,
-	0xb9, 0xe0, 0xc6, 0x25, 0xa1, 0x26, 0x69, 0xa6, 0x49, 0x71, 0x31, 0x97, 0x84, 0x70, 0xb0, 0x15,
-	0x24, 0xe3, 0x2c, 0x3d, 0x53, 0x45, 0xa0, 0xc6, 0x76, 0x35, 0x1d, 0x07, 0x1a, 0x4d, 0xb1, 0x5d,
-	0x1d, 0x36, 0x3e, 0xdf, 0x6d, 0xd1, 0xd7, 0x96, 0x6d, 0xd1, 0x67, 0x32, 0x5b, 0x94, 0x36, 0x16,
-	0xb8, 0x6c, 0x22, 0xb3, 0xf5, 0x4d, 0xc7, 0x01, 0xe5, 0x40, 0x0f, 0x1c, 0x79, 0xc4, 0x77, 0x1d,
-	0xdb, 0xb7, 0x2e, 0xc7, 0x44, 0x8b, 0xbc, 0x22, 0x66, 0x3e, 0xd4, 0x7a, 0xe5, 0xc5, 0x5c, 0x3a,
-	0x59, 0x3d, 0x23, 0xd9, 0x47, 0xc6, 0x30, 0x96, 0xed, 0x87, 0xc9, 0xe8, 0xb3, 0xff, 0x83, 0x03,
-	0xf0, 0xbe, 0x58, 0x2b, 0xf1, 0xb9, 0x98, 0xf8, 0x1b, 0xc2, 0xed, 0x7d, 0x90, 0x70, 0x5d, 0x90,
-	0xf3, 0x98, 0x73, 0xa8, 0x37, 0x78, 0xe6, 0x8d, 0x2f, 0xb6, 0xfb, 0x42, 0x58, 0x4e, 0x1f, 0xb1,
-	0xa9, 0xc1, 0xb3, 0x61, 0x14, 0xb3, 0x77, 0x3a, 0x6e, 0xef, 0x7b, 0x46, 0xfd, 0x9d, 0x03, 0xf9,
-	0xd8, 0xfd, 0xb0, 0xf5, 0x25, 0x2a, 0x9b, 0xb7, 0x0d, 0xcf, 0xa0, 0x8d, 0x8b, 0xe4, 0x6b, 0x90,
-	0x7f, 0x43, 0x2e, 0x7d, 0x2b, 0x20, 0xda, 0xd4, 0x1b, 0x47, 0x0e, 0x89, 0x2d, 0x31, 0x06, 0xca,
-	0x18, 0x44, 0xd1, 0xc8, 0x1b, 0xc3, 0x1a, 0xc8, 0x5a, 0x86, 0x63, 0xb3, 0xaa, 0x7d, 0x56, 0x75,
-	0xb4, 0x98, 0x4b, 0x85, 0xb0, 0x6a, 0x89, 0xc8, 0xf8, 0x80, 0x1e, 0x47, 0xde, 0x38, 0x1c, 0xff,
-	0xf4, 0x27, 0x0e, 0x1c, 0x6e, 0x3a, 0x06, 0x4a, 0xe0, 0x49, 0x47, 0xe9, 0x22, 0x15, 0x0d, 0x51,
-	0x4f, 0xd5, 0x86, 0xaf, 0xfa, 0x8a, 0x36, 0x52, 0x07, 0x7d, 0xa5, 0x8d, 0xba, 0x48, 0xe9, 0x08,
-	0x29, 0xf8, 0x09, 0x10, 0x93, 0x84, 0x3e, 0xee, 0xf5, 0x7b, 0x03, 0xa5, 0x23, 0x70, 0xf0, 0x04,
-	0x94, 0x92, 0x28, 0x56, 0xda, 0x3d, 0xdc, 0x11, 0xf6, 0xb6, 0xb5, 0x0e, 0x31, 0xed, 0x1c, 0x0d,
-	0x86, 0x02, 0x7f, 0xfa, 0x0f, 0x07, 0x72, 0x2b, 0x5f, 0xd1, 0x56, 0xfd, 0x26, 0x1e, 0xbe, 0xda,
-	0x36, 0xc4, 0x63, 0x70, 0x1c, 0xc3, 0x7a, 0x18, 0x7d, 0x83, 0xd4, 0xe6, 0xb0, 0x87, 0x05, 0x0e,
-	0x3e, 0x02, 0x47, 0x31, 0x68, 0xa0, 0xe0, 0x0b, 0xd4, 0x56, 0xb0, 0xb0, 0x97, 0x00, 0x90, 0x7a,
-	0xa1, 0x0c, 0x68, 0x05, 0x0f, 0x45, 0x50, 0x8c, 0x01, 0xed, 0xd1, 0x60, 0xd8, 0xeb, 0xa0, 0xa6,
-	0x2a, 0xa4, 0x61, 0x11, 0x08, 0xf1, 0xc7, 0x7c, 0xab, 0x2a, 0x58, 0xd8, 0x4f, 0xf0, 0x9b, 0xdd,
-	0x2e, 0x3a, 0x47, 0xcd, 0xa1, 0x22, 0x64, 0x60, 0x09, 0xc0, 0x38, 0xff, 0xa5, 0x8a, 0x5a, 0xa3,
-	0x81, 0x70, 0x90, 0x18, 0xb7, 0x8f, 0x7b, 0x17, 0x8a, 0xda, 0x54, 0xdb, 0x8a, 0x90, 0x6d, 0xfd,
-	0xf0, 0xf6, 0xb6, 0xcc, 0xbd, 0xbb, 0x2d, 0x73, 0x7f, 0xdf, 0x96, 0xb9, 0x9f, 0xef, 0xca, 0xa9,
-	0x77, 0x77, 0xe5, 0xd4, 0x5f, 0x77, 0xe5, 0x14, 0x78, 0x6c, 0x39, 0x3b, 0x3e, 0xbe, 0x3e, 0xf7,
-	0xdd, 0xb3, 0x2b, 0x2b, 0xb8, 0x9e, 0x5e, 0xd6, 0x0c, 0x67, 0x52, 0x5f, 0x93, 0xbe, 0xb2, 0x9c,
-	0x58, 0x54, 0xff, 0x71, 0xfd, 0xeb, 0x45, 0xbf, 0x0a, 0xff, 0x32, 0xc3, 0x7e, 0xa1, 0x9e, 0xfe,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x02, 0x4e, 0x99, 0x71, 0x9e, 0x09, 0x00, 0x00,
+	// 1143 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6e, 0xe3, 0x54,
+	0x14, 0x8e, 0xf3, 0x37, 0xc9, 0x49, 0xd5, 0xb8, 0xb7, 0x6d, 0x9a, 0x69, 0x87, 0x24, 0x63, 0x10,
+	0x94, 0x0a, 0x12, 0xb5, 0x33, 0x12, 0xd2, 0x6c, 0x50, 0x7e, 0x5c, 0x6a, 0xd4, 0x3a, 0xd1, 0x75,
+	0x5a, 0x34, 0x20, 0x64, 0xb9, 0xf6, 0x9d, 0xd6, 0x22, 0xb1, 0x2d, 0xdb, 0xc9, 0xd0, 0x37, 0x60,
+	0xc1, 0x82, 0x25, 0x4b, 0x56, 0x3c, 0x00, 0x8f, 0xc0, 0x6a, 0xd8, 0xcd, 0x12, 0xb1, 0x88, 0x50,
+	0xfb, 0x04, 0x64, 0xc1, 0x1a, 0xf9, 0x5e, 0x27, 0x71, 0xd2, 0x44, 0x1a, 0x16, 0xc3, 0x8a, 0x9d,
+	0xcf, 0xf9, 0xbe, 0x73, 0x7c, 0x7e, 0xbe, 0xe3, 0x36, 0x70, 0xe0, 0xb8, 0xf6, 0x90, 0x58, 0x9a,
+	0xa5, 0x93, 0x5a, 0x9f, 0xf8, 0x9a, 0xa1, 0xf9, 0x5a, 0x6d, 0x78, 0x58, 0xf3, 0x1c, 0xa2, 0x9b,
+	0x2f, 0x4c, 0x5d, 0xf3, 0x4d, 0xdb, 0xaa, 0x3a, 0xae, 0xed, 0xdb, 0xa8, 0x30, 0xe3, 0x56, 0x27,
+	0xdc, 0xea, 0xf0, 0x70, 0x77, 0xeb, 0xca, 0xbe, 0xb2, 0x29, 0xa5, 0x16, 0x3c, 0x31, 0xb6, 0xf0,
+	0x6b, 0x12, 0x90, 0xa2, 0xdb, 0x0e, 0x51, 0xa2, 0xa9, 0xd0, 0xd7, 0xc0, 0xcf, 0xe5, 0x56, 0x4d,
+	0xa3, 0xc8, 0x55, 0xb8, 0xfd, 0xb5, 0xc6, 0xd1, 0xab, 0x51, 0x39, 0xf6, 0xc7, 0xa8, 0x9c, 0x3f,
+	0x0b, 0x73, 0xd7, 0x0d, 0xc3, 0x25, 0x9e, 0x37, 0x1e, 0x95, 0x77, 0x6e, 0xb4, 0x7e, 0xef, 0x99,
+	0xb0, 0x18, 0x28, 0xe0, 0xfc, 0x9c, 0x4b, 0x32, 0x90, 0x08, 0x39, 0x83, 0x78, 0xba, 0x6b, 0x3a,
+	0x81, 0xa3, 0x18, 0xaf, 0x70, 0xfb, 0xb9, 0xa3, 0x77, 0xab, 0xcb, 0x2b, 0xaf, 0xb6, 0x66, 0x54,
+	0x1c, 0x8d, 0x43, 0x4d, 0xc8, 0xdb, 0x2f, 0x2d, 0xe2, 0xaa, 0x1a, 0xab, 0x81, 0x78, 0xc5, 0x44,
+	0x25, 0xb1, 0x9f, 0x6d, 0xec, 0x8e, 0x47, 0xe5, 0x02, 0xab, 0x66, 0x81, 0x20, 0xe0, 0x75, 0xea,
+	0xa9, 0x4f, 0x1c, 0xc8, 0x04, 0xde, 0xd1, 0x5c, 0xdf, 0x24, 0x9e, 0x6a, 0x5a, 0x43, 0xbb, 0x37,
+	0x24, 0x46, 0x31, 0x59, 0x49, 0xec, 0xaf, 0x1f, 0x3d, 0x5e, 0x55, 0x50, 0x47, 0x73, 0xfd, 0x9b,
+	0xee, 0x8d, 0x43, 0x1a, 0x7b, 0xb3, 0xb6, 0x17, 0x93, 0x08, 0x38, 0x1f, 0xba, 0xa4, 0xd0, 0x83,
+	0x54, 0xd8, 0xd0, 0x6d, 0xcb, 0x77, 0x35, 0xdd, 0x57, 0x83, 0x91, 0xa8, 0xa6, 0xe1, 0x15, 0x53,
+	0x95, 0xc4, 0xfe, 0x5a, 0xe3, 0xc9, 0xea, 0xb1, 0x16, 0x59, 0xfe, 0x7b, 0x91, 0x02, 0xce, 0x4f,
+	0x7c, 0xc1, 0xf2, 0x24, 0xc3, 0x43, 0x36, 0x6c, 0x3a, 0x2e, 0x19, 0x9a, 0xf6, 0xc0, 0x53, 0x87,
+	0xc4, 0xf5, 0xc2, 0xcd, 0xa5, 0xe9, 0xe6, 0x3e, 0x5d, 0xfd, 0x8a, 0xf7, 0xc2, 0x16, 0xee, 0xc7,
+	0x7e, 0x64, 0xf7, 0x4d, 0x9f, 0xf4, 0x1d, 0xff, 0x46, 0xc0, 0x1b, 0x13, 0xfc, 0x82, 0xc1, 0x92,
+	0xf1, 0x2c, 0xf9, 0xe3, 0x4f, 0xe5, 0x98, 0xf0, 0x5d, 0x0a, 0xb6, 0x9b, 0x91, 0x52, 0xfe, 0xd7,
+	0xd1, 0xdb, 0xd5, 0xd1, 0x29, 0xe4, 0x5c, 0xe2, 0xd9, 0x03, 0x57, 0x27, 0xc1, 0x40, 0x53, 0x74,
+	0xa0, 0x1f, 0x2e, 0x1f, 0x26, 0x62, 0x59, 0x23, 0x7c, 0xe1, 0x24, 0x86, 0x61, 0x62, 0x4b, 0x06,
+	0xda, 0x82, 0xe4, 0xb5, 0xe6, 0x5d, 0x53, 0x95, 0x64, 0x4f, 0x62, 0x98, 0x5a, 0xe8, 0x29, 0x80,
+	0xde, 0xd3, 0x3c, 0x4f, 0xb5, 0xb4, 0x3e, 0x29, 0x3e, 0x08, 0xb0, 0xc6, 0xf6, 0x78, 0x54, 0xde,
+	0x08, 0xd5, 0x38, 0xc5, 0x04, 0x9c, 0xa5, 0x86, 0xac, 0xf5, 0xc9, 0x2a, 0x01, 0x66, 0xde, 0xae,
+	0x00, 0x1b, 0x19, 0x48, 0xb3, 0x76, 0x84, 0xbf, 0x13, 0xb0, 0x89, 0x89, 0x6e, 0xbb, 0xc6, 0x7f,
+	0x2a, 0x44, 0x04, 0x49, 0x3a, 0xa7, 0x40, 0x81, 0x59, 0x4c, 0x9f, 0x51, 0x03, 0xd2, 0xa6, 0xe5,
+	0x0c, 0x7c, 0x26, 0xa6, 0xdc, 0xd1, 0xc1, 0x2a, 0x19, 0x48, 0x01, 0x6b, 0xae, 0x5c, 0x1c, 0x46,
+	0xa2, 0x43, 0xc8, 0xfa, 0x37, 0x0e, 0x61, 0x4b, 0x48, 0xd2, 0x25, 0x6c, 0x8d, 0x47, 0x65, 0x9e,
+	0x15, 0x36, 0x85, 0x04, 0x9c, 0x09, 0x9e, 0xe9, 0x0a, 0x54, 0x2a, 0x8e, 0x41, 0xcf, 0x57, 0x03,
+	0x17, 0x15, 0xc7, 0xfa, 0xd1, 0xfb, 0xab, 0x6f, 0xe2, 0x85, 0x69, 0x99, 0xc1, 0x3b, 0xa9, 0x0e,
+	0x0b, 0x73, 0x8a, 0x99, 0x24, 0x11, 0xa8, 0x5e, 0x06, 0x3d, 0x3f, 0xe0, 0x20, 0x17, 0x36, 0x5d,
+	0xe2, 0x39, 0xb6, 0xe5, 0x99, 0x97, 0x3d, 0xa2, 0x86, 0xe2, 0x2c, 0xa6, 0xdf, 0x54, 0xeb, 0xa5,
+	0xf1, 0xa8, 0xbc, 0x3b, 0x7d, 0xc7, 0x62, 0x1e, 0x01, 0xa3, 0x88, 0xb7, 0xc3, 0x9c, 0x68, 0x17,
+	0x32, 0x7a, 0xcf, 0xb6, 0xb4, 0xcb, 0x1e, 0xd3, 0x62, 0x06, 0x4f, 0xed, 0xf0, 0x1b, 0xf4, 0x1b,
+	0x07, 0xe8, 0xfe, 0x20, 0xa7, 0x8b, 0xe1, 0x22, 0x8b, 0x99, 0x1b, 0x6a, 0xfc, 0x8d, 0x86, 0x7a,
+	0x0c, 0x59, 0x97, 0xaa, 0x2a, 0xd0, 0x4d, 0x82, 0xea, 0xe6, 0x83, 0xe5, 0x9a, 0xe1, 0x27, 0x9d,
+	0x85, 0xec, 0xe0, 0xda, 0x32, 0xcc, 0x8a, 0xdc, 0x5a, 0x32, 0x7a, 0x6b, 0xf7, 0x44, 0xfc, 0x73,
+	0x1c, 0x80, 0x89, 0x98, 0x0e, 0x7c, 0x59, 0x0f, 0x5f, 0x41, 0xce, 0xd3, 0xaf, 0x49, 0x5f, 0x63,
+	0x5b, 0x8e, 0xd3, 0x2d, 0x0b, 0xab, 0x86, 0xaf, 0x50, 0xea, 0xe2, 0x86, 0x23, 0x09, 0x04, 0x0c,
+	0xde, 0x94, 0x83, 0x0a, 0x90, 0x66, 0x16, 0x6b, 0x15, 0x87, 0x16, 0x6a, 0xc1, 0x5a, 0x9f, 0x78,
+	0x9e, 0x76, 0x35, 0x27, 0xc8, 0xc7, 0xe3, 0x51, 0xf9, 0x1d, 0x96, 0x31, 0x8a, 0x46, 0x0f, 0x37,
+	0x17, 0x02, 0x74, 0x96, 0x4b, 0xbe, 0xb6, 0xa9, 0x7f, 0xfb, 0xb5, 0x0d, 0x97, 0xfe, 0x0b, 0x07,
+	0xb9, 0xc8, 0x57, 0x7d, 0xe9, 0xa4, 0x2a, 0xf3, 0x7f, 0x23, 0x12, 0x14, 0x8a, 0xba, 0xd0, 0x27,
+	0x90, 0x7b, 0x49, 0x2e, 0x3d, 0xd3, 0x27, 0xea, 0xc0, 0xed, 0x85, 0x5d, 0x45, 0xe6, 0x14, 0x01,
+	0x05, 0x0c, 0xa1, 0x75, 0xee, 0xf6, 0x50, 0x15, 0x32, 0xa6, 0x6e, 0x5b, 0x34, 0x2a, 0x45, 0xa3,
+	0x36, 0xc7, 0xa3, 0x72, 0x9e, 0x45, 0x4d, 0x10, 0x01, 0x3f, 0x08, 0x1e, 0xcf, 0xdd, 0x1e, 0x2b,
+	0xfa, 0xe0, 0x7b, 0x0e, 0xd6, 0xe7, 0xcf, 0x0e, 0x95, 0x61, 0xaf, 0x25, 0x1e, 0x4b, 0xb2, 0xd4,
+	0x95, 0xda, 0xb2, 0xda, 0x7d, 0xde, 0x11, 0xd5, 0x73, 0x59, 0xe9, 0x88, 0x4d, 0xe9, 0x58, 0x12,
+	0x5b, 0x7c, 0x0c, 0x3d, 0x82, 0xe2, 0x22, 0xa1, 0x83, 0xdb, 0x9d, 0xb6, 0x22, 0xb6, 0x78, 0x0e,
+	0xed, 0x42, 0x61, 0x11, 0xc5, 0x62, 0xb3, 0x8d, 0x5b, 0x7c, 0x7c, 0x59, 0x6a, 0x86, 0xa9, 0xa7,
+	0x92, 0xd2, 0xe5, 0x13, 0x07, 0x7f, 0x71, 0x90, 0x9d, 0x1e, 0x67, 0x90, 0xaa, 0x53, 0xc7, 0xdd,
+	0xe7, 0xcb, 0x8a, 0x78, 0x08, 0xdb, 0x11, 0xac, 0x8d, 0xa5, 0xcf, 0x24, 0xb9, 0xde, 0x6d, 0x63,
+	0x9e, 0x43, 0x3b, 0xb0, 0x19, 0x81, 0x14, 0x11, 0x5f, 0x48, 0x4d, 0x11, 0xf3, 0xf1, 0x05, 0x40,
+	0x92, 0x2f, 0x44, 0x25, 0x88, 0x48, 0xa0, 0x22, 0x6c, 0x45, 0x80, 0xe6, 0xb9, 0xd2, 0x6d, 0xb7,
+	0xa4, 0xba, 0xcc, 0x27, 0xd1, 0x16, 0xf0, 0xd1, 0xd7, 0x7c, 0x21, 0x8b, 0x98, 0x4f, 0x2d, 0xf0,
+	0xeb, 0xc7, 0xc7, 0xd2, 0xa9, 0x54, 0xef, 0x8a, 0x7c, 0x1a, 0x15, 0x00, 0x45, 0xf9, 0x67, 0xb2,
+	0xd4, 0x38, 0x57, 0xf8, 0x07, 0x0b, 0xe5, 0x76, 0x70, 0xfb, 0x42, 0x94, 0xeb, 0x72, 0x53, 0xe4,
+	0x33, 0x07, 0xd7, 0x00, 0xb3, 0x93, 0x40, 0x7b, 0xb0, 0xa3, 0x34, 0x4f, 0xc4, 0xb3, 0xfa, 0xb2,
+	0xa6, 0x2b, 0xf0, 0x28, 0x0a, 0x76, 0x70, 0xbb, 0xdb, 0x56, 0x5b, 0xa2, 0xd2, 0xc4, 0x52, 0x87,
+	0xf5, 0xbe, 0x10, 0xfe, 0xb9, 0xd2, 0x96, 0x55, 0xe6, 0xe0, 0xe3, 0x8d, 0x6f, 0x5e, 0xdd, 0x96,
+	0xb8, 0xd7, 0xb7, 0x25, 0xee, 0xcf, 0xdb, 0x12, 0xf7, 0xc3, 0x5d, 0x29, 0xf6, 0xfa, 0xae, 0x14,
+	0xfb, 0xfd, 0xae, 0x14, 0x83, 0x87, 0xa6, 0xbd, 0xe2, 0x5c, 0x3b, 0xdc, 0x97, 0x4f, 0xaf, 0x4c,
+	0xff, 0x7a, 0x70, 0x59, 0xd5, 0xed, 0x7e, 0x6d, 0x46, 0xfa, 0xd8, 0xb4, 0x23, 0x56, 0xed, 0xdb,
+	0xd9, 0x6f, 0x81, 0xe0, 0x86, 0xbd, 0xcb, 0x34, 0xfd, 0x9f, 0xfe, 0xc9, 0x3f, 0x03, 0x00, 0x05,
+	0x7e, 0x19, 0x48, 0x2f, 0x0c, 0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -654,6 +798,16 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PreviousVersionId.Size()
+		i -= size
+		if _, err := m.PreviousVersionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.ContractSpecIds) > 0 {
 		for iNdEx := len(m.ContractSpecIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -740,6 +894,16 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PreviousVersionId.Size()
+		i -= size
+		if _, err := m.PreviousVersionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.ClassName) > 0 {
 		i -= len(m.ClassName)
 		copy(dAtA[i:], m.ClassName)
@@ -861,6 +1025,16 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Clonable {
+		i--
+		if m.Clonable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ResponsibleParties) > 0 {
 		dAtA8 := make([]byte, len(m.ResponsibleParties)*10)
 		var j7 int
@@ -1004,6 +1178,64 @@ func (m *InputSpecification_Hash) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
+func (m *RecordType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddresses) > 0 {
+		for iNdEx := len(m.OwnerAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OwnerAddresses[iNdEx])
+			copy(dAtA[i:], m.OwnerAddresses[iNdEx])
+			i = encodeVarintSpecification(dAtA, i, uint64(len(m.OwnerAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MessageName) > 0 {
+		i -= len(m.MessageName)
+		copy(dAtA[i:], m.MessageName)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.MessageName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SchemaType != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.SchemaType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Description) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1097,6 +1329,8 @@ func (m *ScopeSpecification) Size() (n int) {
 			n += 1 + l + sovSpecification(uint64(l))
 		}
 	}
+	l = m.PreviousVersionId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
 
@@ -1132,6 +1366,8 @@ func (m *ContractSpecification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecification(uint64(l))
 	}
+	l = m.PreviousVersionId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
 
@@ -1187,6 +1423,9 @@ func (m *RecordSpecification) Size() (n int) {
 		}
 		n += 1 + sovSpecification(uint64(l)) + l
 	}
+	if m.Clonable {
+		n += 2
+	}
 	return n
 }
 
@@ -1230,6 +1469,36 @@ func (m *InputSpecification_Hash) Size() (n int) {
 	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
+func (m *RecordType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	if m.SchemaType != 0 {
+		n += 1 + sovSpecification(uint64(m.SchemaType))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	l = len(m.MessageName)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	if len(m.OwnerAddresses) > 0 {
+		for _, s := range m.OwnerAddresses {
+			l = len(s)
+			n += 1 + l + sovSpecification(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Description) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1495,6 +1764,39 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousVersionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -1815,6 +2117,39 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousVersionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -2084,6 +2419,26 @@ func (m *RecordSpecification) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ResponsibleParties", wireType)
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clonable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Clonable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -2287,6 +2642,205 @@ func (m *InputSpecification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecordType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaType", wireType)
+			}
+			m.SchemaType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaType |= SchemaType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = append(m.Schema[:0], dAtA[iNdEx:postIndex]...)
+			if m.Schema == nil {
+				m.Schema = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddresses = append(m.OwnerAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Description) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
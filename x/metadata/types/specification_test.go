@@ -39,6 +39,11 @@ func (s *SpecificationTestSuite) SetupSuite() {
 }
 
 func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
+	scopeSpecUUID := uuid.New()
+	withPrevious := func(spec *ScopeSpecification, previous MetadataAddress) *ScopeSpecification {
+		spec.PreviousVersionId = previous
+		return spec
+	}
 	tests := []struct {
 		name string
 		spec *ScopeSpecification
@@ -171,6 +176,40 @@ func (s *SpecificationTestSuite) TestScopeSpecValidateBasic() {
 			),
 			"",
 		},
+		// PreviousVersionId tests
+		{
+			"previous version id - wrong address type",
+			withPrevious(NewScopeSpecification(
+				ScopeSpecMetadataAddress(scopeSpecUUID),
+				nil,
+				[]string{specTestBech32},
+				[]PartyType{PartyType_PARTY_TYPE_OWNER},
+				[]MetadataAddress{ContractSpecMetadataAddress(uuid.New())},
+			), ContractSpecMetadataAddress(scopeSpecUUID)),
+			fmt.Sprintf("invalid previous version id: %s is not a scope specification id", ContractSpecMetadataAddress(scopeSpecUUID)),
+		},
+		{
+			"previous version id - same as specification id",
+			withPrevious(NewScopeSpecification(
+				ScopeSpecMetadataAddress(scopeSpecUUID),
+				nil,
+				[]string{specTestBech32},
+				[]PartyType{PartyType_PARTY_TYPE_OWNER},
+				[]MetadataAddress{ContractSpecMetadataAddress(uuid.New())},
+			), ScopeSpecMetadataAddress(scopeSpecUUID)),
+			"a scope specification cannot be its own previous version",
+		},
+		{
+			"previous version id - valid",
+			withPrevious(NewScopeSpecification(
+				ScopeSpecMetadataAddress(scopeSpecUUID),
+				nil,
+				[]string{specTestBech32},
+				[]PartyType{PartyType_PARTY_TYPE_OWNER},
+				[]MetadataAddress{ContractSpecMetadataAddress(uuid.New())},
+			), ScopeSpecMetadataAddress(uuid.New())),
+			"",
+		},
 	}
 
 	for _, tt := range tests {
@@ -235,6 +274,10 @@ func (m *WeirdSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 
 func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 	contractSpecUuid1 := uuid.New()
+	withPrevious := func(spec *ContractSpecification, previous MetadataAddress) *ContractSpecification {
+		spec.PreviousVersionId = previous
+		return spec
+	}
 	tests := []struct {
 		name string
 		spec *ContractSpecification
@@ -423,6 +466,32 @@ func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 			"",
 		},
 
+		// PreviousVersionId tests
+		{
+			"PreviousVersionId - wrong address type",
+			withPrevious(NewContractSpecification(
+				ContractSpecMetadataAddress(contractSpecUuid1),
+				nil,
+				[]string{specTestBech32},
+				[]PartyType{PartyType_PARTY_TYPE_OWNER},
+				NewContractSpecificationSourceHash("somehash"),
+				"someclass",
+			), ScopeSpecMetadataAddress(contractSpecUuid1)),
+			fmt.Sprintf("invalid previous version id: %s is not a contract specification id", ScopeSpecMetadataAddress(contractSpecUuid1)),
+		},
+		{
+			"PreviousVersionId - same as specification id",
+			withPrevious(NewContractSpecification(
+				ContractSpecMetadataAddress(contractSpecUuid1),
+				nil,
+				[]string{specTestBech32},
+				[]PartyType{PartyType_PARTY_TYPE_OWNER},
+				NewContractSpecificationSourceHash("somehash"),
+				"someclass",
+			), ContractSpecMetadataAddress(contractSpecUuid1)),
+			"a contract specification cannot be its own previous version",
+		},
+
 		// A simple valid ContractSpecification
 		{
 			"simple valid test case",
@@ -1240,6 +1309,7 @@ result_type: 2
 responsible_parties:
 - 4
 - 3
+clonable: false
 `
 	actual := recordSpec.String()
 	// fmt.Printf("Actual:\n%s\n-----\n", actual)
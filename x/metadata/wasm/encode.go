@@ -15,6 +15,22 @@ import (
 type MetadataMsgParams struct {
 	// Params for encoding a MsgWriteScopeRequest
 	WriteScope *WriteScope `json:"write_scope,omitempty"`
+	// Params for encoding a MsgWriteSessionRequest
+	WriteSession *WriteSession `json:"write_session,omitempty"`
+	// Params for encoding a MsgWriteRecordRequest
+	WriteRecord *WriteRecord `json:"write_record,omitempty"`
+	// Params for encoding a MsgDeleteRecordRequest
+	DeleteRecord *DeleteRecord `json:"delete_record,omitempty"`
+	// Params for encoding a MsgAddScopeOwnerRequest
+	AddScopeOwner *AddScopeOwner `json:"add_scope_owner,omitempty"`
+	// Params for encoding a MsgDeleteScopeOwnerRequest
+	DeleteScopeOwner *DeleteScopeOwner `json:"delete_scope_owner,omitempty"`
+	// Params for encoding a MsgAddScopeDataAccessRequest
+	AddScopeDataAccess *AddScopeDataAccess `json:"add_scope_data_access,omitempty"`
+	// Params for encoding a MsgDeleteScopeDataAccessRequest
+	DeleteScopeDataAccess *DeleteScopeDataAccess `json:"delete_scope_data_access,omitempty"`
+	// Params for encoding a MsgCloneScopeRequest
+	CloneScope *CloneScope `json:"clone_scope,omitempty"`
 }
 
 // WriteScope are params for encoding a MsgWriteScopeRequest.
@@ -25,6 +41,86 @@ type WriteScope struct {
 	Signers []string `json:"signers"`
 }
 
+// WriteSession are params for encoding a MsgWriteSessionRequest.
+type WriteSession struct {
+	// The session we want to create/update.
+	Session Session `json:"session"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteRecord are params for encoding a MsgWriteRecordRequest.
+type WriteRecord struct {
+	// The record we want to create/update.
+	Record Record `json:"record"`
+	// The parties involved with the record (optional).
+	Parties []*Party `json:"parties,omitempty"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteRecord are params for encoding a MsgDeleteRecordRequest.
+type DeleteRecord struct {
+	// The bech32 address of the record we want to delete.
+	RecordID string `json:"record_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// AddScopeOwner are params for encoding a MsgAddScopeOwnerRequest.
+type AddScopeOwner struct {
+	// The bech32 address of the scope we want to add owners to.
+	ScopeID string `json:"scope_id"`
+	// The owners to add.
+	Owners []*Party `json:"owners"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteScopeOwner are params for encoding a MsgDeleteScopeOwnerRequest.
+type DeleteScopeOwner struct {
+	// The bech32 address of the scope we want to remove owners from.
+	ScopeID string `json:"scope_id"`
+	// The addresses of the owners to remove.
+	Owners []string `json:"owners"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// AddScopeDataAccess are params for encoding a MsgAddScopeDataAccessRequest.
+type AddScopeDataAccess struct {
+	// The bech32 address of the scope we want to add data access addresses to.
+	ScopeID string `json:"scope_id"`
+	// The data access addresses to add.
+	DataAccess []string `json:"data_access"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteScopeDataAccess are params for encoding a MsgDeleteScopeDataAccessRequest.
+type DeleteScopeDataAccess struct {
+	// The bech32 address of the scope we want to remove data access addresses from.
+	ScopeID string `json:"scope_id"`
+	// The data access addresses to remove.
+	DataAccess []string `json:"data_access"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// CloneScope are params for encoding a MsgCloneScopeRequest.
+type CloneScope struct {
+	// The bech32 address of the scope we want to copy.
+	SourceScopeID string `json:"source_scope_id"`
+	// The bech32 address of the new scope.
+	ScopeID string `json:"scope_id"`
+	// Whether to copy the records with clonable record specifications.
+	IncludeRecords bool `json:"include_records,omitempty"`
+	// The bech32 address of the new session for copied records (required if records are included).
+	SessionID string `json:"session_id,omitempty"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
 // Encoder returns a smart contract message encoder for the metadata module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -40,19 +136,31 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sd
 	switch {
 	case params.WriteScope != nil:
 		return params.WriteScope.Encode()
+	case params.WriteSession != nil:
+		return params.WriteSession.Encode()
+	case params.WriteRecord != nil:
+		return params.WriteRecord.Encode()
+	case params.DeleteRecord != nil:
+		return params.DeleteRecord.Encode()
+	case params.AddScopeOwner != nil:
+		return params.AddScopeOwner.Encode()
+	case params.DeleteScopeOwner != nil:
+		return params.DeleteScopeOwner.Encode()
+	case params.AddScopeDataAccess != nil:
+		return params.AddScopeDataAccess.Encode()
+	case params.DeleteScopeDataAccess != nil:
+		return params.DeleteScopeDataAccess.Encode()
+	case params.CloneScope != nil:
+		return params.CloneScope.Encode()
 	default:
 		return nil, fmt.Errorf("wasm: invalid metadata encode request: %s", string(msg))
 	}
 }
 
-// Encode creates a MsgAddScopeDataAccessRequest.
+// Encode creates a MsgWriteScopeRequest.
 func (params *WriteScope) Encode() ([]sdk.Msg, error) {
-	// verify the signer addresses are valid
-	for _, addr := range params.Signers {
-		_, err := sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return nil, fmt.Errorf("wasm: signer address must be a Bech32 string: %v", err)
-		}
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
 	}
 	scope, err := params.Scope.convertToBaseType()
 	if err != nil {
@@ -63,3 +171,163 @@ func (params *WriteScope) Encode() ([]sdk.Msg, error) {
 
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgWriteSessionRequest.
+func (params *WriteSession) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	session, err := params.Session.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgWriteSessionRequest(*session, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgWriteRecordRequest.
+func (params *WriteRecord) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	record, err := params.Record.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	parties, err := convertPartiesToBaseType(params.Parties)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgWriteRecordRequest(*record, nil, "", params.Signers, parties)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteRecordRequest.
+func (params *DeleteRecord) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	recordID, err := types.MetadataAddressFromBech32(params.RecordID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+	}
+
+	msg := types.NewMsgDeleteRecordRequest(recordID, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddScopeOwnerRequest.
+func (params *AddScopeOwner) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	owners, err := convertPartiesToBaseType(params.Owners)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAddScopeOwnerRequest(scopeID, owners, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteScopeOwnerRequest.
+func (params *DeleteScopeOwner) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	for _, addr := range params.Owners {
+		if _, err = sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'owners' address: %v", err)
+		}
+	}
+
+	msg := types.NewMsgDeleteScopeOwnerRequest(scopeID, params.Owners, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddScopeDataAccessRequest.
+func (params *AddScopeDataAccess) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	if err = validateDataAccess(params.DataAccess); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAddScopeDataAccessRequest(scopeID, params.DataAccess, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteScopeDataAccessRequest.
+func (params *DeleteScopeDataAccess) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	if err = validateDataAccess(params.DataAccess); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgDeleteScopeDataAccessRequest(scopeID, params.DataAccess, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgCloneScopeRequest.
+func (params *CloneScope) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	sourceScopeID, err := types.MetadataAddressFromBech32(params.SourceScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'source scope id': %w", err)
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	var sessionID types.MetadataAddress
+	if len(params.SessionID) > 0 {
+		sessionID, err = types.MetadataAddressFromBech32(params.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'session id': %w", err)
+		}
+	}
+
+	msg := types.NewMsgCloneScopeRequest(sourceScopeID, scopeID, params.IncludeRecords, sessionID, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// validateSigners verifies the signer addresses are valid.
+func validateSigners(signers []string) error {
+	for _, addr := range signers {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("wasm: signer address must be a Bech32 string: %v", err)
+		}
+	}
+	return nil
+}
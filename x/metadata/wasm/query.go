@@ -22,6 +22,18 @@ type MetadataQueryParams struct {
 	GetSessions *GetSessionsParams `json:"get_sessions,omitempty"`
 	// Get records by scope ID and name (optional).
 	GetRecords *GetRecordsParams `json:"get_records,omitempty"`
+	// Get a scope specification by ID.
+	GetScopeSpecification *GetScopeSpecificationParams `json:"get_scope_specification,omitempty"`
+	// Get a contract specification by ID.
+	GetContractSpecification *GetContractSpecificationParams `json:"get_contract_specification,omitempty"`
+	// Get a record specification by ID.
+	GetRecordSpecification *GetRecordSpecificationParams `json:"get_record_specification,omitempty"`
+	// Get the record specifications of a contract specification.
+	GetRecordSpecifications *GetRecordSpecificationsParams `json:"get_record_specifications,omitempty"`
+	// Get object store locators by owner address.
+	GetOSLocators *GetOSLocatorsParams `json:"get_os_locators,omitempty"`
+	// Get the object store locators of a scope's parties.
+	GetOSLocatorsByScope *GetOSLocatorsByScopeParams `json:"get_os_locators_by_scope,omitempty"`
 }
 
 // GetScopeParams are the inputs for a scope query.
@@ -44,6 +56,42 @@ type GetRecordsParams struct {
 	Name string `json:"name,omitempty"`
 }
 
+// GetScopeSpecificationParams are the inputs for a scope specification query.
+type GetScopeSpecificationParams struct {
+	// The bech32 address of the scope specification we want to get.
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetContractSpecificationParams are the inputs for a contract specification query.
+type GetContractSpecificationParams struct {
+	// The bech32 address of the contract specification we want to get.
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetRecordSpecificationParams are the inputs for a record specification query.
+type GetRecordSpecificationParams struct {
+	// The bech32 address of the record specification we want to get.
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetRecordSpecificationsParams are the inputs for a record specifications query.
+type GetRecordSpecificationsParams struct {
+	// The bech32 address of the contract specification we want to get record specifications for.
+	ContractSpecificationID string `json:"contract_specification_id"`
+}
+
+// GetOSLocatorsParams are the inputs for an object store locators query.
+type GetOSLocatorsParams struct {
+	// The bech32 address of the locator owner.
+	Owner string `json:"owner"`
+}
+
+// GetOSLocatorsByScopeParams are the inputs for a scope object store locators query.
+type GetOSLocatorsByScopeParams struct {
+	// The bech32 address of the scope we want to get locators for.
+	ScopeID string `json:"scope_id"`
+}
+
 // Querier returns a smart contract querier for the metadata module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -64,6 +112,18 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.GetSessions.Run(ctx, keeper)
 		case params.GetRecords != nil:
 			return params.GetRecords.Run(ctx, keeper)
+		case params.GetScopeSpecification != nil:
+			return params.GetScopeSpecification.Run(ctx, keeper)
+		case params.GetContractSpecification != nil:
+			return params.GetContractSpecification.Run(ctx, keeper)
+		case params.GetRecordSpecification != nil:
+			return params.GetRecordSpecification.Run(ctx, keeper)
+		case params.GetRecordSpecifications != nil:
+			return params.GetRecordSpecifications.Run(ctx, keeper)
+		case params.GetOSLocators != nil:
+			return params.GetOSLocators.Run(ctx, keeper)
+		case params.GetOSLocatorsByScope != nil:
+			return params.GetOSLocatorsByScope.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid metadata query: %s", string(query))
 		}
@@ -112,3 +172,81 @@ func (params *GetRecordsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]by
 	}
 	return createRecordsResponse(records)
 }
+
+// Run gets a scope specification by ID.
+func (params *GetScopeSpecificationParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil || !specID.IsScopeSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid scope specification ID: %s", params.SpecificationID)
+	}
+	spec, found := keeper.GetScopeSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: scope specification not found: %s", params.SpecificationID)
+	}
+	return createScopeSpecificationResponse(spec)
+}
+
+// Run gets a contract specification by ID.
+func (params *GetContractSpecificationParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil || !specID.IsContractSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid contract specification ID: %s", params.SpecificationID)
+	}
+	spec, found := keeper.GetContractSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: contract specification not found: %s", params.SpecificationID)
+	}
+	return createContractSpecificationResponse(spec)
+}
+
+// Run gets a record specification by ID.
+func (params *GetRecordSpecificationParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil || !specID.IsRecordSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid record specification ID: %s", params.SpecificationID)
+	}
+	spec, found := keeper.GetRecordSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: record specification not found: %s", params.SpecificationID)
+	}
+	return createRecordSpecificationResponse(spec)
+}
+
+// Run gets the record specifications of a contract specification.
+func (params *GetRecordSpecificationsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	contractSpecID, err := types.MetadataAddressFromBech32(params.ContractSpecificationID)
+	if err != nil || !contractSpecID.IsContractSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid contract specification ID: %s", params.ContractSpecificationID)
+	}
+	specs, err := keeper.GetRecordSpecificationsForContractSpecificationID(ctx, contractSpecID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to get record specifications: %w", err)
+	}
+	return createRecordSpecificationsResponse(specs)
+}
+
+// Run gets object store locators by owner address.
+func (params *GetOSLocatorsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	owner, err := sdk.AccAddressFromBech32(params.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid owner address: %w", err)
+	}
+	locators, err := keeper.GetOSLocatorsByOwner(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to get object store locators: %w", err)
+	}
+	return createObjectStoreLocatorsResponse(locators)
+}
+
+// Run gets the object store locators of a scope's parties.
+func (params *GetOSLocatorsByScopeParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil || !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("wasm: invalid scope ID: %s", params.ScopeID)
+	}
+	locators, err := keeper.GetOSLocatorByScope(ctx, params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to get object store locators: %w", err)
+	}
+	return createObjectStoreLocatorsResponse(locators)
+}
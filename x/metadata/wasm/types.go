@@ -160,6 +160,103 @@ const (
 	ResultStatusUnspecified ResultStatus = "unspecified"
 )
 
+// ScopeSpecification defines the required parties, resources, conditions, and consideration outputs for a scope.
+type ScopeSpecification struct {
+	SpecificationID string       `json:"specification_id"`
+	Description     *Description `json:"description,omitempty"`
+	OwnerAddresses  []string     `json:"owner_addresses,omitempty"`
+	PartiesInvolved []PartyType  `json:"parties_involved,omitempty"`
+	ContractSpecIDs []string     `json:"contract_spec_ids,omitempty"`
+}
+
+// ContractSpecification defines the required parties, resources, conditions, and consideration outputs for a
+// contract.
+type ContractSpecification struct {
+	SpecificationID string                       `json:"specification_id"`
+	Description     *Description                 `json:"description,omitempty"`
+	OwnerAddresses  []string                     `json:"owner_addresses,omitempty"`
+	PartiesInvolved []PartyType                  `json:"parties_involved,omitempty"`
+	Source          *ContractSpecificationSource `json:"source"`
+	ClassName       string                       `json:"class_name"`
+}
+
+// ContractSpecificationSource is the source of a contract specification. Either resource or hash should be set,
+// but not both.
+type ContractSpecificationSource struct {
+	Resource *ContractSpecificationSourceResource `json:"resource,omitempty"`
+	Hash     *ContractSpecificationSourceHash     `json:"hash,omitempty"`
+}
+
+// ContractSpecificationSourceResource is the address of a resource on chain.
+type ContractSpecificationSourceResource struct {
+	ResourceID string `json:"resource_id"`
+}
+
+// ContractSpecificationSourceHash is the hash of an off-chain contract.
+type ContractSpecificationSourceHash struct {
+	Hash string `json:"hash"`
+}
+
+// RecordSpecifications is a group of record specifications.
+type RecordSpecifications struct {
+	RecordSpecifications []*RecordSpecification `json:"record_specifications"`
+}
+
+// RecordSpecification defines the specification for a record.
+type RecordSpecification struct {
+	SpecificationID    string                `json:"specification_id"`
+	Name               string                `json:"name"`
+	Inputs             []*InputSpecification `json:"inputs,omitempty"`
+	TypeName           string                `json:"type_name"`
+	ResultType         DefinitionType        `json:"result_type"`
+	ResponsibleParties []PartyType           `json:"responsible_parties,omitempty"`
+	Clonable           bool                  `json:"clonable,omitempty"`
+}
+
+// InputSpecification defines a name, type_name, and source reference for a record input.
+type InputSpecification struct {
+	Name     string             `json:"name"`
+	TypeName string             `json:"type_name"`
+	Source   *RecordInputSource `json:"source"`
+}
+
+// Description holds general information that is handy to associate with a structure.
+type Description struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	WebsiteURL  string `json:"website_url,omitempty"`
+	IconURL     string `json:"icon_url,omitempty"`
+}
+
+// DefinitionType indicates the required definition type for a record specification result.
+type DefinitionType string
+
+const (
+	// DefinitionTypeProposed is a concrete definition type.
+	DefinitionTypeProposed DefinitionType = "proposed"
+	// DefinitionTypeRecord is a concrete definition type.
+	DefinitionTypeRecord DefinitionType = "record"
+	// DefinitionTypeRecordList is a concrete definition type.
+	DefinitionTypeRecordList DefinitionType = "record_list"
+	// DefinitionTypeUnspecified is a concrete definition type.
+	DefinitionTypeUnspecified DefinitionType = "unspecified"
+)
+
+// ObjectStoreLocators is a group of object store locators.
+type ObjectStoreLocators struct {
+	Locators []*ObjectStoreLocator `json:"locators"`
+}
+
+// ObjectStoreLocator defines the object store endpoint used by an owner.
+type ObjectStoreLocator struct {
+	Owner         string   `json:"owner"`
+	LocatorURI    string   `json:"locator_uri"`
+	EncryptionKey string   `json:"encryption_key,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Priority      uint32   `json:"priority"`
+	ScopeSpecIDs  []string `json:"scope_spec_ids,omitempty"`
+}
+
 // A slightly modified, non-panicing version of MetadataAddress.String(). Panics across FFI
 // boundaries can crash the chain, so just fail the query.
 func bech32Address(ma types.MetadataAddress) (string, error) {
@@ -187,36 +284,183 @@ func (scope *Scope) convertToBaseType() (*types.Scope, error) {
 	if err != nil {
 		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
 	}
-	// verify the data_access addresses are valid
-	for _, addr := range scope.DataAccess {
-		_, err = sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return nil, fmt.Errorf("wasm: invalid 'data_access' address: %v", err)
-		}
+	if err = validateDataAccess(scope.DataAccess); err != nil {
+		return nil, err
 	}
 	baseType := &types.Scope{
 		ScopeId:           scopeID,
 		SpecificationId:   specificationID,
-		Owners:            make([]types.Party, len(scope.Owners)),
 		DataAccess:        scope.DataAccess,
 		ValueOwnerAddress: scope.ValueOwnerAddress,
 	}
-	for i, o := range scope.Owners {
-		party, err := o.convertToBaseType()
+	baseType.Owners, err = convertPartiesToBaseType(scope.Owners)
+	if err != nil {
+		return nil, err
+	}
+
+	return baseType, nil
+}
+
+// Convert a provwasm session into the baseType session.
+func (session *Session) convertToBaseType() (*types.Session, error) {
+	sessionID, err := types.MetadataAddressFromBech32(session.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'session id': %w", err)
+	}
+	specificationID, err := types.MetadataAddressFromBech32(session.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	parties, err := convertPartiesToBaseType(session.Parties)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Session{
+		SessionId:       sessionID,
+		SpecificationId: specificationID,
+		Parties:         parties,
+		Name:            session.Name,
+		Context:         session.Context,
+	}, nil
+}
+
+// Convert a provwasm record into the baseType record.
+func (record *Record) convertToBaseType() (*types.Record, error) {
+	sessionID, err := types.MetadataAddressFromBech32(record.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'session id': %w", err)
+	}
+	specificationID, err := types.MetadataAddressFromBech32(record.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	if record.Process == nil {
+		return nil, fmt.Errorf("wasm: process must be defined for a record")
+	}
+	process, err := record.Process.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	baseType := &types.Record{
+		Name:            record.Name,
+		SessionId:       sessionID,
+		Process:         *process,
+		Inputs:          make([]types.RecordInput, len(record.Inputs)),
+		Outputs:         make([]types.RecordOutput, len(record.Outputs)),
+		SpecificationId: specificationID,
+	}
+	for i, in := range record.Inputs {
+		input, err := in.convertToBaseType()
 		if err != nil {
 			return nil, err
 		}
-		baseType.Owners[i] = *party
+		baseType.Inputs[i] = *input
 	}
+	for i, out := range record.Outputs {
+		baseType.Outputs[i] = types.RecordOutput{
+			Hash:   out.Hash,
+			Status: out.Status.convertToBaseType(),
+		}
+	}
+	return baseType, nil
+}
 
+// Convert a provwasm process into the baseType process.
+func (process *Process) convertToBaseType() (*types.Process, error) {
+	baseType := &types.Process{
+		Name:   process.Name,
+		Method: process.Method,
+	}
+	switch {
+	case process.ProcessID != nil && process.ProcessID.Address != nil:
+		if _, err := sdk.AccAddressFromBech32(process.ProcessID.Address.Address); err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'process id' address: %v", err)
+		}
+		baseType.ProcessId = &types.Process_Address{Address: process.ProcessID.Address.Address}
+	case process.ProcessID != nil && process.ProcessID.Hash != nil:
+		baseType.ProcessId = &types.Process_Hash{Hash: process.ProcessID.Hash.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: address or hash must be defined for a process id")
+	}
 	return baseType, nil
 }
 
+// Convert a provwasm record input into the baseType record input.
+func (input *RecordInput) convertToBaseType() (*types.RecordInput, error) {
+	baseType := &types.RecordInput{
+		Name:     input.Name,
+		TypeName: input.TypeName,
+		Status:   input.Status.convertToBaseType(),
+	}
+	switch {
+	case input.Source != nil && input.Source.Record != nil:
+		recordID, err := types.MetadataAddressFromBech32(input.Source.Record.RecordID)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+		}
+		baseType.Source = &types.RecordInput_RecordId{RecordId: recordID}
+	case input.Source != nil && input.Source.Hash != nil:
+		baseType.Source = &types.RecordInput_Hash{Hash: input.Source.Hash.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: hash or record id must be defined for a source")
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm record input status into the baseType record input status.
+func (status *InputStatus) convertToBaseType() types.RecordInputStatus {
+	switch *status {
+	case InputStatusProposed:
+		return types.RecordInputStatus_Proposed
+	case InputStatusRecord:
+		return types.RecordInputStatus_Record
+	default:
+		return types.RecordInputStatus_Unknown
+	}
+}
+
+// Convert a provwasm result status into the baseType result status.
+func (status *ResultStatus) convertToBaseType() types.ResultStatus {
+	switch *status {
+	case ResultStatusPass:
+		return types.ResultStatus_RESULT_STATUS_PASS
+	case ResultStatusFail:
+		return types.ResultStatus_RESULT_STATUS_FAIL
+	case ResultStatusSkip:
+		return types.ResultStatus_RESULT_STATUS_SKIP
+	default:
+		return types.ResultStatus_RESULT_STATUS_UNSPECIFIED
+	}
+}
+
+// Convert a slice of provwasm parties into baseType parties.
+func convertPartiesToBaseType(parties []*Party) ([]types.Party, error) {
+	baseTypes := make([]types.Party, len(parties))
+	for i, p := range parties {
+		party, err := p.convertToBaseType()
+		if err != nil {
+			return nil, err
+		}
+		baseTypes[i] = *party
+	}
+	return baseTypes, nil
+}
+
+// Verify that data access addresses are valid.
+func validateDataAccess(addresses []string) error {
+	for _, addr := range addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("wasm: invalid 'data_access' address: %v", err)
+		}
+	}
+	return nil
+}
+
 // Convert a provwasm party into the baseType party.
 func (party *Party) convertToBaseType() (*types.Party, error) {
 	_, err := sdk.AccAddressFromBech32(party.Address)
 	if err != nil {
-		return nil, fmt.Errorf("wasm: invalid 'data_access' address: %v", err)
+		return nil, fmt.Errorf("wasm: invalid party address: %v", err)
 	}
 	return &types.Party{
 		Address: party.Address,
@@ -483,3 +727,208 @@ func createResultStatus(baseType types.ResultStatus) ResultStatus {
 		return ResultStatusUnspecified
 	}
 }
+
+// Convert a scope specification into provwasm JSON format.
+func createScopeSpecificationResponse(baseType types.ScopeSpecification) ([]byte, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &ScopeSpecification{
+		SpecificationID: specificationID,
+		Description:     createDescription(baseType.Description),
+		OwnerAddresses:  baseType.OwnerAddresses,
+		PartiesInvolved: createRoles(baseType.PartiesInvolved),
+		ContractSpecIDs: make([]string, len(baseType.ContractSpecIds)),
+	}
+	for i, id := range baseType.ContractSpecIds {
+		spec.ContractSpecIDs[i], err = bech32Address(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	bz, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal scope specification failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a contract specification into provwasm JSON format.
+func createContractSpecificationResponse(baseType types.ContractSpecification) ([]byte, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	source := &ContractSpecificationSource{}
+	switch s := baseType.GetSource().(type) {
+	case *types.ContractSpecification_ResourceId:
+		resourceID, err := bech32Address(s.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		source.Resource = &ContractSpecificationSourceResource{ResourceID: resourceID}
+	case *types.ContractSpecification_Hash:
+		source.Hash = &ContractSpecificationSourceHash{Hash: s.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: resource id or hash must be defined for a contract specification source")
+	}
+	spec := &ContractSpecification{
+		SpecificationID: specificationID,
+		Description:     createDescription(baseType.Description),
+		OwnerAddresses:  baseType.OwnerAddresses,
+		PartiesInvolved: createRoles(baseType.PartiesInvolved),
+		Source:          source,
+		ClassName:       baseType.ClassName,
+	}
+	bz, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal contract specification failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a record specification into provwasm JSON format.
+func createRecordSpecificationResponse(baseType types.RecordSpecification) ([]byte, error) {
+	spec, err := createRecordSpecification(&baseType)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal record specification failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a slice of record specifications into provwasm JSON format.
+func createRecordSpecificationsResponse(baseTypeSlice []*types.RecordSpecification) ([]byte, error) {
+	specs := &RecordSpecifications{
+		RecordSpecifications: make([]*RecordSpecification, len(baseTypeSlice)),
+	}
+	for i, baseType := range baseTypeSlice {
+		spec, err := createRecordSpecification(baseType)
+		if err != nil {
+			return nil, err
+		}
+		specs.RecordSpecifications[i] = spec
+	}
+	bz, err := json.Marshal(specs)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal record specifications failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a record specification into its provwasm type.
+func createRecordSpecification(baseType *types.RecordSpecification) (*RecordSpecification, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &RecordSpecification{
+		SpecificationID:    specificationID,
+		Name:               baseType.Name,
+		Inputs:             make([]*InputSpecification, len(baseType.Inputs)),
+		TypeName:           baseType.TypeName,
+		ResultType:         createDefinitionType(baseType.ResultType),
+		ResponsibleParties: createRoles(baseType.ResponsibleParties),
+		Clonable:           baseType.Clonable,
+	}
+	for i, in := range baseType.Inputs {
+		input, err := createInputSpecification(in)
+		if err != nil {
+			return nil, err
+		}
+		spec.Inputs[i] = input
+	}
+	return spec, nil
+}
+
+// Convert an input specification into its provwasm type.
+func createInputSpecification(baseType *types.InputSpecification) (*InputSpecification, error) {
+	source := &RecordInputSource{}
+	switch s := baseType.GetSource().(type) {
+	case *types.InputSpecification_RecordId:
+		recordID, err := bech32Address(s.RecordId)
+		if err != nil {
+			return nil, err
+		}
+		source.Record = &RecordInputSourceRecord{RecordID: recordID}
+	case *types.InputSpecification_Hash:
+		source.Hash = &RecordInputSourceHash{Hash: s.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: hash or record id must be defined for an input specification source")
+	}
+	return &InputSpecification{
+		Name:     baseType.Name,
+		TypeName: baseType.TypeName,
+		Source:   source,
+	}, nil
+}
+
+// Convert a slice of object store locators into provwasm JSON format.
+func createObjectStoreLocatorsResponse(baseTypeSlice []types.ObjectStoreLocator) ([]byte, error) {
+	locators := &ObjectStoreLocators{
+		Locators: make([]*ObjectStoreLocator, len(baseTypeSlice)),
+	}
+	for i, baseType := range baseTypeSlice {
+		locator := &ObjectStoreLocator{
+			Owner:         baseType.Owner,
+			LocatorURI:    baseType.LocatorUri,
+			EncryptionKey: baseType.EncryptionKey,
+			Name:          baseType.Name,
+			Priority:      baseType.Priority,
+			ScopeSpecIDs:  make([]string, len(baseType.ScopeSpecIds)),
+		}
+		for j, id := range baseType.ScopeSpecIds {
+			scopeSpecID, err := bech32Address(id)
+			if err != nil {
+				return nil, err
+			}
+			locator.ScopeSpecIDs[j] = scopeSpecID
+		}
+		locators.Locators[i] = locator
+	}
+	bz, err := json.Marshal(locators)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal object store locators failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a description into its provwasm type.
+func createDescription(baseType *types.Description) *Description {
+	if baseType == nil {
+		return nil
+	}
+	return &Description{
+		Name:        baseType.Name,
+		Description: baseType.Description,
+		WebsiteURL:  baseType.WebsiteUrl,
+		IconURL:     baseType.IconUrl,
+	}
+}
+
+// Convert a slice of party types into their provwasm type.
+func createRoles(baseTypes []types.PartyType) []PartyType {
+	roles := make([]PartyType, len(baseTypes))
+	for i, role := range baseTypes {
+		roles[i] = createRole(role)
+	}
+	return roles
+}
+
+// Convert a definition type into its provwasm type.
+func createDefinitionType(baseType types.DefinitionType) DefinitionType {
+	switch baseType {
+	case types.DefinitionType_DEFINITION_TYPE_PROPOSED:
+		return DefinitionTypeProposed
+	case types.DefinitionType_DEFINITION_TYPE_RECORD:
+		return DefinitionTypeRecord
+	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
+		return DefinitionTypeRecordList
+	default:
+		return DefinitionTypeUnspecified
+	}
+}